@@ -0,0 +1,288 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.2
+// source: scheduler/v1/error_reason.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 错误原因，作为 kratos 错误的 reason 返回，HTTP 状态码和 gRPC 状态码见各值的注释
+type ErrorReason int32
+
+const (
+	ErrorReason_SCHEDULER_UNSPECIFIED ErrorReason = 0
+	// 通用
+	ErrorReason_INTERNAL           ErrorReason = 1 // 500 / Internal：未预期的服务端错误，详细信息只记录在日志中
+	ErrorReason_DEADLINE_EXCEEDED  ErrorReason = 2 // 504 / DeadlineExceeded：请求处理超时
+	ErrorReason_CLIENT_CLOSED      ErrorReason = 3 // 499 / Canceled：客户端取消了请求
+	ErrorReason_VALIDATOR          ErrorReason = 4 // 400 / InvalidArgument：请求不满足 scheduler.proto 中的校验规则，由 validate 中间件返回
+	ErrorReason_INVALID_PAGE_TOKEN ErrorReason = 5 // 400 / InvalidArgument：page_token 无法解析，或与请求的排序和筛选条件不一致
+	ErrorReason_INVALID_ORDER_BY   ErrorReason = 6 // 400 / InvalidArgument：列表不支持该排序字段
+	// 认证和授权
+	ErrorReason_UNAUTHENTICATED   ErrorReason = 10 // 401 / Unauthenticated：缺少或无效的凭证
+	ErrorReason_PERMISSION_DENIED ErrorReason = 11 // 403 / PermissionDenied：权限不足，metadata.permission 为缺少的权限
+	// 任务
+	ErrorReason_TASK_NOT_FOUND           ErrorReason = 20 // 404 / NotFound
+	ErrorReason_TASK_NOT_DELETED         ErrorReason = 21 // 409 / Aborted：恢复或彻底删除未删除的任务
+	ErrorReason_TASK_VERSION_REQUIRED    ErrorReason = 22 // 400 / InvalidArgument：更新任务时未提供版本
+	ErrorReason_TASK_VERSION_MISMATCH    ErrorReason = 23 // 409 / Aborted：任务已被修改，metadata.current_version 为当前版本
+	ErrorReason_TASK_REVISION_NOT_FOUND  ErrorReason = 24 // 404 / NotFound
+	ErrorReason_INVALID_TASK             ErrorReason = 25 // 400 / InvalidArgument：任务定义不合法
+	ErrorReason_INVALID_SCHEDULE         ErrorReason = 26 // 400 / InvalidArgument：调度配置无法计算触发时间
+	ErrorReason_INVALID_UPDATE_MASK      ErrorReason = 27 // 400 / InvalidArgument
+	ErrorReason_INVALID_IF_MATCH         ErrorReason = 28 // 400 / InvalidArgument：If-Match 请求头不是合法的版本
+	ErrorReason_INVALID_PRIORITY         ErrorReason = 29 // 400 / InvalidArgument
+	ErrorReason_INVALID_PAYLOAD          ErrorReason = 30 // 400 / InvalidArgument
+	ErrorReason_INVALID_STATE_TRANSITION ErrorReason = 31 // 409 / Aborted：当前状态不允许该操作，metadata.status 为当前状态
+	// 执行记录
+	ErrorReason_EXECUTION_NOT_FOUND ErrorReason = 40 // 404 / NotFound
+	// 日历
+	ErrorReason_CALENDAR_NOT_FOUND ErrorReason = 50 // 404 / NotFound
+	ErrorReason_CALENDAR_IN_USE    ErrorReason = 51 // 409 / Aborted：日历仍被任务引用
+	ErrorReason_INVALID_CALENDAR   ErrorReason = 52 // 400 / InvalidArgument
+	ErrorReason_INVALID_ICALENDAR  ErrorReason = 53 // 400 / InvalidArgument
+	// 资源池
+	ErrorReason_RESOURCE_POOL_NOT_FOUND ErrorReason = 60 // 404 / NotFound
+	ErrorReason_INVALID_RESOURCE_POOL   ErrorReason = 61 // 400 / InvalidArgument
+	// 命名空间
+	ErrorReason_NAMESPACE_NOT_FOUND      ErrorReason = 70 // 404 / NotFound
+	ErrorReason_NAMESPACE_EXISTS         ErrorReason = 71 // 409 / Aborted
+	ErrorReason_NAMESPACE_NOT_EMPTY      ErrorReason = 72 // 409 / Aborted：命名空间中还有任务或密钥
+	ErrorReason_DEFAULT_NAMESPACE        ErrorReason = 73 // 400 / InvalidArgument：默认命名空间不能删除
+	ErrorReason_NAMESPACE_MISMATCH       ErrorReason = 74 // 400 / InvalidArgument：请求的命名空间与 X-Namespace 不一致
+	ErrorReason_INVALID_NAMESPACE        ErrorReason = 75 // 400 / InvalidArgument
+	ErrorReason_NAMESPACE_QUOTA_EXCEEDED ErrorReason = 76 // 429 / ResourceExhausted：metadata.quota 为超出的配额
+	// 密钥
+	ErrorReason_SECRET_NOT_FOUND     ErrorReason = 80 // 404 / NotFound
+	ErrorReason_SECRET_EXISTS        ErrorReason = 81 // 409 / Aborted
+	ErrorReason_INVALID_SECRET       ErrorReason = 82 // 400 / InvalidArgument
+	ErrorReason_SECRETS_DISABLED     ErrorReason = 83 // 501 / Unimplemented：未配置主密钥文件
+	ErrorReason_SECRET_REWRAP_FAILED ErrorReason = 84 // 500 / Internal：主密钥轮换时无法解密数据密钥
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0:  "SCHEDULER_UNSPECIFIED",
+		1:  "INTERNAL",
+		2:  "DEADLINE_EXCEEDED",
+		3:  "CLIENT_CLOSED",
+		4:  "VALIDATOR",
+		5:  "INVALID_PAGE_TOKEN",
+		6:  "INVALID_ORDER_BY",
+		10: "UNAUTHENTICATED",
+		11: "PERMISSION_DENIED",
+		20: "TASK_NOT_FOUND",
+		21: "TASK_NOT_DELETED",
+		22: "TASK_VERSION_REQUIRED",
+		23: "TASK_VERSION_MISMATCH",
+		24: "TASK_REVISION_NOT_FOUND",
+		25: "INVALID_TASK",
+		26: "INVALID_SCHEDULE",
+		27: "INVALID_UPDATE_MASK",
+		28: "INVALID_IF_MATCH",
+		29: "INVALID_PRIORITY",
+		30: "INVALID_PAYLOAD",
+		31: "INVALID_STATE_TRANSITION",
+		40: "EXECUTION_NOT_FOUND",
+		50: "CALENDAR_NOT_FOUND",
+		51: "CALENDAR_IN_USE",
+		52: "INVALID_CALENDAR",
+		53: "INVALID_ICALENDAR",
+		60: "RESOURCE_POOL_NOT_FOUND",
+		61: "INVALID_RESOURCE_POOL",
+		70: "NAMESPACE_NOT_FOUND",
+		71: "NAMESPACE_EXISTS",
+		72: "NAMESPACE_NOT_EMPTY",
+		73: "DEFAULT_NAMESPACE",
+		74: "NAMESPACE_MISMATCH",
+		75: "INVALID_NAMESPACE",
+		76: "NAMESPACE_QUOTA_EXCEEDED",
+		80: "SECRET_NOT_FOUND",
+		81: "SECRET_EXISTS",
+		82: "INVALID_SECRET",
+		83: "SECRETS_DISABLED",
+		84: "SECRET_REWRAP_FAILED",
+	}
+	ErrorReason_value = map[string]int32{
+		"SCHEDULER_UNSPECIFIED":    0,
+		"INTERNAL":                 1,
+		"DEADLINE_EXCEEDED":        2,
+		"CLIENT_CLOSED":            3,
+		"VALIDATOR":                4,
+		"INVALID_PAGE_TOKEN":       5,
+		"INVALID_ORDER_BY":         6,
+		"UNAUTHENTICATED":          10,
+		"PERMISSION_DENIED":        11,
+		"TASK_NOT_FOUND":           20,
+		"TASK_NOT_DELETED":         21,
+		"TASK_VERSION_REQUIRED":    22,
+		"TASK_VERSION_MISMATCH":    23,
+		"TASK_REVISION_NOT_FOUND":  24,
+		"INVALID_TASK":             25,
+		"INVALID_SCHEDULE":         26,
+		"INVALID_UPDATE_MASK":      27,
+		"INVALID_IF_MATCH":         28,
+		"INVALID_PRIORITY":         29,
+		"INVALID_PAYLOAD":          30,
+		"INVALID_STATE_TRANSITION": 31,
+		"EXECUTION_NOT_FOUND":      40,
+		"CALENDAR_NOT_FOUND":       50,
+		"CALENDAR_IN_USE":          51,
+		"INVALID_CALENDAR":         52,
+		"INVALID_ICALENDAR":        53,
+		"RESOURCE_POOL_NOT_FOUND":  60,
+		"INVALID_RESOURCE_POOL":    61,
+		"NAMESPACE_NOT_FOUND":      70,
+		"NAMESPACE_EXISTS":         71,
+		"NAMESPACE_NOT_EMPTY":      72,
+		"DEFAULT_NAMESPACE":        73,
+		"NAMESPACE_MISMATCH":       74,
+		"INVALID_NAMESPACE":        75,
+		"NAMESPACE_QUOTA_EXCEEDED": 76,
+		"SECRET_NOT_FOUND":         80,
+		"SECRET_EXISTS":            81,
+		"INVALID_SECRET":           82,
+		"SECRETS_DISABLED":         83,
+		"SECRET_REWRAP_FAILED":     84,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_scheduler_v1_error_reason_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_scheduler_v1_error_reason_proto_enumTypes[0]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_scheduler_v1_error_reason_proto_rawDescGZIP(), []int{0}
+}
+
+var File_scheduler_v1_error_reason_proto protoreflect.FileDescriptor
+
+const file_scheduler_v1_error_reason_proto_rawDesc = "" +
+	"\n" +
+	"\x1fscheduler/v1/error_reason.proto\x12\fscheduler.v1*\xaa\a\n" +
+	"\vErrorReason\x12\x19\n" +
+	"\x15SCHEDULER_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bINTERNAL\x10\x01\x12\x15\n" +
+	"\x11DEADLINE_EXCEEDED\x10\x02\x12\x11\n" +
+	"\rCLIENT_CLOSED\x10\x03\x12\r\n" +
+	"\tVALIDATOR\x10\x04\x12\x16\n" +
+	"\x12INVALID_PAGE_TOKEN\x10\x05\x12\x14\n" +
+	"\x10INVALID_ORDER_BY\x10\x06\x12\x13\n" +
+	"\x0fUNAUTHENTICATED\x10\n" +
+	"\x12\x15\n" +
+	"\x11PERMISSION_DENIED\x10\v\x12\x12\n" +
+	"\x0eTASK_NOT_FOUND\x10\x14\x12\x14\n" +
+	"\x10TASK_NOT_DELETED\x10\x15\x12\x19\n" +
+	"\x15TASK_VERSION_REQUIRED\x10\x16\x12\x19\n" +
+	"\x15TASK_VERSION_MISMATCH\x10\x17\x12\x1b\n" +
+	"\x17TASK_REVISION_NOT_FOUND\x10\x18\x12\x10\n" +
+	"\fINVALID_TASK\x10\x19\x12\x14\n" +
+	"\x10INVALID_SCHEDULE\x10\x1a\x12\x17\n" +
+	"\x13INVALID_UPDATE_MASK\x10\x1b\x12\x14\n" +
+	"\x10INVALID_IF_MATCH\x10\x1c\x12\x14\n" +
+	"\x10INVALID_PRIORITY\x10\x1d\x12\x13\n" +
+	"\x0fINVALID_PAYLOAD\x10\x1e\x12\x1c\n" +
+	"\x18INVALID_STATE_TRANSITION\x10\x1f\x12\x17\n" +
+	"\x13EXECUTION_NOT_FOUND\x10(\x12\x16\n" +
+	"\x12CALENDAR_NOT_FOUND\x102\x12\x13\n" +
+	"\x0fCALENDAR_IN_USE\x103\x12\x14\n" +
+	"\x10INVALID_CALENDAR\x104\x12\x15\n" +
+	"\x11INVALID_ICALENDAR\x105\x12\x1b\n" +
+	"\x17RESOURCE_POOL_NOT_FOUND\x10<\x12\x19\n" +
+	"\x15INVALID_RESOURCE_POOL\x10=\x12\x17\n" +
+	"\x13NAMESPACE_NOT_FOUND\x10F\x12\x14\n" +
+	"\x10NAMESPACE_EXISTS\x10G\x12\x17\n" +
+	"\x13NAMESPACE_NOT_EMPTY\x10H\x12\x15\n" +
+	"\x11DEFAULT_NAMESPACE\x10I\x12\x16\n" +
+	"\x12NAMESPACE_MISMATCH\x10J\x12\x15\n" +
+	"\x11INVALID_NAMESPACE\x10K\x12\x1c\n" +
+	"\x18NAMESPACE_QUOTA_EXCEEDED\x10L\x12\x14\n" +
+	"\x10SECRET_NOT_FOUND\x10P\x12\x11\n" +
+	"\rSECRET_EXISTS\x10Q\x12\x12\n" +
+	"\x0eINVALID_SECRET\x10R\x12\x14\n" +
+	"\x10SECRETS_DISABLED\x10S\x12\x18\n" +
+	"\x14SECRET_REWRAP_FAILED\x10TBG\n" +
+	"\fscheduler.v1P\x01Z$heytom-scheduler/api/scheduler/v1;v1\xa2\x02\x0eAPISchedulerV1b\x06proto3"
+
+var (
+	file_scheduler_v1_error_reason_proto_rawDescOnce sync.Once
+	file_scheduler_v1_error_reason_proto_rawDescData []byte
+)
+
+func file_scheduler_v1_error_reason_proto_rawDescGZIP() []byte {
+	file_scheduler_v1_error_reason_proto_rawDescOnce.Do(func() {
+		file_scheduler_v1_error_reason_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_scheduler_v1_error_reason_proto_rawDesc), len(file_scheduler_v1_error_reason_proto_rawDesc)))
+	})
+	return file_scheduler_v1_error_reason_proto_rawDescData
+}
+
+var file_scheduler_v1_error_reason_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_scheduler_v1_error_reason_proto_goTypes = []any{
+	(ErrorReason)(0), // 0: scheduler.v1.ErrorReason
+}
+var file_scheduler_v1_error_reason_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_scheduler_v1_error_reason_proto_init() }
+func file_scheduler_v1_error_reason_proto_init() {
+	if File_scheduler_v1_error_reason_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_scheduler_v1_error_reason_proto_rawDesc), len(file_scheduler_v1_error_reason_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_scheduler_v1_error_reason_proto_goTypes,
+		DependencyIndexes: file_scheduler_v1_error_reason_proto_depIdxs,
+		EnumInfos:         file_scheduler_v1_error_reason_proto_enumTypes,
+	}.Build()
+	File_scheduler_v1_error_reason_proto = out.File
+	file_scheduler_v1_error_reason_proto_goTypes = nil
+	file_scheduler_v1_error_reason_proto_depIdxs = nil
+}
@@ -7,10 +7,13 @@
 package v1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -80,6 +83,56 @@ func (TaskType) EnumDescriptor() ([]byte, []int) {
 	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{0}
 }
 
+// 固定间隔任务的间隔模式
+type IntervalMode int32
+
+const (
+	IntervalMode_INTERVAL_MODE_UNSPECIFIED IntervalMode = 0
+	IntervalMode_FIXED_RATE                IntervalMode = 1 // 固定频率：以上一次计划执行时间为基准，默认模式
+	IntervalMode_FIXED_DELAY               IntervalMode = 2 // 固定延迟：以上一次执行结束时间为基准
+)
+
+// Enum value maps for IntervalMode.
+var (
+	IntervalMode_name = map[int32]string{
+		0: "INTERVAL_MODE_UNSPECIFIED",
+		1: "FIXED_RATE",
+		2: "FIXED_DELAY",
+	}
+	IntervalMode_value = map[string]int32{
+		"INTERVAL_MODE_UNSPECIFIED": 0,
+		"FIXED_RATE":                1,
+		"FIXED_DELAY":               2,
+	}
+)
+
+func (x IntervalMode) Enum() *IntervalMode {
+	p := new(IntervalMode)
+	*p = x
+	return p
+}
+
+func (x IntervalMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IntervalMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_scheduler_v1_scheduler_proto_enumTypes[1].Descriptor()
+}
+
+func (IntervalMode) Type() protoreflect.EnumType {
+	return &file_scheduler_v1_scheduler_proto_enumTypes[1]
+}
+
+func (x IntervalMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IntervalMode.Descriptor instead.
+func (IntervalMode) EnumDescriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{1}
+}
+
 // 任务状态枚举
 type TaskStatus int32
 
@@ -126,11 +179,11 @@ func (x TaskStatus) String() string {
 }
 
 func (TaskStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_scheduler_v1_scheduler_proto_enumTypes[1].Descriptor()
+	return file_scheduler_v1_scheduler_proto_enumTypes[2].Descriptor()
 }
 
 func (TaskStatus) Type() protoreflect.EnumType {
-	return &file_scheduler_v1_scheduler_proto_enumTypes[1]
+	return &file_scheduler_v1_scheduler_proto_enumTypes[2]
 }
 
 func (x TaskStatus) Number() protoreflect.EnumNumber {
@@ -139,7 +192,7 @@ func (x TaskStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TaskStatus.Descriptor instead.
 func (TaskStatus) EnumDescriptor() ([]byte, []int) {
-	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{1}
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{2}
 }
 
 // 执行状态枚举
@@ -153,6 +206,7 @@ const (
 	ExecutionStatus_EXECUTION_FAILED             ExecutionStatus = 4 // 失败
 	ExecutionStatus_TIMEOUT                      ExecutionStatus = 5 // 超时
 	ExecutionStatus_EXECUTION_CANCELLED          ExecutionStatus = 6 // 已取消
+	ExecutionStatus_EXECUTION_SKIPPED            ExecutionStatus = 7 // 已跳过（互斥组被占用且并发策略为 SKIP）
 )
 
 // Enum value maps for ExecutionStatus.
@@ -165,6 +219,7 @@ var (
 		4: "EXECUTION_FAILED",
 		5: "TIMEOUT",
 		6: "EXECUTION_CANCELLED",
+		7: "EXECUTION_SKIPPED",
 	}
 	ExecutionStatus_value = map[string]int32{
 		"EXECUTION_STATUS_UNSPECIFIED": 0,
@@ -174,6 +229,7 @@ var (
 		"EXECUTION_FAILED":             4,
 		"TIMEOUT":                      5,
 		"EXECUTION_CANCELLED":          6,
+		"EXECUTION_SKIPPED":            7,
 	}
 )
 
@@ -188,11 +244,11 @@ func (x ExecutionStatus) String() string {
 }
 
 func (ExecutionStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_scheduler_v1_scheduler_proto_enumTypes[2].Descriptor()
+	return file_scheduler_v1_scheduler_proto_enumTypes[3].Descriptor()
 }
 
 func (ExecutionStatus) Type() protoreflect.EnumType {
-	return &file_scheduler_v1_scheduler_proto_enumTypes[2]
+	return &file_scheduler_v1_scheduler_proto_enumTypes[3]
 }
 
 func (x ExecutionStatus) Number() protoreflect.EnumNumber {
@@ -201,7 +257,210 @@ func (x ExecutionStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ExecutionStatus.Descriptor instead.
 func (ExecutionStatus) EnumDescriptor() ([]byte, []int) {
-	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{2}
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{3}
+}
+
+// 互斥组被占用时的并发策略
+type ConcurrencyPolicy int32
+
+const (
+	ConcurrencyPolicy_CONCURRENCY_POLICY_UNSPECIFIED ConcurrencyPolicy = 0
+	ConcurrencyPolicy_WAIT                           ConcurrencyPolicy = 1 // 继续排队，等待互斥组释放，默认策略
+	ConcurrencyPolicy_SKIP                           ConcurrencyPolicy = 2 // 跳过本次执行
+)
+
+// Enum value maps for ConcurrencyPolicy.
+var (
+	ConcurrencyPolicy_name = map[int32]string{
+		0: "CONCURRENCY_POLICY_UNSPECIFIED",
+		1: "WAIT",
+		2: "SKIP",
+	}
+	ConcurrencyPolicy_value = map[string]int32{
+		"CONCURRENCY_POLICY_UNSPECIFIED": 0,
+		"WAIT":                           1,
+		"SKIP":                           2,
+	}
+)
+
+func (x ConcurrencyPolicy) Enum() *ConcurrencyPolicy {
+	p := new(ConcurrencyPolicy)
+	*p = x
+	return p
+}
+
+func (x ConcurrencyPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConcurrencyPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_scheduler_v1_scheduler_proto_enumTypes[4].Descriptor()
+}
+
+func (ConcurrencyPolicy) Type() protoreflect.EnumType {
+	return &file_scheduler_v1_scheduler_proto_enumTypes[4]
+}
+
+func (x ConcurrencyPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConcurrencyPolicy.Descriptor instead.
+func (ConcurrencyPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{4}
+}
+
+// 任务修订动作枚举
+type TaskRevisionAction int32
+
+const (
+	TaskRevisionAction_TASK_REVISION_ACTION_UNSPECIFIED TaskRevisionAction = 0
+	TaskRevisionAction_REVISION_CREATED                 TaskRevisionAction = 1 // 创建任务
+	TaskRevisionAction_REVISION_UPDATED                 TaskRevisionAction = 2 // 更新任务
+	TaskRevisionAction_REVISION_ROLLED_BACK             TaskRevisionAction = 3 // 回滚任务
+)
+
+// Enum value maps for TaskRevisionAction.
+var (
+	TaskRevisionAction_name = map[int32]string{
+		0: "TASK_REVISION_ACTION_UNSPECIFIED",
+		1: "REVISION_CREATED",
+		2: "REVISION_UPDATED",
+		3: "REVISION_ROLLED_BACK",
+	}
+	TaskRevisionAction_value = map[string]int32{
+		"TASK_REVISION_ACTION_UNSPECIFIED": 0,
+		"REVISION_CREATED":                 1,
+		"REVISION_UPDATED":                 2,
+		"REVISION_ROLLED_BACK":             3,
+	}
+)
+
+func (x TaskRevisionAction) Enum() *TaskRevisionAction {
+	p := new(TaskRevisionAction)
+	*p = x
+	return p
+}
+
+func (x TaskRevisionAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskRevisionAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_scheduler_v1_scheduler_proto_enumTypes[5].Descriptor()
+}
+
+func (TaskRevisionAction) Type() protoreflect.EnumType {
+	return &file_scheduler_v1_scheduler_proto_enumTypes[5]
+}
+
+func (x TaskRevisionAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskRevisionAction.Descriptor instead.
+func (TaskRevisionAction) EnumDescriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{5}
+}
+
+// 审计结果枚举
+type AuditResult int32
+
+const (
+	AuditResult_AUDIT_RESULT_UNSPECIFIED AuditResult = 0
+	AuditResult_AUDIT_SUCCESS            AuditResult = 1 // 调用成功
+	AuditResult_AUDIT_FAILURE            AuditResult = 2 // 调用失败
+)
+
+// Enum value maps for AuditResult.
+var (
+	AuditResult_name = map[int32]string{
+		0: "AUDIT_RESULT_UNSPECIFIED",
+		1: "AUDIT_SUCCESS",
+		2: "AUDIT_FAILURE",
+	}
+	AuditResult_value = map[string]int32{
+		"AUDIT_RESULT_UNSPECIFIED": 0,
+		"AUDIT_SUCCESS":            1,
+		"AUDIT_FAILURE":            2,
+	}
+)
+
+func (x AuditResult) Enum() *AuditResult {
+	p := new(AuditResult)
+	*p = x
+	return p
+}
+
+func (x AuditResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_scheduler_v1_scheduler_proto_enumTypes[6].Descriptor()
+}
+
+func (AuditResult) Type() protoreflect.EnumType {
+	return &file_scheduler_v1_scheduler_proto_enumTypes[6]
+}
+
+func (x AuditResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditResult.Descriptor instead.
+func (AuditResult) EnumDescriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{6}
+}
+
+// 日历规则动作枚举
+type CalendarRuleAction int32
+
+const (
+	CalendarRuleAction_CALENDAR_RULE_ACTION_UNSPECIFIED CalendarRuleAction = 0
+	CalendarRuleAction_EXCLUDE                          CalendarRuleAction = 1 // 排除：命中的日期不执行
+	CalendarRuleAction_INCLUDE                          CalendarRuleAction = 2 // 包含：命中的日期强制可执行，优先于排除规则
+)
+
+// Enum value maps for CalendarRuleAction.
+var (
+	CalendarRuleAction_name = map[int32]string{
+		0: "CALENDAR_RULE_ACTION_UNSPECIFIED",
+		1: "EXCLUDE",
+		2: "INCLUDE",
+	}
+	CalendarRuleAction_value = map[string]int32{
+		"CALENDAR_RULE_ACTION_UNSPECIFIED": 0,
+		"EXCLUDE":                          1,
+		"INCLUDE":                          2,
+	}
+)
+
+func (x CalendarRuleAction) Enum() *CalendarRuleAction {
+	p := new(CalendarRuleAction)
+	*p = x
+	return p
+}
+
+func (x CalendarRuleAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CalendarRuleAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_scheduler_v1_scheduler_proto_enumTypes[7].Descriptor()
+}
+
+func (CalendarRuleAction) Type() protoreflect.EnumType {
+	return &file_scheduler_v1_scheduler_proto_enumTypes[7]
+}
+
+func (x CalendarRuleAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CalendarRuleAction.Descriptor instead.
+func (CalendarRuleAction) EnumDescriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{7}
 }
 
 // 创建任务请求
@@ -214,13 +473,26 @@ type CreateTaskRequest struct {
 	// - IMMEDIATE: 可为空
 	// - SCHEDULED: RFC3339格式时间戳，如 "2024-12-31T15:04:05Z"
 	// - CRON: Cron表达式，如 "0 */5 * * * *"
-	// - INTERVAL: 间隔秒数，如 "300" 表示每5分钟
-	Handler       string            `protobuf:"bytes,5,opt,name=handler,proto3" json:"handler,omitempty"`                                                                             // 处理器名称
-	Payload       string            `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`                                                                             // 任务负载（JSON格式）
-	Timeout       int32             `protobuf:"varint,7,opt,name=timeout,proto3" json:"timeout,omitempty"`                                                                            // 超时时间（秒）
-	Metadata      map[string]string `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 元数据
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// - INTERVAL: Go 时长格式或间隔秒数，如 "90s"、"1h30m"、"300"，最小 1s
+	Handler           string                 `protobuf:"bytes,5,opt,name=handler,proto3" json:"handler,omitempty"`                                                                                    // 处理器名称
+	Payload           string                 `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`                                                                                    // 任务负载（JSON格式）
+	Timeout           int32                  `protobuf:"varint,7,opt,name=timeout,proto3" json:"timeout,omitempty"`                                                                                   // 超时时间（秒）
+	Metadata          map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`        // 元数据
+	CalendarId        int64                  `protobuf:"varint,9,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`                                                           // 业务日历ID，跳过日历排除的日期
+	StartTime         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                                                              // 生效开始时间，之前不执行
+	EndTime           *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                                                                    // 生效结束时间，之后不再执行，任务自动完成
+	ActiveWindows     []*TimeWindow          `protobuf:"bytes,12,rep,name=active_windows,json=activeWindows,proto3" json:"active_windows,omitempty"`                                                  // 每日执行时间窗口，为空表示全天
+	MaxRuns           int64                  `protobuf:"varint,13,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`                                                                   // 最大执行次数，达到后任务自动完成，0 表示不限制
+	IntervalMode      IntervalMode           `protobuf:"varint,14,opt,name=interval_mode,json=intervalMode,proto3,enum=scheduler.v1.IntervalMode" json:"interval_mode,omitempty"`                     // INTERVAL 任务的间隔模式，默认固定频率
+	InitialDelay      *durationpb.Duration   `protobuf:"bytes,15,opt,name=initial_delay,json=initialDelay,proto3" json:"initial_delay,omitempty"`                                                     // INTERVAL 任务首次执行前的延迟
+	Jitter            *durationpb.Duration   `protobuf:"bytes,16,opt,name=jitter,proto3" json:"jitter,omitempty"`                                                                                     // 每次执行时间附加的随机延迟上限，用于分散负载
+	Priority          int32                  `protobuf:"varint,17,opt,name=priority,proto3" json:"priority,omitempty"`                                                                                // 优先级 0-9，数值越大越优先执行，默认 0
+	LockGroup         string                 `protobuf:"bytes,18,opt,name=lock_group,json=lockGroup,proto3" json:"lock_group,omitempty"`                                                              // 互斥组，同组任务在集群内不会同时执行
+	ConcurrencyPolicy ConcurrencyPolicy      `protobuf:"varint,19,opt,name=concurrency_policy,json=concurrencyPolicy,proto3,enum=scheduler.v1.ConcurrencyPolicy" json:"concurrency_policy,omitempty"` // 互斥组被占用时的并发策略，默认 WAIT
+	Retention         *ExecutionRetention    `protobuf:"bytes,20,opt,name=retention,proto3" json:"retention,omitempty"`                                                                               // 执行记录保留策略，未设置的字段使用全局配置
+	Namespace         string                 `protobuf:"bytes,21,opt,name=namespace,proto3" json:"namespace,omitempty"`                                                                               // 所属命名空间，为空时使用请求头 X-Namespace 或 default，创建后不能修改
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CreateTaskRequest) Reset() {
@@ -309,28 +581,120 @@ func (x *CreateTaskRequest) GetMetadata() map[string]string {
 	return nil
 }
 
-// 获取任务请求
-type GetTaskRequest struct {
+func (x *CreateTaskRequest) GetCalendarId() int64 {
+	if x != nil {
+		return x.CalendarId
+	}
+	return 0
+}
+
+func (x *CreateTaskRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetActiveWindows() []*TimeWindow {
+	if x != nil {
+		return x.ActiveWindows
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetMaxRuns() int64 {
+	if x != nil {
+		return x.MaxRuns
+	}
+	return 0
+}
+
+func (x *CreateTaskRequest) GetIntervalMode() IntervalMode {
+	if x != nil {
+		return x.IntervalMode
+	}
+	return IntervalMode_INTERVAL_MODE_UNSPECIFIED
+}
+
+func (x *CreateTaskRequest) GetInitialDelay() *durationpb.Duration {
+	if x != nil {
+		return x.InitialDelay
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetJitter() *durationpb.Duration {
+	if x != nil {
+		return x.Jitter
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CreateTaskRequest) GetLockGroup() string {
+	if x != nil {
+		return x.LockGroup
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetConcurrencyPolicy() ConcurrencyPolicy {
+	if x != nil {
+		return x.ConcurrencyPolicy
+	}
+	return ConcurrencyPolicy_CONCURRENCY_POLICY_UNSPECIFIED
+}
+
+func (x *CreateTaskRequest) GetRetention() *ExecutionRetention {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// 每日时间窗口
+type TimeWindow struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"` // 开始时间，格式 HH:MM
+	End           string                 `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`     // 结束时间，格式 HH:MM，早于开始时间表示跨越午夜
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskRequest) Reset() {
-	*x = GetTaskRequest{}
+func (x *TimeWindow) Reset() {
+	*x = TimeWindow{}
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskRequest) String() string {
+func (x *TimeWindow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskRequest) ProtoMessage() {}
+func (*TimeWindow) ProtoMessage() {}
 
-func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
+func (x *TimeWindow) ProtoReflect() protoreflect.Message {
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -342,46 +706,50 @@ func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
-func (*GetTaskRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TimeWindow.ProtoReflect.Descriptor instead.
+func (*TimeWindow) Descriptor() ([]byte, []int) {
 	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GetTaskRequest) GetId() int64 {
+func (x *TimeWindow) GetStart() string {
 	if x != nil {
-		return x.Id
+		return x.Start
 	}
-	return 0
+	return ""
 }
 
-// 更新任务请求
-type UpdateTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Schedule      string                 `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`
-	Payload       string                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
-	Timeout       int32                  `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	Metadata      map[string]string      `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TimeWindow) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
 }
 
-func (x *UpdateTaskRequest) Reset() {
-	*x = UpdateTaskRequest{}
+// 执行记录保留策略，字段为 0 表示不启用该规则，只清理已结束的执行记录，
+// 执行记录在任一已启用的规则内即保留
+type ExecutionRetention struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	KeepLast       int32                  `protobuf:"varint,1,opt,name=keep_last,json=keepLast,proto3" json:"keep_last,omitempty"`                     // 保留最近的 N 条执行记录
+	KeepDays       int32                  `protobuf:"varint,2,opt,name=keep_days,json=keepDays,proto3" json:"keep_days,omitempty"`                     // 保留最近 N 天的执行记录
+	FailedKeepDays int32                  `protobuf:"varint,3,opt,name=failed_keep_days,json=failedKeepDays,proto3" json:"failed_keep_days,omitempty"` // 失败和超时的执行记录保留天数，为 0 时使用 keep_days
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExecutionRetention) Reset() {
+	*x = ExecutionRetention{}
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskRequest) String() string {
+func (x *ExecutionRetention) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskRequest) ProtoMessage() {}
+func (*ExecutionRetention) ProtoMessage() {}
 
-func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+func (x *ExecutionRetention) ProtoReflect() protoreflect.Message {
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -393,82 +761,54 @@ func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecutionRetention.ProtoReflect.Descriptor instead.
+func (*ExecutionRetention) Descriptor() ([]byte, []int) {
 	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *UpdateTaskRequest) GetId() int64 {
+func (x *ExecutionRetention) GetKeepLast() int32 {
 	if x != nil {
-		return x.Id
+		return x.KeepLast
 	}
 	return 0
 }
 
-func (x *UpdateTaskRequest) GetName() string {
+func (x *ExecutionRetention) GetKeepDays() int32 {
 	if x != nil {
-		return x.Name
+		return x.KeepDays
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateTaskRequest) GetDescription() string {
+func (x *ExecutionRetention) GetFailedKeepDays() int32 {
 	if x != nil {
-		return x.Description
+		return x.FailedKeepDays
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateTaskRequest) GetSchedule() string {
-	if x != nil {
-		return x.Schedule
-	}
-	return ""
-}
-
-func (x *UpdateTaskRequest) GetPayload() string {
-	if x != nil {
-		return x.Payload
-	}
-	return ""
-}
-
-func (x *UpdateTaskRequest) GetTimeout() int32 {
-	if x != nil {
-		return x.Timeout
-	}
-	return 0
-}
-
-func (x *UpdateTaskRequest) GetMetadata() map[string]string {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-// 删除任务请求
-type DeleteTaskRequest struct {
+// 获取任务请求
+type GetTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskRequest) Reset() {
-	*x = DeleteTaskRequest{}
+func (x *GetTaskRequest) Reset() {
+	*x = GetTaskRequest{}
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskRequest) String() string {
+func (x *GetTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskRequest) ProtoMessage() {}
+func (*GetTaskRequest) ProtoMessage() {}
 
-func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -480,44 +820,67 @@ func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRequest) Descriptor() ([]byte, []int) {
 	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *DeleteTaskRequest) GetId() int64 {
+func (x *GetTaskRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-// 任务列表请求
-type ListTasksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                                  // 页码
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`          // 每页数量
-	Status        TaskStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=scheduler.v1.TaskStatus" json:"status,omitempty"` // 状态筛选
-	Type          TaskType               `protobuf:"varint,4,opt,name=type,proto3,enum=scheduler.v1.TaskType" json:"type,omitempty"`       // 类型筛选
-	Keyword       string                 `protobuf:"bytes,5,opt,name=keyword,proto3" json:"keyword,omitempty"`                             // 关键词搜索
+// 更新任务请求
+type UpdateTaskRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Schedule          string                 `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Payload           string                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Timeout           int32                  `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Metadata          map[string]string      `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CalendarId        int64                  `protobuf:"varint,8,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	StartTime         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime           *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	ActiveWindows     []*TimeWindow          `protobuf:"bytes,11,rep,name=active_windows,json=activeWindows,proto3" json:"active_windows,omitempty"`
+	MaxRuns           int64                  `protobuf:"varint,12,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
+	IntervalMode      IntervalMode           `protobuf:"varint,13,opt,name=interval_mode,json=intervalMode,proto3,enum=scheduler.v1.IntervalMode" json:"interval_mode,omitempty"`
+	InitialDelay      *durationpb.Duration   `protobuf:"bytes,14,opt,name=initial_delay,json=initialDelay,proto3" json:"initial_delay,omitempty"`
+	Jitter            *durationpb.Duration   `protobuf:"bytes,15,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	Priority          int32                  `protobuf:"varint,16,opt,name=priority,proto3" json:"priority,omitempty"`
+	LockGroup         string                 `protobuf:"bytes,17,opt,name=lock_group,json=lockGroup,proto3" json:"lock_group,omitempty"`
+	ConcurrencyPolicy ConcurrencyPolicy      `protobuf:"varint,18,opt,name=concurrency_policy,json=concurrencyPolicy,proto3,enum=scheduler.v1.ConcurrencyPolicy" json:"concurrency_policy,omitempty"`
+	Retention         *ExecutionRetention    `protobuf:"bytes,19,opt,name=retention,proto3" json:"retention,omitempty"`
+	// 更新前读取到的任务版本，与当前版本不一致时返回 409（gRPC Aborted）
+	// HTTP 请求也可以通过 If-Match 头携带 GetTask 返回的 ETag
+	Version int64 `protobuf:"varint,20,opt,name=version,proto3" json:"version,omitempty"`
+	// 要更新的字段，列出的字段按请求值写入（包括零值，用于清空字段）
+	// 未设置时只更新请求中的非零值字段
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,21,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// 修改任务类型时 schedule 必须能按新类型解析
+	Type          TaskType `protobuf:"varint,22,opt,name=type,proto3,enum=scheduler.v1.TaskType" json:"type,omitempty"`
+	Handler       string   `protobuf:"bytes,23,opt,name=handler,proto3" json:"handler,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTasksRequest) Reset() {
-	*x = ListTasksRequest{}
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTasksRequest) String() string {
+func (x *UpdateTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTasksRequest) ProtoMessage() {}
+func (*UpdateTaskRequest) ProtoMessage() {}
 
-func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -529,69 +892,194 @@ func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
-func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
 	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListTasksRequest) GetPage() int32 {
+func (x *UpdateTaskRequest) GetId() int64 {
 	if x != nil {
-		return x.Page
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListTasksRequest) GetPageSize() int32 {
+func (x *UpdateTaskRequest) GetName() string {
 	if x != nil {
-		return x.PageSize
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
 	}
 	return 0
 }
 
-func (x *ListTasksRequest) GetStatus() TaskStatus {
+func (x *UpdateTaskRequest) GetMetadata() map[string]string {
 	if x != nil {
-		return x.Status
+		return x.Metadata
 	}
-	return TaskStatus_TASK_STATUS_UNSPECIFIED
+	return nil
 }
 
-func (x *ListTasksRequest) GetType() TaskType {
+func (x *UpdateTaskRequest) GetCalendarId() int64 {
+	if x != nil {
+		return x.CalendarId
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetActiveWindows() []*TimeWindow {
+	if x != nil {
+		return x.ActiveWindows
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetMaxRuns() int64 {
+	if x != nil {
+		return x.MaxRuns
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetIntervalMode() IntervalMode {
+	if x != nil {
+		return x.IntervalMode
+	}
+	return IntervalMode_INTERVAL_MODE_UNSPECIFIED
+}
+
+func (x *UpdateTaskRequest) GetInitialDelay() *durationpb.Duration {
+	if x != nil {
+		return x.InitialDelay
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetJitter() *durationpb.Duration {
+	if x != nil {
+		return x.Jitter
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetLockGroup() string {
+	if x != nil {
+		return x.LockGroup
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetConcurrencyPolicy() ConcurrencyPolicy {
+	if x != nil {
+		return x.ConcurrencyPolicy
+	}
+	return ConcurrencyPolicy_CONCURRENCY_POLICY_UNSPECIFIED
+}
+
+func (x *UpdateTaskRequest) GetRetention() *ExecutionRetention {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetType() TaskType {
 	if x != nil {
 		return x.Type
 	}
 	return TaskType_TASK_TYPE_UNSPECIFIED
 }
 
-func (x *ListTasksRequest) GetKeyword() string {
+func (x *UpdateTaskRequest) GetHandler() string {
 	if x != nil {
-		return x.Keyword
+		return x.Handler
 	}
 	return ""
 }
 
-// 执行任务请求
-type ExecuteTaskRequest struct {
+// 删除任务请求
+type DeleteTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Payload       string                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"` // 可选的负载覆盖
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecuteTaskRequest) Reset() {
-	*x = ExecuteTaskRequest{}
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecuteTaskRequest) String() string {
+func (x *DeleteTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteTaskRequest) ProtoMessage() {}
+func (*DeleteTaskRequest) ProtoMessage() {}
 
-func (x *ExecuteTaskRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -603,47 +1091,40 @@ func (x *ExecuteTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteTaskRequest.ProtoReflect.Descriptor instead.
-func (*ExecuteTaskRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
 	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ExecuteTaskRequest) GetId() int64 {
+func (x *DeleteTaskRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *ExecuteTaskRequest) GetPayload() string {
-	if x != nil {
-		return x.Payload
-	}
-	return ""
-}
-
-// 暂停任务请求
-type PauseTaskRequest struct {
+// 恢复已删除任务请求
+type RestoreTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PauseTaskRequest) Reset() {
-	*x = PauseTaskRequest{}
+func (x *RestoreTaskRequest) Reset() {
+	*x = RestoreTaskRequest{}
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PauseTaskRequest) String() string {
+func (x *RestoreTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PauseTaskRequest) ProtoMessage() {}
+func (*RestoreTaskRequest) ProtoMessage() {}
 
-func (x *PauseTaskRequest) ProtoReflect() protoreflect.Message {
+func (x *RestoreTaskRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -655,40 +1136,40 @@ func (x *PauseTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PauseTaskRequest.ProtoReflect.Descriptor instead.
-func (*PauseTaskRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreTaskRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTaskRequest) Descriptor() ([]byte, []int) {
 	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PauseTaskRequest) GetId() int64 {
+func (x *RestoreTaskRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-// 恢复任务请求
-type ResumeTaskRequest struct {
+// 彻底删除任务请求
+type PurgeTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResumeTaskRequest) Reset() {
-	*x = ResumeTaskRequest{}
+func (x *PurgeTaskRequest) Reset() {
+	*x = PurgeTaskRequest{}
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResumeTaskRequest) String() string {
+func (x *PurgeTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResumeTaskRequest) ProtoMessage() {}
+func (*PurgeTaskRequest) ProtoMessage() {}
 
-func (x *ResumeTaskRequest) ProtoReflect() protoreflect.Message {
+func (x *PurgeTaskRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -700,43 +1181,50 @@ func (x *ResumeTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResumeTaskRequest.ProtoReflect.Descriptor instead.
-func (*ResumeTaskRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PurgeTaskRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTaskRequest) Descriptor() ([]byte, []int) {
 	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ResumeTaskRequest) GetId() int64 {
+func (x *PurgeTaskRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-// 获取任务执行历史请求
-type GetTaskExecutionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Status        ExecutionStatus        `protobuf:"varint,4,opt,name=status,proto3,enum=scheduler.v1.ExecutionStatus" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// 任务列表请求
+type ListTasksRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Page           int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                                           // 页码，从 1 开始；为 0 时按 page_token 游标分页
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                   // 每页数量
+	Status         TaskStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=scheduler.v1.TaskStatus" json:"status,omitempty"`          // 状态筛选
+	Type           TaskType               `protobuf:"varint,4,opt,name=type,proto3,enum=scheduler.v1.TaskType" json:"type,omitempty"`                // 类型筛选
+	Keyword        string                 `protobuf:"bytes,5,opt,name=keyword,proto3" json:"keyword,omitempty"`                                      // 关键词搜索
+	CalendarId     int64                  `protobuf:"varint,6,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`             // 业务日历筛选
+	IncludeDeleted bool                   `protobuf:"varint,7,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // 是否包含已删除的任务
+	Namespace      string                 `protobuf:"bytes,8,opt,name=namespace,proto3" json:"namespace,omitempty"`                                  // 命名空间筛选
+	PageToken      string                 `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                 // 上一页返回的 next_page_token，为空时从第一页开始
+	OrderBy        string                 `protobuf:"bytes,10,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`                      // 排序：created_at、next_run_time、name，可跟 asc 或 desc，默认 created_at desc
+	SkipTotal      bool                   `protobuf:"varint,11,opt,name=skip_total,json=skipTotal,proto3" json:"skip_total,omitempty"`               // 为 true 时不统计总数，total 返回 0
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetTaskExecutionsRequest) Reset() {
-	*x = GetTaskExecutionsRequest{}
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskExecutionsRequest) String() string {
+func (x *ListTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskExecutionsRequest) ProtoMessage() {}
+func (*ListTasksRequest) ProtoMessage() {}
 
-func (x *GetTaskExecutionsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -748,61 +1236,112 @@ func (x *GetTaskExecutionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskExecutionsRequest.ProtoReflect.Descriptor instead.
-func (*GetTaskExecutionsRequest) Descriptor() ([]byte, []int) {
-	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetTaskExecutionsRequest) GetTaskId() int64 {
+func (x *ListTasksRequest) GetPage() int32 {
 	if x != nil {
-		return x.TaskId
+		return x.Page
 	}
 	return 0
 }
 
-func (x *GetTaskExecutionsRequest) GetPage() int32 {
+func (x *ListTasksRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Page
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *GetTaskExecutionsRequest) GetPageSize() int32 {
+func (x *ListTasksRequest) GetStatus() TaskStatus {
 	if x != nil {
-		return x.PageSize
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *ListTasksRequest) GetType() TaskType {
+	if x != nil {
+		return x.Type
+	}
+	return TaskType_TASK_TYPE_UNSPECIFIED
+}
+
+func (x *ListTasksRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetCalendarId() int64 {
+	if x != nil {
+		return x.CalendarId
 	}
 	return 0
 }
 
-func (x *GetTaskExecutionsRequest) GetStatus() ExecutionStatus {
+func (x *ListTasksRequest) GetIncludeDeleted() bool {
 	if x != nil {
-		return x.Status
+		return x.IncludeDeleted
 	}
-	return ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED
+	return false
 }
 
-// 获取执行详情请求
-type GetExecutionRequest struct {
+func (x *ListTasksRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetSkipTotal() bool {
+	if x != nil {
+		return x.SkipTotal
+	}
+	return false
+}
+
+// 任务修订列表请求
+type ListTaskRevisionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"` // 页码，从 1 开始，0 等同于 1
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExecutionRequest) Reset() {
-	*x = GetExecutionRequest{}
+func (x *ListTaskRevisionsRequest) Reset() {
+	*x = ListTaskRevisionsRequest{}
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExecutionRequest) String() string {
+func (x *ListTaskRevisionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExecutionRequest) ProtoMessage() {}
+func (*ListTaskRevisionsRequest) ProtoMessage() {}
 
-func (x *GetExecutionRequest) ProtoReflect() protoreflect.Message {
+func (x *ListTaskRevisionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -814,40 +1353,55 @@ func (x *GetExecutionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExecutionRequest.ProtoReflect.Descriptor instead.
-func (*GetExecutionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTaskRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskRevisionsRequest) Descriptor() ([]byte, []int) {
 	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetExecutionRequest) GetId() int64 {
+func (x *ListTaskRevisionsRequest) GetTaskId() int64 {
 	if x != nil {
-		return x.Id
+		return x.TaskId
 	}
 	return 0
 }
 
-// 取消执行请求
-type CancelExecutionRequest struct {
+func (x *ListTaskRevisionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTaskRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 获取任务修订请求
+type GetTaskRevisionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelExecutionRequest) Reset() {
-	*x = CancelExecutionRequest{}
+func (x *GetTaskRevisionRequest) Reset() {
+	*x = GetTaskRevisionRequest{}
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelExecutionRequest) String() string {
+func (x *GetTaskRevisionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelExecutionRequest) ProtoMessage() {}
+func (*GetTaskRevisionRequest) ProtoMessage() {}
 
-func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTaskRevisionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -859,55 +1413,51 @@ func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelExecutionRequest.ProtoReflect.Descriptor instead.
-func (*CancelExecutionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTaskRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRevisionRequest) Descriptor() ([]byte, []int) {
 	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CancelExecutionRequest) GetId() int64 {
+func (x *GetTaskRevisionRequest) GetTaskId() int64 {
 	if x != nil {
-		return x.Id
+		return x.TaskId
 	}
 	return 0
 }
 
-// 任务响应
-type TaskReply struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Type           TaskType               `protobuf:"varint,4,opt,name=type,proto3,enum=scheduler.v1.TaskType" json:"type,omitempty"`
-	Status         TaskStatus             `protobuf:"varint,5,opt,name=status,proto3,enum=scheduler.v1.TaskStatus" json:"status,omitempty"`
-	Schedule       string                 `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule,omitempty"`
-	Handler        string                 `protobuf:"bytes,7,opt,name=handler,proto3" json:"handler,omitempty"`
-	Payload        string                 `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
-	Timeout        int32                  `protobuf:"varint,9,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	Metadata       map[string]string      `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	NextRunTime    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`         // 下次执行时间
-	ExecutionCount int64                  `protobuf:"varint,14,opt,name=execution_count,json=executionCount,proto3" json:"execution_count,omitempty"` // 执行次数
-	SuccessCount   int64                  `protobuf:"varint,15,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`       // 成功次数
-	FailedCount    int64                  `protobuf:"varint,16,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`          // 失败次数
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetTaskRevisionRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
 }
 
-func (x *TaskReply) Reset() {
-	*x = TaskReply{}
+// 回滚任务请求
+type RollbackTaskRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Revision int64                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"` // 回滚到的修订版本
+	// 回滚前读取到的任务版本，与当前版本不一致时返回 409（gRPC Aborted）
+	// HTTP 请求也可以通过 If-Match 头携带 GetTask 返回的 ETag
+	Version       int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackTaskRequest) Reset() {
+	*x = RollbackTaskRequest{}
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TaskReply) String() string {
+func (x *RollbackTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskReply) ProtoMessage() {}
+func (*RollbackTaskRequest) ProtoMessage() {}
 
-func (x *TaskReply) ProtoReflect() protoreflect.Message {
+func (x *RollbackTaskRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_scheduler_v1_scheduler_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -919,149 +1469,4030 @@ func (x *TaskReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskReply.ProtoReflect.Descriptor instead.
-func (*TaskReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RollbackTaskRequest.ProtoReflect.Descriptor instead.
+func (*RollbackTaskRequest) Descriptor() ([]byte, []int) {
 	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *TaskReply) GetId() int64 {
+func (x *RollbackTaskRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *TaskReply) GetName() string {
+func (x *RollbackTaskRequest) GetRevision() int64 {
 	if x != nil {
-		return x.Name
+		return x.Revision
 	}
-	return ""
+	return 0
 }
 
-func (x *TaskReply) GetDescription() string {
+func (x *RollbackTaskRequest) GetVersion() int64 {
 	if x != nil {
-		return x.Description
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *TaskReply) GetType() TaskType {
-	if x != nil {
-		return x.Type
-	}
-	return TaskType_TASK_TYPE_UNSPECIFIED
+// 执行任务请求
+type ExecuteTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Payload       string                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`          // 可选的负载覆盖
+	Priority      *int32                 `protobuf:"varint,3,opt,name=priority,proto3,oneof" json:"priority,omitempty"` // 可选的优先级覆盖，0-9
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TaskReply) GetStatus() TaskStatus {
-	if x != nil {
-		return x.Status
-	}
-	return TaskStatus_TASK_STATUS_UNSPECIFIED
+func (x *ExecuteTaskRequest) Reset() {
+	*x = ExecuteTaskRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *TaskReply) GetSchedule() string {
+func (x *ExecuteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteTaskRequest) ProtoMessage() {}
+
+func (x *ExecuteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[12]
 	if x != nil {
-		return x.Schedule
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *TaskReply) GetHandler() string {
+// Deprecated: Use ExecuteTaskRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExecuteTaskRequest) GetId() int64 {
 	if x != nil {
-		return x.Handler
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *TaskReply) GetPayload() string {
+func (x *ExecuteTaskRequest) GetPayload() string {
 	if x != nil {
 		return x.Payload
 	}
 	return ""
 }
 
-func (x *TaskReply) GetTimeout() int32 {
-	if x != nil {
-		return x.Timeout
+func (x *ExecuteTaskRequest) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
 	}
 	return 0
 }
 
-func (x *TaskReply) GetMetadata() map[string]string {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
+// 暂停任务请求
+type PauseTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // 暂停原因，记录到任务的状态原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TaskReply) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+func (x *PauseTaskRequest) Reset() {
+	*x = PauseTaskRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *TaskReply) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
+func (x *PauseTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TaskReply) GetNextRunTime() *timestamppb.Timestamp {
+func (*PauseTaskRequest) ProtoMessage() {}
+
+func (x *PauseTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[13]
 	if x != nil {
-		return x.NextRunTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *TaskReply) GetExecutionCount() int64 {
-	if x != nil {
-		return x.ExecutionCount
-	}
-	return 0
+// Deprecated: Use PauseTaskRequest.ProtoReflect.Descriptor instead.
+func (*PauseTaskRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *TaskReply) GetSuccessCount() int64 {
+func (x *PauseTaskRequest) GetId() int64 {
 	if x != nil {
-		return x.SuccessCount
+		return x.Id
 	}
 	return 0
 }
 
-func (x *TaskReply) GetFailedCount() int64 {
+func (x *PauseTaskRequest) GetReason() string {
 	if x != nil {
-		return x.FailedCount
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-// 任务列表响应
-type ListTasksReply struct {
+// 恢复任务请求
+type ResumeTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*TaskReply           `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // 恢复原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeTaskRequest) Reset() {
+	*x = ResumeTaskRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeTaskRequest) ProtoMessage() {}
+
+func (x *ResumeTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeTaskRequest.ProtoReflect.Descriptor instead.
+func (*ResumeTaskRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ResumeTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResumeTaskRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 取消任务请求
+type CancelTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // 取消原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTaskRequest) Reset() {
+	*x = CancelTaskRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTaskRequest) ProtoMessage() {}
+
+func (x *CancelTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTaskRequest.ProtoReflect.Descriptor instead.
+func (*CancelTaskRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CancelTaskRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 获取任务执行历史请求
+type GetTaskExecutionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"` // 页码，从 1 开始；为 0 时按 page_token 游标分页
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Status        ExecutionStatus        `protobuf:"varint,4,opt,name=status,proto3,enum=scheduler.v1.ExecutionStatus" json:"status,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`  // 上一页返回的 next_page_token，为空时从第一页开始
+	OrderBy       string                 `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`        // 排序：created_at、duration，可跟 asc 或 desc，默认 created_at desc
+	SkipTotal     bool                   `protobuf:"varint,7,opt,name=skip_total,json=skipTotal,proto3" json:"skip_total,omitempty"` // 为 true 时不统计总数，total 返回 0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskExecutionsRequest) Reset() {
+	*x = GetTaskExecutionsRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskExecutionsRequest) ProtoMessage() {}
+
+func (x *GetTaskExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetTaskExecutionsRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *GetTaskExecutionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetTaskExecutionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetTaskExecutionsRequest) GetStatus() ExecutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED
+}
+
+func (x *GetTaskExecutionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetTaskExecutionsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetTaskExecutionsRequest) GetSkipTotal() bool {
+	if x != nil {
+		return x.SkipTotal
+	}
+	return false
+}
+
+// 获取执行详情请求
+type GetExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExecutionRequest) Reset() {
+	*x = GetExecutionRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionRequest) ProtoMessage() {}
+
+func (x *GetExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionRequest.ProtoReflect.Descriptor instead.
+func (*GetExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetExecutionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 取消执行请求
+type CancelExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelExecutionRequest) Reset() {
+	*x = CancelExecutionRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelExecutionRequest) ProtoMessage() {}
+
+func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelExecutionRequest.ProtoReflect.Descriptor instead.
+func (*CancelExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CancelExecutionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 获取执行队列统计请求
+type GetQueueStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueueStatsRequest) Reset() {
+	*x = GetQueueStatsRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueueStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueStatsRequest) ProtoMessage() {}
+
+func (x *GetQueueStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetQueueStatsRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{19}
+}
+
+// 调度预览请求
+type PreviewScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          TaskType               `protobuf:"varint,1,opt,name=type,proto3,enum=scheduler.v1.TaskType" json:"type,omitempty"`    // 任务类型
+	Schedule      string                 `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`                        // 调度配置，格式同 CreateTaskRequest.schedule
+	Timezone      string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`                        // 展示触发时间的 IANA 时区，如 "Asia/Shanghai"，为空时使用服务端时区；触发时间始终按服务端时区计算
+	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`                             // 返回的触发次数，默认 5，最大 100
+	CalendarId    int64                  `protobuf:"varint,5,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"` // 可选的业务日历ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewScheduleRequest) Reset() {
+	*x = PreviewScheduleRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewScheduleRequest) ProtoMessage() {}
+
+func (x *PreviewScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewScheduleRequest.ProtoReflect.Descriptor instead.
+func (*PreviewScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PreviewScheduleRequest) GetType() TaskType {
+	if x != nil {
+		return x.Type
+	}
+	return TaskType_TASK_TYPE_UNSPECIFIED
+}
+
+func (x *PreviewScheduleRequest) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *PreviewScheduleRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *PreviewScheduleRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PreviewScheduleRequest) GetCalendarId() int64 {
+	if x != nil {
+		return x.CalendarId
+	}
+	return 0
+}
+
+// 任务响应
+type TaskReply struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Type              TaskType               `protobuf:"varint,4,opt,name=type,proto3,enum=scheduler.v1.TaskType" json:"type,omitempty"`
+	Status            TaskStatus             `protobuf:"varint,5,opt,name=status,proto3,enum=scheduler.v1.TaskStatus" json:"status,omitempty"`
+	Schedule          string                 `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Handler           string                 `protobuf:"bytes,7,opt,name=handler,proto3" json:"handler,omitempty"`
+	Payload           string                 `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+	Timeout           int32                  `protobuf:"varint,9,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Metadata          map[string]string      `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	NextRunTime       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`                                                      // 下次执行时间
+	ExecutionCount    int64                  `protobuf:"varint,14,opt,name=execution_count,json=executionCount,proto3" json:"execution_count,omitempty"`                                              // 执行次数
+	SuccessCount      int64                  `protobuf:"varint,15,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`                                                    // 成功次数
+	FailedCount       int64                  `protobuf:"varint,16,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`                                                       // 失败次数
+	CalendarId        int64                  `protobuf:"varint,17,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`                                                          // 业务日历ID
+	StartTime         *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                                                              // 生效开始时间
+	EndTime           *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                                                                    // 生效结束时间
+	ActiveWindows     []*TimeWindow          `protobuf:"bytes,20,rep,name=active_windows,json=activeWindows,proto3" json:"active_windows,omitempty"`                                                  // 每日执行时间窗口
+	MaxRuns           int64                  `protobuf:"varint,21,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`                                                                   // 最大执行次数
+	IntervalMode      IntervalMode           `protobuf:"varint,22,opt,name=interval_mode,json=intervalMode,proto3,enum=scheduler.v1.IntervalMode" json:"interval_mode,omitempty"`                     // 间隔模式
+	InitialDelay      *durationpb.Duration   `protobuf:"bytes,23,opt,name=initial_delay,json=initialDelay,proto3" json:"initial_delay,omitempty"`                                                     // 首次执行延迟
+	Jitter            *durationpb.Duration   `protobuf:"bytes,24,opt,name=jitter,proto3" json:"jitter,omitempty"`                                                                                     // 随机延迟上限
+	Priority          int32                  `protobuf:"varint,25,opt,name=priority,proto3" json:"priority,omitempty"`                                                                                // 优先级
+	LockGroup         string                 `protobuf:"bytes,26,opt,name=lock_group,json=lockGroup,proto3" json:"lock_group,omitempty"`                                                              // 互斥组
+	ConcurrencyPolicy ConcurrencyPolicy      `protobuf:"varint,27,opt,name=concurrency_policy,json=concurrencyPolicy,proto3,enum=scheduler.v1.ConcurrencyPolicy" json:"concurrency_policy,omitempty"` // 并发策略
+	Retention         *ExecutionRetention    `protobuf:"bytes,28,opt,name=retention,proto3" json:"retention,omitempty"`                                                                               // 执行记录保留策略
+	DeletedAt         *timestamppb.Timestamp `protobuf:"bytes,29,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`                                                              // 删除时间，未删除时为空
+	Version           int64                  `protobuf:"varint,30,opt,name=version,proto3" json:"version,omitempty"`                                                                                  // 任务版本，每次更新任务定义时递增
+	Namespace         string                 `protobuf:"bytes,31,opt,name=namespace,proto3" json:"namespace,omitempty"`                                                                               // 所属命名空间
+	StatusReason      string                 `protobuf:"bytes,32,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`                                                     // 最近一次状态变化的原因
+	StatusChangedAt   *timestamppb.Timestamp `protobuf:"bytes,33,opt,name=status_changed_at,json=statusChangedAt,proto3" json:"status_changed_at,omitempty"`                                          // 最近一次状态变化的时间
+	RunCount          int64                  `protobuf:"varint,34,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`                                                                // 调度次数，达到 max_runs 后任务完成，不含手动执行
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TaskReply) Reset() {
+	*x = TaskReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskReply) ProtoMessage() {}
+
+func (x *TaskReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskReply.ProtoReflect.Descriptor instead.
+func (*TaskReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TaskReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TaskReply) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskReply) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaskReply) GetType() TaskType {
+	if x != nil {
+		return x.Type
+	}
+	return TaskType_TASK_TYPE_UNSPECIFIED
+}
+
+func (x *TaskReply) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *TaskReply) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *TaskReply) GetHandler() string {
+	if x != nil {
+		return x.Handler
+	}
+	return ""
+}
+
+func (x *TaskReply) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *TaskReply) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *TaskReply) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *TaskReply) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TaskReply) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TaskReply) GetNextRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunTime
+	}
+	return nil
+}
+
+func (x *TaskReply) GetExecutionCount() int64 {
+	if x != nil {
+		return x.ExecutionCount
+	}
+	return 0
+}
+
+func (x *TaskReply) GetSuccessCount() int64 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *TaskReply) GetFailedCount() int64 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *TaskReply) GetCalendarId() int64 {
+	if x != nil {
+		return x.CalendarId
+	}
+	return 0
+}
+
+func (x *TaskReply) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *TaskReply) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *TaskReply) GetActiveWindows() []*TimeWindow {
+	if x != nil {
+		return x.ActiveWindows
+	}
+	return nil
+}
+
+func (x *TaskReply) GetMaxRuns() int64 {
+	if x != nil {
+		return x.MaxRuns
+	}
+	return 0
+}
+
+func (x *TaskReply) GetIntervalMode() IntervalMode {
+	if x != nil {
+		return x.IntervalMode
+	}
+	return IntervalMode_INTERVAL_MODE_UNSPECIFIED
+}
+
+func (x *TaskReply) GetInitialDelay() *durationpb.Duration {
+	if x != nil {
+		return x.InitialDelay
+	}
+	return nil
+}
+
+func (x *TaskReply) GetJitter() *durationpb.Duration {
+	if x != nil {
+		return x.Jitter
+	}
+	return nil
+}
+
+func (x *TaskReply) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *TaskReply) GetLockGroup() string {
+	if x != nil {
+		return x.LockGroup
+	}
+	return ""
+}
+
+func (x *TaskReply) GetConcurrencyPolicy() ConcurrencyPolicy {
+	if x != nil {
+		return x.ConcurrencyPolicy
+	}
+	return ConcurrencyPolicy_CONCURRENCY_POLICY_UNSPECIFIED
+}
+
+func (x *TaskReply) GetRetention() *ExecutionRetention {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *TaskReply) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *TaskReply) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TaskReply) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *TaskReply) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *TaskReply) GetStatusChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatusChangedAt
+	}
+	return nil
+}
+
+func (x *TaskReply) GetRunCount() int64 {
+	if x != nil {
+		return x.RunCount
+	}
+	return 0
+}
+
+// 任务列表响应
+type ListTasksReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*TaskReply           `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 游标分页时下一页的 page_token，没有后续记录时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksReply) Reset() {
+	*x = ListTasksReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksReply) ProtoMessage() {}
+
+func (x *ListTasksReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksReply.ProtoReflect.Descriptor instead.
+func (*ListTasksReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListTasksReply) GetTasks() []*TaskReply {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListTasksReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListTasksReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTasksReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTasksReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// 任务定义的字段变化
+type TaskFieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // 字段名，与 UpdateTaskRequest 的字段名一致
+	OldValue      string                 `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      string                 `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskFieldChange) Reset() {
+	*x = TaskFieldChange{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskFieldChange) ProtoMessage() {}
+
+func (x *TaskFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskFieldChange.ProtoReflect.Descriptor instead.
+func (*TaskFieldChange) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TaskFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *TaskFieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *TaskFieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+// 任务修订响应
+type TaskRevisionReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // 修订对应的任务版本
+	Action        TaskRevisionAction     `protobuf:"varint,3,opt,name=action,proto3,enum=scheduler.v1.TaskRevisionAction" json:"action,omitempty"`
+	Author        string                 `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`                                     // 操作者
+	Snapshot      *TaskReply             `protobuf:"bytes,5,opt,name=snapshot,proto3" json:"snapshot,omitempty"`                                 // 任务定义快照，只包含可更新的字段
+	Changes       []*TaskFieldChange     `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`                                   // 相对上一版本变化的字段
+	SourceVersion int64                  `protobuf:"varint,7,opt,name=source_version,json=sourceVersion,proto3" json:"source_version,omitempty"` // 回滚时的目标版本
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskRevisionReply) Reset() {
+	*x = TaskRevisionReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskRevisionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRevisionReply) ProtoMessage() {}
+
+func (x *TaskRevisionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRevisionReply.ProtoReflect.Descriptor instead.
+func (*TaskRevisionReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TaskRevisionReply) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *TaskRevisionReply) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TaskRevisionReply) GetAction() TaskRevisionAction {
+	if x != nil {
+		return x.Action
+	}
+	return TaskRevisionAction_TASK_REVISION_ACTION_UNSPECIFIED
+}
+
+func (x *TaskRevisionReply) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *TaskRevisionReply) GetSnapshot() *TaskReply {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *TaskRevisionReply) GetChanges() []*TaskFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *TaskRevisionReply) GetSourceVersion() int64 {
+	if x != nil {
+		return x.SourceVersion
+	}
+	return 0
+}
+
+func (x *TaskRevisionReply) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// 任务修订列表响应
+type ListTaskRevisionsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*TaskRevisionReply   `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskRevisionsReply) Reset() {
+	*x = ListTaskRevisionsReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskRevisionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskRevisionsReply) ProtoMessage() {}
+
+func (x *ListTaskRevisionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskRevisionsReply.ProtoReflect.Descriptor instead.
+func (*ListTaskRevisionsReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListTaskRevisionsReply) GetRevisions() []*TaskRevisionReply {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListTaskRevisionsReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListTaskRevisionsReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTaskRevisionsReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 任务执行响应
+type TaskExecutionReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExecutionId   int64                  `protobuf:"varint,1,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskExecutionReply) Reset() {
+	*x = TaskExecutionReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskExecutionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskExecutionReply) ProtoMessage() {}
+
+func (x *TaskExecutionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskExecutionReply.ProtoReflect.Descriptor instead.
+func (*TaskExecutionReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TaskExecutionReply) GetExecutionId() int64 {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return 0
+}
+
+func (x *TaskExecutionReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 执行记录响应
+type ExecutionReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TaskName      string                 `protobuf:"bytes,3,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	Status        ExecutionStatus        `protobuf:"varint,4,opt,name=status,proto3,enum=scheduler.v1.ExecutionStatus" json:"status,omitempty"`
+	NodeId        string                 `protobuf:"bytes,5,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"` // 执行节点ID
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Duration      int32                  `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`                           // 执行耗时（毫秒）
+	Result        string                 `protobuf:"bytes,9,opt,name=result,proto3" json:"result,omitempty"`                                // 执行结果
+	Error         string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`                                 // 错误信息
+	RetryCount    int32                  `protobuf:"varint,11,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`    // 重试次数
+	Payload       string                 `protobuf:"bytes,12,opt,name=payload,proto3" json:"payload,omitempty"`                             // 执行负载
+	Priority      int32                  `protobuf:"varint,13,opt,name=priority,proto3" json:"priority,omitempty"`                          // 优先级
+	WaitTime      int32                  `protobuf:"varint,14,opt,name=wait_time,json=waitTime,proto3" json:"wait_time,omitempty"`          // 开始执行前的排队等待耗时（毫秒）
+	TaskVersion   int64                  `protobuf:"varint,15,opt,name=task_version,json=taskVersion,proto3" json:"task_version,omitempty"` // 执行时的任务版本，对应任务修订
+	Namespace     string                 `protobuf:"bytes,16,opt,name=namespace,proto3" json:"namespace,omitempty"`                         // 任务所属的命名空间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionReply) Reset() {
+	*x = ExecutionReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionReply) ProtoMessage() {}
+
+func (x *ExecutionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionReply.ProtoReflect.Descriptor instead.
+func (*ExecutionReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExecutionReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExecutionReply) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *ExecutionReply) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
+
+func (x *ExecutionReply) GetStatus() ExecutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED
+}
+
+func (x *ExecutionReply) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ExecutionReply) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ExecutionReply) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ExecutionReply) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *ExecutionReply) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *ExecutionReply) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ExecutionReply) GetRetryCount() int32 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+func (x *ExecutionReply) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *ExecutionReply) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *ExecutionReply) GetWaitTime() int32 {
+	if x != nil {
+		return x.WaitTime
+	}
+	return 0
+}
+
+func (x *ExecutionReply) GetTaskVersion() int64 {
+	if x != nil {
+		return x.TaskVersion
+	}
+	return 0
+}
+
+func (x *ExecutionReply) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// 执行历史列表响应
+type ListExecutionsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Executions    []*ExecutionReply      `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // 游标分页时下一页的 page_token，没有后续记录时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExecutionsReply) Reset() {
+	*x = ListExecutionsReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExecutionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExecutionsReply) ProtoMessage() {}
+
+func (x *ListExecutionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExecutionsReply.ProtoReflect.Descriptor instead.
+func (*ListExecutionsReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListExecutionsReply) GetExecutions() []*ExecutionReply {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+func (x *ListExecutionsReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListExecutionsReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListExecutionsReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListExecutionsReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// 单个优先级的队列统计
+type PriorityQueueStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Priority       int32                  `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`                                    // 优先级
+	Queued         int64                  `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"`                                        // 排队中的执行数
+	OldestQueuedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=oldest_queued_at,json=oldestQueuedAt,proto3" json:"oldest_queued_at,omitempty"` // 最早入队时间
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PriorityQueueStats) Reset() {
+	*x = PriorityQueueStats{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriorityQueueStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriorityQueueStats) ProtoMessage() {}
+
+func (x *PriorityQueueStats) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriorityQueueStats.ProtoReflect.Descriptor instead.
+func (*PriorityQueueStats) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PriorityQueueStats) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *PriorityQueueStats) GetQueued() int64 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *PriorityQueueStats) GetOldestQueuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OldestQueuedAt
+	}
+	return nil
+}
+
+// 执行队列统计响应
+type QueueStatsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Priorities    []*PriorityQueueStats  `protobuf:"bytes,1,rep,name=priorities,proto3" json:"priorities,omitempty"`                       // 按优先级从高到低排列
+	TotalQueued   int64                  `protobuf:"varint,2,opt,name=total_queued,json=totalQueued,proto3" json:"total_queued,omitempty"` // 排队中的执行总数
+	Executing     int64                  `protobuf:"varint,3,opt,name=executing,proto3" json:"executing,omitempty"`                        // 执行中的执行总数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueStatsReply) Reset() {
+	*x = QueueStatsReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueStatsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueStatsReply) ProtoMessage() {}
+
+func (x *QueueStatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueStatsReply.ProtoReflect.Descriptor instead.
+func (*QueueStatsReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *QueueStatsReply) GetPriorities() []*PriorityQueueStats {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
+func (x *QueueStatsReply) GetTotalQueued() int64 {
+	if x != nil {
+		return x.TotalQueued
+	}
+	return 0
+}
+
+func (x *QueueStatsReply) GetExecuting() int64 {
+	if x != nil {
+		return x.Executing
+	}
+	return 0
+}
+
+// 调度预览响应
+type PreviewScheduleReply struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Valid         bool                     `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`                                    // 调度配置是否有效
+	Errors        []string                 `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`                                   // 校验错误
+	Description   string                   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                         // 可读描述
+	Timezone      string                   `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`                               // 展示触发时间使用的时区
+	NextRunTimes  []*timestamppb.Timestamp `protobuf:"bytes,5,rep,name=next_run_times,json=nextRunTimes,proto3" json:"next_run_times,omitempty"` // 后续触发时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewScheduleReply) Reset() {
+	*x = PreviewScheduleReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewScheduleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewScheduleReply) ProtoMessage() {}
+
+func (x *PreviewScheduleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewScheduleReply.ProtoReflect.Descriptor instead.
+func (*PreviewScheduleReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PreviewScheduleReply) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *PreviewScheduleReply) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *PreviewScheduleReply) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PreviewScheduleReply) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *PreviewScheduleReply) GetNextRunTimes() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunTimes
+	}
+	return nil
+}
+
+// 日历规则
+// 未设置 weekdays 时匹配 start_date 至 end_date（end_date 为空表示单日）；
+// 设置 weekdays 时按周匹配，start_date/end_date 可选地限定生效区间
+type CalendarRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        CalendarRuleAction     `protobuf:"varint,1,opt,name=action,proto3,enum=scheduler.v1.CalendarRuleAction" json:"action,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // 开始日期，格式 YYYY-MM-DD
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // 结束日期（包含），格式 YYYY-MM-DD
+	Weekdays      []int32                `protobuf:"varint,4,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`            // 星期，0 表示周日，6 表示周六
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`              // 说明，如节假日名称
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarRule) Reset() {
+	*x = CalendarRule{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarRule) ProtoMessage() {}
+
+func (x *CalendarRule) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarRule.ProtoReflect.Descriptor instead.
+func (*CalendarRule) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CalendarRule) GetAction() CalendarRuleAction {
+	if x != nil {
+		return x.Action
+	}
+	return CalendarRuleAction_CALENDAR_RULE_ACTION_UNSPECIFIED
+}
+
+func (x *CalendarRule) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CalendarRule) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *CalendarRule) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *CalendarRule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// 创建日历请求
+type CreateCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Timezone      string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA 时区，为空时使用任务调度时区
+	Rules         []*CalendarRule        `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCalendarRequest) Reset() {
+	*x = CreateCalendarRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCalendarRequest) ProtoMessage() {}
+
+func (x *CreateCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCalendarRequest.ProtoReflect.Descriptor instead.
+func (*CreateCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateCalendarRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCalendarRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCalendarRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CreateCalendarRequest) GetRules() []*CalendarRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// 获取日历请求
+type GetCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarRequest) Reset() {
+	*x = GetCalendarRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarRequest) ProtoMessage() {}
+
+func (x *GetCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetCalendarRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 更新日历请求（整体替换）
+type UpdateCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Rules         []*CalendarRule        `protobuf:"bytes,5,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCalendarRequest) Reset() {
+	*x = UpdateCalendarRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCalendarRequest) ProtoMessage() {}
+
+func (x *UpdateCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCalendarRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateCalendarRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCalendarRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCalendarRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCalendarRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateCalendarRequest) GetRules() []*CalendarRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// 删除日历请求
+type DeleteCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCalendarRequest) Reset() {
+	*x = DeleteCalendarRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCalendarRequest) ProtoMessage() {}
+
+func (x *DeleteCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCalendarRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteCalendarRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 日历列表请求
+type ListCalendarsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"` // 页码，从 1 开始，0 等同于 1
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Keyword       string                 `protobuf:"bytes,3,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCalendarsRequest) Reset() {
+	*x = ListCalendarsRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCalendarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalendarsRequest) ProtoMessage() {}
+
+func (x *ListCalendarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCalendarsRequest.ProtoReflect.Descriptor instead.
+func (*ListCalendarsRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListCalendarsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCalendarsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCalendarsRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+// 导入日历请求
+type ImportCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                              // 追加到已有日历，为 0 时新建日历
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                           // 新建日历的名称
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                             // 新建日历的描述
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`                                   // 新建日历的时区
+	Ics           string                 `protobuf:"bytes,5,opt,name=ics,proto3" json:"ics,omitempty"`                                             // iCalendar 文件内容
+	Action        CalendarRuleAction     `protobuf:"varint,6,opt,name=action,proto3,enum=scheduler.v1.CalendarRuleAction" json:"action,omitempty"` // 导入事件的规则动作，默认 EXCLUDE
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCalendarRequest) Reset() {
+	*x = ImportCalendarRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCalendarRequest) ProtoMessage() {}
+
+func (x *ImportCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCalendarRequest.ProtoReflect.Descriptor instead.
+func (*ImportCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ImportCalendarRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ImportCalendarRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportCalendarRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ImportCalendarRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ImportCalendarRequest) GetIcs() string {
+	if x != nil {
+		return x.Ics
+	}
+	return ""
+}
+
+func (x *ImportCalendarRequest) GetAction() CalendarRuleAction {
+	if x != nil {
+		return x.Action
+	}
+	return CalendarRuleAction_CALENDAR_RULE_ACTION_UNSPECIFIED
+}
+
+// 日历响应
+type CalendarReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Rules         []*CalendarRule        `protobuf:"bytes,5,rep,name=rules,proto3" json:"rules,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarReply) Reset() {
+	*x = CalendarReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarReply) ProtoMessage() {}
+
+func (x *CalendarReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarReply.ProtoReflect.Descriptor instead.
+func (*CalendarReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CalendarReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CalendarReply) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CalendarReply) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CalendarReply) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CalendarReply) GetRules() []*CalendarRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *CalendarReply) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CalendarReply) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// 日历列表响应
+type ListCalendarsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendars     []*CalendarReply       `protobuf:"bytes,1,rep,name=calendars,proto3" json:"calendars,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCalendarsReply) Reset() {
+	*x = ListCalendarsReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCalendarsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalendarsReply) ProtoMessage() {}
+
+func (x *ListCalendarsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCalendarsReply.ProtoReflect.Descriptor instead.
+func (*ListCalendarsReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListCalendarsReply) GetCalendars() []*CalendarReply {
+	if x != nil {
+		return x.Calendars
+	}
+	return nil
+}
+
+func (x *ListCalendarsReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListCalendarsReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCalendarsReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 创建资源池请求
+type CreateResourcePoolRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // 资源池名称，唯一
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Handler        string                 `protobuf:"bytes,3,opt,name=handler,proto3" json:"handler,omitempty"`                                      // 按处理器名称匹配任务，与 label_key 二选一
+	LabelKey       string                 `protobuf:"bytes,4,opt,name=label_key,json=labelKey,proto3" json:"label_key,omitempty"`                    // 按元数据标签匹配任务
+	LabelValue     string                 `protobuf:"bytes,5,opt,name=label_value,json=labelValue,proto3" json:"label_value,omitempty"`              // 标签值，为空时匹配任意值
+	MaxConcurrency int32                  `protobuf:"varint,6,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"` // 集群内的最大并发执行数，0 表示不限制
+	RateLimit      float64                `protobuf:"fixed64,7,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`               // 每个工作节点上每秒允许开始的执行数，0 表示不限制
+	Burst          int32                  `protobuf:"varint,8,opt,name=burst,proto3" json:"burst,omitempty"`                                         // 令牌桶容量，默认 1
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateResourcePoolRequest) Reset() {
+	*x = CreateResourcePoolRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateResourcePoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateResourcePoolRequest) ProtoMessage() {}
+
+func (x *CreateResourcePoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResourcePoolRequest.ProtoReflect.Descriptor instead.
+func (*CreateResourcePoolRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateResourcePoolRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateResourcePoolRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateResourcePoolRequest) GetHandler() string {
+	if x != nil {
+		return x.Handler
+	}
+	return ""
+}
+
+func (x *CreateResourcePoolRequest) GetLabelKey() string {
+	if x != nil {
+		return x.LabelKey
+	}
+	return ""
+}
+
+func (x *CreateResourcePoolRequest) GetLabelValue() string {
+	if x != nil {
+		return x.LabelValue
+	}
+	return ""
+}
+
+func (x *CreateResourcePoolRequest) GetMaxConcurrency() int32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *CreateResourcePoolRequest) GetRateLimit() float64 {
+	if x != nil {
+		return x.RateLimit
+	}
+	return 0
+}
+
+func (x *CreateResourcePoolRequest) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+// 获取资源池请求
+type GetResourcePoolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourcePoolRequest) Reset() {
+	*x = GetResourcePoolRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourcePoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourcePoolRequest) ProtoMessage() {}
+
+func (x *GetResourcePoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourcePoolRequest.ProtoReflect.Descriptor instead.
+func (*GetResourcePoolRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetResourcePoolRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 更新资源池请求
+type UpdateResourcePoolRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Handler        string                 `protobuf:"bytes,4,opt,name=handler,proto3" json:"handler,omitempty"`
+	LabelKey       string                 `protobuf:"bytes,5,opt,name=label_key,json=labelKey,proto3" json:"label_key,omitempty"`
+	LabelValue     string                 `protobuf:"bytes,6,opt,name=label_value,json=labelValue,proto3" json:"label_value,omitempty"`
+	MaxConcurrency int32                  `protobuf:"varint,7,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	RateLimit      float64                `protobuf:"fixed64,8,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	Burst          int32                  `protobuf:"varint,9,opt,name=burst,proto3" json:"burst,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateResourcePoolRequest) Reset() {
+	*x = UpdateResourcePoolRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateResourcePoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResourcePoolRequest) ProtoMessage() {}
+
+func (x *UpdateResourcePoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResourcePoolRequest.ProtoReflect.Descriptor instead.
+func (*UpdateResourcePoolRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdateResourcePoolRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateResourcePoolRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateResourcePoolRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateResourcePoolRequest) GetHandler() string {
+	if x != nil {
+		return x.Handler
+	}
+	return ""
+}
+
+func (x *UpdateResourcePoolRequest) GetLabelKey() string {
+	if x != nil {
+		return x.LabelKey
+	}
+	return ""
+}
+
+func (x *UpdateResourcePoolRequest) GetLabelValue() string {
+	if x != nil {
+		return x.LabelValue
+	}
+	return ""
+}
+
+func (x *UpdateResourcePoolRequest) GetMaxConcurrency() int32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *UpdateResourcePoolRequest) GetRateLimit() float64 {
+	if x != nil {
+		return x.RateLimit
+	}
+	return 0
+}
+
+func (x *UpdateResourcePoolRequest) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+// 删除资源池请求
+type DeleteResourcePoolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResourcePoolRequest) Reset() {
+	*x = DeleteResourcePoolRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResourcePoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourcePoolRequest) ProtoMessage() {}
+
+func (x *DeleteResourcePoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourcePoolRequest.ProtoReflect.Descriptor instead.
+func (*DeleteResourcePoolRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DeleteResourcePoolRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 资源池列表请求
+type ListResourcePoolsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"` // 页码，从 1 开始，0 等同于 1
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Keyword       string                 `protobuf:"bytes,3,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResourcePoolsRequest) Reset() {
+	*x = ListResourcePoolsRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourcePoolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourcePoolsRequest) ProtoMessage() {}
+
+func (x *ListResourcePoolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourcePoolsRequest.ProtoReflect.Descriptor instead.
+func (*ListResourcePoolsRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListResourcePoolsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListResourcePoolsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListResourcePoolsRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+// 资源池响应
+type ResourcePoolReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Handler        string                 `protobuf:"bytes,4,opt,name=handler,proto3" json:"handler,omitempty"`
+	LabelKey       string                 `protobuf:"bytes,5,opt,name=label_key,json=labelKey,proto3" json:"label_key,omitempty"`
+	LabelValue     string                 `protobuf:"bytes,6,opt,name=label_value,json=labelValue,proto3" json:"label_value,omitempty"`
+	MaxConcurrency int32                  `protobuf:"varint,7,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	RateLimit      float64                `protobuf:"fixed64,8,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	Burst          int32                  `protobuf:"varint,9,opt,name=burst,proto3" json:"burst,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResourcePoolReply) Reset() {
+	*x = ResourcePoolReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourcePoolReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourcePoolReply) ProtoMessage() {}
+
+func (x *ResourcePoolReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourcePoolReply.ProtoReflect.Descriptor instead.
+func (*ResourcePoolReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ResourcePoolReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResourcePoolReply) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourcePoolReply) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ResourcePoolReply) GetHandler() string {
+	if x != nil {
+		return x.Handler
+	}
+	return ""
+}
+
+func (x *ResourcePoolReply) GetLabelKey() string {
+	if x != nil {
+		return x.LabelKey
+	}
+	return ""
+}
+
+func (x *ResourcePoolReply) GetLabelValue() string {
+	if x != nil {
+		return x.LabelValue
+	}
+	return ""
+}
+
+func (x *ResourcePoolReply) GetMaxConcurrency() int32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *ResourcePoolReply) GetRateLimit() float64 {
+	if x != nil {
+		return x.RateLimit
+	}
+	return 0
+}
+
+func (x *ResourcePoolReply) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *ResourcePoolReply) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ResourcePoolReply) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// 资源池列表响应
+type ListResourcePoolsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pools         []*ResourcePoolReply   `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResourcePoolsReply) Reset() {
+	*x = ListResourcePoolsReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourcePoolsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourcePoolsReply) ProtoMessage() {}
+
+func (x *ListResourcePoolsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourcePoolsReply.ProtoReflect.Descriptor instead.
+func (*ListResourcePoolsReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListResourcePoolsReply) GetPools() []*ResourcePoolReply {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+func (x *ListResourcePoolsReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListResourcePoolsReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListResourcePoolsReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 审计事件列表请求
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"` // 页码，从 1 开始，0 等同于 1
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`                                  // 操作者筛选
+	Operation     string                 `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`                          // 接口名称筛选，如 DeleteTask
+	TargetId      int64                  `protobuf:"varint,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`           // 操作对象ID筛选
+	Result        AuditResult            `protobuf:"varint,6,opt,name=result,proto3,enum=scheduler.v1.AuditResult" json:"result,omitempty"` // 结果筛选
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`         // 开始时间（包含）
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`               // 结束时间（不包含）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListAuditEventsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetResult() AuditResult {
+	if x != nil {
+		return x.Result
+	}
+	return AuditResult_AUDIT_RESULT_UNSPECIFIED
+}
+
+func (x *ListAuditEventsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+// 审计事件响应
+type AuditEventReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`                        // 操作者
+	Operation     string                 `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`                // 接口名称
+	TargetId      int64                  `protobuf:"varint,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"` // 操作对象ID，创建类接口为新对象的ID
+	Targets       []string               `protobuf:"bytes,6,rep,name=targets,proto3" json:"targets,omitempty"`                    // 请求中的所有ID字段，格式为 field=value
+	Request       string                 `protobuf:"bytes,7,opt,name=request,proto3" json:"request,omitempty"`                    // 请求摘要（JSON，负载已脱敏，超长时截断）
+	Result        AuditResult            `protobuf:"varint,8,opt,name=result,proto3,enum=scheduler.v1.AuditResult" json:"result,omitempty"`
+	Code          int32                  `protobuf:"varint,9,opt,name=code,proto3" json:"code,omitempty"`                         // 失败时的 HTTP 状态码
+	Reason        string                 `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`                     // 失败时的错误原因
+	Message       string                 `protobuf:"bytes,11,opt,name=message,proto3" json:"message,omitempty"`                   // 失败时的错误信息
+	ClientIp      string                 `protobuf:"bytes,12,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"` // 客户端IP
+	Transport     string                 `protobuf:"bytes,13,opt,name=transport,proto3" json:"transport,omitempty"`               // http 或 grpc
+	Duration      int32                  `protobuf:"varint,14,opt,name=duration,proto3" json:"duration,omitempty"`                // 耗时（毫秒）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEventReply) Reset() {
+	*x = AuditEventReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEventReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEventReply) ProtoMessage() {}
+
+func (x *AuditEventReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEventReply.ProtoReflect.Descriptor instead.
+func (*AuditEventReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AuditEventReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEventReply) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *AuditEventReply) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEventReply) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *AuditEventReply) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *AuditEventReply) GetTargets() []string {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *AuditEventReply) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *AuditEventReply) GetResult() AuditResult {
+	if x != nil {
+		return x.Result
+	}
+	return AuditResult_AUDIT_RESULT_UNSPECIFIED
+}
+
+func (x *AuditEventReply) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AuditEventReply) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AuditEventReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AuditEventReply) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *AuditEventReply) GetTransport() string {
+	if x != nil {
+		return x.Transport
+	}
+	return ""
+}
+
+func (x *AuditEventReply) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+// 审计事件列表响应
+type ListAuditEventsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEventReply     `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsReply) Reset() {
+	*x = ListAuditEventsReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsReply) ProtoMessage() {}
+
+func (x *ListAuditEventsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsReply.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListAuditEventsReply) GetEvents() []*AuditEventReply {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListAuditEventsReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditEventsReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 当前调用方请求
+type WhoAmIRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WhoAmIRequest) Reset() {
+	*x = WhoAmIRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WhoAmIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhoAmIRequest) ProtoMessage() {}
+
+func (x *WhoAmIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhoAmIRequest.ProtoReflect.Descriptor instead.
+func (*WhoAmIRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{51}
+}
+
+// 角色授权
+type RoleGrant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 非空时只作用于元数据包含全部标签的任务
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Namespaces    []string               `protobuf:"bytes,4,rep,name=namespaces,proto3" json:"namespaces,omitempty"` // 非空时只作用于这些命名空间中的任务
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleGrant) Reset() {
+	*x = RoleGrant{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleGrant) ProtoMessage() {}
+
+func (x *RoleGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleGrant.ProtoReflect.Descriptor instead.
+func (*RoleGrant) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RoleGrant) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoleGrant) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *RoleGrant) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *RoleGrant) GetNamespaces() []string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+// 当前调用方响应
+type WhoAmIReply struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                              // 主体名称，未启用认证时为空
+	AuthMethod           string                 `protobuf:"bytes,2,opt,name=auth_method,json=authMethod,proto3" json:"auth_method,omitempty"`                                // api_key、jwt 或 mtls
+	AuthorizationEnabled bool                   `protobuf:"varint,3,opt,name=authorization_enabled,json=authorizationEnabled,proto3" json:"authorization_enabled,omitempty"` // 是否按角色校验权限，未启用时拥有全部权限
+	Grants               []*RoleGrant           `protobuf:"bytes,4,rep,name=grants,proto3" json:"grants,omitempty"`
+	Permissions          []string               `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"` // 不限范围的有效权限
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *WhoAmIReply) Reset() {
+	*x = WhoAmIReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WhoAmIReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhoAmIReply) ProtoMessage() {}
+
+func (x *WhoAmIReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhoAmIReply.ProtoReflect.Descriptor instead.
+func (*WhoAmIReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *WhoAmIReply) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WhoAmIReply) GetAuthMethod() string {
+	if x != nil {
+		return x.AuthMethod
+	}
+	return ""
+}
+
+func (x *WhoAmIReply) GetAuthorizationEnabled() bool {
+	if x != nil {
+		return x.AuthorizationEnabled
+	}
+	return false
+}
+
+func (x *WhoAmIReply) GetGrants() []*RoleGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+func (x *WhoAmIReply) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+// 创建命名空间请求
+type CreateNamespaceRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Name                    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // 命名空间名称，唯一，小写字母、数字和连字符，最长 63 个字符
+	Description             string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	MaxTasks                int32                  `protobuf:"varint,3,opt,name=max_tasks,json=maxTasks,proto3" json:"max_tasks,omitempty"`                                                // 最大任务数（不包括已删除的任务），0 表示不限制
+	MaxConcurrentExecutions int32                  `protobuf:"varint,4,opt,name=max_concurrent_executions,json=maxConcurrentExecutions,proto3" json:"max_concurrent_executions,omitempty"` // 所有工作节点合计的最大同时执行数，0 表示不限制
+	MaxExecutionsPerMinute  int32                  `protobuf:"varint,5,opt,name=max_executions_per_minute,json=maxExecutionsPerMinute,proto3" json:"max_executions_per_minute,omitempty"`  // 所有工作节点合计每分钟最多开始执行的次数，0 表示不限制
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *CreateNamespaceRequest) Reset() {
+	*x = CreateNamespaceRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNamespaceRequest) ProtoMessage() {}
+
+func (x *CreateNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*CreateNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateNamespaceRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateNamespaceRequest) GetMaxTasks() int32 {
+	if x != nil {
+		return x.MaxTasks
+	}
+	return 0
+}
+
+func (x *CreateNamespaceRequest) GetMaxConcurrentExecutions() int32 {
+	if x != nil {
+		return x.MaxConcurrentExecutions
+	}
+	return 0
+}
+
+func (x *CreateNamespaceRequest) GetMaxExecutionsPerMinute() int32 {
+	if x != nil {
+		return x.MaxExecutionsPerMinute
+	}
+	return 0
+}
+
+// 获取命名空间请求
+type GetNamespaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespaceRequest) Reset() {
+	*x = GetNamespaceRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceRequest) ProtoMessage() {}
+
+func (x *GetNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// 更新命名空间请求
+type UpdateNamespaceRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Name                    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description             string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	MaxTasks                int32                  `protobuf:"varint,3,opt,name=max_tasks,json=maxTasks,proto3" json:"max_tasks,omitempty"`
+	MaxConcurrentExecutions int32                  `protobuf:"varint,4,opt,name=max_concurrent_executions,json=maxConcurrentExecutions,proto3" json:"max_concurrent_executions,omitempty"`
+	MaxExecutionsPerMinute  int32                  `protobuf:"varint,5,opt,name=max_executions_per_minute,json=maxExecutionsPerMinute,proto3" json:"max_executions_per_minute,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *UpdateNamespaceRequest) Reset() {
+	*x = UpdateNamespaceRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNamespaceRequest) ProtoMessage() {}
+
+func (x *UpdateNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpdateNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateNamespaceRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateNamespaceRequest) GetMaxTasks() int32 {
+	if x != nil {
+		return x.MaxTasks
+	}
+	return 0
+}
+
+func (x *UpdateNamespaceRequest) GetMaxConcurrentExecutions() int32 {
+	if x != nil {
+		return x.MaxConcurrentExecutions
+	}
+	return 0
+}
+
+func (x *UpdateNamespaceRequest) GetMaxExecutionsPerMinute() int32 {
+	if x != nil {
+		return x.MaxExecutionsPerMinute
+	}
+	return 0
+}
+
+// 删除命名空间请求
+type DeleteNamespaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNamespaceRequest) Reset() {
+	*x = DeleteNamespaceRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNamespaceRequest) ProtoMessage() {}
+
+func (x *DeleteNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeleteNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// 命名空间列表请求
+type ListNamespacesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"` // 页码，从 1 开始，0 等同于 1
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Keyword       string                 `protobuf:"bytes,3,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNamespacesRequest) Reset() {
+	*x = ListNamespacesRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNamespacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNamespacesRequest) ProtoMessage() {}
+
+func (x *ListNamespacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNamespacesRequest.ProtoReflect.Descriptor instead.
+func (*ListNamespacesRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListNamespacesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListNamespacesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNamespacesRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+// 命名空间响应
+type NamespaceReply struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description             string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	MaxTasks                int32                  `protobuf:"varint,4,opt,name=max_tasks,json=maxTasks,proto3" json:"max_tasks,omitempty"`
+	MaxConcurrentExecutions int32                  `protobuf:"varint,5,opt,name=max_concurrent_executions,json=maxConcurrentExecutions,proto3" json:"max_concurrent_executions,omitempty"`
+	MaxExecutionsPerMinute  int32                  `protobuf:"varint,6,opt,name=max_executions_per_minute,json=maxExecutionsPerMinute,proto3" json:"max_executions_per_minute,omitempty"`
+	CreatedAt               *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *NamespaceReply) Reset() {
+	*x = NamespaceReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceReply) ProtoMessage() {}
+
+func (x *NamespaceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceReply.ProtoReflect.Descriptor instead.
+func (*NamespaceReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *NamespaceReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NamespaceReply) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NamespaceReply) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *NamespaceReply) GetMaxTasks() int32 {
+	if x != nil {
+		return x.MaxTasks
+	}
+	return 0
+}
+
+func (x *NamespaceReply) GetMaxConcurrentExecutions() int32 {
+	if x != nil {
+		return x.MaxConcurrentExecutions
+	}
+	return 0
+}
+
+func (x *NamespaceReply) GetMaxExecutionsPerMinute() int32 {
+	if x != nil {
+		return x.MaxExecutionsPerMinute
+	}
+	return 0
+}
+
+func (x *NamespaceReply) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NamespaceReply) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// 命名空间列表响应
+type ListNamespacesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespaces    []*NamespaceReply      `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNamespacesReply) Reset() {
+	*x = ListNamespacesReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNamespacesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNamespacesReply) ProtoMessage() {}
+
+func (x *ListNamespacesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNamespacesReply.ProtoReflect.Descriptor instead.
+func (*ListNamespacesReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListNamespacesReply) GetNamespaces() []*NamespaceReply {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *ListNamespacesReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListNamespacesReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListNamespacesReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 创建密钥请求
+type CreateSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // 所属命名空间，为空时使用请求头 X-Namespace 或 default
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`           // 密钥名称，命名空间内唯一，字母、数字、'_'、'-' 和 '.'，最长 128 个字符
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"` // 密钥的值，只写
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSecretRequest) Reset() {
+	*x = CreateSecretRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecretRequest) ProtoMessage() {}
+
+func (x *CreateSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecretRequest.ProtoReflect.Descriptor instead.
+func (*CreateSecretRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateSecretRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CreateSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSecretRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateSecretRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// 获取密钥请求
+type GetSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecretRequest) Reset() {
+	*x = GetSecretRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretRequest) ProtoMessage() {}
+
+func (x *GetSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetSecretRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// 更新密钥请求
+type UpdateSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"` // 新的值，为空时保留原值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSecretRequest) Reset() {
+	*x = UpdateSecretRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecretRequest) ProtoMessage() {}
+
+func (x *UpdateSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecretRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSecretRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UpdateSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSecretRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpdateSecretRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateSecretRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// 删除密钥请求
+type DeleteSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTasksReply) Reset() {
-	*x = ListTasksReply{}
-	mi := &file_scheduler_v1_scheduler_proto_msgTypes[12]
+func (x *DeleteSecretRequest) Reset() {
+	*x = DeleteSecretRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTasksReply) String() string {
+func (x *DeleteSecretRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTasksReply) ProtoMessage() {}
+func (*DeleteSecretRequest) ProtoMessage() {}
 
-func (x *ListTasksReply) ProtoReflect() protoreflect.Message {
-	mi := &file_scheduler_v1_scheduler_proto_msgTypes[12]
+func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,63 +5503,51 @@ func (x *ListTasksReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTasksReply.ProtoReflect.Descriptor instead.
-func (*ListTasksReply) Descriptor() ([]byte, []int) {
-	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *ListTasksReply) GetTasks() []*TaskReply {
-	if x != nil {
-		return x.Tasks
-	}
-	return nil
-}
-
-func (x *ListTasksReply) GetTotal() int64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// Deprecated: Use DeleteSecretRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ListTasksReply) GetPage() int32 {
+func (x *DeleteSecretRequest) GetName() string {
 	if x != nil {
-		return x.Page
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ListTasksReply) GetPageSize() int32 {
+func (x *DeleteSecretRequest) GetNamespace() string {
 	if x != nil {
-		return x.PageSize
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-// 任务执行响应
-type TaskExecutionReply struct {
+// 密钥列表请求
+type ListSecretsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExecutionId   int64                  `protobuf:"varint,1,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"` // 页码，从 1 开始，0 等同于 1
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Keyword       string                 `protobuf:"bytes,4,opt,name=keyword,proto3" json:"keyword,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TaskExecutionReply) Reset() {
-	*x = TaskExecutionReply{}
-	mi := &file_scheduler_v1_scheduler_proto_msgTypes[13]
+func (x *ListSecretsRequest) Reset() {
+	*x = ListSecretsRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TaskExecutionReply) String() string {
+func (x *ListSecretsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskExecutionReply) ProtoMessage() {}
+func (*ListSecretsRequest) ProtoMessage() {}
 
-func (x *TaskExecutionReply) ProtoReflect() protoreflect.Message {
-	mi := &file_scheduler_v1_scheduler_proto_msgTypes[13]
+func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1139,59 +5558,69 @@ func (x *TaskExecutionReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskExecutionReply.ProtoReflect.Descriptor instead.
-func (*TaskExecutionReply) Descriptor() ([]byte, []int) {
-	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListSecretsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *TaskExecutionReply) GetExecutionId() int64 {
+func (x *ListSecretsRequest) GetPage() int32 {
 	if x != nil {
-		return x.ExecutionId
+		return x.Page
 	}
 	return 0
 }
 
-func (x *TaskExecutionReply) GetMessage() string {
+func (x *ListSecretsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Message
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSecretsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
 	}
 	return ""
 }
 
-// 执行记录响应
-type ExecutionReply struct {
+func (x *ListSecretsRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+// 密钥响应，不包括值
+type SecretReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	TaskName      string                 `protobuf:"bytes,3,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
-	Status        ExecutionStatus        `protobuf:"varint,4,opt,name=status,proto3,enum=scheduler.v1.ExecutionStatus" json:"status,omitempty"`
-	NodeId        string                 `protobuf:"bytes,5,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"` // 执行节点ID
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Duration      int32                  `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`                        // 执行耗时（毫秒）
-	Result        string                 `protobuf:"bytes,9,opt,name=result,proto3" json:"result,omitempty"`                             // 执行结果
-	Error         string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`                              // 错误信息
-	RetryCount    int32                  `protobuf:"varint,11,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"` // 重试次数
-	Payload       string                 `protobuf:"bytes,12,opt,name=payload,proto3" json:"payload,omitempty"`                          // 执行负载
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Version       int32                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`         // 值的版本，每次修改值时加一
+	KeyId         string                 `protobuf:"bytes,6,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"` // 加密数据密钥的主密钥ID
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecutionReply) Reset() {
-	*x = ExecutionReply{}
-	mi := &file_scheduler_v1_scheduler_proto_msgTypes[14]
+func (x *SecretReply) Reset() {
+	*x = SecretReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecutionReply) String() string {
+func (x *SecretReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutionReply) ProtoMessage() {}
+func (*SecretReply) ProtoMessage() {}
 
-func (x *ExecutionReply) ProtoReflect() protoreflect.Message {
-	mi := &file_scheduler_v1_scheduler_proto_msgTypes[14]
+func (x *SecretReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1202,121 +5631,158 @@ func (x *ExecutionReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutionReply.ProtoReflect.Descriptor instead.
-func (*ExecutionReply) Descriptor() ([]byte, []int) {
-	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SecretReply.ProtoReflect.Descriptor instead.
+func (*SecretReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ExecutionReply) GetId() int64 {
+func (x *SecretReply) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *ExecutionReply) GetTaskId() int64 {
+func (x *SecretReply) GetNamespace() string {
 	if x != nil {
-		return x.TaskId
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-func (x *ExecutionReply) GetTaskName() string {
+func (x *SecretReply) GetName() string {
 	if x != nil {
-		return x.TaskName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ExecutionReply) GetStatus() ExecutionStatus {
+func (x *SecretReply) GetDescription() string {
 	if x != nil {
-		return x.Status
+		return x.Description
 	}
-	return ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *ExecutionReply) GetNodeId() string {
+func (x *SecretReply) GetVersion() int32 {
 	if x != nil {
-		return x.NodeId
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SecretReply) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
 	}
 	return ""
 }
 
-func (x *ExecutionReply) GetStartTime() *timestamppb.Timestamp {
+func (x *SecretReply) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.StartTime
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *ExecutionReply) GetEndTime() *timestamppb.Timestamp {
+func (x *SecretReply) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EndTime
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (x *ExecutionReply) GetDuration() int32 {
+// 密钥列表响应
+type ListSecretsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secrets       []*SecretReply         `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretsReply) Reset() {
+	*x = ListSecretsReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsReply) ProtoMessage() {}
+
+func (x *ListSecretsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[67]
 	if x != nil {
-		return x.Duration
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ExecutionReply) GetResult() string {
+// Deprecated: Use ListSecretsReply.ProtoReflect.Descriptor instead.
+func (*ListSecretsReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListSecretsReply) GetSecrets() []*SecretReply {
 	if x != nil {
-		return x.Result
+		return x.Secrets
 	}
-	return ""
+	return nil
 }
 
-func (x *ExecutionReply) GetError() string {
+func (x *ListSecretsReply) GetTotal() int64 {
 	if x != nil {
-		return x.Error
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *ExecutionReply) GetRetryCount() int32 {
+func (x *ListSecretsReply) GetPage() int32 {
 	if x != nil {
-		return x.RetryCount
+		return x.Page
 	}
 	return 0
 }
 
-func (x *ExecutionReply) GetPayload() string {
+func (x *ListSecretsReply) GetPageSize() int32 {
 	if x != nil {
-		return x.Payload
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-// 执行历史列表响应
-type ListExecutionsReply struct {
+// 轮换主密钥请求
+type RotateSecretKeysRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Executions    []*ExecutionReply      `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListExecutionsReply) Reset() {
-	*x = ListExecutionsReply{}
-	mi := &file_scheduler_v1_scheduler_proto_msgTypes[15]
+func (x *RotateSecretKeysRequest) Reset() {
+	*x = RotateSecretKeysRequest{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListExecutionsReply) String() string {
+func (x *RotateSecretKeysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExecutionsReply) ProtoMessage() {}
+func (*RotateSecretKeysRequest) ProtoMessage() {}
 
-func (x *ListExecutionsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_scheduler_v1_scheduler_proto_msgTypes[15]
+func (x *RotateSecretKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1327,35 +5793,60 @@ func (x *ListExecutionsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExecutionsReply.ProtoReflect.Descriptor instead.
-func (*ListExecutionsReply) Descriptor() ([]byte, []int) {
-	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RotateSecretKeysRequest.ProtoReflect.Descriptor instead.
+func (*RotateSecretKeysRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *ListExecutionsReply) GetExecutions() []*ExecutionReply {
-	if x != nil {
-		return x.Executions
-	}
-	return nil
+// 轮换主密钥响应
+type RotateSecretKeysReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PrimaryKeyId  string                 `protobuf:"bytes,1,opt,name=primary_key_id,json=primaryKeyId,proto3" json:"primary_key_id,omitempty"` // 主用密钥ID
+	Rewrapped     int64                  `protobuf:"varint,2,opt,name=rewrapped,proto3" json:"rewrapped,omitempty"`                            // 重新加密的密钥数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListExecutionsReply) GetTotal() int64 {
+func (x *RotateSecretKeysReply) Reset() {
+	*x = RotateSecretKeysReply{}
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateSecretKeysReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateSecretKeysReply) ProtoMessage() {}
+
+func (x *RotateSecretKeysReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_v1_scheduler_proto_msgTypes[69]
 	if x != nil {
-		return x.Total
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ListExecutionsReply) GetPage() int32 {
+// Deprecated: Use RotateSecretKeysReply.ProtoReflect.Descriptor instead.
+func (*RotateSecretKeysReply) Descriptor() ([]byte, []int) {
+	return file_scheduler_v1_scheduler_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RotateSecretKeysReply) GetPrimaryKeyId() string {
 	if x != nil {
-		return x.Page
+		return x.PrimaryKeyId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListExecutionsReply) GetPageSize() int32 {
+func (x *RotateSecretKeysReply) GetRewrapped() int64 {
 	if x != nil {
-		return x.PageSize
+		return x.Rewrapped
 	}
 	return 0
 }
@@ -1364,56 +5855,153 @@ var File_scheduler_v1_scheduler_proto protoreflect.FileDescriptor
 
 const file_scheduler_v1_scheduler_proto_rawDesc = "" +
 	"\n" +
-	"\x1cscheduler/v1/scheduler.proto\x12\fscheduler.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xe7\x02\n" +
-	"\x11CreateTaskRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12*\n" +
-	"\x04type\x18\x03 \x01(\x0e2\x16.scheduler.v1.TaskTypeR\x04type\x12\x1a\n" +
-	"\bschedule\x18\x04 \x01(\tR\bschedule\x12\x18\n" +
-	"\ahandler\x18\x05 \x01(\tR\ahandler\x12\x18\n" +
-	"\apayload\x18\x06 \x01(\tR\apayload\x12\x18\n" +
-	"\atimeout\x18\a \x01(\x05R\atimeout\x12I\n" +
-	"\bmetadata\x18\b \x03(\v2-.scheduler.v1.CreateTaskRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\x1cscheduler/v1/scheduler.proto\x12\fscheduler.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x17validate/validate.proto\"\x82\t\n" +
+	"\x11CreateTaskRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x126\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x16.scheduler.v1.TaskTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x04type\x12$\n" +
+	"\bschedule\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\bschedule\x12$\n" +
+	"\ahandler\x18\x05 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\ahandler\x12\x18\n" +
+	"\apayload\x18\x06 \x01(\tR\apayload\x12!\n" +
+	"\atimeout\x18\a \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\atimeout\x12I\n" +
+	"\bmetadata\x18\b \x03(\v2-.scheduler.v1.CreateTaskRequest.MetadataEntryR\bmetadata\x12(\n" +
+	"\vcalendar_id\x18\t \x01(\x03B\a\xfaB\x04\"\x02(\x00R\n" +
+	"calendarId\x129\n" +
+	"\n" +
+	"start_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12?\n" +
+	"\x0eactive_windows\x18\f \x03(\v2\x18.scheduler.v1.TimeWindowR\ractiveWindows\x12\"\n" +
+	"\bmax_runs\x18\r \x01(\x03B\a\xfaB\x04\"\x02(\x00R\amaxRuns\x12I\n" +
+	"\rinterval_mode\x18\x0e \x01(\x0e2\x1a.scheduler.v1.IntervalModeB\b\xfaB\x05\x82\x01\x02\x10\x01R\fintervalMode\x12H\n" +
+	"\rinitial_delay\x18\x0f \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x022\x00R\finitialDelay\x12;\n" +
+	"\x06jitter\x18\x10 \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x022\x00R\x06jitter\x12%\n" +
+	"\bpriority\x18\x11 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\t(\x00R\bpriority\x12'\n" +
+	"\n" +
+	"lock_group\x18\x12 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\tlockGroup\x12X\n" +
+	"\x12concurrency_policy\x18\x13 \x01(\x0e2\x1f.scheduler.v1.ConcurrencyPolicyB\b\xfaB\x05\x82\x01\x02\x10\x01R\x11concurrencyPolicy\x12>\n" +
+	"\tretention\x18\x14 \x01(\v2 .scheduler.v1.ExecutionRetentionR\tretention\x12%\n" +
+	"\tnamespace\x18\x15 \x01(\tB\a\xfaB\x04r\x02\x18?R\tnamespace\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\" \n" +
-	"\x0eGetTaskRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\xb1\x02\n" +
-	"\x11UpdateTaskRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
-	"\bschedule\x18\x04 \x01(\tR\bschedule\x12\x18\n" +
-	"\apayload\x18\x05 \x01(\tR\apayload\x12\x18\n" +
-	"\atimeout\x18\x06 \x01(\x05R\atimeout\x12I\n" +
-	"\bmetadata\x18\a \x03(\v2-.scheduler.v1.UpdateTaskRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x84\x01\n" +
+	"\n" +
+	"TimeWindow\x12<\n" +
+	"\x05start\x18\x01 \x01(\tB&\xfaB#r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$R\x05start\x128\n" +
+	"\x03end\x18\x02 \x01(\tB&\xfaB#r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$R\x03end\"\x93\x01\n" +
+	"\x12ExecutionRetention\x12$\n" +
+	"\tkeep_last\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bkeepLast\x12$\n" +
+	"\tkeep_days\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bkeepDays\x121\n" +
+	"\x10failed_keep_days\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x0efailedKeepDays\")\n" +
+	"\x0eGetTaskRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"\xce\t\n" +
+	"\x11UpdateTaskRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12\x1c\n" +
+	"\x04name\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12$\n" +
+	"\bschedule\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\bschedule\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x12!\n" +
+	"\atimeout\x18\x06 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\atimeout\x12I\n" +
+	"\bmetadata\x18\a \x03(\v2-.scheduler.v1.UpdateTaskRequest.MetadataEntryR\bmetadata\x12(\n" +
+	"\vcalendar_id\x18\b \x01(\x03B\a\xfaB\x04\"\x02(\x00R\n" +
+	"calendarId\x129\n" +
+	"\n" +
+	"start_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12?\n" +
+	"\x0eactive_windows\x18\v \x03(\v2\x18.scheduler.v1.TimeWindowR\ractiveWindows\x12\"\n" +
+	"\bmax_runs\x18\f \x01(\x03B\a\xfaB\x04\"\x02(\x00R\amaxRuns\x12I\n" +
+	"\rinterval_mode\x18\r \x01(\x0e2\x1a.scheduler.v1.IntervalModeB\b\xfaB\x05\x82\x01\x02\x10\x01R\fintervalMode\x12H\n" +
+	"\rinitial_delay\x18\x0e \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x022\x00R\finitialDelay\x12;\n" +
+	"\x06jitter\x18\x0f \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x022\x00R\x06jitter\x12%\n" +
+	"\bpriority\x18\x10 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\t(\x00R\bpriority\x12'\n" +
+	"\n" +
+	"lock_group\x18\x11 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\tlockGroup\x12X\n" +
+	"\x12concurrency_policy\x18\x12 \x01(\x0e2\x1f.scheduler.v1.ConcurrencyPolicyB\b\xfaB\x05\x82\x01\x02\x10\x01R\x11concurrencyPolicy\x12>\n" +
+	"\tretention\x18\x13 \x01(\v2 .scheduler.v1.ExecutionRetentionR\tretention\x12!\n" +
+	"\aversion\x18\x14 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\aversion\x12;\n" +
+	"\vupdate_mask\x18\x15 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x124\n" +
+	"\x04type\x18\x16 \x01(\x0e2\x16.scheduler.v1.TaskTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\x04type\x12\"\n" +
+	"\ahandler\x18\x17 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\ahandler\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"#\n" +
-	"\x11DeleteTaskRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\xbb\x01\n" +
-	"\x10ListTasksRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x120\n" +
-	"\x06status\x18\x03 \x01(\x0e2\x18.scheduler.v1.TaskStatusR\x06status\x12*\n" +
-	"\x04type\x18\x04 \x01(\x0e2\x16.scheduler.v1.TaskTypeR\x04type\x12\x18\n" +
-	"\akeyword\x18\x05 \x01(\tR\akeyword\">\n" +
-	"\x12ExecuteTaskRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
-	"\apayload\x18\x02 \x01(\tR\apayload\"\"\n" +
-	"\x10PauseTaskRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"#\n" +
-	"\x11ResumeTaskRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\x9b\x01\n" +
-	"\x18GetTaskExecutionsRequest\x12\x17\n" +
-	"\atask_id\x18\x01 \x01(\x03R\x06taskId\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x125\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x1d.scheduler.v1.ExecutionStatusR\x06status\"%\n" +
-	"\x13GetExecutionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"(\n" +
-	"\x16CancelExecutionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\xc0\x05\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\",\n" +
+	"\x11DeleteTaskRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"-\n" +
+	"\x12RestoreTaskRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"+\n" +
+	"\x10PurgeTaskRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"\xca\x03\n" +
+	"\x10ListTasksRequest\x12\x1b\n" +
+	"\x04page\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x01R\bpageSize\x12:\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x18.scheduler.v1.TaskStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\x124\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x16.scheduler.v1.TaskTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\x04type\x12\x18\n" +
+	"\akeyword\x18\x05 \x01(\tR\akeyword\x12(\n" +
+	"\vcalendar_id\x18\x06 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\n" +
+	"calendarId\x12'\n" +
+	"\x0finclude_deleted\x18\a \x01(\bR\x0eincludeDeleted\x12%\n" +
+	"\tnamespace\x18\b \x01(\tB\a\xfaB\x04r\x02\x18?R\tnamespace\x12'\n" +
+	"\n" +
+	"page_token\x18\t \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\tpageToken\x12\"\n" +
+	"\border_by\x18\n" +
+	" \x01(\tB\a\xfaB\x04r\x02\x18@R\aorderBy\x12\x1d\n" +
+	"\n" +
+	"skip_total\x18\v \x01(\bR\tskipTotal\"\x82\x01\n" +
+	"\x18ListTaskRevisionsRequest\x12 \n" +
+	"\atask_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06taskId\x12\x1b\n" +
+	"\x04page\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12'\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x01R\bpageSize\"]\n" +
+	"\x16GetTaskRevisionRequest\x12 \n" +
+	"\atask_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06taskId\x12!\n" +
+	"\aversion\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aversion\"v\n" +
+	"\x13RollbackTaskRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12#\n" +
+	"\brevision\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\brevision\x12!\n" +
+	"\aversion\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\aversion\"\x80\x01\n" +
+	"\x12ExecuteTaskRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\tR\apayload\x12*\n" +
+	"\bpriority\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\t(\x00H\x00R\bpriority\x88\x01\x01B\v\n" +
+	"\t_priority\"M\n" +
+	"\x10PauseTaskRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12 \n" +
+	"\x06reason\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x06reason\"N\n" +
+	"\x11ResumeTaskRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12 \n" +
+	"\x06reason\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x06reason\"N\n" +
+	"\x11CancelTaskRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12 \n" +
+	"\x06reason\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x06reason\"\xaf\x02\n" +
+	"\x18GetTaskExecutionsRequest\x12 \n" +
+	"\atask_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06taskId\x12\x1b\n" +
+	"\x04page\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12'\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x01R\bpageSize\x12?\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1d.scheduler.v1.ExecutionStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\x12'\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\tpageToken\x12\"\n" +
+	"\border_by\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x18@R\aorderBy\x12\x1d\n" +
+	"\n" +
+	"skip_total\x18\a \x01(\bR\tskipTotal\".\n" +
+	"\x13GetExecutionRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"1\n" +
+	"\x16CancelExecutionRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"\x16\n" +
+	"\x14GetQueueStatsRequest\"\xe4\x01\n" +
+	"\x16PreviewScheduleRequest\x124\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x16.scheduler.v1.TaskTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\x04type\x12$\n" +
+	"\bschedule\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\bschedule\x12#\n" +
+	"\btimezone\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18@R\btimezone\x12\x1f\n" +
+	"\x05count\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\x05count\x12(\n" +
+	"\vcalendar_id\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\n" +
+	"calendarId\"\xab\f\n" +
 	"\tTaskReply\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1433,18 +6021,60 @@ const file_scheduler_v1_scheduler_proto_rawDesc = "" +
 	"\rnext_run_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vnextRunTime\x12'\n" +
 	"\x0fexecution_count\x18\x0e \x01(\x03R\x0eexecutionCount\x12#\n" +
 	"\rsuccess_count\x18\x0f \x01(\x03R\fsuccessCount\x12!\n" +
-	"\ffailed_count\x18\x10 \x01(\x03R\vfailedCount\x1a;\n" +
+	"\ffailed_count\x18\x10 \x01(\x03R\vfailedCount\x12\x1f\n" +
+	"\vcalendar_id\x18\x11 \x01(\x03R\n" +
+	"calendarId\x129\n" +
+	"\n" +
+	"start_time\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12?\n" +
+	"\x0eactive_windows\x18\x14 \x03(\v2\x18.scheduler.v1.TimeWindowR\ractiveWindows\x12\x19\n" +
+	"\bmax_runs\x18\x15 \x01(\x03R\amaxRuns\x12?\n" +
+	"\rinterval_mode\x18\x16 \x01(\x0e2\x1a.scheduler.v1.IntervalModeR\fintervalMode\x12>\n" +
+	"\rinitial_delay\x18\x17 \x01(\v2\x19.google.protobuf.DurationR\finitialDelay\x121\n" +
+	"\x06jitter\x18\x18 \x01(\v2\x19.google.protobuf.DurationR\x06jitter\x12\x1a\n" +
+	"\bpriority\x18\x19 \x01(\x05R\bpriority\x12\x1d\n" +
+	"\n" +
+	"lock_group\x18\x1a \x01(\tR\tlockGroup\x12N\n" +
+	"\x12concurrency_policy\x18\x1b \x01(\x0e2\x1f.scheduler.v1.ConcurrencyPolicyR\x11concurrencyPolicy\x12>\n" +
+	"\tretention\x18\x1c \x01(\v2 .scheduler.v1.ExecutionRetentionR\tretention\x129\n" +
+	"\n" +
+	"deleted_at\x18\x1d \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x18\n" +
+	"\aversion\x18\x1e \x01(\x03R\aversion\x12\x1c\n" +
+	"\tnamespace\x18\x1f \x01(\tR\tnamespace\x12#\n" +
+	"\rstatus_reason\x18  \x01(\tR\fstatusReason\x12F\n" +
+	"\x11status_changed_at\x18! \x01(\v2\x1a.google.protobuf.TimestampR\x0fstatusChangedAt\x12\x1b\n" +
+	"\trun_count\x18\" \x01(\x03R\brunCount\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x86\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xae\x01\n" +
 	"\x0eListTasksReply\x12-\n" +
 	"\x05tasks\x18\x01 \x03(\v2\x17.scheduler.v1.TaskReplyR\x05tasks\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"a\n" +
+	"\x0fTaskFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\"\xe8\x02\n" +
+	"\x11TaskRevisionReply\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x03R\x06taskId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x128\n" +
+	"\x06action\x18\x03 \x01(\x0e2 .scheduler.v1.TaskRevisionActionR\x06action\x12\x16\n" +
+	"\x06author\x18\x04 \x01(\tR\x06author\x123\n" +
+	"\bsnapshot\x18\x05 \x01(\v2\x17.scheduler.v1.TaskReplyR\bsnapshot\x127\n" +
+	"\achanges\x18\x06 \x03(\v2\x1d.scheduler.v1.TaskFieldChangeR\achanges\x12%\n" +
+	"\x0esource_version\x18\a \x01(\x03R\rsourceVersion\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9e\x01\n" +
+	"\x16ListTaskRevisionsReply\x12=\n" +
+	"\trevisions\x18\x01 \x03(\v2\x1f.scheduler.v1.TaskRevisionReplyR\trevisions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"Q\n" +
 	"\x12TaskExecutionReply\x12!\n" +
 	"\fexecution_id\x18\x01 \x01(\x03R\vexecutionId\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x9d\x03\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x97\x04\n" +
 	"\x0eExecutionReply\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12\x1b\n" +
@@ -1460,20 +6090,287 @@ const file_scheduler_v1_scheduler_proto_rawDesc = "" +
 	" \x01(\tR\x05error\x12\x1f\n" +
 	"\vretry_count\x18\v \x01(\x05R\n" +
 	"retryCount\x12\x18\n" +
-	"\apayload\x18\f \x01(\tR\apayload\"\x9a\x01\n" +
+	"\apayload\x18\f \x01(\tR\apayload\x12\x1a\n" +
+	"\bpriority\x18\r \x01(\x05R\bpriority\x12\x1b\n" +
+	"\twait_time\x18\x0e \x01(\x05R\bwaitTime\x12!\n" +
+	"\ftask_version\x18\x0f \x01(\x03R\vtaskVersion\x12\x1c\n" +
+	"\tnamespace\x18\x10 \x01(\tR\tnamespace\"\xc2\x01\n" +
 	"\x13ListExecutionsReply\x12<\n" +
 	"\n" +
 	"executions\x18\x01 \x03(\v2\x1c.scheduler.v1.ExecutionReplyR\n" +
 	"executions\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize*[\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"\x8e\x01\n" +
+	"\x12PriorityQueueStats\x12\x1a\n" +
+	"\bpriority\x18\x01 \x01(\x05R\bpriority\x12\x16\n" +
+	"\x06queued\x18\x02 \x01(\x03R\x06queued\x12D\n" +
+	"\x10oldest_queued_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0eoldestQueuedAt\"\x94\x01\n" +
+	"\x0fQueueStatsReply\x12@\n" +
+	"\n" +
+	"priorities\x18\x01 \x03(\v2 .scheduler.v1.PriorityQueueStatsR\n" +
+	"priorities\x12!\n" +
+	"\ftotal_queued\x18\x02 \x01(\x03R\vtotalQueued\x12\x1c\n" +
+	"\texecuting\x18\x03 \x01(\x03R\texecuting\"\xc4\x01\n" +
+	"\x14PreviewScheduleReply\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x16\n" +
+	"\x06errors\x18\x02 \x03(\tR\x06errors\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12@\n" +
+	"\x0enext_run_times\x18\x05 \x03(\v2\x1a.google.protobuf.TimestampR\fnextRunTimes\"\xde\x01\n" +
+	"\fCalendarRule\x12D\n" +
+	"\x06action\x18\x01 \x01(\x0e2 .scheduler.v1.CalendarRuleActionB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x06action\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12,\n" +
+	"\bweekdays\x18\x04 \x03(\x05B\x10\xfaB\r\x92\x01\n" +
+	"\x18\x01\"\x06\x1a\x04\x18\x06(\x00R\bweekdays\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"\xb0\x01\n" +
+	"\x15CreateCalendarRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12#\n" +
+	"\btimezone\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18@R\btimezone\x120\n" +
+	"\x05rules\x18\x04 \x03(\v2\x1a.scheduler.v1.CalendarRuleR\x05rules\"-\n" +
+	"\x12GetCalendarRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"\xc9\x01\n" +
+	"\x15UpdateCalendarRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\btimezone\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18@R\btimezone\x120\n" +
+	"\x05rules\x18\x05 \x03(\v2\x1a.scheduler.v1.CalendarRuleR\x05rules\"0\n" +
+	"\x15DeleteCalendarRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"v\n" +
+	"\x14ListCalendarsRequest\x12\x1b\n" +
+	"\x04page\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x01R\bpageSize\x12\x18\n" +
+	"\akeyword\x18\x03 \x01(\tR\akeyword\"\xf4\x01\n" +
+	"\x15ImportCalendarRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x02id\x12\x1c\n" +
+	"\x04name\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\btimezone\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18@R\btimezone\x12\x19\n" +
+	"\x03ics\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x03ics\x12B\n" +
+	"\x06action\x18\x06 \x01(\x0e2 .scheduler.v1.CalendarRuleActionB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06action\"\x99\x02\n" +
+	"\rCalendarReply\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x120\n" +
+	"\x05rules\x18\x05 \x03(\v2\x1a.scheduler.v1.CalendarRuleR\x05rules\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x96\x01\n" +
+	"\x12ListCalendarsReply\x129\n" +
+	"\tcalendars\x18\x01 \x03(\v2\x1b.scheduler.v1.CalendarReplyR\tcalendars\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xd3\x02\n" +
+	"\x19CreateResourcePoolRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\"\n" +
+	"\ahandler\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\ahandler\x12%\n" +
+	"\tlabel_key\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\blabelKey\x12)\n" +
+	"\vlabel_value\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\n" +
+	"labelValue\x120\n" +
+	"\x0fmax_concurrency\x18\x06 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x0emaxConcurrency\x12-\n" +
+	"\n" +
+	"rate_limit\x18\a \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\trateLimit\x12\x1d\n" +
+	"\x05burst\x18\b \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x05burst\"1\n" +
+	"\x16GetResourcePoolRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"\xec\x02\n" +
+	"\x19UpdateResourcePoolRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\"\n" +
+	"\ahandler\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\ahandler\x12%\n" +
+	"\tlabel_key\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\blabelKey\x12)\n" +
+	"\vlabel_value\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\n" +
+	"labelValue\x120\n" +
+	"\x0fmax_concurrency\x18\a \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x0emaxConcurrency\x12-\n" +
+	"\n" +
+	"rate_limit\x18\b \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\trateLimit\x12\x1d\n" +
+	"\x05burst\x18\t \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x05burst\"4\n" +
+	"\x19DeleteResourcePoolRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"z\n" +
+	"\x18ListResourcePoolsRequest\x12\x1b\n" +
+	"\x04page\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x01R\bpageSize\x12\x18\n" +
+	"\akeyword\x18\x03 \x01(\tR\akeyword\"\x85\x03\n" +
+	"\x11ResourcePoolReply\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\ahandler\x18\x04 \x01(\tR\ahandler\x12\x1b\n" +
+	"\tlabel_key\x18\x05 \x01(\tR\blabelKey\x12\x1f\n" +
+	"\vlabel_value\x18\x06 \x01(\tR\n" +
+	"labelValue\x12'\n" +
+	"\x0fmax_concurrency\x18\a \x01(\x05R\x0emaxConcurrency\x12\x1d\n" +
+	"\n" +
+	"rate_limit\x18\b \x01(\x01R\trateLimit\x12\x14\n" +
+	"\x05burst\x18\t \x01(\x05R\x05burst\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x96\x01\n" +
+	"\x16ListResourcePoolsReply\x125\n" +
+	"\x05pools\x18\x01 \x03(\v2\x1f.scheduler.v1.ResourcePoolReplyR\x05pools\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xe7\x02\n" +
+	"\x16ListAuditEventsRequest\x12\x1b\n" +
+	"\x04page\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x01R\bpageSize\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x1c\n" +
+	"\toperation\x18\x04 \x01(\tR\toperation\x12$\n" +
+	"\ttarget_id\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\btargetId\x12;\n" +
+	"\x06result\x18\x06 \x01(\x0e2\x19.scheduler.v1.AuditResultB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06result\x129\n" +
+	"\n" +
+	"start_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"\xa6\x03\n" +
+	"\x0fAuditEventReply\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12.\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x1c\n" +
+	"\toperation\x18\x04 \x01(\tR\toperation\x12\x1b\n" +
+	"\ttarget_id\x18\x05 \x01(\x03R\btargetId\x12\x18\n" +
+	"\atargets\x18\x06 \x03(\tR\atargets\x12\x18\n" +
+	"\arequest\x18\a \x01(\tR\arequest\x121\n" +
+	"\x06result\x18\b \x01(\x0e2\x19.scheduler.v1.AuditResultR\x06result\x12\x12\n" +
+	"\x04code\x18\t \x01(\x05R\x04code\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\v \x01(\tR\amessage\x12\x1b\n" +
+	"\tclient_ip\x18\f \x01(\tR\bclientIp\x12\x1c\n" +
+	"\ttransport\x18\r \x01(\tR\ttransport\x12\x1a\n" +
+	"\bduration\x18\x0e \x01(\x05R\bduration\"\x94\x01\n" +
+	"\x14ListAuditEventsReply\x125\n" +
+	"\x06events\x18\x01 \x03(\v2\x1d.scheduler.v1.AuditEventReplyR\x06events\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x0f\n" +
+	"\rWhoAmIRequest\"\xd9\x01\n" +
+	"\tRoleGrant\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12;\n" +
+	"\x06labels\x18\x02 \x03(\v2#.scheduler.v1.RoleGrant.LabelsEntryR\x06labels\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\x12\x1e\n" +
+	"\n" +
+	"namespaces\x18\x04 \x03(\tR\n" +
+	"namespaces\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xca\x01\n" +
+	"\vWhoAmIReply\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vauth_method\x18\x02 \x01(\tR\n" +
+	"authMethod\x123\n" +
+	"\x15authorization_enabled\x18\x03 \x01(\bR\x14authorizationEnabled\x12/\n" +
+	"\x06grants\x18\x04 \x03(\v2\x17.scheduler.v1.RoleGrantR\x06grants\x12 \n" +
+	"\vpermissions\x18\x05 \x03(\tR\vpermissions\"\x88\x02\n" +
+	"\x16CreateNamespaceRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18?R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12$\n" +
+	"\tmax_tasks\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bmaxTasks\x12C\n" +
+	"\x19max_concurrent_executions\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x17maxConcurrentExecutions\x12B\n" +
+	"\x19max_executions_per_minute\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x16maxExecutionsPerMinute\"2\n" +
+	"\x13GetNamespaceRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\"\x86\x02\n" +
+	"\x16UpdateNamespaceRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12$\n" +
+	"\tmax_tasks\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bmaxTasks\x12C\n" +
+	"\x19max_concurrent_executions\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x17maxConcurrentExecutions\x12B\n" +
+	"\x19max_executions_per_minute\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x16maxExecutionsPerMinute\"5\n" +
+	"\x16DeleteNamespaceRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\"w\n" +
+	"\x15ListNamespacesRequest\x12\x1b\n" +
+	"\x04page\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x01R\bpageSize\x12\x18\n" +
+	"\akeyword\x18\x03 \x01(\tR\akeyword\"\xe0\x02\n" +
+	"\x0eNamespaceReply\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tmax_tasks\x18\x04 \x01(\x05R\bmaxTasks\x12:\n" +
+	"\x19max_concurrent_executions\x18\x05 \x01(\x05R\x17maxConcurrentExecutions\x129\n" +
+	"\x19max_executions_per_minute\x18\x06 \x01(\x05R\x16maxExecutionsPerMinute\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9a\x01\n" +
+	"\x13ListNamespacesReply\x12<\n" +
+	"\n" +
+	"namespaces\x18\x01 \x03(\v2\x1c.scheduler.v1.NamespaceReplyR\n" +
+	"namespaces\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x9d\x01\n" +
+	"\x13CreateSecretRequest\x12%\n" +
+	"\tnamespace\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x18?R\tnamespace\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\x05value\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05value\"V\n" +
+	"\x10GetSecretRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12%\n" +
+	"\tnamespace\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18?R\tnamespace\"\x91\x01\n" +
+	"\x13UpdateSecretRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12%\n" +
+	"\tnamespace\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18?R\tnamespace\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\"Y\n" +
+	"\x13DeleteSecretRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12%\n" +
+	"\tnamespace\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18?R\tnamespace\"\x9b\x01\n" +
+	"\x12ListSecretsRequest\x12\x1b\n" +
+	"\x04page\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x04page\x12'\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x01R\bpageSize\x12%\n" +
+	"\tnamespace\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18?R\tnamespace\x12\x18\n" +
+	"\akeyword\x18\x04 \x01(\tR\akeyword\"\x98\x02\n" +
+	"\vSecretReply\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x05R\aversion\x12\x15\n" +
+	"\x06key_id\x18\x06 \x01(\tR\x05keyId\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8e\x01\n" +
+	"\x10ListSecretsReply\x123\n" +
+	"\asecrets\x18\x01 \x03(\v2\x19.scheduler.v1.SecretReplyR\asecrets\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x19\n" +
+	"\x17RotateSecretKeysRequest\"[\n" +
+	"\x15RotateSecretKeysReply\x12$\n" +
+	"\x0eprimary_key_id\x18\x01 \x01(\tR\fprimaryKeyId\x12\x1c\n" +
+	"\trewrapped\x18\x02 \x01(\x03R\trewrapped*[\n" +
 	"\bTaskType\x12\x19\n" +
 	"\x15TASK_TYPE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tIMMEDIATE\x10\x01\x12\r\n" +
 	"\tSCHEDULED\x10\x02\x12\b\n" +
 	"\x04CRON\x10\x03\x12\f\n" +
-	"\bINTERVAL\x10\x04*y\n" +
+	"\bINTERVAL\x10\x04*N\n" +
+	"\fIntervalMode\x12\x1d\n" +
+	"\x19INTERVAL_MODE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"FIXED_RATE\x10\x01\x12\x0f\n" +
+	"\vFIXED_DELAY\x10\x02*y\n" +
 	"\n" +
 	"TaskStatus\x12\x1b\n" +
 	"\x17TASK_STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
@@ -1484,7 +6381,7 @@ const file_scheduler_v1_scheduler_proto_rawDesc = "" +
 	"\tCOMPLETED\x10\x04\x12\n" +
 	"\n" +
 	"\x06FAILED\x10\x05\x12\r\n" +
-	"\tCANCELLED\x10\x06*\x97\x01\n" +
+	"\tCANCELLED\x10\x06*\xae\x01\n" +
 	"\x0fExecutionStatus\x12 \n" +
 	"\x1cEXECUTION_STATUS_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
@@ -1493,7 +6390,25 @@ const file_scheduler_v1_scheduler_proto_rawDesc = "" +
 	"\aSUCCESS\x10\x03\x12\x14\n" +
 	"\x10EXECUTION_FAILED\x10\x04\x12\v\n" +
 	"\aTIMEOUT\x10\x05\x12\x17\n" +
-	"\x13EXECUTION_CANCELLED\x10\x062\xcc\t\n" +
+	"\x13EXECUTION_CANCELLED\x10\x06\x12\x15\n" +
+	"\x11EXECUTION_SKIPPED\x10\a*K\n" +
+	"\x11ConcurrencyPolicy\x12\"\n" +
+	"\x1eCONCURRENCY_POLICY_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04WAIT\x10\x01\x12\b\n" +
+	"\x04SKIP\x10\x02*\x80\x01\n" +
+	"\x12TaskRevisionAction\x12$\n" +
+	" TASK_REVISION_ACTION_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10REVISION_CREATED\x10\x01\x12\x14\n" +
+	"\x10REVISION_UPDATED\x10\x02\x12\x18\n" +
+	"\x14REVISION_ROLLED_BACK\x10\x03*Q\n" +
+	"\vAuditResult\x12\x1c\n" +
+	"\x18AUDIT_RESULT_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rAUDIT_SUCCESS\x10\x01\x12\x11\n" +
+	"\rAUDIT_FAILURE\x10\x02*T\n" +
+	"\x12CalendarRuleAction\x12$\n" +
+	" CALENDAR_RULE_ACTION_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aEXCLUDE\x10\x01\x12\v\n" +
+	"\aINCLUDE\x10\x022\xed&\n" +
 	"\tScheduler\x12`\n" +
 	"\n" +
 	"CreateTask\x12\x1f.scheduler.v1.CreateTaskRequest\x1a\x17.scheduler.v1.TaskReply\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/tasks\x12\\\n" +
@@ -1501,15 +6416,48 @@ const file_scheduler_v1_scheduler_proto_rawDesc = "" +
 	"\n" +
 	"UpdateTask\x12\x1f.scheduler.v1.UpdateTaskRequest\x1a\x17.scheduler.v1.TaskReply\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/api/v1/tasks/{id}\x12a\n" +
 	"\n" +
-	"DeleteTask\x12\x1f.scheduler.v1.DeleteTaskRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/tasks/{id}\x12`\n" +
-	"\tListTasks\x12\x1e.scheduler.v1.ListTasksRequest\x1a\x1c.scheduler.v1.ListTasksReply\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/tasks\x12x\n" +
+	"DeleteTask\x12\x1f.scheduler.v1.DeleteTaskRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/tasks/{id}\x12o\n" +
+	"\vRestoreTask\x12 .scheduler.v1.RestoreTaskRequest\x1a\x17.scheduler.v1.TaskReply\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/tasks/{id}/restore\x12h\n" +
+	"\tPurgeTask\x12\x1e.scheduler.v1.PurgeTaskRequest\x1a\x16.google.protobuf.Empty\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/tasks/{id}/purge\x12`\n" +
+	"\tListTasks\x12\x1e.scheduler.v1.ListTasksRequest\x1a\x1c.scheduler.v1.ListTasksReply\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/tasks\x12\x8c\x01\n" +
+	"\x11ListTaskRevisions\x12&.scheduler.v1.ListTaskRevisionsRequest\x1a$.scheduler.v1.ListTaskRevisionsReply\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/tasks/{task_id}/revisions\x12\x8d\x01\n" +
+	"\x0fGetTaskRevision\x12$.scheduler.v1.GetTaskRevisionRequest\x1a\x1f.scheduler.v1.TaskRevisionReply\"3\x82\xd3\xe4\x93\x02-\x12+/api/v1/tasks/{task_id}/revisions/{version}\x12r\n" +
+	"\fRollbackTask\x12!.scheduler.v1.RollbackTaskRequest\x1a\x17.scheduler.v1.TaskReply\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/tasks/{id}/rollback\x12x\n" +
 	"\vExecuteTask\x12 .scheduler.v1.ExecuteTaskRequest\x1a .scheduler.v1.TaskExecutionReply\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/tasks/{id}/execute\x12i\n" +
 	"\tPauseTask\x12\x1e.scheduler.v1.PauseTaskRequest\x1a\x17.scheduler.v1.TaskReply\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/tasks/{id}/pause\x12l\n" +
 	"\n" +
-	"ResumeTask\x12\x1f.scheduler.v1.ResumeTaskRequest\x1a\x17.scheduler.v1.TaskReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/tasks/{id}/resume\x12\x8a\x01\n" +
+	"ResumeTask\x12\x1f.scheduler.v1.ResumeTaskRequest\x1a\x17.scheduler.v1.TaskReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/tasks/{id}/resume\x12l\n" +
+	"\n" +
+	"CancelTask\x12\x1f.scheduler.v1.CancelTaskRequest\x1a\x17.scheduler.v1.TaskReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/tasks/{id}/cancel\x12\x8a\x01\n" +
 	"\x11GetTaskExecutions\x12&.scheduler.v1.GetTaskExecutionsRequest\x1a!.scheduler.v1.ListExecutionsReply\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/tasks/{task_id}/executions\x12p\n" +
 	"\fGetExecution\x12!.scheduler.v1.GetExecutionRequest\x1a\x1c.scheduler.v1.ExecutionReply\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/executions/{id}\x12\x80\x01\n" +
-	"\x0fCancelExecution\x12$.scheduler.v1.CancelExecutionRequest\x1a\x1c.scheduler.v1.ExecutionReply\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/executions/{id}/cancelBW\n" +
+	"\x0fCancelExecution\x12$.scheduler.v1.CancelExecutionRequest\x1a\x1c.scheduler.v1.ExecutionReply\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/executions/{id}/cancel\x12o\n" +
+	"\rGetQueueStats\x12\".scheduler.v1.GetQueueStatsRequest\x1a\x1d.scheduler.v1.QueueStatsReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/queue/stats\x12~\n" +
+	"\x0fPreviewSchedule\x12$.scheduler.v1.PreviewScheduleRequest\x1a\".scheduler.v1.PreviewScheduleReply\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/schedules/preview\x12p\n" +
+	"\x0eCreateCalendar\x12#.scheduler.v1.CreateCalendarRequest\x1a\x1b.scheduler.v1.CalendarReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/calendars\x12l\n" +
+	"\vGetCalendar\x12 .scheduler.v1.GetCalendarRequest\x1a\x1b.scheduler.v1.CalendarReply\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/calendars/{id}\x12u\n" +
+	"\x0eUpdateCalendar\x12#.scheduler.v1.UpdateCalendarRequest\x1a\x1b.scheduler.v1.CalendarReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/api/v1/calendars/{id}\x12m\n" +
+	"\x0eDeleteCalendar\x12#.scheduler.v1.DeleteCalendarRequest\x1a\x16.google.protobuf.Empty\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/calendars/{id}\x12p\n" +
+	"\rListCalendars\x12\".scheduler.v1.ListCalendarsRequest\x1a .scheduler.v1.ListCalendarsReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/calendars\x12w\n" +
+	"\x0eImportCalendar\x12#.scheduler.v1.ImportCalendarRequest\x1a\x1b.scheduler.v1.CalendarReply\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/calendars/import\x12x\n" +
+	"\x12CreateResourcePool\x12'.scheduler.v1.CreateResourcePoolRequest\x1a\x1f.scheduler.v1.ResourcePoolReply\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/pools\x12t\n" +
+	"\x0fGetResourcePool\x12$.scheduler.v1.GetResourcePoolRequest\x1a\x1f.scheduler.v1.ResourcePoolReply\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/pools/{id}\x12}\n" +
+	"\x12UpdateResourcePool\x12'.scheduler.v1.UpdateResourcePoolRequest\x1a\x1f.scheduler.v1.ResourcePoolReply\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/api/v1/pools/{id}\x12q\n" +
+	"\x12DeleteResourcePool\x12'.scheduler.v1.DeleteResourcePoolRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/pools/{id}\x12x\n" +
+	"\x11ListResourcePools\x12&.scheduler.v1.ListResourcePoolsRequest\x1a$.scheduler.v1.ListResourcePoolsReply\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/pools\x12y\n" +
+	"\x0fListAuditEvents\x12$.scheduler.v1.ListAuditEventsRequest\x1a\".scheduler.v1.ListAuditEventsReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/audit-events\x12X\n" +
+	"\x06WhoAmI\x12\x1b.scheduler.v1.WhoAmIRequest\x1a\x19.scheduler.v1.WhoAmIReply\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/whoami\x12t\n" +
+	"\x0fCreateNamespace\x12$.scheduler.v1.CreateNamespaceRequest\x1a\x1c.scheduler.v1.NamespaceReply\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/namespaces\x12r\n" +
+	"\fGetNamespace\x12!.scheduler.v1.GetNamespaceRequest\x1a\x1c.scheduler.v1.NamespaceReply\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/namespaces/{name}\x12{\n" +
+	"\x0fUpdateNamespace\x12$.scheduler.v1.UpdateNamespaceRequest\x1a\x1c.scheduler.v1.NamespaceReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/api/v1/namespaces/{name}\x12r\n" +
+	"\x0fDeleteNamespace\x12$.scheduler.v1.DeleteNamespaceRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b*\x19/api/v1/namespaces/{name}\x12t\n" +
+	"\x0eListNamespaces\x12#.scheduler.v1.ListNamespacesRequest\x1a!.scheduler.v1.ListNamespacesReply\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/namespaces\x12h\n" +
+	"\fCreateSecret\x12!.scheduler.v1.CreateSecretRequest\x1a\x19.scheduler.v1.SecretReply\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/v1/secrets\x12f\n" +
+	"\tGetSecret\x12\x1e.scheduler.v1.GetSecretRequest\x1a\x19.scheduler.v1.SecretReply\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/secrets/{name}\x12o\n" +
+	"\fUpdateSecret\x12!.scheduler.v1.UpdateSecretRequest\x1a\x19.scheduler.v1.SecretReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/api/v1/secrets/{name}\x12i\n" +
+	"\fDeleteSecret\x12!.scheduler.v1.DeleteSecretRequest\x1a\x16.google.protobuf.Empty\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/secrets/{name}\x12h\n" +
+	"\vListSecrets\x12 .scheduler.v1.ListSecretsRequest\x1a\x1e.scheduler.v1.ListSecretsReply\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/secrets\x12\x86\x01\n" +
+	"\x10RotateSecretKeys\x12%.scheduler.v1.RotateSecretKeysRequest\x1a#.scheduler.v1.RotateSecretKeysReply\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/secrets/rotate-keysBW\n" +
 	"\x1bdev.kratos.api.scheduler.v1B\x10SchedulerProtoV1P\x01Z$heytom-scheduler/api/scheduler/v1;v1b\x06proto3"
 
 var (
@@ -1524,79 +6472,267 @@ func file_scheduler_v1_scheduler_proto_rawDescGZIP() []byte {
 	return file_scheduler_v1_scheduler_proto_rawDescData
 }
 
-var file_scheduler_v1_scheduler_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_scheduler_v1_scheduler_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_scheduler_v1_scheduler_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_scheduler_v1_scheduler_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
 var file_scheduler_v1_scheduler_proto_goTypes = []any{
-	(TaskType)(0),                    // 0: scheduler.v1.TaskType
-	(TaskStatus)(0),                  // 1: scheduler.v1.TaskStatus
-	(ExecutionStatus)(0),             // 2: scheduler.v1.ExecutionStatus
-	(*CreateTaskRequest)(nil),        // 3: scheduler.v1.CreateTaskRequest
-	(*GetTaskRequest)(nil),           // 4: scheduler.v1.GetTaskRequest
-	(*UpdateTaskRequest)(nil),        // 5: scheduler.v1.UpdateTaskRequest
-	(*DeleteTaskRequest)(nil),        // 6: scheduler.v1.DeleteTaskRequest
-	(*ListTasksRequest)(nil),         // 7: scheduler.v1.ListTasksRequest
-	(*ExecuteTaskRequest)(nil),       // 8: scheduler.v1.ExecuteTaskRequest
-	(*PauseTaskRequest)(nil),         // 9: scheduler.v1.PauseTaskRequest
-	(*ResumeTaskRequest)(nil),        // 10: scheduler.v1.ResumeTaskRequest
-	(*GetTaskExecutionsRequest)(nil), // 11: scheduler.v1.GetTaskExecutionsRequest
-	(*GetExecutionRequest)(nil),      // 12: scheduler.v1.GetExecutionRequest
-	(*CancelExecutionRequest)(nil),   // 13: scheduler.v1.CancelExecutionRequest
-	(*TaskReply)(nil),                // 14: scheduler.v1.TaskReply
-	(*ListTasksReply)(nil),           // 15: scheduler.v1.ListTasksReply
-	(*TaskExecutionReply)(nil),       // 16: scheduler.v1.TaskExecutionReply
-	(*ExecutionReply)(nil),           // 17: scheduler.v1.ExecutionReply
-	(*ListExecutionsReply)(nil),      // 18: scheduler.v1.ListExecutionsReply
-	nil,                              // 19: scheduler.v1.CreateTaskRequest.MetadataEntry
-	nil,                              // 20: scheduler.v1.UpdateTaskRequest.MetadataEntry
-	nil,                              // 21: scheduler.v1.TaskReply.MetadataEntry
-	(*timestamppb.Timestamp)(nil),    // 22: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),            // 23: google.protobuf.Empty
+	(TaskType)(0),                     // 0: scheduler.v1.TaskType
+	(IntervalMode)(0),                 // 1: scheduler.v1.IntervalMode
+	(TaskStatus)(0),                   // 2: scheduler.v1.TaskStatus
+	(ExecutionStatus)(0),              // 3: scheduler.v1.ExecutionStatus
+	(ConcurrencyPolicy)(0),            // 4: scheduler.v1.ConcurrencyPolicy
+	(TaskRevisionAction)(0),           // 5: scheduler.v1.TaskRevisionAction
+	(AuditResult)(0),                  // 6: scheduler.v1.AuditResult
+	(CalendarRuleAction)(0),           // 7: scheduler.v1.CalendarRuleAction
+	(*CreateTaskRequest)(nil),         // 8: scheduler.v1.CreateTaskRequest
+	(*TimeWindow)(nil),                // 9: scheduler.v1.TimeWindow
+	(*ExecutionRetention)(nil),        // 10: scheduler.v1.ExecutionRetention
+	(*GetTaskRequest)(nil),            // 11: scheduler.v1.GetTaskRequest
+	(*UpdateTaskRequest)(nil),         // 12: scheduler.v1.UpdateTaskRequest
+	(*DeleteTaskRequest)(nil),         // 13: scheduler.v1.DeleteTaskRequest
+	(*RestoreTaskRequest)(nil),        // 14: scheduler.v1.RestoreTaskRequest
+	(*PurgeTaskRequest)(nil),          // 15: scheduler.v1.PurgeTaskRequest
+	(*ListTasksRequest)(nil),          // 16: scheduler.v1.ListTasksRequest
+	(*ListTaskRevisionsRequest)(nil),  // 17: scheduler.v1.ListTaskRevisionsRequest
+	(*GetTaskRevisionRequest)(nil),    // 18: scheduler.v1.GetTaskRevisionRequest
+	(*RollbackTaskRequest)(nil),       // 19: scheduler.v1.RollbackTaskRequest
+	(*ExecuteTaskRequest)(nil),        // 20: scheduler.v1.ExecuteTaskRequest
+	(*PauseTaskRequest)(nil),          // 21: scheduler.v1.PauseTaskRequest
+	(*ResumeTaskRequest)(nil),         // 22: scheduler.v1.ResumeTaskRequest
+	(*CancelTaskRequest)(nil),         // 23: scheduler.v1.CancelTaskRequest
+	(*GetTaskExecutionsRequest)(nil),  // 24: scheduler.v1.GetTaskExecutionsRequest
+	(*GetExecutionRequest)(nil),       // 25: scheduler.v1.GetExecutionRequest
+	(*CancelExecutionRequest)(nil),    // 26: scheduler.v1.CancelExecutionRequest
+	(*GetQueueStatsRequest)(nil),      // 27: scheduler.v1.GetQueueStatsRequest
+	(*PreviewScheduleRequest)(nil),    // 28: scheduler.v1.PreviewScheduleRequest
+	(*TaskReply)(nil),                 // 29: scheduler.v1.TaskReply
+	(*ListTasksReply)(nil),            // 30: scheduler.v1.ListTasksReply
+	(*TaskFieldChange)(nil),           // 31: scheduler.v1.TaskFieldChange
+	(*TaskRevisionReply)(nil),         // 32: scheduler.v1.TaskRevisionReply
+	(*ListTaskRevisionsReply)(nil),    // 33: scheduler.v1.ListTaskRevisionsReply
+	(*TaskExecutionReply)(nil),        // 34: scheduler.v1.TaskExecutionReply
+	(*ExecutionReply)(nil),            // 35: scheduler.v1.ExecutionReply
+	(*ListExecutionsReply)(nil),       // 36: scheduler.v1.ListExecutionsReply
+	(*PriorityQueueStats)(nil),        // 37: scheduler.v1.PriorityQueueStats
+	(*QueueStatsReply)(nil),           // 38: scheduler.v1.QueueStatsReply
+	(*PreviewScheduleReply)(nil),      // 39: scheduler.v1.PreviewScheduleReply
+	(*CalendarRule)(nil),              // 40: scheduler.v1.CalendarRule
+	(*CreateCalendarRequest)(nil),     // 41: scheduler.v1.CreateCalendarRequest
+	(*GetCalendarRequest)(nil),        // 42: scheduler.v1.GetCalendarRequest
+	(*UpdateCalendarRequest)(nil),     // 43: scheduler.v1.UpdateCalendarRequest
+	(*DeleteCalendarRequest)(nil),     // 44: scheduler.v1.DeleteCalendarRequest
+	(*ListCalendarsRequest)(nil),      // 45: scheduler.v1.ListCalendarsRequest
+	(*ImportCalendarRequest)(nil),     // 46: scheduler.v1.ImportCalendarRequest
+	(*CalendarReply)(nil),             // 47: scheduler.v1.CalendarReply
+	(*ListCalendarsReply)(nil),        // 48: scheduler.v1.ListCalendarsReply
+	(*CreateResourcePoolRequest)(nil), // 49: scheduler.v1.CreateResourcePoolRequest
+	(*GetResourcePoolRequest)(nil),    // 50: scheduler.v1.GetResourcePoolRequest
+	(*UpdateResourcePoolRequest)(nil), // 51: scheduler.v1.UpdateResourcePoolRequest
+	(*DeleteResourcePoolRequest)(nil), // 52: scheduler.v1.DeleteResourcePoolRequest
+	(*ListResourcePoolsRequest)(nil),  // 53: scheduler.v1.ListResourcePoolsRequest
+	(*ResourcePoolReply)(nil),         // 54: scheduler.v1.ResourcePoolReply
+	(*ListResourcePoolsReply)(nil),    // 55: scheduler.v1.ListResourcePoolsReply
+	(*ListAuditEventsRequest)(nil),    // 56: scheduler.v1.ListAuditEventsRequest
+	(*AuditEventReply)(nil),           // 57: scheduler.v1.AuditEventReply
+	(*ListAuditEventsReply)(nil),      // 58: scheduler.v1.ListAuditEventsReply
+	(*WhoAmIRequest)(nil),             // 59: scheduler.v1.WhoAmIRequest
+	(*RoleGrant)(nil),                 // 60: scheduler.v1.RoleGrant
+	(*WhoAmIReply)(nil),               // 61: scheduler.v1.WhoAmIReply
+	(*CreateNamespaceRequest)(nil),    // 62: scheduler.v1.CreateNamespaceRequest
+	(*GetNamespaceRequest)(nil),       // 63: scheduler.v1.GetNamespaceRequest
+	(*UpdateNamespaceRequest)(nil),    // 64: scheduler.v1.UpdateNamespaceRequest
+	(*DeleteNamespaceRequest)(nil),    // 65: scheduler.v1.DeleteNamespaceRequest
+	(*ListNamespacesRequest)(nil),     // 66: scheduler.v1.ListNamespacesRequest
+	(*NamespaceReply)(nil),            // 67: scheduler.v1.NamespaceReply
+	(*ListNamespacesReply)(nil),       // 68: scheduler.v1.ListNamespacesReply
+	(*CreateSecretRequest)(nil),       // 69: scheduler.v1.CreateSecretRequest
+	(*GetSecretRequest)(nil),          // 70: scheduler.v1.GetSecretRequest
+	(*UpdateSecretRequest)(nil),       // 71: scheduler.v1.UpdateSecretRequest
+	(*DeleteSecretRequest)(nil),       // 72: scheduler.v1.DeleteSecretRequest
+	(*ListSecretsRequest)(nil),        // 73: scheduler.v1.ListSecretsRequest
+	(*SecretReply)(nil),               // 74: scheduler.v1.SecretReply
+	(*ListSecretsReply)(nil),          // 75: scheduler.v1.ListSecretsReply
+	(*RotateSecretKeysRequest)(nil),   // 76: scheduler.v1.RotateSecretKeysRequest
+	(*RotateSecretKeysReply)(nil),     // 77: scheduler.v1.RotateSecretKeysReply
+	nil,                               // 78: scheduler.v1.CreateTaskRequest.MetadataEntry
+	nil,                               // 79: scheduler.v1.UpdateTaskRequest.MetadataEntry
+	nil,                               // 80: scheduler.v1.TaskReply.MetadataEntry
+	nil,                               // 81: scheduler.v1.RoleGrant.LabelsEntry
+	(*timestamppb.Timestamp)(nil),     // 82: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),       // 83: google.protobuf.Duration
+	(*fieldmaskpb.FieldMask)(nil),     // 84: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),             // 85: google.protobuf.Empty
 }
 var file_scheduler_v1_scheduler_proto_depIdxs = []int32{
-	0,  // 0: scheduler.v1.CreateTaskRequest.type:type_name -> scheduler.v1.TaskType
-	19, // 1: scheduler.v1.CreateTaskRequest.metadata:type_name -> scheduler.v1.CreateTaskRequest.MetadataEntry
-	20, // 2: scheduler.v1.UpdateTaskRequest.metadata:type_name -> scheduler.v1.UpdateTaskRequest.MetadataEntry
-	1,  // 3: scheduler.v1.ListTasksRequest.status:type_name -> scheduler.v1.TaskStatus
-	0,  // 4: scheduler.v1.ListTasksRequest.type:type_name -> scheduler.v1.TaskType
-	2,  // 5: scheduler.v1.GetTaskExecutionsRequest.status:type_name -> scheduler.v1.ExecutionStatus
-	0,  // 6: scheduler.v1.TaskReply.type:type_name -> scheduler.v1.TaskType
-	1,  // 7: scheduler.v1.TaskReply.status:type_name -> scheduler.v1.TaskStatus
-	21, // 8: scheduler.v1.TaskReply.metadata:type_name -> scheduler.v1.TaskReply.MetadataEntry
-	22, // 9: scheduler.v1.TaskReply.created_at:type_name -> google.protobuf.Timestamp
-	22, // 10: scheduler.v1.TaskReply.updated_at:type_name -> google.protobuf.Timestamp
-	22, // 11: scheduler.v1.TaskReply.next_run_time:type_name -> google.protobuf.Timestamp
-	14, // 12: scheduler.v1.ListTasksReply.tasks:type_name -> scheduler.v1.TaskReply
-	2,  // 13: scheduler.v1.ExecutionReply.status:type_name -> scheduler.v1.ExecutionStatus
-	22, // 14: scheduler.v1.ExecutionReply.start_time:type_name -> google.protobuf.Timestamp
-	22, // 15: scheduler.v1.ExecutionReply.end_time:type_name -> google.protobuf.Timestamp
-	17, // 16: scheduler.v1.ListExecutionsReply.executions:type_name -> scheduler.v1.ExecutionReply
-	3,  // 17: scheduler.v1.Scheduler.CreateTask:input_type -> scheduler.v1.CreateTaskRequest
-	4,  // 18: scheduler.v1.Scheduler.GetTask:input_type -> scheduler.v1.GetTaskRequest
-	5,  // 19: scheduler.v1.Scheduler.UpdateTask:input_type -> scheduler.v1.UpdateTaskRequest
-	6,  // 20: scheduler.v1.Scheduler.DeleteTask:input_type -> scheduler.v1.DeleteTaskRequest
-	7,  // 21: scheduler.v1.Scheduler.ListTasks:input_type -> scheduler.v1.ListTasksRequest
-	8,  // 22: scheduler.v1.Scheduler.ExecuteTask:input_type -> scheduler.v1.ExecuteTaskRequest
-	9,  // 23: scheduler.v1.Scheduler.PauseTask:input_type -> scheduler.v1.PauseTaskRequest
-	10, // 24: scheduler.v1.Scheduler.ResumeTask:input_type -> scheduler.v1.ResumeTaskRequest
-	11, // 25: scheduler.v1.Scheduler.GetTaskExecutions:input_type -> scheduler.v1.GetTaskExecutionsRequest
-	12, // 26: scheduler.v1.Scheduler.GetExecution:input_type -> scheduler.v1.GetExecutionRequest
-	13, // 27: scheduler.v1.Scheduler.CancelExecution:input_type -> scheduler.v1.CancelExecutionRequest
-	14, // 28: scheduler.v1.Scheduler.CreateTask:output_type -> scheduler.v1.TaskReply
-	14, // 29: scheduler.v1.Scheduler.GetTask:output_type -> scheduler.v1.TaskReply
-	14, // 30: scheduler.v1.Scheduler.UpdateTask:output_type -> scheduler.v1.TaskReply
-	23, // 31: scheduler.v1.Scheduler.DeleteTask:output_type -> google.protobuf.Empty
-	15, // 32: scheduler.v1.Scheduler.ListTasks:output_type -> scheduler.v1.ListTasksReply
-	16, // 33: scheduler.v1.Scheduler.ExecuteTask:output_type -> scheduler.v1.TaskExecutionReply
-	14, // 34: scheduler.v1.Scheduler.PauseTask:output_type -> scheduler.v1.TaskReply
-	14, // 35: scheduler.v1.Scheduler.ResumeTask:output_type -> scheduler.v1.TaskReply
-	18, // 36: scheduler.v1.Scheduler.GetTaskExecutions:output_type -> scheduler.v1.ListExecutionsReply
-	17, // 37: scheduler.v1.Scheduler.GetExecution:output_type -> scheduler.v1.ExecutionReply
-	17, // 38: scheduler.v1.Scheduler.CancelExecution:output_type -> scheduler.v1.ExecutionReply
-	28, // [28:39] is the sub-list for method output_type
-	17, // [17:28] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	0,   // 0: scheduler.v1.CreateTaskRequest.type:type_name -> scheduler.v1.TaskType
+	78,  // 1: scheduler.v1.CreateTaskRequest.metadata:type_name -> scheduler.v1.CreateTaskRequest.MetadataEntry
+	82,  // 2: scheduler.v1.CreateTaskRequest.start_time:type_name -> google.protobuf.Timestamp
+	82,  // 3: scheduler.v1.CreateTaskRequest.end_time:type_name -> google.protobuf.Timestamp
+	9,   // 4: scheduler.v1.CreateTaskRequest.active_windows:type_name -> scheduler.v1.TimeWindow
+	1,   // 5: scheduler.v1.CreateTaskRequest.interval_mode:type_name -> scheduler.v1.IntervalMode
+	83,  // 6: scheduler.v1.CreateTaskRequest.initial_delay:type_name -> google.protobuf.Duration
+	83,  // 7: scheduler.v1.CreateTaskRequest.jitter:type_name -> google.protobuf.Duration
+	4,   // 8: scheduler.v1.CreateTaskRequest.concurrency_policy:type_name -> scheduler.v1.ConcurrencyPolicy
+	10,  // 9: scheduler.v1.CreateTaskRequest.retention:type_name -> scheduler.v1.ExecutionRetention
+	79,  // 10: scheduler.v1.UpdateTaskRequest.metadata:type_name -> scheduler.v1.UpdateTaskRequest.MetadataEntry
+	82,  // 11: scheduler.v1.UpdateTaskRequest.start_time:type_name -> google.protobuf.Timestamp
+	82,  // 12: scheduler.v1.UpdateTaskRequest.end_time:type_name -> google.protobuf.Timestamp
+	9,   // 13: scheduler.v1.UpdateTaskRequest.active_windows:type_name -> scheduler.v1.TimeWindow
+	1,   // 14: scheduler.v1.UpdateTaskRequest.interval_mode:type_name -> scheduler.v1.IntervalMode
+	83,  // 15: scheduler.v1.UpdateTaskRequest.initial_delay:type_name -> google.protobuf.Duration
+	83,  // 16: scheduler.v1.UpdateTaskRequest.jitter:type_name -> google.protobuf.Duration
+	4,   // 17: scheduler.v1.UpdateTaskRequest.concurrency_policy:type_name -> scheduler.v1.ConcurrencyPolicy
+	10,  // 18: scheduler.v1.UpdateTaskRequest.retention:type_name -> scheduler.v1.ExecutionRetention
+	84,  // 19: scheduler.v1.UpdateTaskRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,   // 20: scheduler.v1.UpdateTaskRequest.type:type_name -> scheduler.v1.TaskType
+	2,   // 21: scheduler.v1.ListTasksRequest.status:type_name -> scheduler.v1.TaskStatus
+	0,   // 22: scheduler.v1.ListTasksRequest.type:type_name -> scheduler.v1.TaskType
+	3,   // 23: scheduler.v1.GetTaskExecutionsRequest.status:type_name -> scheduler.v1.ExecutionStatus
+	0,   // 24: scheduler.v1.PreviewScheduleRequest.type:type_name -> scheduler.v1.TaskType
+	0,   // 25: scheduler.v1.TaskReply.type:type_name -> scheduler.v1.TaskType
+	2,   // 26: scheduler.v1.TaskReply.status:type_name -> scheduler.v1.TaskStatus
+	80,  // 27: scheduler.v1.TaskReply.metadata:type_name -> scheduler.v1.TaskReply.MetadataEntry
+	82,  // 28: scheduler.v1.TaskReply.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 29: scheduler.v1.TaskReply.updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 30: scheduler.v1.TaskReply.next_run_time:type_name -> google.protobuf.Timestamp
+	82,  // 31: scheduler.v1.TaskReply.start_time:type_name -> google.protobuf.Timestamp
+	82,  // 32: scheduler.v1.TaskReply.end_time:type_name -> google.protobuf.Timestamp
+	9,   // 33: scheduler.v1.TaskReply.active_windows:type_name -> scheduler.v1.TimeWindow
+	1,   // 34: scheduler.v1.TaskReply.interval_mode:type_name -> scheduler.v1.IntervalMode
+	83,  // 35: scheduler.v1.TaskReply.initial_delay:type_name -> google.protobuf.Duration
+	83,  // 36: scheduler.v1.TaskReply.jitter:type_name -> google.protobuf.Duration
+	4,   // 37: scheduler.v1.TaskReply.concurrency_policy:type_name -> scheduler.v1.ConcurrencyPolicy
+	10,  // 38: scheduler.v1.TaskReply.retention:type_name -> scheduler.v1.ExecutionRetention
+	82,  // 39: scheduler.v1.TaskReply.deleted_at:type_name -> google.protobuf.Timestamp
+	82,  // 40: scheduler.v1.TaskReply.status_changed_at:type_name -> google.protobuf.Timestamp
+	29,  // 41: scheduler.v1.ListTasksReply.tasks:type_name -> scheduler.v1.TaskReply
+	5,   // 42: scheduler.v1.TaskRevisionReply.action:type_name -> scheduler.v1.TaskRevisionAction
+	29,  // 43: scheduler.v1.TaskRevisionReply.snapshot:type_name -> scheduler.v1.TaskReply
+	31,  // 44: scheduler.v1.TaskRevisionReply.changes:type_name -> scheduler.v1.TaskFieldChange
+	82,  // 45: scheduler.v1.TaskRevisionReply.created_at:type_name -> google.protobuf.Timestamp
+	32,  // 46: scheduler.v1.ListTaskRevisionsReply.revisions:type_name -> scheduler.v1.TaskRevisionReply
+	3,   // 47: scheduler.v1.ExecutionReply.status:type_name -> scheduler.v1.ExecutionStatus
+	82,  // 48: scheduler.v1.ExecutionReply.start_time:type_name -> google.protobuf.Timestamp
+	82,  // 49: scheduler.v1.ExecutionReply.end_time:type_name -> google.protobuf.Timestamp
+	35,  // 50: scheduler.v1.ListExecutionsReply.executions:type_name -> scheduler.v1.ExecutionReply
+	82,  // 51: scheduler.v1.PriorityQueueStats.oldest_queued_at:type_name -> google.protobuf.Timestamp
+	37,  // 52: scheduler.v1.QueueStatsReply.priorities:type_name -> scheduler.v1.PriorityQueueStats
+	82,  // 53: scheduler.v1.PreviewScheduleReply.next_run_times:type_name -> google.protobuf.Timestamp
+	7,   // 54: scheduler.v1.CalendarRule.action:type_name -> scheduler.v1.CalendarRuleAction
+	40,  // 55: scheduler.v1.CreateCalendarRequest.rules:type_name -> scheduler.v1.CalendarRule
+	40,  // 56: scheduler.v1.UpdateCalendarRequest.rules:type_name -> scheduler.v1.CalendarRule
+	7,   // 57: scheduler.v1.ImportCalendarRequest.action:type_name -> scheduler.v1.CalendarRuleAction
+	40,  // 58: scheduler.v1.CalendarReply.rules:type_name -> scheduler.v1.CalendarRule
+	82,  // 59: scheduler.v1.CalendarReply.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 60: scheduler.v1.CalendarReply.updated_at:type_name -> google.protobuf.Timestamp
+	47,  // 61: scheduler.v1.ListCalendarsReply.calendars:type_name -> scheduler.v1.CalendarReply
+	82,  // 62: scheduler.v1.ResourcePoolReply.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 63: scheduler.v1.ResourcePoolReply.updated_at:type_name -> google.protobuf.Timestamp
+	54,  // 64: scheduler.v1.ListResourcePoolsReply.pools:type_name -> scheduler.v1.ResourcePoolReply
+	6,   // 65: scheduler.v1.ListAuditEventsRequest.result:type_name -> scheduler.v1.AuditResult
+	82,  // 66: scheduler.v1.ListAuditEventsRequest.start_time:type_name -> google.protobuf.Timestamp
+	82,  // 67: scheduler.v1.ListAuditEventsRequest.end_time:type_name -> google.protobuf.Timestamp
+	82,  // 68: scheduler.v1.AuditEventReply.time:type_name -> google.protobuf.Timestamp
+	6,   // 69: scheduler.v1.AuditEventReply.result:type_name -> scheduler.v1.AuditResult
+	57,  // 70: scheduler.v1.ListAuditEventsReply.events:type_name -> scheduler.v1.AuditEventReply
+	81,  // 71: scheduler.v1.RoleGrant.labels:type_name -> scheduler.v1.RoleGrant.LabelsEntry
+	60,  // 72: scheduler.v1.WhoAmIReply.grants:type_name -> scheduler.v1.RoleGrant
+	82,  // 73: scheduler.v1.NamespaceReply.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 74: scheduler.v1.NamespaceReply.updated_at:type_name -> google.protobuf.Timestamp
+	67,  // 75: scheduler.v1.ListNamespacesReply.namespaces:type_name -> scheduler.v1.NamespaceReply
+	82,  // 76: scheduler.v1.SecretReply.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 77: scheduler.v1.SecretReply.updated_at:type_name -> google.protobuf.Timestamp
+	74,  // 78: scheduler.v1.ListSecretsReply.secrets:type_name -> scheduler.v1.SecretReply
+	8,   // 79: scheduler.v1.Scheduler.CreateTask:input_type -> scheduler.v1.CreateTaskRequest
+	11,  // 80: scheduler.v1.Scheduler.GetTask:input_type -> scheduler.v1.GetTaskRequest
+	12,  // 81: scheduler.v1.Scheduler.UpdateTask:input_type -> scheduler.v1.UpdateTaskRequest
+	13,  // 82: scheduler.v1.Scheduler.DeleteTask:input_type -> scheduler.v1.DeleteTaskRequest
+	14,  // 83: scheduler.v1.Scheduler.RestoreTask:input_type -> scheduler.v1.RestoreTaskRequest
+	15,  // 84: scheduler.v1.Scheduler.PurgeTask:input_type -> scheduler.v1.PurgeTaskRequest
+	16,  // 85: scheduler.v1.Scheduler.ListTasks:input_type -> scheduler.v1.ListTasksRequest
+	17,  // 86: scheduler.v1.Scheduler.ListTaskRevisions:input_type -> scheduler.v1.ListTaskRevisionsRequest
+	18,  // 87: scheduler.v1.Scheduler.GetTaskRevision:input_type -> scheduler.v1.GetTaskRevisionRequest
+	19,  // 88: scheduler.v1.Scheduler.RollbackTask:input_type -> scheduler.v1.RollbackTaskRequest
+	20,  // 89: scheduler.v1.Scheduler.ExecuteTask:input_type -> scheduler.v1.ExecuteTaskRequest
+	21,  // 90: scheduler.v1.Scheduler.PauseTask:input_type -> scheduler.v1.PauseTaskRequest
+	22,  // 91: scheduler.v1.Scheduler.ResumeTask:input_type -> scheduler.v1.ResumeTaskRequest
+	23,  // 92: scheduler.v1.Scheduler.CancelTask:input_type -> scheduler.v1.CancelTaskRequest
+	24,  // 93: scheduler.v1.Scheduler.GetTaskExecutions:input_type -> scheduler.v1.GetTaskExecutionsRequest
+	25,  // 94: scheduler.v1.Scheduler.GetExecution:input_type -> scheduler.v1.GetExecutionRequest
+	26,  // 95: scheduler.v1.Scheduler.CancelExecution:input_type -> scheduler.v1.CancelExecutionRequest
+	27,  // 96: scheduler.v1.Scheduler.GetQueueStats:input_type -> scheduler.v1.GetQueueStatsRequest
+	28,  // 97: scheduler.v1.Scheduler.PreviewSchedule:input_type -> scheduler.v1.PreviewScheduleRequest
+	41,  // 98: scheduler.v1.Scheduler.CreateCalendar:input_type -> scheduler.v1.CreateCalendarRequest
+	42,  // 99: scheduler.v1.Scheduler.GetCalendar:input_type -> scheduler.v1.GetCalendarRequest
+	43,  // 100: scheduler.v1.Scheduler.UpdateCalendar:input_type -> scheduler.v1.UpdateCalendarRequest
+	44,  // 101: scheduler.v1.Scheduler.DeleteCalendar:input_type -> scheduler.v1.DeleteCalendarRequest
+	45,  // 102: scheduler.v1.Scheduler.ListCalendars:input_type -> scheduler.v1.ListCalendarsRequest
+	46,  // 103: scheduler.v1.Scheduler.ImportCalendar:input_type -> scheduler.v1.ImportCalendarRequest
+	49,  // 104: scheduler.v1.Scheduler.CreateResourcePool:input_type -> scheduler.v1.CreateResourcePoolRequest
+	50,  // 105: scheduler.v1.Scheduler.GetResourcePool:input_type -> scheduler.v1.GetResourcePoolRequest
+	51,  // 106: scheduler.v1.Scheduler.UpdateResourcePool:input_type -> scheduler.v1.UpdateResourcePoolRequest
+	52,  // 107: scheduler.v1.Scheduler.DeleteResourcePool:input_type -> scheduler.v1.DeleteResourcePoolRequest
+	53,  // 108: scheduler.v1.Scheduler.ListResourcePools:input_type -> scheduler.v1.ListResourcePoolsRequest
+	56,  // 109: scheduler.v1.Scheduler.ListAuditEvents:input_type -> scheduler.v1.ListAuditEventsRequest
+	59,  // 110: scheduler.v1.Scheduler.WhoAmI:input_type -> scheduler.v1.WhoAmIRequest
+	62,  // 111: scheduler.v1.Scheduler.CreateNamespace:input_type -> scheduler.v1.CreateNamespaceRequest
+	63,  // 112: scheduler.v1.Scheduler.GetNamespace:input_type -> scheduler.v1.GetNamespaceRequest
+	64,  // 113: scheduler.v1.Scheduler.UpdateNamespace:input_type -> scheduler.v1.UpdateNamespaceRequest
+	65,  // 114: scheduler.v1.Scheduler.DeleteNamespace:input_type -> scheduler.v1.DeleteNamespaceRequest
+	66,  // 115: scheduler.v1.Scheduler.ListNamespaces:input_type -> scheduler.v1.ListNamespacesRequest
+	69,  // 116: scheduler.v1.Scheduler.CreateSecret:input_type -> scheduler.v1.CreateSecretRequest
+	70,  // 117: scheduler.v1.Scheduler.GetSecret:input_type -> scheduler.v1.GetSecretRequest
+	71,  // 118: scheduler.v1.Scheduler.UpdateSecret:input_type -> scheduler.v1.UpdateSecretRequest
+	72,  // 119: scheduler.v1.Scheduler.DeleteSecret:input_type -> scheduler.v1.DeleteSecretRequest
+	73,  // 120: scheduler.v1.Scheduler.ListSecrets:input_type -> scheduler.v1.ListSecretsRequest
+	76,  // 121: scheduler.v1.Scheduler.RotateSecretKeys:input_type -> scheduler.v1.RotateSecretKeysRequest
+	29,  // 122: scheduler.v1.Scheduler.CreateTask:output_type -> scheduler.v1.TaskReply
+	29,  // 123: scheduler.v1.Scheduler.GetTask:output_type -> scheduler.v1.TaskReply
+	29,  // 124: scheduler.v1.Scheduler.UpdateTask:output_type -> scheduler.v1.TaskReply
+	85,  // 125: scheduler.v1.Scheduler.DeleteTask:output_type -> google.protobuf.Empty
+	29,  // 126: scheduler.v1.Scheduler.RestoreTask:output_type -> scheduler.v1.TaskReply
+	85,  // 127: scheduler.v1.Scheduler.PurgeTask:output_type -> google.protobuf.Empty
+	30,  // 128: scheduler.v1.Scheduler.ListTasks:output_type -> scheduler.v1.ListTasksReply
+	33,  // 129: scheduler.v1.Scheduler.ListTaskRevisions:output_type -> scheduler.v1.ListTaskRevisionsReply
+	32,  // 130: scheduler.v1.Scheduler.GetTaskRevision:output_type -> scheduler.v1.TaskRevisionReply
+	29,  // 131: scheduler.v1.Scheduler.RollbackTask:output_type -> scheduler.v1.TaskReply
+	34,  // 132: scheduler.v1.Scheduler.ExecuteTask:output_type -> scheduler.v1.TaskExecutionReply
+	29,  // 133: scheduler.v1.Scheduler.PauseTask:output_type -> scheduler.v1.TaskReply
+	29,  // 134: scheduler.v1.Scheduler.ResumeTask:output_type -> scheduler.v1.TaskReply
+	29,  // 135: scheduler.v1.Scheduler.CancelTask:output_type -> scheduler.v1.TaskReply
+	36,  // 136: scheduler.v1.Scheduler.GetTaskExecutions:output_type -> scheduler.v1.ListExecutionsReply
+	35,  // 137: scheduler.v1.Scheduler.GetExecution:output_type -> scheduler.v1.ExecutionReply
+	35,  // 138: scheduler.v1.Scheduler.CancelExecution:output_type -> scheduler.v1.ExecutionReply
+	38,  // 139: scheduler.v1.Scheduler.GetQueueStats:output_type -> scheduler.v1.QueueStatsReply
+	39,  // 140: scheduler.v1.Scheduler.PreviewSchedule:output_type -> scheduler.v1.PreviewScheduleReply
+	47,  // 141: scheduler.v1.Scheduler.CreateCalendar:output_type -> scheduler.v1.CalendarReply
+	47,  // 142: scheduler.v1.Scheduler.GetCalendar:output_type -> scheduler.v1.CalendarReply
+	47,  // 143: scheduler.v1.Scheduler.UpdateCalendar:output_type -> scheduler.v1.CalendarReply
+	85,  // 144: scheduler.v1.Scheduler.DeleteCalendar:output_type -> google.protobuf.Empty
+	48,  // 145: scheduler.v1.Scheduler.ListCalendars:output_type -> scheduler.v1.ListCalendarsReply
+	47,  // 146: scheduler.v1.Scheduler.ImportCalendar:output_type -> scheduler.v1.CalendarReply
+	54,  // 147: scheduler.v1.Scheduler.CreateResourcePool:output_type -> scheduler.v1.ResourcePoolReply
+	54,  // 148: scheduler.v1.Scheduler.GetResourcePool:output_type -> scheduler.v1.ResourcePoolReply
+	54,  // 149: scheduler.v1.Scheduler.UpdateResourcePool:output_type -> scheduler.v1.ResourcePoolReply
+	85,  // 150: scheduler.v1.Scheduler.DeleteResourcePool:output_type -> google.protobuf.Empty
+	55,  // 151: scheduler.v1.Scheduler.ListResourcePools:output_type -> scheduler.v1.ListResourcePoolsReply
+	58,  // 152: scheduler.v1.Scheduler.ListAuditEvents:output_type -> scheduler.v1.ListAuditEventsReply
+	61,  // 153: scheduler.v1.Scheduler.WhoAmI:output_type -> scheduler.v1.WhoAmIReply
+	67,  // 154: scheduler.v1.Scheduler.CreateNamespace:output_type -> scheduler.v1.NamespaceReply
+	67,  // 155: scheduler.v1.Scheduler.GetNamespace:output_type -> scheduler.v1.NamespaceReply
+	67,  // 156: scheduler.v1.Scheduler.UpdateNamespace:output_type -> scheduler.v1.NamespaceReply
+	85,  // 157: scheduler.v1.Scheduler.DeleteNamespace:output_type -> google.protobuf.Empty
+	68,  // 158: scheduler.v1.Scheduler.ListNamespaces:output_type -> scheduler.v1.ListNamespacesReply
+	74,  // 159: scheduler.v1.Scheduler.CreateSecret:output_type -> scheduler.v1.SecretReply
+	74,  // 160: scheduler.v1.Scheduler.GetSecret:output_type -> scheduler.v1.SecretReply
+	74,  // 161: scheduler.v1.Scheduler.UpdateSecret:output_type -> scheduler.v1.SecretReply
+	85,  // 162: scheduler.v1.Scheduler.DeleteSecret:output_type -> google.protobuf.Empty
+	75,  // 163: scheduler.v1.Scheduler.ListSecrets:output_type -> scheduler.v1.ListSecretsReply
+	77,  // 164: scheduler.v1.Scheduler.RotateSecretKeys:output_type -> scheduler.v1.RotateSecretKeysReply
+	122, // [122:165] is the sub-list for method output_type
+	79,  // [79:122] is the sub-list for method input_type
+	79,  // [79:79] is the sub-list for extension type_name
+	79,  // [79:79] is the sub-list for extension extendee
+	0,   // [0:79] is the sub-list for field type_name
 }
 
 func init() { file_scheduler_v1_scheduler_proto_init() }
@@ -1604,13 +6740,14 @@ func file_scheduler_v1_scheduler_proto_init() {
 	if File_scheduler_v1_scheduler_proto != nil {
 		return
 	}
+	file_scheduler_v1_scheduler_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_scheduler_v1_scheduler_proto_rawDesc), len(file_scheduler_v1_scheduler_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   19,
+			NumEnums:      8,
+			NumMessages:   74,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
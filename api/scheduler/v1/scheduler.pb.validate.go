@@ -0,0 +1,10659 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: scheduler/v1/scheduler.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CreateTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTaskRequestMultiError, or nil if none found.
+func (m *CreateTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := CreateTaskRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if _, ok := _CreateTaskRequest_Type_NotInLookup[m.GetType()]; ok {
+		err := CreateTaskRequestValidationError{
+			field:  "Type",
+			reason: "value must not be in list [TASK_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TaskType_name[int32(m.GetType())]; !ok {
+		err := CreateTaskRequestValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSchedule()) > 255 {
+		err := CreateTaskRequestValidationError{
+			field:  "Schedule",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetHandler()); l < 1 || l > 255 {
+		err := CreateTaskRequestValidationError{
+			field:  "Handler",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Payload
+
+	if m.GetTimeout() < 0 {
+		err := CreateTaskRequestValidationError{
+			field:  "Timeout",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Metadata
+
+	if m.GetCalendarId() < 0 {
+		err := CreateTaskRequestValidationError{
+			field:  "CalendarId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTaskRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTaskRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTaskRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTaskRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTaskRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTaskRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetActiveWindows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateTaskRequestValidationError{
+						field:  fmt.Sprintf("ActiveWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateTaskRequestValidationError{
+						field:  fmt.Sprintf("ActiveWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTaskRequestValidationError{
+					field:  fmt.Sprintf("ActiveWindows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetMaxRuns() < 0 {
+		err := CreateTaskRequestValidationError{
+			field:  "MaxRuns",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := IntervalMode_name[int32(m.GetIntervalMode())]; !ok {
+		err := CreateTaskRequestValidationError{
+			field:  "IntervalMode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetInitialDelay(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = CreateTaskRequestValidationError{
+				field:  "InitialDelay",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := CreateTaskRequestValidationError{
+					field:  "InitialDelay",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetJitter(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = CreateTaskRequestValidationError{
+				field:  "Jitter",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := CreateTaskRequestValidationError{
+					field:  "Jitter",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if val := m.GetPriority(); val < 0 || val > 9 {
+		err := CreateTaskRequestValidationError{
+			field:  "Priority",
+			reason: "value must be inside range [0, 9]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLockGroup()) > 255 {
+		err := CreateTaskRequestValidationError{
+			field:  "LockGroup",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ConcurrencyPolicy_name[int32(m.GetConcurrencyPolicy())]; !ok {
+		err := CreateTaskRequestValidationError{
+			field:  "ConcurrencyPolicy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRetention()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTaskRequestValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTaskRequestValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetention()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTaskRequestValidationError{
+				field:  "Retention",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := CreateTaskRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTaskRequestMultiError) AllErrors() []error { return m }
+
+// CreateTaskRequestValidationError is the validation error returned by
+// CreateTaskRequest.Validate if the designated constraints aren't met.
+type CreateTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTaskRequestValidationError) ErrorName() string {
+	return "CreateTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTaskRequestValidationError{}
+
+var _CreateTaskRequest_Type_NotInLookup = map[TaskType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on TimeWindow with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TimeWindow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeWindow with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TimeWindowMultiError, or
+// nil if none found.
+func (m *TimeWindow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeWindow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_TimeWindow_Start_Pattern.MatchString(m.GetStart()) {
+		err := TimeWindowValidationError{
+			field:  "Start",
+			reason: "value does not match regex pattern \"^([01][0-9]|2[0-3]):[0-5][0-9]$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_TimeWindow_End_Pattern.MatchString(m.GetEnd()) {
+		err := TimeWindowValidationError{
+			field:  "End",
+			reason: "value does not match regex pattern \"^([01][0-9]|2[0-3]):[0-5][0-9]$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TimeWindowMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeWindowMultiError is an error wrapping multiple validation errors
+// returned by TimeWindow.ValidateAll() if the designated constraints aren't met.
+type TimeWindowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeWindowMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeWindowMultiError) AllErrors() []error { return m }
+
+// TimeWindowValidationError is the validation error returned by
+// TimeWindow.Validate if the designated constraints aren't met.
+type TimeWindowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeWindowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeWindowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeWindowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeWindowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeWindowValidationError) ErrorName() string { return "TimeWindowValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TimeWindowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeWindow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeWindowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeWindowValidationError{}
+
+var _TimeWindow_Start_Pattern = regexp.MustCompile("^([01][0-9]|2[0-3]):[0-5][0-9]$")
+
+var _TimeWindow_End_Pattern = regexp.MustCompile("^([01][0-9]|2[0-3]):[0-5][0-9]$")
+
+// Validate checks the field values on ExecutionRetention with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExecutionRetention) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExecutionRetention with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExecutionRetentionMultiError, or nil if none found.
+func (m *ExecutionRetention) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExecutionRetention) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetKeepLast() < 0 {
+		err := ExecutionRetentionValidationError{
+			field:  "KeepLast",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetKeepDays() < 0 {
+		err := ExecutionRetentionValidationError{
+			field:  "KeepDays",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFailedKeepDays() < 0 {
+		err := ExecutionRetentionValidationError{
+			field:  "FailedKeepDays",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExecutionRetentionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecutionRetentionMultiError is an error wrapping multiple validation errors
+// returned by ExecutionRetention.ValidateAll() if the designated constraints
+// aren't met.
+type ExecutionRetentionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecutionRetentionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecutionRetentionMultiError) AllErrors() []error { return m }
+
+// ExecutionRetentionValidationError is the validation error returned by
+// ExecutionRetention.Validate if the designated constraints aren't met.
+type ExecutionRetentionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecutionRetentionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecutionRetentionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecutionRetentionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecutionRetentionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecutionRetentionValidationError) ErrorName() string {
+	return "ExecutionRetentionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExecutionRetentionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecutionRetention.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecutionRetentionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecutionRetentionValidationError{}
+
+// Validate checks the field values on GetTaskRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetTaskRequestMultiError,
+// or nil if none found.
+func (m *GetTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetTaskRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTaskRequestMultiError) AllErrors() []error { return m }
+
+// GetTaskRequestValidationError is the validation error returned by
+// GetTaskRequest.Validate if the designated constraints aren't met.
+type GetTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTaskRequestValidationError) ErrorName() string { return "GetTaskRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTaskRequestValidationError{}
+
+// Validate checks the field values on UpdateTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTaskRequestMultiError, or nil if none found.
+func (m *UpdateTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateTaskRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 255 {
+		err := UpdateTaskRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if utf8.RuneCountInString(m.GetSchedule()) > 255 {
+		err := UpdateTaskRequestValidationError{
+			field:  "Schedule",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Payload
+
+	if m.GetTimeout() < 0 {
+		err := UpdateTaskRequestValidationError{
+			field:  "Timeout",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Metadata
+
+	if m.GetCalendarId() < 0 {
+		err := UpdateTaskRequestValidationError{
+			field:  "CalendarId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTaskRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTaskRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetActiveWindows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateTaskRequestValidationError{
+						field:  fmt.Sprintf("ActiveWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateTaskRequestValidationError{
+						field:  fmt.Sprintf("ActiveWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateTaskRequestValidationError{
+					field:  fmt.Sprintf("ActiveWindows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetMaxRuns() < 0 {
+		err := UpdateTaskRequestValidationError{
+			field:  "MaxRuns",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := IntervalMode_name[int32(m.GetIntervalMode())]; !ok {
+		err := UpdateTaskRequestValidationError{
+			field:  "IntervalMode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetInitialDelay(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = UpdateTaskRequestValidationError{
+				field:  "InitialDelay",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := UpdateTaskRequestValidationError{
+					field:  "InitialDelay",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetJitter(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = UpdateTaskRequestValidationError{
+				field:  "Jitter",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := UpdateTaskRequestValidationError{
+					field:  "Jitter",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if val := m.GetPriority(); val < 0 || val > 9 {
+		err := UpdateTaskRequestValidationError{
+			field:  "Priority",
+			reason: "value must be inside range [0, 9]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLockGroup()) > 255 {
+		err := UpdateTaskRequestValidationError{
+			field:  "LockGroup",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ConcurrencyPolicy_name[int32(m.GetConcurrencyPolicy())]; !ok {
+		err := UpdateTaskRequestValidationError{
+			field:  "ConcurrencyPolicy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRetention()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetention()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTaskRequestValidationError{
+				field:  "Retention",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetVersion() < 0 {
+		err := UpdateTaskRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTaskRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTaskRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := TaskType_name[int32(m.GetType())]; !ok {
+		err := UpdateTaskRequestValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetHandler()) > 255 {
+		err := UpdateTaskRequestValidationError{
+			field:  "Handler",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTaskRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTaskRequestValidationError is the validation error returned by
+// UpdateTaskRequest.Validate if the designated constraints aren't met.
+type UpdateTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTaskRequestValidationError) ErrorName() string {
+	return "UpdateTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTaskRequestValidationError{}
+
+// Validate checks the field values on DeleteTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTaskRequestMultiError, or nil if none found.
+func (m *DeleteTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteTaskRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTaskRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTaskRequestValidationError is the validation error returned by
+// DeleteTaskRequest.Validate if the designated constraints aren't met.
+type DeleteTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTaskRequestValidationError) ErrorName() string {
+	return "DeleteTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTaskRequestValidationError{}
+
+// Validate checks the field values on RestoreTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreTaskRequestMultiError, or nil if none found.
+func (m *RestoreTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := RestoreTaskRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by RestoreTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RestoreTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreTaskRequestMultiError) AllErrors() []error { return m }
+
+// RestoreTaskRequestValidationError is the validation error returned by
+// RestoreTaskRequest.Validate if the designated constraints aren't met.
+type RestoreTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreTaskRequestValidationError) ErrorName() string {
+	return "RestoreTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreTaskRequestValidationError{}
+
+// Validate checks the field values on PurgeTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PurgeTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeTaskRequestMultiError, or nil if none found.
+func (m *PurgeTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := PurgeTaskRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PurgeTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by PurgeTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PurgeTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeTaskRequestMultiError) AllErrors() []error { return m }
+
+// PurgeTaskRequestValidationError is the validation error returned by
+// PurgeTaskRequest.Validate if the designated constraints aren't met.
+type PurgeTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeTaskRequestValidationError) ErrorName() string { return "PurgeTaskRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PurgeTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeTaskRequestValidationError{}
+
+// Validate checks the field values on ListTasksRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTasksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTasksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTasksRequestMultiError, or nil if none found.
+func (m *ListTasksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTasksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() < 0 {
+		err := ListTasksRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 1000 {
+		err := ListTasksRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TaskStatus_name[int32(m.GetStatus())]; !ok {
+		err := ListTasksRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TaskType_name[int32(m.GetType())]; !ok {
+		err := ListTasksRequestValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Keyword
+
+	if m.GetCalendarId() < 0 {
+		err := ListTasksRequestValidationError{
+			field:  "CalendarId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IncludeDeleted
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := ListTasksRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 1024 {
+		err := ListTasksRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOrderBy()) > 64 {
+		err := ListTasksRequestValidationError{
+			field:  "OrderBy",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SkipTotal
+
+	if len(errors) > 0 {
+		return ListTasksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTasksRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTasksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTasksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTasksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTasksRequestMultiError) AllErrors() []error { return m }
+
+// ListTasksRequestValidationError is the validation error returned by
+// ListTasksRequest.Validate if the designated constraints aren't met.
+type ListTasksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTasksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTasksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTasksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTasksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTasksRequestValidationError) ErrorName() string { return "ListTasksRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTasksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTasksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTasksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTasksRequestValidationError{}
+
+// Validate checks the field values on ListTaskRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTaskRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTaskRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTaskRevisionsRequestMultiError, or nil if none found.
+func (m *ListTaskRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTaskRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTaskId() <= 0 {
+		err := ListTaskRevisionsRequestValidationError{
+			field:  "TaskId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := ListTaskRevisionsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 1000 {
+		err := ListTaskRevisionsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTaskRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTaskRevisionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTaskRevisionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTaskRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTaskRevisionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTaskRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// ListTaskRevisionsRequestValidationError is the validation error returned by
+// ListTaskRevisionsRequest.Validate if the designated constraints aren't met.
+type ListTaskRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTaskRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTaskRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTaskRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTaskRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTaskRevisionsRequestValidationError) ErrorName() string {
+	return "ListTaskRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTaskRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTaskRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTaskRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTaskRevisionsRequestValidationError{}
+
+// Validate checks the field values on GetTaskRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTaskRevisionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTaskRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTaskRevisionRequestMultiError, or nil if none found.
+func (m *GetTaskRevisionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTaskRevisionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTaskId() <= 0 {
+		err := GetTaskRevisionRequestValidationError{
+			field:  "TaskId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() <= 0 {
+		err := GetTaskRevisionRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetTaskRevisionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTaskRevisionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTaskRevisionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTaskRevisionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTaskRevisionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTaskRevisionRequestMultiError) AllErrors() []error { return m }
+
+// GetTaskRevisionRequestValidationError is the validation error returned by
+// GetTaskRevisionRequest.Validate if the designated constraints aren't met.
+type GetTaskRevisionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTaskRevisionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTaskRevisionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTaskRevisionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTaskRevisionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTaskRevisionRequestValidationError) ErrorName() string {
+	return "GetTaskRevisionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTaskRevisionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTaskRevisionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTaskRevisionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTaskRevisionRequestValidationError{}
+
+// Validate checks the field values on RollbackTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackTaskRequestMultiError, or nil if none found.
+func (m *RollbackTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := RollbackTaskRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRevision() <= 0 {
+		err := RollbackTaskRequestValidationError{
+			field:  "Revision",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 0 {
+		err := RollbackTaskRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RollbackTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by RollbackTaskRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackTaskRequestMultiError) AllErrors() []error { return m }
+
+// RollbackTaskRequestValidationError is the validation error returned by
+// RollbackTaskRequest.Validate if the designated constraints aren't met.
+type RollbackTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackTaskRequestValidationError) ErrorName() string {
+	return "RollbackTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackTaskRequestValidationError{}
+
+// Validate checks the field values on ExecuteTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExecuteTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExecuteTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExecuteTaskRequestMultiError, or nil if none found.
+func (m *ExecuteTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExecuteTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ExecuteTaskRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Payload
+
+	if m.Priority != nil {
+
+		if val := m.GetPriority(); val < 0 || val > 9 {
+			err := ExecuteTaskRequestValidationError{
+				field:  "Priority",
+				reason: "value must be inside range [0, 9]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ExecuteTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecuteTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by ExecuteTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExecuteTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecuteTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecuteTaskRequestMultiError) AllErrors() []error { return m }
+
+// ExecuteTaskRequestValidationError is the validation error returned by
+// ExecuteTaskRequest.Validate if the designated constraints aren't met.
+type ExecuteTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecuteTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecuteTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecuteTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecuteTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecuteTaskRequestValidationError) ErrorName() string {
+	return "ExecuteTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExecuteTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecuteTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecuteTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecuteTaskRequestValidationError{}
+
+// Validate checks the field values on PauseTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PauseTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseTaskRequestMultiError, or nil if none found.
+func (m *PauseTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := PauseTaskRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 200 {
+		err := PauseTaskRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PauseTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by PauseTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PauseTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseTaskRequestMultiError) AllErrors() []error { return m }
+
+// PauseTaskRequestValidationError is the validation error returned by
+// PauseTaskRequest.Validate if the designated constraints aren't met.
+type PauseTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseTaskRequestValidationError) ErrorName() string { return "PauseTaskRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PauseTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseTaskRequestValidationError{}
+
+// Validate checks the field values on ResumeTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResumeTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeTaskRequestMultiError, or nil if none found.
+func (m *ResumeTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ResumeTaskRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 200 {
+		err := ResumeTaskRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResumeTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by ResumeTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ResumeTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeTaskRequestMultiError) AllErrors() []error { return m }
+
+// ResumeTaskRequestValidationError is the validation error returned by
+// ResumeTaskRequest.Validate if the designated constraints aren't met.
+type ResumeTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeTaskRequestValidationError) ErrorName() string {
+	return "ResumeTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeTaskRequestValidationError{}
+
+// Validate checks the field values on CancelTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CancelTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelTaskRequestMultiError, or nil if none found.
+func (m *CancelTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := CancelTaskRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 200 {
+		err := CancelTaskRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by CancelTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CancelTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelTaskRequestMultiError) AllErrors() []error { return m }
+
+// CancelTaskRequestValidationError is the validation error returned by
+// CancelTaskRequest.Validate if the designated constraints aren't met.
+type CancelTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelTaskRequestValidationError) ErrorName() string {
+	return "CancelTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelTaskRequestValidationError{}
+
+// Validate checks the field values on GetTaskExecutionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTaskExecutionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTaskExecutionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTaskExecutionsRequestMultiError, or nil if none found.
+func (m *GetTaskExecutionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTaskExecutionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTaskId() <= 0 {
+		err := GetTaskExecutionsRequestValidationError{
+			field:  "TaskId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := GetTaskExecutionsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 1000 {
+		err := GetTaskExecutionsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ExecutionStatus_name[int32(m.GetStatus())]; !ok {
+		err := GetTaskExecutionsRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 1024 {
+		err := GetTaskExecutionsRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOrderBy()) > 64 {
+		err := GetTaskExecutionsRequestValidationError{
+			field:  "OrderBy",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SkipTotal
+
+	if len(errors) > 0 {
+		return GetTaskExecutionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTaskExecutionsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTaskExecutionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTaskExecutionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTaskExecutionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTaskExecutionsRequestMultiError) AllErrors() []error { return m }
+
+// GetTaskExecutionsRequestValidationError is the validation error returned by
+// GetTaskExecutionsRequest.Validate if the designated constraints aren't met.
+type GetTaskExecutionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTaskExecutionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTaskExecutionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTaskExecutionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTaskExecutionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTaskExecutionsRequestValidationError) ErrorName() string {
+	return "GetTaskExecutionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTaskExecutionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTaskExecutionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTaskExecutionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTaskExecutionsRequestValidationError{}
+
+// Validate checks the field values on GetExecutionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExecutionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExecutionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetExecutionRequestMultiError, or nil if none found.
+func (m *GetExecutionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExecutionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetExecutionRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetExecutionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExecutionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetExecutionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetExecutionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExecutionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExecutionRequestMultiError) AllErrors() []error { return m }
+
+// GetExecutionRequestValidationError is the validation error returned by
+// GetExecutionRequest.Validate if the designated constraints aren't met.
+type GetExecutionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExecutionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExecutionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExecutionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExecutionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExecutionRequestValidationError) ErrorName() string {
+	return "GetExecutionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExecutionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExecutionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExecutionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExecutionRequestValidationError{}
+
+// Validate checks the field values on CancelExecutionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelExecutionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelExecutionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelExecutionRequestMultiError, or nil if none found.
+func (m *CancelExecutionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelExecutionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := CancelExecutionRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelExecutionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelExecutionRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelExecutionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelExecutionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelExecutionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelExecutionRequestMultiError) AllErrors() []error { return m }
+
+// CancelExecutionRequestValidationError is the validation error returned by
+// CancelExecutionRequest.Validate if the designated constraints aren't met.
+type CancelExecutionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelExecutionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelExecutionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelExecutionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelExecutionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelExecutionRequestValidationError) ErrorName() string {
+	return "CancelExecutionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelExecutionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelExecutionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelExecutionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelExecutionRequestValidationError{}
+
+// Validate checks the field values on GetQueueStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetQueueStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQueueStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQueueStatsRequestMultiError, or nil if none found.
+func (m *GetQueueStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQueueStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetQueueStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQueueStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetQueueStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetQueueStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQueueStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQueueStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetQueueStatsRequestValidationError is the validation error returned by
+// GetQueueStatsRequest.Validate if the designated constraints aren't met.
+type GetQueueStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQueueStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQueueStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQueueStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQueueStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQueueStatsRequestValidationError) ErrorName() string {
+	return "GetQueueStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetQueueStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQueueStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQueueStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQueueStatsRequestValidationError{}
+
+// Validate checks the field values on PreviewScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreviewScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewScheduleRequestMultiError, or nil if none found.
+func (m *PreviewScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreviewScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := TaskType_name[int32(m.GetType())]; !ok {
+		err := PreviewScheduleRequestValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSchedule()) > 255 {
+		err := PreviewScheduleRequestValidationError{
+			field:  "Schedule",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTimezone()) > 64 {
+		err := PreviewScheduleRequestValidationError{
+			field:  "Timezone",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetCount(); val < 0 || val > 100 {
+		err := PreviewScheduleRequestValidationError{
+			field:  "Count",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCalendarId() < 0 {
+		err := PreviewScheduleRequestValidationError{
+			field:  "CalendarId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PreviewScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreviewScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by PreviewScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PreviewScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewScheduleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewScheduleRequestMultiError) AllErrors() []error { return m }
+
+// PreviewScheduleRequestValidationError is the validation error returned by
+// PreviewScheduleRequest.Validate if the designated constraints aren't met.
+type PreviewScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewScheduleRequestValidationError) ErrorName() string {
+	return "PreviewScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewScheduleRequestValidationError{}
+
+// Validate checks the field values on TaskReply with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaskReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TaskReplyMultiError, or nil
+// if none found.
+func (m *TaskReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
+	// no validation rules for Schedule
+
+	// no validation rules for Handler
+
+	// no validation rules for Payload
+
+	// no validation rules for Timeout
+
+	// no validation rules for Metadata
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskReplyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskReplyValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNextRunTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "NextRunTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "NextRunTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextRunTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskReplyValidationError{
+				field:  "NextRunTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ExecutionCount
+
+	// no validation rules for SuccessCount
+
+	// no validation rules for FailedCount
+
+	// no validation rules for CalendarId
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskReplyValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskReplyValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetActiveWindows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskReplyValidationError{
+						field:  fmt.Sprintf("ActiveWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskReplyValidationError{
+						field:  fmt.Sprintf("ActiveWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskReplyValidationError{
+					field:  fmt.Sprintf("ActiveWindows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for MaxRuns
+
+	// no validation rules for IntervalMode
+
+	if all {
+		switch v := interface{}(m.GetInitialDelay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "InitialDelay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "InitialDelay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInitialDelay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskReplyValidationError{
+				field:  "InitialDelay",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetJitter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "Jitter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "Jitter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJitter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskReplyValidationError{
+				field:  "Jitter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Priority
+
+	// no validation rules for LockGroup
+
+	// no validation rules for ConcurrencyPolicy
+
+	if all {
+		switch v := interface{}(m.GetRetention()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetention()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskReplyValidationError{
+				field:  "Retention",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskReplyValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Version
+
+	// no validation rules for Namespace
+
+	// no validation rules for StatusReason
+
+	if all {
+		switch v := interface{}(m.GetStatusChangedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "StatusChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskReplyValidationError{
+					field:  "StatusChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatusChangedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskReplyValidationError{
+				field:  "StatusChangedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RunCount
+
+	if len(errors) > 0 {
+		return TaskReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskReplyMultiError is an error wrapping multiple validation errors returned
+// by TaskReply.ValidateAll() if the designated constraints aren't met.
+type TaskReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskReplyMultiError) AllErrors() []error { return m }
+
+// TaskReplyValidationError is the validation error returned by
+// TaskReply.Validate if the designated constraints aren't met.
+type TaskReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskReplyValidationError) ErrorName() string { return "TaskReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskReplyValidationError{}
+
+// Validate checks the field values on ListTasksReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListTasksReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTasksReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListTasksReplyMultiError,
+// or nil if none found.
+func (m *ListTasksReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTasksReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTasks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTasksReplyValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTasksReplyValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTasksReplyValidationError{
+					field:  fmt.Sprintf("Tasks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListTasksReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTasksReplyMultiError is an error wrapping multiple validation errors
+// returned by ListTasksReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListTasksReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTasksReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTasksReplyMultiError) AllErrors() []error { return m }
+
+// ListTasksReplyValidationError is the validation error returned by
+// ListTasksReply.Validate if the designated constraints aren't met.
+type ListTasksReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTasksReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTasksReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTasksReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTasksReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTasksReplyValidationError) ErrorName() string { return "ListTasksReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTasksReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTasksReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTasksReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTasksReplyValidationError{}
+
+// Validate checks the field values on TaskFieldChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TaskFieldChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskFieldChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TaskFieldChangeMultiError, or nil if none found.
+func (m *TaskFieldChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskFieldChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for OldValue
+
+	// no validation rules for NewValue
+
+	if len(errors) > 0 {
+		return TaskFieldChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskFieldChangeMultiError is an error wrapping multiple validation errors
+// returned by TaskFieldChange.ValidateAll() if the designated constraints
+// aren't met.
+type TaskFieldChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskFieldChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskFieldChangeMultiError) AllErrors() []error { return m }
+
+// TaskFieldChangeValidationError is the validation error returned by
+// TaskFieldChange.Validate if the designated constraints aren't met.
+type TaskFieldChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskFieldChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskFieldChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskFieldChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskFieldChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskFieldChangeValidationError) ErrorName() string { return "TaskFieldChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskFieldChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskFieldChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskFieldChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskFieldChangeValidationError{}
+
+// Validate checks the field values on TaskRevisionReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TaskRevisionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskRevisionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TaskRevisionReplyMultiError, or nil if none found.
+func (m *TaskRevisionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskRevisionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TaskId
+
+	// no validation rules for Version
+
+	// no validation rules for Action
+
+	// no validation rules for Author
+
+	if all {
+		switch v := interface{}(m.GetSnapshot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskRevisionReplyValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskRevisionReplyValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnapshot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskRevisionReplyValidationError{
+				field:  "Snapshot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskRevisionReplyValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskRevisionReplyValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskRevisionReplyValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SourceVersion
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskRevisionReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskRevisionReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskRevisionReplyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TaskRevisionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskRevisionReplyMultiError is an error wrapping multiple validation errors
+// returned by TaskRevisionReply.ValidateAll() if the designated constraints
+// aren't met.
+type TaskRevisionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskRevisionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskRevisionReplyMultiError) AllErrors() []error { return m }
+
+// TaskRevisionReplyValidationError is the validation error returned by
+// TaskRevisionReply.Validate if the designated constraints aren't met.
+type TaskRevisionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskRevisionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskRevisionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskRevisionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskRevisionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskRevisionReplyValidationError) ErrorName() string {
+	return "TaskRevisionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TaskRevisionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskRevisionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskRevisionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskRevisionReplyValidationError{}
+
+// Validate checks the field values on ListTaskRevisionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTaskRevisionsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTaskRevisionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTaskRevisionsReplyMultiError, or nil if none found.
+func (m *ListTaskRevisionsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTaskRevisionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRevisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTaskRevisionsReplyValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTaskRevisionsReplyValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTaskRevisionsReplyValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListTaskRevisionsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTaskRevisionsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListTaskRevisionsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListTaskRevisionsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTaskRevisionsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTaskRevisionsReplyMultiError) AllErrors() []error { return m }
+
+// ListTaskRevisionsReplyValidationError is the validation error returned by
+// ListTaskRevisionsReply.Validate if the designated constraints aren't met.
+type ListTaskRevisionsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTaskRevisionsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTaskRevisionsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTaskRevisionsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTaskRevisionsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTaskRevisionsReplyValidationError) ErrorName() string {
+	return "ListTaskRevisionsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTaskRevisionsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTaskRevisionsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTaskRevisionsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTaskRevisionsReplyValidationError{}
+
+// Validate checks the field values on TaskExecutionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TaskExecutionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskExecutionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TaskExecutionReplyMultiError, or nil if none found.
+func (m *TaskExecutionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskExecutionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ExecutionId
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return TaskExecutionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskExecutionReplyMultiError is an error wrapping multiple validation errors
+// returned by TaskExecutionReply.ValidateAll() if the designated constraints
+// aren't met.
+type TaskExecutionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskExecutionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskExecutionReplyMultiError) AllErrors() []error { return m }
+
+// TaskExecutionReplyValidationError is the validation error returned by
+// TaskExecutionReply.Validate if the designated constraints aren't met.
+type TaskExecutionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskExecutionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskExecutionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskExecutionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskExecutionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskExecutionReplyValidationError) ErrorName() string {
+	return "TaskExecutionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TaskExecutionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskExecutionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskExecutionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskExecutionReplyValidationError{}
+
+// Validate checks the field values on ExecutionReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExecutionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExecutionReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExecutionReplyMultiError,
+// or nil if none found.
+func (m *ExecutionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExecutionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TaskId
+
+	// no validation rules for TaskName
+
+	// no validation rules for Status
+
+	// no validation rules for NodeId
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExecutionReplyValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExecutionReplyValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExecutionReplyValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExecutionReplyValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExecutionReplyValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExecutionReplyValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Duration
+
+	// no validation rules for Result
+
+	// no validation rules for Error
+
+	// no validation rules for RetryCount
+
+	// no validation rules for Payload
+
+	// no validation rules for Priority
+
+	// no validation rules for WaitTime
+
+	// no validation rules for TaskVersion
+
+	// no validation rules for Namespace
+
+	if len(errors) > 0 {
+		return ExecutionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecutionReplyMultiError is an error wrapping multiple validation errors
+// returned by ExecutionReply.ValidateAll() if the designated constraints
+// aren't met.
+type ExecutionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecutionReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecutionReplyMultiError) AllErrors() []error { return m }
+
+// ExecutionReplyValidationError is the validation error returned by
+// ExecutionReply.Validate if the designated constraints aren't met.
+type ExecutionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecutionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecutionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecutionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecutionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecutionReplyValidationError) ErrorName() string { return "ExecutionReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExecutionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecutionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecutionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecutionReplyValidationError{}
+
+// Validate checks the field values on ListExecutionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListExecutionsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListExecutionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListExecutionsReplyMultiError, or nil if none found.
+func (m *ListExecutionsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListExecutionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetExecutions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListExecutionsReplyValidationError{
+						field:  fmt.Sprintf("Executions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListExecutionsReplyValidationError{
+						field:  fmt.Sprintf("Executions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListExecutionsReplyValidationError{
+					field:  fmt.Sprintf("Executions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListExecutionsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListExecutionsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListExecutionsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListExecutionsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListExecutionsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListExecutionsReplyMultiError) AllErrors() []error { return m }
+
+// ListExecutionsReplyValidationError is the validation error returned by
+// ListExecutionsReply.Validate if the designated constraints aren't met.
+type ListExecutionsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListExecutionsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListExecutionsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListExecutionsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListExecutionsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListExecutionsReplyValidationError) ErrorName() string {
+	return "ListExecutionsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListExecutionsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListExecutionsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListExecutionsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListExecutionsReplyValidationError{}
+
+// Validate checks the field values on PriorityQueueStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PriorityQueueStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PriorityQueueStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PriorityQueueStatsMultiError, or nil if none found.
+func (m *PriorityQueueStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PriorityQueueStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Priority
+
+	// no validation rules for Queued
+
+	if all {
+		switch v := interface{}(m.GetOldestQueuedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PriorityQueueStatsValidationError{
+					field:  "OldestQueuedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PriorityQueueStatsValidationError{
+					field:  "OldestQueuedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOldestQueuedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PriorityQueueStatsValidationError{
+				field:  "OldestQueuedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PriorityQueueStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// PriorityQueueStatsMultiError is an error wrapping multiple validation errors
+// returned by PriorityQueueStats.ValidateAll() if the designated constraints
+// aren't met.
+type PriorityQueueStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PriorityQueueStatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PriorityQueueStatsMultiError) AllErrors() []error { return m }
+
+// PriorityQueueStatsValidationError is the validation error returned by
+// PriorityQueueStats.Validate if the designated constraints aren't met.
+type PriorityQueueStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PriorityQueueStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PriorityQueueStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PriorityQueueStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PriorityQueueStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PriorityQueueStatsValidationError) ErrorName() string {
+	return "PriorityQueueStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PriorityQueueStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPriorityQueueStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PriorityQueueStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PriorityQueueStatsValidationError{}
+
+// Validate checks the field values on QueueStatsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *QueueStatsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueueStatsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueueStatsReplyMultiError, or nil if none found.
+func (m *QueueStatsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueueStatsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPriorities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueueStatsReplyValidationError{
+						field:  fmt.Sprintf("Priorities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueueStatsReplyValidationError{
+						field:  fmt.Sprintf("Priorities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueueStatsReplyValidationError{
+					field:  fmt.Sprintf("Priorities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalQueued
+
+	// no validation rules for Executing
+
+	if len(errors) > 0 {
+		return QueueStatsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueueStatsReplyMultiError is an error wrapping multiple validation errors
+// returned by QueueStatsReply.ValidateAll() if the designated constraints
+// aren't met.
+type QueueStatsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueueStatsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueueStatsReplyMultiError) AllErrors() []error { return m }
+
+// QueueStatsReplyValidationError is the validation error returned by
+// QueueStatsReply.Validate if the designated constraints aren't met.
+type QueueStatsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueueStatsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueueStatsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueueStatsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueueStatsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueueStatsReplyValidationError) ErrorName() string { return "QueueStatsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueueStatsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueueStatsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueueStatsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueueStatsReplyValidationError{}
+
+// Validate checks the field values on PreviewScheduleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewScheduleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreviewScheduleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewScheduleReplyMultiError, or nil if none found.
+func (m *PreviewScheduleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreviewScheduleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Valid
+
+	// no validation rules for Description
+
+	// no validation rules for Timezone
+
+	for idx, item := range m.GetNextRunTimes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PreviewScheduleReplyValidationError{
+						field:  fmt.Sprintf("NextRunTimes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PreviewScheduleReplyValidationError{
+						field:  fmt.Sprintf("NextRunTimes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PreviewScheduleReplyValidationError{
+					field:  fmt.Sprintf("NextRunTimes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PreviewScheduleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreviewScheduleReplyMultiError is an error wrapping multiple validation
+// errors returned by PreviewScheduleReply.ValidateAll() if the designated
+// constraints aren't met.
+type PreviewScheduleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewScheduleReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewScheduleReplyMultiError) AllErrors() []error { return m }
+
+// PreviewScheduleReplyValidationError is the validation error returned by
+// PreviewScheduleReply.Validate if the designated constraints aren't met.
+type PreviewScheduleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewScheduleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewScheduleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewScheduleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewScheduleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewScheduleReplyValidationError) ErrorName() string {
+	return "PreviewScheduleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewScheduleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewScheduleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewScheduleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewScheduleReplyValidationError{}
+
+// Validate checks the field values on CalendarRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CalendarRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CalendarRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CalendarRuleMultiError, or
+// nil if none found.
+func (m *CalendarRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CalendarRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _CalendarRule_Action_NotInLookup[m.GetAction()]; ok {
+		err := CalendarRuleValidationError{
+			field:  "Action",
+			reason: "value must not be in list [CALENDAR_RULE_ACTION_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := CalendarRuleAction_name[int32(m.GetAction())]; !ok {
+		err := CalendarRuleValidationError{
+			field:  "Action",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	_CalendarRule_Weekdays_Unique := make(map[int32]struct{}, len(m.GetWeekdays()))
+
+	for idx, item := range m.GetWeekdays() {
+		_, _ = idx, item
+
+		if _, exists := _CalendarRule_Weekdays_Unique[item]; exists {
+			err := CalendarRuleValidationError{
+				field:  fmt.Sprintf("Weekdays[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CalendarRule_Weekdays_Unique[item] = struct{}{}
+		}
+
+		if val := item; val < 0 || val > 6 {
+			err := CalendarRuleValidationError{
+				field:  fmt.Sprintf("Weekdays[%v]", idx),
+				reason: "value must be inside range [0, 6]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return CalendarRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// CalendarRuleMultiError is an error wrapping multiple validation errors
+// returned by CalendarRule.ValidateAll() if the designated constraints aren't met.
+type CalendarRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CalendarRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CalendarRuleMultiError) AllErrors() []error { return m }
+
+// CalendarRuleValidationError is the validation error returned by
+// CalendarRule.Validate if the designated constraints aren't met.
+type CalendarRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CalendarRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CalendarRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CalendarRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CalendarRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CalendarRuleValidationError) ErrorName() string { return "CalendarRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CalendarRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCalendarRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CalendarRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CalendarRuleValidationError{}
+
+var _CalendarRule_Action_NotInLookup = map[CalendarRuleAction]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on CreateCalendarRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCalendarRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCalendarRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCalendarRequestMultiError, or nil if none found.
+func (m *CreateCalendarRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCalendarRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := CreateCalendarRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if utf8.RuneCountInString(m.GetTimezone()) > 64 {
+		err := CreateCalendarRequestValidationError{
+			field:  "Timezone",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateCalendarRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateCalendarRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateCalendarRequestValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateCalendarRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCalendarRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateCalendarRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCalendarRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCalendarRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCalendarRequestMultiError) AllErrors() []error { return m }
+
+// CreateCalendarRequestValidationError is the validation error returned by
+// CreateCalendarRequest.Validate if the designated constraints aren't met.
+type CreateCalendarRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCalendarRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCalendarRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCalendarRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCalendarRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCalendarRequestValidationError) ErrorName() string {
+	return "CreateCalendarRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCalendarRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCalendarRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCalendarRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCalendarRequestValidationError{}
+
+// Validate checks the field values on GetCalendarRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCalendarRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCalendarRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCalendarRequestMultiError, or nil if none found.
+func (m *GetCalendarRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCalendarRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetCalendarRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCalendarRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCalendarRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCalendarRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCalendarRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCalendarRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCalendarRequestMultiError) AllErrors() []error { return m }
+
+// GetCalendarRequestValidationError is the validation error returned by
+// GetCalendarRequest.Validate if the designated constraints aren't met.
+type GetCalendarRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCalendarRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCalendarRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCalendarRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCalendarRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCalendarRequestValidationError) ErrorName() string {
+	return "GetCalendarRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCalendarRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCalendarRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCalendarRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCalendarRequestValidationError{}
+
+// Validate checks the field values on UpdateCalendarRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCalendarRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCalendarRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCalendarRequestMultiError, or nil if none found.
+func (m *UpdateCalendarRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCalendarRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateCalendarRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := UpdateCalendarRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if utf8.RuneCountInString(m.GetTimezone()) > 64 {
+		err := UpdateCalendarRequestValidationError{
+			field:  "Timezone",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateCalendarRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateCalendarRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateCalendarRequestValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateCalendarRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCalendarRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateCalendarRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCalendarRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCalendarRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCalendarRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCalendarRequestValidationError is the validation error returned by
+// UpdateCalendarRequest.Validate if the designated constraints aren't met.
+type UpdateCalendarRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCalendarRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCalendarRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCalendarRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCalendarRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCalendarRequestValidationError) ErrorName() string {
+	return "UpdateCalendarRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCalendarRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCalendarRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCalendarRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCalendarRequestValidationError{}
+
+// Validate checks the field values on DeleteCalendarRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCalendarRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCalendarRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCalendarRequestMultiError, or nil if none found.
+func (m *DeleteCalendarRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCalendarRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteCalendarRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteCalendarRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCalendarRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteCalendarRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCalendarRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCalendarRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCalendarRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCalendarRequestValidationError is the validation error returned by
+// DeleteCalendarRequest.Validate if the designated constraints aren't met.
+type DeleteCalendarRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCalendarRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCalendarRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCalendarRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCalendarRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCalendarRequestValidationError) ErrorName() string {
+	return "DeleteCalendarRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCalendarRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCalendarRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCalendarRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCalendarRequestValidationError{}
+
+// Validate checks the field values on ListCalendarsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCalendarsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCalendarsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCalendarsRequestMultiError, or nil if none found.
+func (m *ListCalendarsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCalendarsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() < 0 {
+		err := ListCalendarsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 1000 {
+		err := ListCalendarsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Keyword
+
+	if len(errors) > 0 {
+		return ListCalendarsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCalendarsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCalendarsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCalendarsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCalendarsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCalendarsRequestMultiError) AllErrors() []error { return m }
+
+// ListCalendarsRequestValidationError is the validation error returned by
+// ListCalendarsRequest.Validate if the designated constraints aren't met.
+type ListCalendarsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCalendarsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCalendarsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCalendarsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCalendarsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCalendarsRequestValidationError) ErrorName() string {
+	return "ListCalendarsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCalendarsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCalendarsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCalendarsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCalendarsRequestValidationError{}
+
+// Validate checks the field values on ImportCalendarRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportCalendarRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportCalendarRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportCalendarRequestMultiError, or nil if none found.
+func (m *ImportCalendarRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportCalendarRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 0 {
+		err := ImportCalendarRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 255 {
+		err := ImportCalendarRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if utf8.RuneCountInString(m.GetTimezone()) > 64 {
+		err := ImportCalendarRequestValidationError{
+			field:  "Timezone",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetIcs()) < 1 {
+		err := ImportCalendarRequestValidationError{
+			field:  "Ics",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := CalendarRuleAction_name[int32(m.GetAction())]; !ok {
+		err := ImportCalendarRequestValidationError{
+			field:  "Action",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ImportCalendarRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportCalendarRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportCalendarRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportCalendarRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportCalendarRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportCalendarRequestMultiError) AllErrors() []error { return m }
+
+// ImportCalendarRequestValidationError is the validation error returned by
+// ImportCalendarRequest.Validate if the designated constraints aren't met.
+type ImportCalendarRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportCalendarRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportCalendarRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportCalendarRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportCalendarRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportCalendarRequestValidationError) ErrorName() string {
+	return "ImportCalendarRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportCalendarRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportCalendarRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportCalendarRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportCalendarRequestValidationError{}
+
+// Validate checks the field values on CalendarReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CalendarReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CalendarReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CalendarReplyMultiError, or
+// nil if none found.
+func (m *CalendarReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CalendarReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Timezone
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CalendarReplyValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CalendarReplyValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CalendarReplyValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CalendarReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CalendarReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CalendarReplyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CalendarReplyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CalendarReplyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CalendarReplyValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CalendarReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CalendarReplyMultiError is an error wrapping multiple validation errors
+// returned by CalendarReply.ValidateAll() if the designated constraints
+// aren't met.
+type CalendarReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CalendarReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CalendarReplyMultiError) AllErrors() []error { return m }
+
+// CalendarReplyValidationError is the validation error returned by
+// CalendarReply.Validate if the designated constraints aren't met.
+type CalendarReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CalendarReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CalendarReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CalendarReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CalendarReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CalendarReplyValidationError) ErrorName() string { return "CalendarReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CalendarReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCalendarReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CalendarReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CalendarReplyValidationError{}
+
+// Validate checks the field values on ListCalendarsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCalendarsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCalendarsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCalendarsReplyMultiError, or nil if none found.
+func (m *ListCalendarsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCalendarsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCalendars() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCalendarsReplyValidationError{
+						field:  fmt.Sprintf("Calendars[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCalendarsReplyValidationError{
+						field:  fmt.Sprintf("Calendars[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCalendarsReplyValidationError{
+					field:  fmt.Sprintf("Calendars[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListCalendarsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCalendarsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListCalendarsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListCalendarsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCalendarsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCalendarsReplyMultiError) AllErrors() []error { return m }
+
+// ListCalendarsReplyValidationError is the validation error returned by
+// ListCalendarsReply.Validate if the designated constraints aren't met.
+type ListCalendarsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCalendarsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCalendarsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCalendarsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCalendarsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCalendarsReplyValidationError) ErrorName() string {
+	return "ListCalendarsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCalendarsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCalendarsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCalendarsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCalendarsReplyValidationError{}
+
+// Validate checks the field values on CreateResourcePoolRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateResourcePoolRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateResourcePoolRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateResourcePoolRequestMultiError, or nil if none found.
+func (m *CreateResourcePoolRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateResourcePoolRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := CreateResourcePoolRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if utf8.RuneCountInString(m.GetHandler()) > 255 {
+		err := CreateResourcePoolRequestValidationError{
+			field:  "Handler",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLabelKey()) > 255 {
+		err := CreateResourcePoolRequestValidationError{
+			field:  "LabelKey",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLabelValue()) > 255 {
+		err := CreateResourcePoolRequestValidationError{
+			field:  "LabelValue",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxConcurrency() < 0 {
+		err := CreateResourcePoolRequestValidationError{
+			field:  "MaxConcurrency",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRateLimit() < 0 {
+		err := CreateResourcePoolRequestValidationError{
+			field:  "RateLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBurst() < 0 {
+		err := CreateResourcePoolRequestValidationError{
+			field:  "Burst",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateResourcePoolRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateResourcePoolRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateResourcePoolRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateResourcePoolRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateResourcePoolRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateResourcePoolRequestMultiError) AllErrors() []error { return m }
+
+// CreateResourcePoolRequestValidationError is the validation error returned by
+// CreateResourcePoolRequest.Validate if the designated constraints aren't met.
+type CreateResourcePoolRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateResourcePoolRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateResourcePoolRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateResourcePoolRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateResourcePoolRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateResourcePoolRequestValidationError) ErrorName() string {
+	return "CreateResourcePoolRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateResourcePoolRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateResourcePoolRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateResourcePoolRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateResourcePoolRequestValidationError{}
+
+// Validate checks the field values on GetResourcePoolRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetResourcePoolRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetResourcePoolRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetResourcePoolRequestMultiError, or nil if none found.
+func (m *GetResourcePoolRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetResourcePoolRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetResourcePoolRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetResourcePoolRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetResourcePoolRequestMultiError is an error wrapping multiple validation
+// errors returned by GetResourcePoolRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetResourcePoolRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetResourcePoolRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetResourcePoolRequestMultiError) AllErrors() []error { return m }
+
+// GetResourcePoolRequestValidationError is the validation error returned by
+// GetResourcePoolRequest.Validate if the designated constraints aren't met.
+type GetResourcePoolRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetResourcePoolRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetResourcePoolRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetResourcePoolRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetResourcePoolRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetResourcePoolRequestValidationError) ErrorName() string {
+	return "GetResourcePoolRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetResourcePoolRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetResourcePoolRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetResourcePoolRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetResourcePoolRequestValidationError{}
+
+// Validate checks the field values on UpdateResourcePoolRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateResourcePoolRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateResourcePoolRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateResourcePoolRequestMultiError, or nil if none found.
+func (m *UpdateResourcePoolRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateResourcePoolRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateResourcePoolRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := UpdateResourcePoolRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if utf8.RuneCountInString(m.GetHandler()) > 255 {
+		err := UpdateResourcePoolRequestValidationError{
+			field:  "Handler",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLabelKey()) > 255 {
+		err := UpdateResourcePoolRequestValidationError{
+			field:  "LabelKey",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLabelValue()) > 255 {
+		err := UpdateResourcePoolRequestValidationError{
+			field:  "LabelValue",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxConcurrency() < 0 {
+		err := UpdateResourcePoolRequestValidationError{
+			field:  "MaxConcurrency",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRateLimit() < 0 {
+		err := UpdateResourcePoolRequestValidationError{
+			field:  "RateLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBurst() < 0 {
+		err := UpdateResourcePoolRequestValidationError{
+			field:  "Burst",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateResourcePoolRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateResourcePoolRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateResourcePoolRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateResourcePoolRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateResourcePoolRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateResourcePoolRequestMultiError) AllErrors() []error { return m }
+
+// UpdateResourcePoolRequestValidationError is the validation error returned by
+// UpdateResourcePoolRequest.Validate if the designated constraints aren't met.
+type UpdateResourcePoolRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateResourcePoolRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateResourcePoolRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateResourcePoolRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateResourcePoolRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateResourcePoolRequestValidationError) ErrorName() string {
+	return "UpdateResourcePoolRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateResourcePoolRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateResourcePoolRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateResourcePoolRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateResourcePoolRequestValidationError{}
+
+// Validate checks the field values on DeleteResourcePoolRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteResourcePoolRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteResourcePoolRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteResourcePoolRequestMultiError, or nil if none found.
+func (m *DeleteResourcePoolRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteResourcePoolRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteResourcePoolRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteResourcePoolRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteResourcePoolRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteResourcePoolRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteResourcePoolRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteResourcePoolRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteResourcePoolRequestMultiError) AllErrors() []error { return m }
+
+// DeleteResourcePoolRequestValidationError is the validation error returned by
+// DeleteResourcePoolRequest.Validate if the designated constraints aren't met.
+type DeleteResourcePoolRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteResourcePoolRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteResourcePoolRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteResourcePoolRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteResourcePoolRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteResourcePoolRequestValidationError) ErrorName() string {
+	return "DeleteResourcePoolRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteResourcePoolRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteResourcePoolRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteResourcePoolRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteResourcePoolRequestValidationError{}
+
+// Validate checks the field values on ListResourcePoolsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListResourcePoolsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListResourcePoolsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListResourcePoolsRequestMultiError, or nil if none found.
+func (m *ListResourcePoolsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListResourcePoolsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() < 0 {
+		err := ListResourcePoolsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 1000 {
+		err := ListResourcePoolsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Keyword
+
+	if len(errors) > 0 {
+		return ListResourcePoolsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListResourcePoolsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListResourcePoolsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListResourcePoolsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListResourcePoolsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListResourcePoolsRequestMultiError) AllErrors() []error { return m }
+
+// ListResourcePoolsRequestValidationError is the validation error returned by
+// ListResourcePoolsRequest.Validate if the designated constraints aren't met.
+type ListResourcePoolsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListResourcePoolsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListResourcePoolsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListResourcePoolsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListResourcePoolsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListResourcePoolsRequestValidationError) ErrorName() string {
+	return "ListResourcePoolsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListResourcePoolsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListResourcePoolsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListResourcePoolsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListResourcePoolsRequestValidationError{}
+
+// Validate checks the field values on ResourcePoolReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResourcePoolReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResourcePoolReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResourcePoolReplyMultiError, or nil if none found.
+func (m *ResourcePoolReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResourcePoolReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Handler
+
+	// no validation rules for LabelKey
+
+	// no validation rules for LabelValue
+
+	// no validation rules for MaxConcurrency
+
+	// no validation rules for RateLimit
+
+	// no validation rules for Burst
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourcePoolReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourcePoolReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourcePoolReplyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourcePoolReplyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourcePoolReplyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourcePoolReplyValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResourcePoolReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResourcePoolReplyMultiError is an error wrapping multiple validation errors
+// returned by ResourcePoolReply.ValidateAll() if the designated constraints
+// aren't met.
+type ResourcePoolReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResourcePoolReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResourcePoolReplyMultiError) AllErrors() []error { return m }
+
+// ResourcePoolReplyValidationError is the validation error returned by
+// ResourcePoolReply.Validate if the designated constraints aren't met.
+type ResourcePoolReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourcePoolReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourcePoolReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourcePoolReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourcePoolReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourcePoolReplyValidationError) ErrorName() string {
+	return "ResourcePoolReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResourcePoolReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResourcePoolReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourcePoolReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourcePoolReplyValidationError{}
+
+// Validate checks the field values on ListResourcePoolsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListResourcePoolsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListResourcePoolsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListResourcePoolsReplyMultiError, or nil if none found.
+func (m *ListResourcePoolsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListResourcePoolsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPools() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListResourcePoolsReplyValidationError{
+						field:  fmt.Sprintf("Pools[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListResourcePoolsReplyValidationError{
+						field:  fmt.Sprintf("Pools[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListResourcePoolsReplyValidationError{
+					field:  fmt.Sprintf("Pools[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListResourcePoolsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListResourcePoolsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListResourcePoolsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListResourcePoolsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListResourcePoolsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListResourcePoolsReplyMultiError) AllErrors() []error { return m }
+
+// ListResourcePoolsReplyValidationError is the validation error returned by
+// ListResourcePoolsReply.Validate if the designated constraints aren't met.
+type ListResourcePoolsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListResourcePoolsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListResourcePoolsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListResourcePoolsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListResourcePoolsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListResourcePoolsReplyValidationError) ErrorName() string {
+	return "ListResourcePoolsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListResourcePoolsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListResourcePoolsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListResourcePoolsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListResourcePoolsReplyValidationError{}
+
+// Validate checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsRequestMultiError, or nil if none found.
+func (m *ListAuditEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() < 0 {
+		err := ListAuditEventsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 1000 {
+		err := ListAuditEventsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Actor
+
+	// no validation rules for Operation
+
+	if m.GetTargetId() < 0 {
+		err := ListAuditEventsRequestValidationError{
+			field:  "TargetId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AuditResult_name[int32(m.GetResult())]; !ok {
+		err := ListAuditEventsRequestValidationError{
+			field:  "Result",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListAuditEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsRequestValidationError is the validation error returned by
+// ListAuditEventsRequest.Validate if the designated constraints aren't met.
+type ListAuditEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsRequestValidationError) ErrorName() string {
+	return "ListAuditEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsRequestValidationError{}
+
+// Validate checks the field values on AuditEventReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuditEventReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditEventReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuditEventReplyMultiError, or nil if none found.
+func (m *AuditEventReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditEventReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventReplyValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventReplyValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventReplyValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Actor
+
+	// no validation rules for Operation
+
+	// no validation rules for TargetId
+
+	// no validation rules for Request
+
+	// no validation rules for Result
+
+	// no validation rules for Code
+
+	// no validation rules for Reason
+
+	// no validation rules for Message
+
+	// no validation rules for ClientIp
+
+	// no validation rules for Transport
+
+	// no validation rules for Duration
+
+	if len(errors) > 0 {
+		return AuditEventReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditEventReplyMultiError is an error wrapping multiple validation errors
+// returned by AuditEventReply.ValidateAll() if the designated constraints
+// aren't met.
+type AuditEventReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditEventReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditEventReplyMultiError) AllErrors() []error { return m }
+
+// AuditEventReplyValidationError is the validation error returned by
+// AuditEventReply.Validate if the designated constraints aren't met.
+type AuditEventReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEventReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEventReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEventReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEventReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEventReplyValidationError) ErrorName() string { return "AuditEventReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditEventReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEventReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEventReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEventReplyValidationError{}
+
+// Validate checks the field values on ListAuditEventsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsReplyMultiError, or nil if none found.
+func (m *ListAuditEventsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditEventsReplyValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditEventsReplyValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditEventsReplyValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListAuditEventsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditEventsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsReplyMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsReplyValidationError is the validation error returned by
+// ListAuditEventsReply.Validate if the designated constraints aren't met.
+type ListAuditEventsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsReplyValidationError) ErrorName() string {
+	return "ListAuditEventsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsReplyValidationError{}
+
+// Validate checks the field values on WhoAmIRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WhoAmIRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WhoAmIRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WhoAmIRequestMultiError, or
+// nil if none found.
+func (m *WhoAmIRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WhoAmIRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return WhoAmIRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WhoAmIRequestMultiError is an error wrapping multiple validation errors
+// returned by WhoAmIRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WhoAmIRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WhoAmIRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WhoAmIRequestMultiError) AllErrors() []error { return m }
+
+// WhoAmIRequestValidationError is the validation error returned by
+// WhoAmIRequest.Validate if the designated constraints aren't met.
+type WhoAmIRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WhoAmIRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WhoAmIRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WhoAmIRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WhoAmIRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WhoAmIRequestValidationError) ErrorName() string { return "WhoAmIRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WhoAmIRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWhoAmIRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WhoAmIRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WhoAmIRequestValidationError{}
+
+// Validate checks the field values on RoleGrant with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoleGrant) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleGrant with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoleGrantMultiError, or nil
+// if none found.
+func (m *RoleGrant) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleGrant) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Role
+
+	// no validation rules for Labels
+
+	if len(errors) > 0 {
+		return RoleGrantMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleGrantMultiError is an error wrapping multiple validation errors returned
+// by RoleGrant.ValidateAll() if the designated constraints aren't met.
+type RoleGrantMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleGrantMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleGrantMultiError) AllErrors() []error { return m }
+
+// RoleGrantValidationError is the validation error returned by
+// RoleGrant.Validate if the designated constraints aren't met.
+type RoleGrantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleGrantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleGrantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleGrantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleGrantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleGrantValidationError) ErrorName() string { return "RoleGrantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleGrantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleGrant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleGrantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleGrantValidationError{}
+
+// Validate checks the field values on WhoAmIReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WhoAmIReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WhoAmIReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WhoAmIReplyMultiError, or
+// nil if none found.
+func (m *WhoAmIReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WhoAmIReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for AuthMethod
+
+	// no validation rules for AuthorizationEnabled
+
+	for idx, item := range m.GetGrants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WhoAmIReplyValidationError{
+						field:  fmt.Sprintf("Grants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WhoAmIReplyValidationError{
+						field:  fmt.Sprintf("Grants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WhoAmIReplyValidationError{
+					field:  fmt.Sprintf("Grants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WhoAmIReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// WhoAmIReplyMultiError is an error wrapping multiple validation errors
+// returned by WhoAmIReply.ValidateAll() if the designated constraints aren't met.
+type WhoAmIReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WhoAmIReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WhoAmIReplyMultiError) AllErrors() []error { return m }
+
+// WhoAmIReplyValidationError is the validation error returned by
+// WhoAmIReply.Validate if the designated constraints aren't met.
+type WhoAmIReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WhoAmIReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WhoAmIReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WhoAmIReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WhoAmIReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WhoAmIReplyValidationError) ErrorName() string { return "WhoAmIReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WhoAmIReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWhoAmIReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WhoAmIReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WhoAmIReplyValidationError{}
+
+// Validate checks the field values on CreateNamespaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateNamespaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateNamespaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateNamespaceRequestMultiError, or nil if none found.
+func (m *CreateNamespaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateNamespaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 63 {
+		err := CreateNamespaceRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 63 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if m.GetMaxTasks() < 0 {
+		err := CreateNamespaceRequestValidationError{
+			field:  "MaxTasks",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxConcurrentExecutions() < 0 {
+		err := CreateNamespaceRequestValidationError{
+			field:  "MaxConcurrentExecutions",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxExecutionsPerMinute() < 0 {
+		err := CreateNamespaceRequestValidationError{
+			field:  "MaxExecutionsPerMinute",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateNamespaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateNamespaceRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateNamespaceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateNamespaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateNamespaceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateNamespaceRequestMultiError) AllErrors() []error { return m }
+
+// CreateNamespaceRequestValidationError is the validation error returned by
+// CreateNamespaceRequest.Validate if the designated constraints aren't met.
+type CreateNamespaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateNamespaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateNamespaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateNamespaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateNamespaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateNamespaceRequestValidationError) ErrorName() string {
+	return "CreateNamespaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateNamespaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateNamespaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateNamespaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateNamespaceRequestValidationError{}
+
+// Validate checks the field values on GetNamespaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetNamespaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNamespaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetNamespaceRequestMultiError, or nil if none found.
+func (m *GetNamespaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNamespaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := GetNamespaceRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetNamespaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNamespaceRequestMultiError is an error wrapping multiple validation
+// errors returned by GetNamespaceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetNamespaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNamespaceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNamespaceRequestMultiError) AllErrors() []error { return m }
+
+// GetNamespaceRequestValidationError is the validation error returned by
+// GetNamespaceRequest.Validate if the designated constraints aren't met.
+type GetNamespaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNamespaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNamespaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNamespaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNamespaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNamespaceRequestValidationError) ErrorName() string {
+	return "GetNamespaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNamespaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNamespaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNamespaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNamespaceRequestValidationError{}
+
+// Validate checks the field values on UpdateNamespaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateNamespaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateNamespaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateNamespaceRequestMultiError, or nil if none found.
+func (m *UpdateNamespaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateNamespaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := UpdateNamespaceRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if m.GetMaxTasks() < 0 {
+		err := UpdateNamespaceRequestValidationError{
+			field:  "MaxTasks",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxConcurrentExecutions() < 0 {
+		err := UpdateNamespaceRequestValidationError{
+			field:  "MaxConcurrentExecutions",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxExecutionsPerMinute() < 0 {
+		err := UpdateNamespaceRequestValidationError{
+			field:  "MaxExecutionsPerMinute",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateNamespaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateNamespaceRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateNamespaceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateNamespaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateNamespaceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateNamespaceRequestMultiError) AllErrors() []error { return m }
+
+// UpdateNamespaceRequestValidationError is the validation error returned by
+// UpdateNamespaceRequest.Validate if the designated constraints aren't met.
+type UpdateNamespaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateNamespaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateNamespaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateNamespaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateNamespaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateNamespaceRequestValidationError) ErrorName() string {
+	return "UpdateNamespaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateNamespaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateNamespaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateNamespaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateNamespaceRequestValidationError{}
+
+// Validate checks the field values on DeleteNamespaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteNamespaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteNamespaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteNamespaceRequestMultiError, or nil if none found.
+func (m *DeleteNamespaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteNamespaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := DeleteNamespaceRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteNamespaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteNamespaceRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteNamespaceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteNamespaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteNamespaceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteNamespaceRequestMultiError) AllErrors() []error { return m }
+
+// DeleteNamespaceRequestValidationError is the validation error returned by
+// DeleteNamespaceRequest.Validate if the designated constraints aren't met.
+type DeleteNamespaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteNamespaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteNamespaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteNamespaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteNamespaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteNamespaceRequestValidationError) ErrorName() string {
+	return "DeleteNamespaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteNamespaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteNamespaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteNamespaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteNamespaceRequestValidationError{}
+
+// Validate checks the field values on ListNamespacesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNamespacesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNamespacesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNamespacesRequestMultiError, or nil if none found.
+func (m *ListNamespacesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNamespacesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() < 0 {
+		err := ListNamespacesRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 1000 {
+		err := ListNamespacesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Keyword
+
+	if len(errors) > 0 {
+		return ListNamespacesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNamespacesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListNamespacesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListNamespacesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNamespacesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNamespacesRequestMultiError) AllErrors() []error { return m }
+
+// ListNamespacesRequestValidationError is the validation error returned by
+// ListNamespacesRequest.Validate if the designated constraints aren't met.
+type ListNamespacesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNamespacesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNamespacesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNamespacesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNamespacesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNamespacesRequestValidationError) ErrorName() string {
+	return "ListNamespacesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNamespacesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNamespacesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNamespacesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNamespacesRequestValidationError{}
+
+// Validate checks the field values on NamespaceReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NamespaceReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NamespaceReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NamespaceReplyMultiError,
+// or nil if none found.
+func (m *NamespaceReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NamespaceReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for MaxTasks
+
+	// no validation rules for MaxConcurrentExecutions
+
+	// no validation rules for MaxExecutionsPerMinute
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NamespaceReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NamespaceReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NamespaceReplyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NamespaceReplyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NamespaceReplyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NamespaceReplyValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NamespaceReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// NamespaceReplyMultiError is an error wrapping multiple validation errors
+// returned by NamespaceReply.ValidateAll() if the designated constraints
+// aren't met.
+type NamespaceReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NamespaceReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NamespaceReplyMultiError) AllErrors() []error { return m }
+
+// NamespaceReplyValidationError is the validation error returned by
+// NamespaceReply.Validate if the designated constraints aren't met.
+type NamespaceReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NamespaceReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NamespaceReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NamespaceReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NamespaceReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NamespaceReplyValidationError) ErrorName() string { return "NamespaceReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NamespaceReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNamespaceReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NamespaceReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NamespaceReplyValidationError{}
+
+// Validate checks the field values on ListNamespacesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNamespacesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNamespacesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNamespacesReplyMultiError, or nil if none found.
+func (m *ListNamespacesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNamespacesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNamespaces() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListNamespacesReplyValidationError{
+						field:  fmt.Sprintf("Namespaces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListNamespacesReplyValidationError{
+						field:  fmt.Sprintf("Namespaces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListNamespacesReplyValidationError{
+					field:  fmt.Sprintf("Namespaces[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListNamespacesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNamespacesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListNamespacesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListNamespacesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNamespacesReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNamespacesReplyMultiError) AllErrors() []error { return m }
+
+// ListNamespacesReplyValidationError is the validation error returned by
+// ListNamespacesReply.Validate if the designated constraints aren't met.
+type ListNamespacesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNamespacesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNamespacesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNamespacesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNamespacesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNamespacesReplyValidationError) ErrorName() string {
+	return "ListNamespacesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNamespacesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNamespacesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNamespacesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNamespacesReplyValidationError{}
+
+// Validate checks the field values on CreateSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSecretRequestMultiError, or nil if none found.
+func (m *CreateSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := CreateSecretRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 128 {
+		err := CreateSecretRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if utf8.RuneCountInString(m.GetValue()) < 1 {
+		err := CreateSecretRequestValidationError{
+			field:  "Value",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSecretRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSecretRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSecretRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSecretRequestMultiError) AllErrors() []error { return m }
+
+// CreateSecretRequestValidationError is the validation error returned by
+// CreateSecretRequest.Validate if the designated constraints aren't met.
+type CreateSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSecretRequestValidationError) ErrorName() string {
+	return "CreateSecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSecretRequestValidationError{}
+
+// Validate checks the field values on GetSecretRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSecretRequestMultiError, or nil if none found.
+func (m *GetSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := GetSecretRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := GetSecretRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSecretRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSecretRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSecretRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSecretRequestMultiError) AllErrors() []error { return m }
+
+// GetSecretRequestValidationError is the validation error returned by
+// GetSecretRequest.Validate if the designated constraints aren't met.
+type GetSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSecretRequestValidationError) ErrorName() string { return "GetSecretRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSecretRequestValidationError{}
+
+// Validate checks the field values on UpdateSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSecretRequestMultiError, or nil if none found.
+func (m *UpdateSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := UpdateSecretRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := UpdateSecretRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return UpdateSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSecretRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateSecretRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSecretRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSecretRequestMultiError) AllErrors() []error { return m }
+
+// UpdateSecretRequestValidationError is the validation error returned by
+// UpdateSecretRequest.Validate if the designated constraints aren't met.
+type UpdateSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSecretRequestValidationError) ErrorName() string {
+	return "UpdateSecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSecretRequestValidationError{}
+
+// Validate checks the field values on DeleteSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSecretRequestMultiError, or nil if none found.
+func (m *DeleteSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := DeleteSecretRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := DeleteSecretRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSecretRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSecretRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSecretRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSecretRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSecretRequestValidationError is the validation error returned by
+// DeleteSecretRequest.Validate if the designated constraints aren't met.
+type DeleteSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSecretRequestValidationError) ErrorName() string {
+	return "DeleteSecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSecretRequestValidationError{}
+
+// Validate checks the field values on ListSecretsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSecretsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSecretsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSecretsRequestMultiError, or nil if none found.
+func (m *ListSecretsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSecretsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() < 0 {
+		err := ListSecretsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 1000 {
+		err := ListSecretsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := ListSecretsRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Keyword
+
+	if len(errors) > 0 {
+		return ListSecretsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSecretsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListSecretsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListSecretsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSecretsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSecretsRequestMultiError) AllErrors() []error { return m }
+
+// ListSecretsRequestValidationError is the validation error returned by
+// ListSecretsRequest.Validate if the designated constraints aren't met.
+type ListSecretsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSecretsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSecretsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSecretsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSecretsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSecretsRequestValidationError) ErrorName() string {
+	return "ListSecretsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSecretsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSecretsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSecretsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSecretsRequestValidationError{}
+
+// Validate checks the field values on SecretReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SecretReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecretReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecretReplyMultiError, or
+// nil if none found.
+func (m *SecretReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecretReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Namespace
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Version
+
+	// no validation rules for KeyId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretReplyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretReplyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SecretReplyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SecretReplyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SecretReplyValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SecretReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecretReplyMultiError is an error wrapping multiple validation errors
+// returned by SecretReply.ValidateAll() if the designated constraints aren't met.
+type SecretReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecretReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecretReplyMultiError) AllErrors() []error { return m }
+
+// SecretReplyValidationError is the validation error returned by
+// SecretReply.Validate if the designated constraints aren't met.
+type SecretReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecretReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecretReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecretReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecretReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecretReplyValidationError) ErrorName() string { return "SecretReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SecretReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecretReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecretReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecretReplyValidationError{}
+
+// Validate checks the field values on ListSecretsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListSecretsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSecretsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSecretsReplyMultiError, or nil if none found.
+func (m *ListSecretsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSecretsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSecrets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSecretsReplyValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSecretsReplyValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSecretsReplyValidationError{
+					field:  fmt.Sprintf("Secrets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListSecretsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSecretsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListSecretsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListSecretsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSecretsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSecretsReplyMultiError) AllErrors() []error { return m }
+
+// ListSecretsReplyValidationError is the validation error returned by
+// ListSecretsReply.Validate if the designated constraints aren't met.
+type ListSecretsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSecretsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSecretsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSecretsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSecretsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSecretsReplyValidationError) ErrorName() string { return "ListSecretsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListSecretsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSecretsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSecretsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSecretsReplyValidationError{}
+
+// Validate checks the field values on RotateSecretKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateSecretKeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateSecretKeysRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RotateSecretKeysRequestMultiError, or nil if none found.
+func (m *RotateSecretKeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateSecretKeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RotateSecretKeysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateSecretKeysRequestMultiError is an error wrapping multiple validation
+// errors returned by RotateSecretKeysRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RotateSecretKeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateSecretKeysRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateSecretKeysRequestMultiError) AllErrors() []error { return m }
+
+// RotateSecretKeysRequestValidationError is the validation error returned by
+// RotateSecretKeysRequest.Validate if the designated constraints aren't met.
+type RotateSecretKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateSecretKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateSecretKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateSecretKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateSecretKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateSecretKeysRequestValidationError) ErrorName() string {
+	return "RotateSecretKeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateSecretKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateSecretKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateSecretKeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateSecretKeysRequestValidationError{}
+
+// Validate checks the field values on RotateSecretKeysReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateSecretKeysReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateSecretKeysReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RotateSecretKeysReplyMultiError, or nil if none found.
+func (m *RotateSecretKeysReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateSecretKeysReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PrimaryKeyId
+
+	// no validation rules for Rewrapped
+
+	if len(errors) > 0 {
+		return RotateSecretKeysReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateSecretKeysReplyMultiError is an error wrapping multiple validation
+// errors returned by RotateSecretKeysReply.ValidateAll() if the designated
+// constraints aren't met.
+type RotateSecretKeysReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateSecretKeysReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateSecretKeysReplyMultiError) AllErrors() []error { return m }
+
+// RotateSecretKeysReplyValidationError is the validation error returned by
+// RotateSecretKeysReply.Validate if the designated constraints aren't met.
+type RotateSecretKeysReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateSecretKeysReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateSecretKeysReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateSecretKeysReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateSecretKeysReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateSecretKeysReplyValidationError) ErrorName() string {
+	return "RotateSecretKeysReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateSecretKeysReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateSecretKeysReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateSecretKeysReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateSecretKeysReplyValidationError{}
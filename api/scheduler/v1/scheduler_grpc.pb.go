@@ -20,17 +20,49 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Scheduler_CreateTask_FullMethodName        = "/scheduler.v1.Scheduler/CreateTask"
-	Scheduler_GetTask_FullMethodName           = "/scheduler.v1.Scheduler/GetTask"
-	Scheduler_UpdateTask_FullMethodName        = "/scheduler.v1.Scheduler/UpdateTask"
-	Scheduler_DeleteTask_FullMethodName        = "/scheduler.v1.Scheduler/DeleteTask"
-	Scheduler_ListTasks_FullMethodName         = "/scheduler.v1.Scheduler/ListTasks"
-	Scheduler_ExecuteTask_FullMethodName       = "/scheduler.v1.Scheduler/ExecuteTask"
-	Scheduler_PauseTask_FullMethodName         = "/scheduler.v1.Scheduler/PauseTask"
-	Scheduler_ResumeTask_FullMethodName        = "/scheduler.v1.Scheduler/ResumeTask"
-	Scheduler_GetTaskExecutions_FullMethodName = "/scheduler.v1.Scheduler/GetTaskExecutions"
-	Scheduler_GetExecution_FullMethodName      = "/scheduler.v1.Scheduler/GetExecution"
-	Scheduler_CancelExecution_FullMethodName   = "/scheduler.v1.Scheduler/CancelExecution"
+	Scheduler_CreateTask_FullMethodName         = "/scheduler.v1.Scheduler/CreateTask"
+	Scheduler_GetTask_FullMethodName            = "/scheduler.v1.Scheduler/GetTask"
+	Scheduler_UpdateTask_FullMethodName         = "/scheduler.v1.Scheduler/UpdateTask"
+	Scheduler_DeleteTask_FullMethodName         = "/scheduler.v1.Scheduler/DeleteTask"
+	Scheduler_RestoreTask_FullMethodName        = "/scheduler.v1.Scheduler/RestoreTask"
+	Scheduler_PurgeTask_FullMethodName          = "/scheduler.v1.Scheduler/PurgeTask"
+	Scheduler_ListTasks_FullMethodName          = "/scheduler.v1.Scheduler/ListTasks"
+	Scheduler_ListTaskRevisions_FullMethodName  = "/scheduler.v1.Scheduler/ListTaskRevisions"
+	Scheduler_GetTaskRevision_FullMethodName    = "/scheduler.v1.Scheduler/GetTaskRevision"
+	Scheduler_RollbackTask_FullMethodName       = "/scheduler.v1.Scheduler/RollbackTask"
+	Scheduler_ExecuteTask_FullMethodName        = "/scheduler.v1.Scheduler/ExecuteTask"
+	Scheduler_PauseTask_FullMethodName          = "/scheduler.v1.Scheduler/PauseTask"
+	Scheduler_ResumeTask_FullMethodName         = "/scheduler.v1.Scheduler/ResumeTask"
+	Scheduler_CancelTask_FullMethodName         = "/scheduler.v1.Scheduler/CancelTask"
+	Scheduler_GetTaskExecutions_FullMethodName  = "/scheduler.v1.Scheduler/GetTaskExecutions"
+	Scheduler_GetExecution_FullMethodName       = "/scheduler.v1.Scheduler/GetExecution"
+	Scheduler_CancelExecution_FullMethodName    = "/scheduler.v1.Scheduler/CancelExecution"
+	Scheduler_GetQueueStats_FullMethodName      = "/scheduler.v1.Scheduler/GetQueueStats"
+	Scheduler_PreviewSchedule_FullMethodName    = "/scheduler.v1.Scheduler/PreviewSchedule"
+	Scheduler_CreateCalendar_FullMethodName     = "/scheduler.v1.Scheduler/CreateCalendar"
+	Scheduler_GetCalendar_FullMethodName        = "/scheduler.v1.Scheduler/GetCalendar"
+	Scheduler_UpdateCalendar_FullMethodName     = "/scheduler.v1.Scheduler/UpdateCalendar"
+	Scheduler_DeleteCalendar_FullMethodName     = "/scheduler.v1.Scheduler/DeleteCalendar"
+	Scheduler_ListCalendars_FullMethodName      = "/scheduler.v1.Scheduler/ListCalendars"
+	Scheduler_ImportCalendar_FullMethodName     = "/scheduler.v1.Scheduler/ImportCalendar"
+	Scheduler_CreateResourcePool_FullMethodName = "/scheduler.v1.Scheduler/CreateResourcePool"
+	Scheduler_GetResourcePool_FullMethodName    = "/scheduler.v1.Scheduler/GetResourcePool"
+	Scheduler_UpdateResourcePool_FullMethodName = "/scheduler.v1.Scheduler/UpdateResourcePool"
+	Scheduler_DeleteResourcePool_FullMethodName = "/scheduler.v1.Scheduler/DeleteResourcePool"
+	Scheduler_ListResourcePools_FullMethodName  = "/scheduler.v1.Scheduler/ListResourcePools"
+	Scheduler_ListAuditEvents_FullMethodName    = "/scheduler.v1.Scheduler/ListAuditEvents"
+	Scheduler_WhoAmI_FullMethodName             = "/scheduler.v1.Scheduler/WhoAmI"
+	Scheduler_CreateNamespace_FullMethodName    = "/scheduler.v1.Scheduler/CreateNamespace"
+	Scheduler_GetNamespace_FullMethodName       = "/scheduler.v1.Scheduler/GetNamespace"
+	Scheduler_UpdateNamespace_FullMethodName    = "/scheduler.v1.Scheduler/UpdateNamespace"
+	Scheduler_DeleteNamespace_FullMethodName    = "/scheduler.v1.Scheduler/DeleteNamespace"
+	Scheduler_ListNamespaces_FullMethodName     = "/scheduler.v1.Scheduler/ListNamespaces"
+	Scheduler_CreateSecret_FullMethodName       = "/scheduler.v1.Scheduler/CreateSecret"
+	Scheduler_GetSecret_FullMethodName          = "/scheduler.v1.Scheduler/GetSecret"
+	Scheduler_UpdateSecret_FullMethodName       = "/scheduler.v1.Scheduler/UpdateSecret"
+	Scheduler_DeleteSecret_FullMethodName       = "/scheduler.v1.Scheduler/DeleteSecret"
+	Scheduler_ListSecrets_FullMethodName        = "/scheduler.v1.Scheduler/ListSecrets"
+	Scheduler_RotateSecretKeys_FullMethodName   = "/scheduler.v1.Scheduler/RotateSecretKeys"
 )
 
 // SchedulerClient is the client API for Scheduler service.
@@ -45,22 +77,86 @@ type SchedulerClient interface {
 	GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*TaskReply, error)
 	// 更新任务
 	UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*TaskReply, error)
-	// 删除任务
+	// 删除任务（软删除），同时取消仍在排队中的执行记录
 	DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 恢复已删除的任务
+	RestoreTask(ctx context.Context, in *RestoreTaskRequest, opts ...grpc.CallOption) (*TaskReply, error)
+	// 彻底删除已删除的任务及其执行记录
+	PurgeTask(ctx context.Context, in *PurgeTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 任务列表查询
 	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksReply, error)
+	// 任务修订列表，按版本倒序
+	ListTaskRevisions(ctx context.Context, in *ListTaskRevisionsRequest, opts ...grpc.CallOption) (*ListTaskRevisionsReply, error)
+	// 获取任务指定版本的修订
+	GetTaskRevision(ctx context.Context, in *GetTaskRevisionRequest, opts ...grpc.CallOption) (*TaskRevisionReply, error)
+	// 将任务定义回滚到指定版本，回滚本身记录为新的修订
+	RollbackTask(ctx context.Context, in *RollbackTaskRequest, opts ...grpc.CallOption) (*TaskReply, error)
 	// 立即执行任务
 	ExecuteTask(ctx context.Context, in *ExecuteTaskRequest, opts ...grpc.CallOption) (*TaskExecutionReply, error)
 	// 暂停任务
 	PauseTask(ctx context.Context, in *PauseTaskRequest, opts ...grpc.CallOption) (*TaskReply, error)
 	// 恢复任务
 	ResumeTask(ctx context.Context, in *ResumeTaskRequest, opts ...grpc.CallOption) (*TaskReply, error)
+	// 取消任务，已取消的任务不再调度且不能恢复
+	CancelTask(ctx context.Context, in *CancelTaskRequest, opts ...grpc.CallOption) (*TaskReply, error)
 	// 获取任务执行历史
 	GetTaskExecutions(ctx context.Context, in *GetTaskExecutionsRequest, opts ...grpc.CallOption) (*ListExecutionsReply, error)
 	// 获取单次执行详情
 	GetExecution(ctx context.Context, in *GetExecutionRequest, opts ...grpc.CallOption) (*ExecutionReply, error)
 	// 取消执行中的任务
 	CancelExecution(ctx context.Context, in *CancelExecutionRequest, opts ...grpc.CallOption) (*ExecutionReply, error)
+	// 获取执行队列统计
+	GetQueueStats(ctx context.Context, in *GetQueueStatsRequest, opts ...grpc.CallOption) (*QueueStatsReply, error)
+	// 预览调度配置的后续触发时间
+	PreviewSchedule(ctx context.Context, in *PreviewScheduleRequest, opts ...grpc.CallOption) (*PreviewScheduleReply, error)
+	// 创建业务日历
+	CreateCalendar(ctx context.Context, in *CreateCalendarRequest, opts ...grpc.CallOption) (*CalendarReply, error)
+	// 获取业务日历详情
+	GetCalendar(ctx context.Context, in *GetCalendarRequest, opts ...grpc.CallOption) (*CalendarReply, error)
+	// 更新业务日历
+	UpdateCalendar(ctx context.Context, in *UpdateCalendarRequest, opts ...grpc.CallOption) (*CalendarReply, error)
+	// 删除业务日历
+	DeleteCalendar(ctx context.Context, in *DeleteCalendarRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 业务日历列表查询
+	ListCalendars(ctx context.Context, in *ListCalendarsRequest, opts ...grpc.CallOption) (*ListCalendarsReply, error)
+	// 从 iCalendar（.ics）导入业务日历
+	ImportCalendar(ctx context.Context, in *ImportCalendarRequest, opts ...grpc.CallOption) (*CalendarReply, error)
+	// 创建资源池
+	CreateResourcePool(ctx context.Context, in *CreateResourcePoolRequest, opts ...grpc.CallOption) (*ResourcePoolReply, error)
+	// 获取资源池详情
+	GetResourcePool(ctx context.Context, in *GetResourcePoolRequest, opts ...grpc.CallOption) (*ResourcePoolReply, error)
+	// 更新资源池
+	UpdateResourcePool(ctx context.Context, in *UpdateResourcePoolRequest, opts ...grpc.CallOption) (*ResourcePoolReply, error)
+	// 删除资源池
+	DeleteResourcePool(ctx context.Context, in *DeleteResourcePoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 资源池列表
+	ListResourcePools(ctx context.Context, in *ListResourcePoolsRequest, opts ...grpc.CallOption) (*ListResourcePoolsReply, error)
+	// 审计事件列表，按时间倒序
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsReply, error)
+	// 当前调用方及其有效权限
+	WhoAmI(ctx context.Context, in *WhoAmIRequest, opts ...grpc.CallOption) (*WhoAmIReply, error)
+	// 创建命名空间
+	CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*NamespaceReply, error)
+	// 获取命名空间详情
+	GetNamespace(ctx context.Context, in *GetNamespaceRequest, opts ...grpc.CallOption) (*NamespaceReply, error)
+	// 更新命名空间的描述和配额
+	UpdateNamespace(ctx context.Context, in *UpdateNamespaceRequest, opts ...grpc.CallOption) (*NamespaceReply, error)
+	// 删除命名空间，命名空间中不能有任务（包括已删除的任务）或密钥
+	DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 命名空间列表，按名称排序
+	ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesReply, error)
+	// 创建密钥，值加密后保存，任何接口都不返回值
+	CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...grpc.CallOption) (*SecretReply, error)
+	// 获取密钥详情（不包括值）
+	GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*SecretReply, error)
+	// 更新密钥的描述，value 不为空时替换值
+	UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...grpc.CallOption) (*SecretReply, error)
+	// 删除密钥，引用该密钥的任务在执行时失败
+	DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 密钥列表，按命名空间和名称排序
+	ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsReply, error)
+	// 用主用密钥重新加密其他主密钥加密的数据密钥，用于轮换主密钥
+	RotateSecretKeys(ctx context.Context, in *RotateSecretKeysRequest, opts ...grpc.CallOption) (*RotateSecretKeysReply, error)
 }
 
 type schedulerClient struct {
@@ -111,6 +207,26 @@ func (c *schedulerClient) DeleteTask(ctx context.Context, in *DeleteTaskRequest,
 	return out, nil
 }
 
+func (c *schedulerClient) RestoreTask(ctx context.Context, in *RestoreTaskRequest, opts ...grpc.CallOption) (*TaskReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskReply)
+	err := c.cc.Invoke(ctx, Scheduler_RestoreTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) PurgeTask(ctx context.Context, in *PurgeTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Scheduler_PurgeTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *schedulerClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListTasksReply)
@@ -121,6 +237,36 @@ func (c *schedulerClient) ListTasks(ctx context.Context, in *ListTasksRequest, o
 	return out, nil
 }
 
+func (c *schedulerClient) ListTaskRevisions(ctx context.Context, in *ListTaskRevisionsRequest, opts ...grpc.CallOption) (*ListTaskRevisionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskRevisionsReply)
+	err := c.cc.Invoke(ctx, Scheduler_ListTaskRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) GetTaskRevision(ctx context.Context, in *GetTaskRevisionRequest, opts ...grpc.CallOption) (*TaskRevisionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskRevisionReply)
+	err := c.cc.Invoke(ctx, Scheduler_GetTaskRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) RollbackTask(ctx context.Context, in *RollbackTaskRequest, opts ...grpc.CallOption) (*TaskReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskReply)
+	err := c.cc.Invoke(ctx, Scheduler_RollbackTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *schedulerClient) ExecuteTask(ctx context.Context, in *ExecuteTaskRequest, opts ...grpc.CallOption) (*TaskExecutionReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TaskExecutionReply)
@@ -148,325 +294,1331 @@ func (c *schedulerClient) ResumeTask(ctx context.Context, in *ResumeTaskRequest,
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *schedulerClient) CancelTask(ctx context.Context, in *CancelTaskRequest, opts ...grpc.CallOption) (*TaskReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskReply)
+	err := c.cc.Invoke(ctx, Scheduler_CancelTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) GetTaskExecutions(ctx context.Context, in *GetTaskExecutionsRequest, opts ...grpc.CallOption) (*ListExecutionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExecutionsReply)
+	err := c.cc.Invoke(ctx, Scheduler_GetTaskExecutions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) GetExecution(ctx context.Context, in *GetExecutionRequest, opts ...grpc.CallOption) (*ExecutionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExecutionReply)
+	err := c.cc.Invoke(ctx, Scheduler_GetExecution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) CancelExecution(ctx context.Context, in *CancelExecutionRequest, opts ...grpc.CallOption) (*ExecutionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExecutionReply)
+	err := c.cc.Invoke(ctx, Scheduler_CancelExecution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) GetQueueStats(ctx context.Context, in *GetQueueStatsRequest, opts ...grpc.CallOption) (*QueueStatsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueueStatsReply)
+	err := c.cc.Invoke(ctx, Scheduler_GetQueueStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) PreviewSchedule(ctx context.Context, in *PreviewScheduleRequest, opts ...grpc.CallOption) (*PreviewScheduleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewScheduleReply)
+	err := c.cc.Invoke(ctx, Scheduler_PreviewSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) CreateCalendar(ctx context.Context, in *CreateCalendarRequest, opts ...grpc.CallOption) (*CalendarReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarReply)
+	err := c.cc.Invoke(ctx, Scheduler_CreateCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) GetCalendar(ctx context.Context, in *GetCalendarRequest, opts ...grpc.CallOption) (*CalendarReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarReply)
+	err := c.cc.Invoke(ctx, Scheduler_GetCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) UpdateCalendar(ctx context.Context, in *UpdateCalendarRequest, opts ...grpc.CallOption) (*CalendarReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarReply)
+	err := c.cc.Invoke(ctx, Scheduler_UpdateCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) DeleteCalendar(ctx context.Context, in *DeleteCalendarRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Scheduler_DeleteCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) ListCalendars(ctx context.Context, in *ListCalendarsRequest, opts ...grpc.CallOption) (*ListCalendarsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCalendarsReply)
+	err := c.cc.Invoke(ctx, Scheduler_ListCalendars_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) ImportCalendar(ctx context.Context, in *ImportCalendarRequest, opts ...grpc.CallOption) (*CalendarReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarReply)
+	err := c.cc.Invoke(ctx, Scheduler_ImportCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) CreateResourcePool(ctx context.Context, in *CreateResourcePoolRequest, opts ...grpc.CallOption) (*ResourcePoolReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourcePoolReply)
+	err := c.cc.Invoke(ctx, Scheduler_CreateResourcePool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) GetResourcePool(ctx context.Context, in *GetResourcePoolRequest, opts ...grpc.CallOption) (*ResourcePoolReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourcePoolReply)
+	err := c.cc.Invoke(ctx, Scheduler_GetResourcePool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) UpdateResourcePool(ctx context.Context, in *UpdateResourcePoolRequest, opts ...grpc.CallOption) (*ResourcePoolReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourcePoolReply)
+	err := c.cc.Invoke(ctx, Scheduler_UpdateResourcePool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) DeleteResourcePool(ctx context.Context, in *DeleteResourcePoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Scheduler_DeleteResourcePool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) ListResourcePools(ctx context.Context, in *ListResourcePoolsRequest, opts ...grpc.CallOption) (*ListResourcePoolsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResourcePoolsReply)
+	err := c.cc.Invoke(ctx, Scheduler_ListResourcePools_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsReply)
+	err := c.cc.Invoke(ctx, Scheduler_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) WhoAmI(ctx context.Context, in *WhoAmIRequest, opts ...grpc.CallOption) (*WhoAmIReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WhoAmIReply)
+	err := c.cc.Invoke(ctx, Scheduler_WhoAmI_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*NamespaceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NamespaceReply)
+	err := c.cc.Invoke(ctx, Scheduler_CreateNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) GetNamespace(ctx context.Context, in *GetNamespaceRequest, opts ...grpc.CallOption) (*NamespaceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NamespaceReply)
+	err := c.cc.Invoke(ctx, Scheduler_GetNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) UpdateNamespace(ctx context.Context, in *UpdateNamespaceRequest, opts ...grpc.CallOption) (*NamespaceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NamespaceReply)
+	err := c.cc.Invoke(ctx, Scheduler_UpdateNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Scheduler_DeleteNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNamespacesReply)
+	err := c.cc.Invoke(ctx, Scheduler_ListNamespaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...grpc.CallOption) (*SecretReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SecretReply)
+	err := c.cc.Invoke(ctx, Scheduler_CreateSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*SecretReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SecretReply)
+	err := c.cc.Invoke(ctx, Scheduler_GetSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...grpc.CallOption) (*SecretReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SecretReply)
+	err := c.cc.Invoke(ctx, Scheduler_UpdateSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Scheduler_DeleteSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSecretsReply)
+	err := c.cc.Invoke(ctx, Scheduler_ListSecrets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) RotateSecretKeys(ctx context.Context, in *RotateSecretKeysRequest, opts ...grpc.CallOption) (*RotateSecretKeysReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateSecretKeysReply)
+	err := c.cc.Invoke(ctx, Scheduler_RotateSecretKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SchedulerServer is the server API for Scheduler service.
+// All implementations must embed UnimplementedSchedulerServer
+// for forward compatibility.
+//
+// 任务调度服务定义
+type SchedulerServer interface {
+	// 创建任务
+	CreateTask(context.Context, *CreateTaskRequest) (*TaskReply, error)
+	// 获取任务详情
+	GetTask(context.Context, *GetTaskRequest) (*TaskReply, error)
+	// 更新任务
+	UpdateTask(context.Context, *UpdateTaskRequest) (*TaskReply, error)
+	// 删除任务（软删除），同时取消仍在排队中的执行记录
+	DeleteTask(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error)
+	// 恢复已删除的任务
+	RestoreTask(context.Context, *RestoreTaskRequest) (*TaskReply, error)
+	// 彻底删除已删除的任务及其执行记录
+	PurgeTask(context.Context, *PurgeTaskRequest) (*emptypb.Empty, error)
+	// 任务列表查询
+	ListTasks(context.Context, *ListTasksRequest) (*ListTasksReply, error)
+	// 任务修订列表，按版本倒序
+	ListTaskRevisions(context.Context, *ListTaskRevisionsRequest) (*ListTaskRevisionsReply, error)
+	// 获取任务指定版本的修订
+	GetTaskRevision(context.Context, *GetTaskRevisionRequest) (*TaskRevisionReply, error)
+	// 将任务定义回滚到指定版本，回滚本身记录为新的修订
+	RollbackTask(context.Context, *RollbackTaskRequest) (*TaskReply, error)
+	// 立即执行任务
+	ExecuteTask(context.Context, *ExecuteTaskRequest) (*TaskExecutionReply, error)
+	// 暂停任务
+	PauseTask(context.Context, *PauseTaskRequest) (*TaskReply, error)
+	// 恢复任务
+	ResumeTask(context.Context, *ResumeTaskRequest) (*TaskReply, error)
+	// 取消任务，已取消的任务不再调度且不能恢复
+	CancelTask(context.Context, *CancelTaskRequest) (*TaskReply, error)
+	// 获取任务执行历史
+	GetTaskExecutions(context.Context, *GetTaskExecutionsRequest) (*ListExecutionsReply, error)
+	// 获取单次执行详情
+	GetExecution(context.Context, *GetExecutionRequest) (*ExecutionReply, error)
+	// 取消执行中的任务
+	CancelExecution(context.Context, *CancelExecutionRequest) (*ExecutionReply, error)
+	// 获取执行队列统计
+	GetQueueStats(context.Context, *GetQueueStatsRequest) (*QueueStatsReply, error)
+	// 预览调度配置的后续触发时间
+	PreviewSchedule(context.Context, *PreviewScheduleRequest) (*PreviewScheduleReply, error)
+	// 创建业务日历
+	CreateCalendar(context.Context, *CreateCalendarRequest) (*CalendarReply, error)
+	// 获取业务日历详情
+	GetCalendar(context.Context, *GetCalendarRequest) (*CalendarReply, error)
+	// 更新业务日历
+	UpdateCalendar(context.Context, *UpdateCalendarRequest) (*CalendarReply, error)
+	// 删除业务日历
+	DeleteCalendar(context.Context, *DeleteCalendarRequest) (*emptypb.Empty, error)
+	// 业务日历列表查询
+	ListCalendars(context.Context, *ListCalendarsRequest) (*ListCalendarsReply, error)
+	// 从 iCalendar（.ics）导入业务日历
+	ImportCalendar(context.Context, *ImportCalendarRequest) (*CalendarReply, error)
+	// 创建资源池
+	CreateResourcePool(context.Context, *CreateResourcePoolRequest) (*ResourcePoolReply, error)
+	// 获取资源池详情
+	GetResourcePool(context.Context, *GetResourcePoolRequest) (*ResourcePoolReply, error)
+	// 更新资源池
+	UpdateResourcePool(context.Context, *UpdateResourcePoolRequest) (*ResourcePoolReply, error)
+	// 删除资源池
+	DeleteResourcePool(context.Context, *DeleteResourcePoolRequest) (*emptypb.Empty, error)
+	// 资源池列表
+	ListResourcePools(context.Context, *ListResourcePoolsRequest) (*ListResourcePoolsReply, error)
+	// 审计事件列表，按时间倒序
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsReply, error)
+	// 当前调用方及其有效权限
+	WhoAmI(context.Context, *WhoAmIRequest) (*WhoAmIReply, error)
+	// 创建命名空间
+	CreateNamespace(context.Context, *CreateNamespaceRequest) (*NamespaceReply, error)
+	// 获取命名空间详情
+	GetNamespace(context.Context, *GetNamespaceRequest) (*NamespaceReply, error)
+	// 更新命名空间的描述和配额
+	UpdateNamespace(context.Context, *UpdateNamespaceRequest) (*NamespaceReply, error)
+	// 删除命名空间，命名空间中不能有任务（包括已删除的任务）或密钥
+	DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*emptypb.Empty, error)
+	// 命名空间列表，按名称排序
+	ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesReply, error)
+	// 创建密钥，值加密后保存，任何接口都不返回值
+	CreateSecret(context.Context, *CreateSecretRequest) (*SecretReply, error)
+	// 获取密钥详情（不包括值）
+	GetSecret(context.Context, *GetSecretRequest) (*SecretReply, error)
+	// 更新密钥的描述，value 不为空时替换值
+	UpdateSecret(context.Context, *UpdateSecretRequest) (*SecretReply, error)
+	// 删除密钥，引用该密钥的任务在执行时失败
+	DeleteSecret(context.Context, *DeleteSecretRequest) (*emptypb.Empty, error)
+	// 密钥列表，按命名空间和名称排序
+	ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsReply, error)
+	// 用主用密钥重新加密其他主密钥加密的数据密钥，用于轮换主密钥
+	RotateSecretKeys(context.Context, *RotateSecretKeysRequest) (*RotateSecretKeysReply, error)
+	mustEmbedUnimplementedSchedulerServer()
+}
+
+// UnimplementedSchedulerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSchedulerServer struct{}
+
+func (UnimplementedSchedulerServer) CreateTask(context.Context, *CreateTaskRequest) (*TaskReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTask not implemented")
+}
+func (UnimplementedSchedulerServer) GetTask(context.Context, *GetTaskRequest) (*TaskReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTask not implemented")
+}
+func (UnimplementedSchedulerServer) UpdateTask(context.Context, *UpdateTaskRequest) (*TaskReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTask not implemented")
+}
+func (UnimplementedSchedulerServer) DeleteTask(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTask not implemented")
+}
+func (UnimplementedSchedulerServer) RestoreTask(context.Context, *RestoreTaskRequest) (*TaskReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreTask not implemented")
+}
+func (UnimplementedSchedulerServer) PurgeTask(context.Context, *PurgeTaskRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeTask not implemented")
+}
+func (UnimplementedSchedulerServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedSchedulerServer) ListTaskRevisions(context.Context, *ListTaskRevisionsRequest) (*ListTaskRevisionsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTaskRevisions not implemented")
+}
+func (UnimplementedSchedulerServer) GetTaskRevision(context.Context, *GetTaskRevisionRequest) (*TaskRevisionReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTaskRevision not implemented")
+}
+func (UnimplementedSchedulerServer) RollbackTask(context.Context, *RollbackTaskRequest) (*TaskReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RollbackTask not implemented")
+}
+func (UnimplementedSchedulerServer) ExecuteTask(context.Context, *ExecuteTaskRequest) (*TaskExecutionReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExecuteTask not implemented")
+}
+func (UnimplementedSchedulerServer) PauseTask(context.Context, *PauseTaskRequest) (*TaskReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseTask not implemented")
+}
+func (UnimplementedSchedulerServer) ResumeTask(context.Context, *ResumeTaskRequest) (*TaskReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeTask not implemented")
+}
+func (UnimplementedSchedulerServer) CancelTask(context.Context, *CancelTaskRequest) (*TaskReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelTask not implemented")
+}
+func (UnimplementedSchedulerServer) GetTaskExecutions(context.Context, *GetTaskExecutionsRequest) (*ListExecutionsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTaskExecutions not implemented")
+}
+func (UnimplementedSchedulerServer) GetExecution(context.Context, *GetExecutionRequest) (*ExecutionReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExecution not implemented")
+}
+func (UnimplementedSchedulerServer) CancelExecution(context.Context, *CancelExecutionRequest) (*ExecutionReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelExecution not implemented")
+}
+func (UnimplementedSchedulerServer) GetQueueStats(context.Context, *GetQueueStatsRequest) (*QueueStatsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetQueueStats not implemented")
+}
+func (UnimplementedSchedulerServer) PreviewSchedule(context.Context, *PreviewScheduleRequest) (*PreviewScheduleReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewSchedule not implemented")
+}
+func (UnimplementedSchedulerServer) CreateCalendar(context.Context, *CreateCalendarRequest) (*CalendarReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCalendar not implemented")
+}
+func (UnimplementedSchedulerServer) GetCalendar(context.Context, *GetCalendarRequest) (*CalendarReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCalendar not implemented")
+}
+func (UnimplementedSchedulerServer) UpdateCalendar(context.Context, *UpdateCalendarRequest) (*CalendarReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCalendar not implemented")
+}
+func (UnimplementedSchedulerServer) DeleteCalendar(context.Context, *DeleteCalendarRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCalendar not implemented")
+}
+func (UnimplementedSchedulerServer) ListCalendars(context.Context, *ListCalendarsRequest) (*ListCalendarsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCalendars not implemented")
+}
+func (UnimplementedSchedulerServer) ImportCalendar(context.Context, *ImportCalendarRequest) (*CalendarReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportCalendar not implemented")
+}
+func (UnimplementedSchedulerServer) CreateResourcePool(context.Context, *CreateResourcePoolRequest) (*ResourcePoolReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateResourcePool not implemented")
+}
+func (UnimplementedSchedulerServer) GetResourcePool(context.Context, *GetResourcePoolRequest) (*ResourcePoolReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetResourcePool not implemented")
+}
+func (UnimplementedSchedulerServer) UpdateResourcePool(context.Context, *UpdateResourcePoolRequest) (*ResourcePoolReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateResourcePool not implemented")
+}
+func (UnimplementedSchedulerServer) DeleteResourcePool(context.Context, *DeleteResourcePoolRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteResourcePool not implemented")
+}
+func (UnimplementedSchedulerServer) ListResourcePools(context.Context, *ListResourcePoolsRequest) (*ListResourcePoolsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListResourcePools not implemented")
+}
+func (UnimplementedSchedulerServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedSchedulerServer) WhoAmI(context.Context, *WhoAmIRequest) (*WhoAmIReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method WhoAmI not implemented")
+}
+func (UnimplementedSchedulerServer) CreateNamespace(context.Context, *CreateNamespaceRequest) (*NamespaceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateNamespace not implemented")
+}
+func (UnimplementedSchedulerServer) GetNamespace(context.Context, *GetNamespaceRequest) (*NamespaceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNamespace not implemented")
+}
+func (UnimplementedSchedulerServer) UpdateNamespace(context.Context, *UpdateNamespaceRequest) (*NamespaceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateNamespace not implemented")
+}
+func (UnimplementedSchedulerServer) DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteNamespace not implemented")
+}
+func (UnimplementedSchedulerServer) ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNamespaces not implemented")
+}
+func (UnimplementedSchedulerServer) CreateSecret(context.Context, *CreateSecretRequest) (*SecretReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSecret not implemented")
+}
+func (UnimplementedSchedulerServer) GetSecret(context.Context, *GetSecretRequest) (*SecretReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSecret not implemented")
+}
+func (UnimplementedSchedulerServer) UpdateSecret(context.Context, *UpdateSecretRequest) (*SecretReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSecret not implemented")
+}
+func (UnimplementedSchedulerServer) DeleteSecret(context.Context, *DeleteSecretRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSecret not implemented")
+}
+func (UnimplementedSchedulerServer) ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSecrets not implemented")
+}
+func (UnimplementedSchedulerServer) RotateSecretKeys(context.Context, *RotateSecretKeysRequest) (*RotateSecretKeysReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateSecretKeys not implemented")
+}
+func (UnimplementedSchedulerServer) mustEmbedUnimplementedSchedulerServer() {}
+func (UnimplementedSchedulerServer) testEmbeddedByValue()                   {}
+
+// UnsafeSchedulerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SchedulerServer will
+// result in compilation errors.
+type UnsafeSchedulerServer interface {
+	mustEmbedUnimplementedSchedulerServer()
+}
+
+func RegisterSchedulerServer(s grpc.ServiceRegistrar, srv SchedulerServer) {
+	// If the following call panics, it indicates UnimplementedSchedulerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Scheduler_ServiceDesc, srv)
+}
+
+func _Scheduler_CreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).CreateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_CreateTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).CreateTask(ctx, req.(*CreateTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_GetTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_GetTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetTask(ctx, req.(*GetTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).UpdateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_UpdateTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).UpdateTask(ctx, req.(*UpdateTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).DeleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_DeleteTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).DeleteTask(ctx, req.(*DeleteTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_RestoreTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).RestoreTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_RestoreTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).RestoreTask(ctx, req.(*RestoreTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_PurgeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).PurgeTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_PurgeTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).PurgeTask(ctx, req.(*PurgeTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_ListTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ListTasks(ctx, req.(*ListTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_ListTaskRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).ListTaskRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_ListTaskRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ListTaskRevisions(ctx, req.(*ListTaskRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_GetTaskRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetTaskRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_GetTaskRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetTaskRevision(ctx, req.(*GetTaskRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_RollbackTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).RollbackTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_RollbackTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).RollbackTask(ctx, req.(*RollbackTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_ExecuteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).ExecuteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_ExecuteTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ExecuteTask(ctx, req.(*ExecuteTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_PauseTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).PauseTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_PauseTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).PauseTask(ctx, req.(*PauseTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_ResumeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).ResumeTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_ResumeTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ResumeTask(ctx, req.(*ResumeTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_CancelTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).CancelTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_CancelTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).CancelTask(ctx, req.(*CancelTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_GetTaskExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetTaskExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_GetTaskExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetTaskExecutions(ctx, req.(*GetTaskExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_GetExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_GetExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetExecution(ctx, req.(*GetExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_CancelExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).CancelExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_CancelExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).CancelExecution(ctx, req.(*CancelExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_GetQueueStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueueStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetQueueStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_GetQueueStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetQueueStats(ctx, req.(*GetQueueStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_PreviewSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).PreviewSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_PreviewSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).PreviewSchedule(ctx, req.(*PreviewScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_CreateCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).CreateCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_CreateCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).CreateCalendar(ctx, req.(*CreateCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_GetCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_GetCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetCalendar(ctx, req.(*GetCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_UpdateCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).UpdateCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_UpdateCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).UpdateCalendar(ctx, req.(*UpdateCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_DeleteCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).DeleteCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_DeleteCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).DeleteCalendar(ctx, req.(*DeleteCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_ListCalendars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCalendarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).ListCalendars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_ListCalendars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ListCalendars(ctx, req.(*ListCalendarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_ImportCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).ImportCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_ImportCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ImportCalendar(ctx, req.(*ImportCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_CreateResourcePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateResourcePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).CreateResourcePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_CreateResourcePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).CreateResourcePool(ctx, req.(*CreateResourcePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_GetResourcePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourcePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetResourcePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_GetResourcePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetResourcePool(ctx, req.(*GetResourcePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_UpdateResourcePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateResourcePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).UpdateResourcePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_UpdateResourcePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).UpdateResourcePool(ctx, req.(*UpdateResourcePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_DeleteResourcePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteResourcePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).DeleteResourcePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_DeleteResourcePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).DeleteResourcePool(ctx, req.(*DeleteResourcePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *schedulerClient) GetTaskExecutions(ctx context.Context, in *GetTaskExecutionsRequest, opts ...grpc.CallOption) (*ListExecutionsReply, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListExecutionsReply)
-	err := c.cc.Invoke(ctx, Scheduler_GetTaskExecutions_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _Scheduler_ListResourcePools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourcePoolsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SchedulerServer).ListResourcePools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_ListResourcePools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ListResourcePools(ctx, req.(*ListResourcePoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *schedulerClient) GetExecution(ctx context.Context, in *GetExecutionRequest, opts ...grpc.CallOption) (*ExecutionReply, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ExecutionReply)
-	err := c.cc.Invoke(ctx, Scheduler_GetExecution_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _Scheduler_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SchedulerServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *schedulerClient) CancelExecution(ctx context.Context, in *CancelExecutionRequest, opts ...grpc.CallOption) (*ExecutionReply, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ExecutionReply)
-	err := c.cc.Invoke(ctx, Scheduler_CancelExecution_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _Scheduler_WhoAmI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhoAmIRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// SchedulerServer is the server API for Scheduler service.
-// All implementations must embed UnimplementedSchedulerServer
-// for forward compatibility.
-//
-// 任务调度服务定义
-type SchedulerServer interface {
-	// 创建任务
-	CreateTask(context.Context, *CreateTaskRequest) (*TaskReply, error)
-	// 获取任务详情
-	GetTask(context.Context, *GetTaskRequest) (*TaskReply, error)
-	// 更新任务
-	UpdateTask(context.Context, *UpdateTaskRequest) (*TaskReply, error)
-	// 删除任务
-	DeleteTask(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error)
-	// 任务列表查询
-	ListTasks(context.Context, *ListTasksRequest) (*ListTasksReply, error)
-	// 立即执行任务
-	ExecuteTask(context.Context, *ExecuteTaskRequest) (*TaskExecutionReply, error)
-	// 暂停任务
-	PauseTask(context.Context, *PauseTaskRequest) (*TaskReply, error)
-	// 恢复任务
-	ResumeTask(context.Context, *ResumeTaskRequest) (*TaskReply, error)
-	// 获取任务执行历史
-	GetTaskExecutions(context.Context, *GetTaskExecutionsRequest) (*ListExecutionsReply, error)
-	// 获取单次执行详情
-	GetExecution(context.Context, *GetExecutionRequest) (*ExecutionReply, error)
-	// 取消执行中的任务
-	CancelExecution(context.Context, *CancelExecutionRequest) (*ExecutionReply, error)
-	mustEmbedUnimplementedSchedulerServer()
-}
-
-// UnimplementedSchedulerServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedSchedulerServer struct{}
-
-func (UnimplementedSchedulerServer) CreateTask(context.Context, *CreateTaskRequest) (*TaskReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateTask not implemented")
-}
-func (UnimplementedSchedulerServer) GetTask(context.Context, *GetTaskRequest) (*TaskReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetTask not implemented")
-}
-func (UnimplementedSchedulerServer) UpdateTask(context.Context, *UpdateTaskRequest) (*TaskReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateTask not implemented")
-}
-func (UnimplementedSchedulerServer) DeleteTask(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteTask not implemented")
-}
-func (UnimplementedSchedulerServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListTasks not implemented")
-}
-func (UnimplementedSchedulerServer) ExecuteTask(context.Context, *ExecuteTaskRequest) (*TaskExecutionReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method ExecuteTask not implemented")
-}
-func (UnimplementedSchedulerServer) PauseTask(context.Context, *PauseTaskRequest) (*TaskReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method PauseTask not implemented")
-}
-func (UnimplementedSchedulerServer) ResumeTask(context.Context, *ResumeTaskRequest) (*TaskReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method ResumeTask not implemented")
-}
-func (UnimplementedSchedulerServer) GetTaskExecutions(context.Context, *GetTaskExecutionsRequest) (*ListExecutionsReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetTaskExecutions not implemented")
-}
-func (UnimplementedSchedulerServer) GetExecution(context.Context, *GetExecutionRequest) (*ExecutionReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetExecution not implemented")
-}
-func (UnimplementedSchedulerServer) CancelExecution(context.Context, *CancelExecutionRequest) (*ExecutionReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method CancelExecution not implemented")
-}
-func (UnimplementedSchedulerServer) mustEmbedUnimplementedSchedulerServer() {}
-func (UnimplementedSchedulerServer) testEmbeddedByValue()                   {}
-
-// UnsafeSchedulerServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SchedulerServer will
-// result in compilation errors.
-type UnsafeSchedulerServer interface {
-	mustEmbedUnimplementedSchedulerServer()
-}
-
-func RegisterSchedulerServer(s grpc.ServiceRegistrar, srv SchedulerServer) {
-	// If the following call panics, it indicates UnimplementedSchedulerServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+	if interceptor == nil {
+		return srv.(SchedulerServer).WhoAmI(ctx, in)
 	}
-	s.RegisterService(&Scheduler_ServiceDesc, srv)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scheduler_WhoAmI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).WhoAmI(ctx, req.(*WhoAmIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Scheduler_CreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTaskRequest)
+func _Scheduler_CreateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNamespaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SchedulerServer).CreateTask(ctx, in)
+		return srv.(SchedulerServer).CreateNamespace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Scheduler_CreateTask_FullMethodName,
+		FullMethod: Scheduler_CreateNamespace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).CreateTask(ctx, req.(*CreateTaskRequest))
+		return srv.(SchedulerServer).CreateNamespace(ctx, req.(*CreateNamespaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Scheduler_GetTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTaskRequest)
+func _Scheduler_GetNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SchedulerServer).GetTask(ctx, in)
+		return srv.(SchedulerServer).GetNamespace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Scheduler_GetTask_FullMethodName,
+		FullMethod: Scheduler_GetNamespace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).GetTask(ctx, req.(*GetTaskRequest))
+		return srv.(SchedulerServer).GetNamespace(ctx, req.(*GetNamespaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Scheduler_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTaskRequest)
+func _Scheduler_UpdateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNamespaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SchedulerServer).UpdateTask(ctx, in)
+		return srv.(SchedulerServer).UpdateNamespace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Scheduler_UpdateTask_FullMethodName,
+		FullMethod: Scheduler_UpdateNamespace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).UpdateTask(ctx, req.(*UpdateTaskRequest))
+		return srv.(SchedulerServer).UpdateNamespace(ctx, req.(*UpdateNamespaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Scheduler_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTaskRequest)
+func _Scheduler_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNamespaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SchedulerServer).DeleteTask(ctx, in)
+		return srv.(SchedulerServer).DeleteNamespace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Scheduler_DeleteTask_FullMethodName,
+		FullMethod: Scheduler_DeleteNamespace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).DeleteTask(ctx, req.(*DeleteTaskRequest))
+		return srv.(SchedulerServer).DeleteNamespace(ctx, req.(*DeleteNamespaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Scheduler_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTasksRequest)
+func _Scheduler_ListNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespacesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SchedulerServer).ListTasks(ctx, in)
+		return srv.(SchedulerServer).ListNamespaces(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Scheduler_ListTasks_FullMethodName,
+		FullMethod: Scheduler_ListNamespaces_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).ListTasks(ctx, req.(*ListTasksRequest))
+		return srv.(SchedulerServer).ListNamespaces(ctx, req.(*ListNamespacesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Scheduler_ExecuteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExecuteTaskRequest)
+func _Scheduler_CreateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSecretRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SchedulerServer).ExecuteTask(ctx, in)
+		return srv.(SchedulerServer).CreateSecret(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Scheduler_ExecuteTask_FullMethodName,
+		FullMethod: Scheduler_CreateSecret_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).ExecuteTask(ctx, req.(*ExecuteTaskRequest))
+		return srv.(SchedulerServer).CreateSecret(ctx, req.(*CreateSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Scheduler_PauseTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PauseTaskRequest)
+func _Scheduler_GetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SchedulerServer).PauseTask(ctx, in)
+		return srv.(SchedulerServer).GetSecret(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Scheduler_PauseTask_FullMethodName,
+		FullMethod: Scheduler_GetSecret_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).PauseTask(ctx, req.(*PauseTaskRequest))
+		return srv.(SchedulerServer).GetSecret(ctx, req.(*GetSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Scheduler_ResumeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResumeTaskRequest)
+func _Scheduler_UpdateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSecretRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SchedulerServer).ResumeTask(ctx, in)
+		return srv.(SchedulerServer).UpdateSecret(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Scheduler_ResumeTask_FullMethodName,
+		FullMethod: Scheduler_UpdateSecret_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).ResumeTask(ctx, req.(*ResumeTaskRequest))
+		return srv.(SchedulerServer).UpdateSecret(ctx, req.(*UpdateSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Scheduler_GetTaskExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTaskExecutionsRequest)
+func _Scheduler_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSecretRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SchedulerServer).GetTaskExecutions(ctx, in)
+		return srv.(SchedulerServer).DeleteSecret(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Scheduler_GetTaskExecutions_FullMethodName,
+		FullMethod: Scheduler_DeleteSecret_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).GetTaskExecutions(ctx, req.(*GetTaskExecutionsRequest))
+		return srv.(SchedulerServer).DeleteSecret(ctx, req.(*DeleteSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Scheduler_GetExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExecutionRequest)
+func _Scheduler_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecretsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SchedulerServer).GetExecution(ctx, in)
+		return srv.(SchedulerServer).ListSecrets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Scheduler_GetExecution_FullMethodName,
+		FullMethod: Scheduler_ListSecrets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).GetExecution(ctx, req.(*GetExecutionRequest))
+		return srv.(SchedulerServer).ListSecrets(ctx, req.(*ListSecretsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Scheduler_CancelExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelExecutionRequest)
+func _Scheduler_RotateSecretKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateSecretKeysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SchedulerServer).CancelExecution(ctx, in)
+		return srv.(SchedulerServer).RotateSecretKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Scheduler_CancelExecution_FullMethodName,
+		FullMethod: Scheduler_RotateSecretKeys_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SchedulerServer).CancelExecution(ctx, req.(*CancelExecutionRequest))
+		return srv.(SchedulerServer).RotateSecretKeys(ctx, req.(*RotateSecretKeysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -494,10 +1646,30 @@ var Scheduler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTask",
 			Handler:    _Scheduler_DeleteTask_Handler,
 		},
+		{
+			MethodName: "RestoreTask",
+			Handler:    _Scheduler_RestoreTask_Handler,
+		},
+		{
+			MethodName: "PurgeTask",
+			Handler:    _Scheduler_PurgeTask_Handler,
+		},
 		{
 			MethodName: "ListTasks",
 			Handler:    _Scheduler_ListTasks_Handler,
 		},
+		{
+			MethodName: "ListTaskRevisions",
+			Handler:    _Scheduler_ListTaskRevisions_Handler,
+		},
+		{
+			MethodName: "GetTaskRevision",
+			Handler:    _Scheduler_GetTaskRevision_Handler,
+		},
+		{
+			MethodName: "RollbackTask",
+			Handler:    _Scheduler_RollbackTask_Handler,
+		},
 		{
 			MethodName: "ExecuteTask",
 			Handler:    _Scheduler_ExecuteTask_Handler,
@@ -510,6 +1682,10 @@ var Scheduler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResumeTask",
 			Handler:    _Scheduler_ResumeTask_Handler,
 		},
+		{
+			MethodName: "CancelTask",
+			Handler:    _Scheduler_CancelTask_Handler,
+		},
 		{
 			MethodName: "GetTaskExecutions",
 			Handler:    _Scheduler_GetTaskExecutions_Handler,
@@ -522,6 +1698,110 @@ var Scheduler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelExecution",
 			Handler:    _Scheduler_CancelExecution_Handler,
 		},
+		{
+			MethodName: "GetQueueStats",
+			Handler:    _Scheduler_GetQueueStats_Handler,
+		},
+		{
+			MethodName: "PreviewSchedule",
+			Handler:    _Scheduler_PreviewSchedule_Handler,
+		},
+		{
+			MethodName: "CreateCalendar",
+			Handler:    _Scheduler_CreateCalendar_Handler,
+		},
+		{
+			MethodName: "GetCalendar",
+			Handler:    _Scheduler_GetCalendar_Handler,
+		},
+		{
+			MethodName: "UpdateCalendar",
+			Handler:    _Scheduler_UpdateCalendar_Handler,
+		},
+		{
+			MethodName: "DeleteCalendar",
+			Handler:    _Scheduler_DeleteCalendar_Handler,
+		},
+		{
+			MethodName: "ListCalendars",
+			Handler:    _Scheduler_ListCalendars_Handler,
+		},
+		{
+			MethodName: "ImportCalendar",
+			Handler:    _Scheduler_ImportCalendar_Handler,
+		},
+		{
+			MethodName: "CreateResourcePool",
+			Handler:    _Scheduler_CreateResourcePool_Handler,
+		},
+		{
+			MethodName: "GetResourcePool",
+			Handler:    _Scheduler_GetResourcePool_Handler,
+		},
+		{
+			MethodName: "UpdateResourcePool",
+			Handler:    _Scheduler_UpdateResourcePool_Handler,
+		},
+		{
+			MethodName: "DeleteResourcePool",
+			Handler:    _Scheduler_DeleteResourcePool_Handler,
+		},
+		{
+			MethodName: "ListResourcePools",
+			Handler:    _Scheduler_ListResourcePools_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Scheduler_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "WhoAmI",
+			Handler:    _Scheduler_WhoAmI_Handler,
+		},
+		{
+			MethodName: "CreateNamespace",
+			Handler:    _Scheduler_CreateNamespace_Handler,
+		},
+		{
+			MethodName: "GetNamespace",
+			Handler:    _Scheduler_GetNamespace_Handler,
+		},
+		{
+			MethodName: "UpdateNamespace",
+			Handler:    _Scheduler_UpdateNamespace_Handler,
+		},
+		{
+			MethodName: "DeleteNamespace",
+			Handler:    _Scheduler_DeleteNamespace_Handler,
+		},
+		{
+			MethodName: "ListNamespaces",
+			Handler:    _Scheduler_ListNamespaces_Handler,
+		},
+		{
+			MethodName: "CreateSecret",
+			Handler:    _Scheduler_CreateSecret_Handler,
+		},
+		{
+			MethodName: "GetSecret",
+			Handler:    _Scheduler_GetSecret_Handler,
+		},
+		{
+			MethodName: "UpdateSecret",
+			Handler:    _Scheduler_UpdateSecret_Handler,
+		},
+		{
+			MethodName: "DeleteSecret",
+			Handler:    _Scheduler_DeleteSecret_Handler,
+		},
+		{
+			MethodName: "ListSecrets",
+			Handler:    _Scheduler_ListSecrets_Handler,
+		},
+		{
+			MethodName: "RotateSecretKeys",
+			Handler:    _Scheduler_RotateSecretKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "scheduler/v1/scheduler.proto",
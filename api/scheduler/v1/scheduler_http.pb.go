@@ -21,40 +21,136 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationSchedulerCancelExecution = "/scheduler.v1.Scheduler/CancelExecution"
+const OperationSchedulerCancelTask = "/scheduler.v1.Scheduler/CancelTask"
+const OperationSchedulerCreateCalendar = "/scheduler.v1.Scheduler/CreateCalendar"
+const OperationSchedulerCreateNamespace = "/scheduler.v1.Scheduler/CreateNamespace"
+const OperationSchedulerCreateResourcePool = "/scheduler.v1.Scheduler/CreateResourcePool"
+const OperationSchedulerCreateSecret = "/scheduler.v1.Scheduler/CreateSecret"
 const OperationSchedulerCreateTask = "/scheduler.v1.Scheduler/CreateTask"
+const OperationSchedulerDeleteCalendar = "/scheduler.v1.Scheduler/DeleteCalendar"
+const OperationSchedulerDeleteNamespace = "/scheduler.v1.Scheduler/DeleteNamespace"
+const OperationSchedulerDeleteResourcePool = "/scheduler.v1.Scheduler/DeleteResourcePool"
+const OperationSchedulerDeleteSecret = "/scheduler.v1.Scheduler/DeleteSecret"
 const OperationSchedulerDeleteTask = "/scheduler.v1.Scheduler/DeleteTask"
 const OperationSchedulerExecuteTask = "/scheduler.v1.Scheduler/ExecuteTask"
+const OperationSchedulerGetCalendar = "/scheduler.v1.Scheduler/GetCalendar"
 const OperationSchedulerGetExecution = "/scheduler.v1.Scheduler/GetExecution"
+const OperationSchedulerGetNamespace = "/scheduler.v1.Scheduler/GetNamespace"
+const OperationSchedulerGetQueueStats = "/scheduler.v1.Scheduler/GetQueueStats"
+const OperationSchedulerGetResourcePool = "/scheduler.v1.Scheduler/GetResourcePool"
+const OperationSchedulerGetSecret = "/scheduler.v1.Scheduler/GetSecret"
 const OperationSchedulerGetTask = "/scheduler.v1.Scheduler/GetTask"
 const OperationSchedulerGetTaskExecutions = "/scheduler.v1.Scheduler/GetTaskExecutions"
+const OperationSchedulerGetTaskRevision = "/scheduler.v1.Scheduler/GetTaskRevision"
+const OperationSchedulerImportCalendar = "/scheduler.v1.Scheduler/ImportCalendar"
+const OperationSchedulerListAuditEvents = "/scheduler.v1.Scheduler/ListAuditEvents"
+const OperationSchedulerListCalendars = "/scheduler.v1.Scheduler/ListCalendars"
+const OperationSchedulerListNamespaces = "/scheduler.v1.Scheduler/ListNamespaces"
+const OperationSchedulerListResourcePools = "/scheduler.v1.Scheduler/ListResourcePools"
+const OperationSchedulerListSecrets = "/scheduler.v1.Scheduler/ListSecrets"
+const OperationSchedulerListTaskRevisions = "/scheduler.v1.Scheduler/ListTaskRevisions"
 const OperationSchedulerListTasks = "/scheduler.v1.Scheduler/ListTasks"
 const OperationSchedulerPauseTask = "/scheduler.v1.Scheduler/PauseTask"
+const OperationSchedulerPreviewSchedule = "/scheduler.v1.Scheduler/PreviewSchedule"
+const OperationSchedulerPurgeTask = "/scheduler.v1.Scheduler/PurgeTask"
+const OperationSchedulerRestoreTask = "/scheduler.v1.Scheduler/RestoreTask"
 const OperationSchedulerResumeTask = "/scheduler.v1.Scheduler/ResumeTask"
+const OperationSchedulerRollbackTask = "/scheduler.v1.Scheduler/RollbackTask"
+const OperationSchedulerRotateSecretKeys = "/scheduler.v1.Scheduler/RotateSecretKeys"
+const OperationSchedulerUpdateCalendar = "/scheduler.v1.Scheduler/UpdateCalendar"
+const OperationSchedulerUpdateNamespace = "/scheduler.v1.Scheduler/UpdateNamespace"
+const OperationSchedulerUpdateResourcePool = "/scheduler.v1.Scheduler/UpdateResourcePool"
+const OperationSchedulerUpdateSecret = "/scheduler.v1.Scheduler/UpdateSecret"
 const OperationSchedulerUpdateTask = "/scheduler.v1.Scheduler/UpdateTask"
+const OperationSchedulerWhoAmI = "/scheduler.v1.Scheduler/WhoAmI"
 
 type SchedulerHTTPServer interface {
 	// CancelExecution 取消执行中的任务
 	CancelExecution(context.Context, *CancelExecutionRequest) (*ExecutionReply, error)
+	// CancelTask 取消任务，已取消的任务不再调度且不能恢复
+	CancelTask(context.Context, *CancelTaskRequest) (*TaskReply, error)
+	// CreateCalendar 创建业务日历
+	CreateCalendar(context.Context, *CreateCalendarRequest) (*CalendarReply, error)
+	// CreateNamespace 创建命名空间
+	CreateNamespace(context.Context, *CreateNamespaceRequest) (*NamespaceReply, error)
+	// CreateResourcePool 创建资源池
+	CreateResourcePool(context.Context, *CreateResourcePoolRequest) (*ResourcePoolReply, error)
+	// CreateSecret 创建密钥，值加密后保存，任何接口都不返回值
+	CreateSecret(context.Context, *CreateSecretRequest) (*SecretReply, error)
 	// CreateTask 创建任务
 	CreateTask(context.Context, *CreateTaskRequest) (*TaskReply, error)
-	// DeleteTask 删除任务
+	// DeleteCalendar 删除业务日历
+	DeleteCalendar(context.Context, *DeleteCalendarRequest) (*emptypb.Empty, error)
+	// DeleteNamespace 删除命名空间，命名空间中不能有任务（包括已删除的任务）或密钥
+	DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*emptypb.Empty, error)
+	// DeleteResourcePool 删除资源池
+	DeleteResourcePool(context.Context, *DeleteResourcePoolRequest) (*emptypb.Empty, error)
+	// DeleteSecret 删除密钥，引用该密钥的任务在执行时失败
+	DeleteSecret(context.Context, *DeleteSecretRequest) (*emptypb.Empty, error)
+	// DeleteTask 删除任务（软删除），同时取消仍在排队中的执行记录
 	DeleteTask(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error)
 	// ExecuteTask 立即执行任务
 	ExecuteTask(context.Context, *ExecuteTaskRequest) (*TaskExecutionReply, error)
+	// GetCalendar 获取业务日历详情
+	GetCalendar(context.Context, *GetCalendarRequest) (*CalendarReply, error)
 	// GetExecution 获取单次执行详情
 	GetExecution(context.Context, *GetExecutionRequest) (*ExecutionReply, error)
+	// GetNamespace 获取命名空间详情
+	GetNamespace(context.Context, *GetNamespaceRequest) (*NamespaceReply, error)
+	// GetQueueStats 获取执行队列统计
+	GetQueueStats(context.Context, *GetQueueStatsRequest) (*QueueStatsReply, error)
+	// GetResourcePool 获取资源池详情
+	GetResourcePool(context.Context, *GetResourcePoolRequest) (*ResourcePoolReply, error)
+	// GetSecret 获取密钥详情（不包括值）
+	GetSecret(context.Context, *GetSecretRequest) (*SecretReply, error)
 	// GetTask 获取任务详情
 	GetTask(context.Context, *GetTaskRequest) (*TaskReply, error)
 	// GetTaskExecutions 获取任务执行历史
 	GetTaskExecutions(context.Context, *GetTaskExecutionsRequest) (*ListExecutionsReply, error)
+	// GetTaskRevision 获取任务指定版本的修订
+	GetTaskRevision(context.Context, *GetTaskRevisionRequest) (*TaskRevisionReply, error)
+	// ImportCalendar 从 iCalendar（.ics）导入业务日历
+	ImportCalendar(context.Context, *ImportCalendarRequest) (*CalendarReply, error)
+	// ListAuditEvents 审计事件列表，按时间倒序
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsReply, error)
+	// ListCalendars 业务日历列表查询
+	ListCalendars(context.Context, *ListCalendarsRequest) (*ListCalendarsReply, error)
+	// ListNamespaces 命名空间列表，按名称排序
+	ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesReply, error)
+	// ListResourcePools 资源池列表
+	ListResourcePools(context.Context, *ListResourcePoolsRequest) (*ListResourcePoolsReply, error)
+	// ListSecrets 密钥列表，按命名空间和名称排序
+	ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsReply, error)
+	// ListTaskRevisions 任务修订列表，按版本倒序
+	ListTaskRevisions(context.Context, *ListTaskRevisionsRequest) (*ListTaskRevisionsReply, error)
 	// ListTasks 任务列表查询
 	ListTasks(context.Context, *ListTasksRequest) (*ListTasksReply, error)
 	// PauseTask 暂停任务
 	PauseTask(context.Context, *PauseTaskRequest) (*TaskReply, error)
+	// PreviewSchedule 预览调度配置的后续触发时间
+	PreviewSchedule(context.Context, *PreviewScheduleRequest) (*PreviewScheduleReply, error)
+	// PurgeTask 彻底删除已删除的任务及其执行记录
+	PurgeTask(context.Context, *PurgeTaskRequest) (*emptypb.Empty, error)
+	// RestoreTask 恢复已删除的任务
+	RestoreTask(context.Context, *RestoreTaskRequest) (*TaskReply, error)
 	// ResumeTask 恢复任务
 	ResumeTask(context.Context, *ResumeTaskRequest) (*TaskReply, error)
+	// RollbackTask 将任务定义回滚到指定版本，回滚本身记录为新的修订
+	RollbackTask(context.Context, *RollbackTaskRequest) (*TaskReply, error)
+	// RotateSecretKeys 用主用密钥重新加密其他主密钥加密的数据密钥，用于轮换主密钥
+	RotateSecretKeys(context.Context, *RotateSecretKeysRequest) (*RotateSecretKeysReply, error)
+	// UpdateCalendar 更新业务日历
+	UpdateCalendar(context.Context, *UpdateCalendarRequest) (*CalendarReply, error)
+	// UpdateNamespace 更新命名空间的描述和配额
+	UpdateNamespace(context.Context, *UpdateNamespaceRequest) (*NamespaceReply, error)
+	// UpdateResourcePool 更新资源池
+	UpdateResourcePool(context.Context, *UpdateResourcePoolRequest) (*ResourcePoolReply, error)
+	// UpdateSecret 更新密钥的描述，value 不为空时替换值
+	UpdateSecret(context.Context, *UpdateSecretRequest) (*SecretReply, error)
 	// UpdateTask 更新任务
 	UpdateTask(context.Context, *UpdateTaskRequest) (*TaskReply, error)
+	// WhoAmI 当前调用方及其有效权限
+	WhoAmI(context.Context, *WhoAmIRequest) (*WhoAmIReply, error)
 }
 
 func RegisterSchedulerHTTPServer(s *http.Server, srv SchedulerHTTPServer) {
@@ -63,13 +159,45 @@ func RegisterSchedulerHTTPServer(s *http.Server, srv SchedulerHTTPServer) {
 	r.GET("/api/v1/tasks/{id}", _Scheduler_GetTask0_HTTP_Handler(srv))
 	r.PUT("/api/v1/tasks/{id}", _Scheduler_UpdateTask0_HTTP_Handler(srv))
 	r.DELETE("/api/v1/tasks/{id}", _Scheduler_DeleteTask0_HTTP_Handler(srv))
+	r.POST("/api/v1/tasks/{id}/restore", _Scheduler_RestoreTask0_HTTP_Handler(srv))
+	r.POST("/api/v1/tasks/{id}/purge", _Scheduler_PurgeTask0_HTTP_Handler(srv))
 	r.GET("/api/v1/tasks", _Scheduler_ListTasks0_HTTP_Handler(srv))
+	r.GET("/api/v1/tasks/{task_id}/revisions", _Scheduler_ListTaskRevisions0_HTTP_Handler(srv))
+	r.GET("/api/v1/tasks/{task_id}/revisions/{version}", _Scheduler_GetTaskRevision0_HTTP_Handler(srv))
+	r.POST("/api/v1/tasks/{id}/rollback", _Scheduler_RollbackTask0_HTTP_Handler(srv))
 	r.POST("/api/v1/tasks/{id}/execute", _Scheduler_ExecuteTask0_HTTP_Handler(srv))
 	r.POST("/api/v1/tasks/{id}/pause", _Scheduler_PauseTask0_HTTP_Handler(srv))
 	r.POST("/api/v1/tasks/{id}/resume", _Scheduler_ResumeTask0_HTTP_Handler(srv))
+	r.POST("/api/v1/tasks/{id}/cancel", _Scheduler_CancelTask0_HTTP_Handler(srv))
 	r.GET("/api/v1/tasks/{task_id}/executions", _Scheduler_GetTaskExecutions0_HTTP_Handler(srv))
 	r.GET("/api/v1/executions/{id}", _Scheduler_GetExecution0_HTTP_Handler(srv))
 	r.POST("/api/v1/executions/{id}/cancel", _Scheduler_CancelExecution0_HTTP_Handler(srv))
+	r.GET("/api/v1/queue/stats", _Scheduler_GetQueueStats0_HTTP_Handler(srv))
+	r.GET("/api/v1/schedules/preview", _Scheduler_PreviewSchedule0_HTTP_Handler(srv))
+	r.POST("/api/v1/calendars", _Scheduler_CreateCalendar0_HTTP_Handler(srv))
+	r.GET("/api/v1/calendars/{id}", _Scheduler_GetCalendar0_HTTP_Handler(srv))
+	r.PUT("/api/v1/calendars/{id}", _Scheduler_UpdateCalendar0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/calendars/{id}", _Scheduler_DeleteCalendar0_HTTP_Handler(srv))
+	r.GET("/api/v1/calendars", _Scheduler_ListCalendars0_HTTP_Handler(srv))
+	r.POST("/api/v1/calendars/import", _Scheduler_ImportCalendar0_HTTP_Handler(srv))
+	r.POST("/api/v1/pools", _Scheduler_CreateResourcePool0_HTTP_Handler(srv))
+	r.GET("/api/v1/pools/{id}", _Scheduler_GetResourcePool0_HTTP_Handler(srv))
+	r.PUT("/api/v1/pools/{id}", _Scheduler_UpdateResourcePool0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/pools/{id}", _Scheduler_DeleteResourcePool0_HTTP_Handler(srv))
+	r.GET("/api/v1/pools", _Scheduler_ListResourcePools0_HTTP_Handler(srv))
+	r.GET("/api/v1/audit-events", _Scheduler_ListAuditEvents0_HTTP_Handler(srv))
+	r.GET("/api/v1/whoami", _Scheduler_WhoAmI0_HTTP_Handler(srv))
+	r.POST("/api/v1/namespaces", _Scheduler_CreateNamespace0_HTTP_Handler(srv))
+	r.GET("/api/v1/namespaces/{name}", _Scheduler_GetNamespace0_HTTP_Handler(srv))
+	r.PUT("/api/v1/namespaces/{name}", _Scheduler_UpdateNamespace0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/namespaces/{name}", _Scheduler_DeleteNamespace0_HTTP_Handler(srv))
+	r.GET("/api/v1/namespaces", _Scheduler_ListNamespaces0_HTTP_Handler(srv))
+	r.POST("/api/v1/secrets", _Scheduler_CreateSecret0_HTTP_Handler(srv))
+	r.GET("/api/v1/secrets/{name}", _Scheduler_GetSecret0_HTTP_Handler(srv))
+	r.PUT("/api/v1/secrets/{name}", _Scheduler_UpdateSecret0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/secrets/{name}", _Scheduler_DeleteSecret0_HTTP_Handler(srv))
+	r.GET("/api/v1/secrets", _Scheduler_ListSecrets0_HTTP_Handler(srv))
+	r.POST("/api/v1/secrets/rotate-keys", _Scheduler_RotateSecretKeys0_HTTP_Handler(srv))
 }
 
 func _Scheduler_CreateTask0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
@@ -163,6 +291,56 @@ func _Scheduler_DeleteTask0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.
 	}
 }
 
+func _Scheduler_RestoreTask0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreTaskRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerRestoreTask)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreTask(ctx, req.(*RestoreTaskRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TaskReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_PurgeTask0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgeTaskRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerPurgeTask)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeTask(ctx, req.(*PurgeTaskRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Scheduler_ListTasks0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ListTasksRequest
@@ -182,6 +360,75 @@ func _Scheduler_ListTasks0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.C
 	}
 }
 
+func _Scheduler_ListTaskRevisions0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTaskRevisionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerListTaskRevisions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTaskRevisions(ctx, req.(*ListTaskRevisionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTaskRevisionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_GetTaskRevision0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTaskRevisionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerGetTaskRevision)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTaskRevision(ctx, req.(*GetTaskRevisionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TaskRevisionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_RollbackTask0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RollbackTaskRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerRollbackTask)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RollbackTask(ctx, req.(*RollbackTaskRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TaskReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Scheduler_ExecuteTask0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ExecuteTaskRequest
@@ -257,6 +504,31 @@ func _Scheduler_ResumeTask0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.
 	}
 }
 
+func _Scheduler_CancelTask0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CancelTaskRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerCancelTask)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CancelTask(ctx, req.(*CancelTaskRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TaskReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Scheduler_GetTaskExecutions0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetTaskExecutionsRequest
@@ -326,90 +598,854 @@ func _Scheduler_CancelExecution0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx
 	}
 }
 
-type SchedulerHTTPClient interface {
-	// CancelExecution 取消执行中的任务
-	CancelExecution(ctx context.Context, req *CancelExecutionRequest, opts ...http.CallOption) (rsp *ExecutionReply, err error)
-	// CreateTask 创建任务
-	CreateTask(ctx context.Context, req *CreateTaskRequest, opts ...http.CallOption) (rsp *TaskReply, err error)
-	// DeleteTask 删除任务
-	DeleteTask(ctx context.Context, req *DeleteTaskRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
-	// ExecuteTask 立即执行任务
-	ExecuteTask(ctx context.Context, req *ExecuteTaskRequest, opts ...http.CallOption) (rsp *TaskExecutionReply, err error)
-	// GetExecution 获取单次执行详情
-	GetExecution(ctx context.Context, req *GetExecutionRequest, opts ...http.CallOption) (rsp *ExecutionReply, err error)
-	// GetTask 获取任务详情
-	GetTask(ctx context.Context, req *GetTaskRequest, opts ...http.CallOption) (rsp *TaskReply, err error)
-	// GetTaskExecutions 获取任务执行历史
-	GetTaskExecutions(ctx context.Context, req *GetTaskExecutionsRequest, opts ...http.CallOption) (rsp *ListExecutionsReply, err error)
-	// ListTasks 任务列表查询
-	ListTasks(ctx context.Context, req *ListTasksRequest, opts ...http.CallOption) (rsp *ListTasksReply, err error)
-	// PauseTask 暂停任务
-	PauseTask(ctx context.Context, req *PauseTaskRequest, opts ...http.CallOption) (rsp *TaskReply, err error)
-	// ResumeTask 恢复任务
-	ResumeTask(ctx context.Context, req *ResumeTaskRequest, opts ...http.CallOption) (rsp *TaskReply, err error)
-	// UpdateTask 更新任务
-	UpdateTask(ctx context.Context, req *UpdateTaskRequest, opts ...http.CallOption) (rsp *TaskReply, err error)
-}
-
-type SchedulerHTTPClientImpl struct {
-	cc *http.Client
+func _Scheduler_GetQueueStats0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetQueueStatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerGetQueueStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetQueueStats(ctx, req.(*GetQueueStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*QueueStatsReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-func NewSchedulerHTTPClient(client *http.Client) SchedulerHTTPClient {
-	return &SchedulerHTTPClientImpl{client}
+func _Scheduler_PreviewSchedule0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PreviewScheduleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerPreviewSchedule)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PreviewSchedule(ctx, req.(*PreviewScheduleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PreviewScheduleReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-// CancelExecution 取消执行中的任务
-func (c *SchedulerHTTPClientImpl) CancelExecution(ctx context.Context, in *CancelExecutionRequest, opts ...http.CallOption) (*ExecutionReply, error) {
-	var out ExecutionReply
-	pattern := "/api/v1/executions/{id}/cancel"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSchedulerCancelExecution))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Scheduler_CreateCalendar0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateCalendarRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerCreateCalendar)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateCalendar(ctx, req.(*CreateCalendarRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CalendarReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// CreateTask 创建任务
-func (c *SchedulerHTTPClientImpl) CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...http.CallOption) (*TaskReply, error) {
-	var out TaskReply
-	pattern := "/api/v1/tasks"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSchedulerCreateTask))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Scheduler_GetCalendar0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCalendarRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerGetCalendar)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCalendar(ctx, req.(*GetCalendarRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CalendarReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// DeleteTask 删除任务
-func (c *SchedulerHTTPClientImpl) DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
-	var out emptypb.Empty
-	pattern := "/api/v1/tasks/{id}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationSchedulerDeleteTask))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Scheduler_UpdateCalendar0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateCalendarRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerUpdateCalendar)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateCalendar(ctx, req.(*UpdateCalendarRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CalendarReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// ExecuteTask 立即执行任务
-func (c *SchedulerHTTPClientImpl) ExecuteTask(ctx context.Context, in *ExecuteTaskRequest, opts ...http.CallOption) (*TaskExecutionReply, error) {
-	var out TaskExecutionReply
-	pattern := "/api/v1/tasks/{id}/execute"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationSchedulerExecuteTask))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
+func _Scheduler_DeleteCalendar0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCalendarRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerDeleteCalendar)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteCalendar(ctx, req.(*DeleteCalendarRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_ListCalendars0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListCalendarsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerListCalendars)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCalendars(ctx, req.(*ListCalendarsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListCalendarsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_ImportCalendar0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImportCalendarRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerImportCalendar)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImportCalendar(ctx, req.(*ImportCalendarRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CalendarReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_CreateResourcePool0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateResourcePoolRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerCreateResourcePool)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateResourcePool(ctx, req.(*CreateResourcePoolRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResourcePoolReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_GetResourcePool0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetResourcePoolRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerGetResourcePool)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetResourcePool(ctx, req.(*GetResourcePoolRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResourcePoolReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_UpdateResourcePool0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateResourcePoolRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerUpdateResourcePool)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateResourcePool(ctx, req.(*UpdateResourcePoolRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResourcePoolReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_DeleteResourcePool0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteResourcePoolRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerDeleteResourcePool)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteResourcePool(ctx, req.(*DeleteResourcePoolRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_ListResourcePools0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListResourcePoolsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerListResourcePools)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListResourcePools(ctx, req.(*ListResourcePoolsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListResourcePoolsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_ListAuditEvents0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAuditEventsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerListAuditEvents)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAuditEventsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_WhoAmI0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in WhoAmIRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerWhoAmI)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.WhoAmI(ctx, req.(*WhoAmIRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WhoAmIReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_CreateNamespace0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateNamespaceRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerCreateNamespace)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateNamespace(ctx, req.(*CreateNamespaceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*NamespaceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_GetNamespace0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetNamespaceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerGetNamespace)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetNamespace(ctx, req.(*GetNamespaceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*NamespaceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_UpdateNamespace0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateNamespaceRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerUpdateNamespace)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateNamespace(ctx, req.(*UpdateNamespaceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*NamespaceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_DeleteNamespace0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteNamespaceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerDeleteNamespace)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteNamespace(ctx, req.(*DeleteNamespaceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_ListNamespaces0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListNamespacesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerListNamespaces)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListNamespaces(ctx, req.(*ListNamespacesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListNamespacesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_CreateSecret0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateSecretRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerCreateSecret)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateSecret(ctx, req.(*CreateSecretRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SecretReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_GetSecret0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSecretRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerGetSecret)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSecret(ctx, req.(*GetSecretRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SecretReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_UpdateSecret0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateSecretRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerUpdateSecret)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateSecret(ctx, req.(*UpdateSecretRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SecretReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_DeleteSecret0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteSecretRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerDeleteSecret)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteSecret(ctx, req.(*DeleteSecretRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_ListSecrets0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSecretsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerListSecrets)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSecrets(ctx, req.(*ListSecretsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSecretsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Scheduler_RotateSecretKeys0_HTTP_Handler(srv SchedulerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RotateSecretKeysRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSchedulerRotateSecretKeys)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RotateSecretKeys(ctx, req.(*RotateSecretKeysRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RotateSecretKeysReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type SchedulerHTTPClient interface {
+	// CancelExecution 取消执行中的任务
+	CancelExecution(ctx context.Context, req *CancelExecutionRequest, opts ...http.CallOption) (rsp *ExecutionReply, err error)
+	// CancelTask 取消任务，已取消的任务不再调度且不能恢复
+	CancelTask(ctx context.Context, req *CancelTaskRequest, opts ...http.CallOption) (rsp *TaskReply, err error)
+	// CreateCalendar 创建业务日历
+	CreateCalendar(ctx context.Context, req *CreateCalendarRequest, opts ...http.CallOption) (rsp *CalendarReply, err error)
+	// CreateNamespace 创建命名空间
+	CreateNamespace(ctx context.Context, req *CreateNamespaceRequest, opts ...http.CallOption) (rsp *NamespaceReply, err error)
+	// CreateResourcePool 创建资源池
+	CreateResourcePool(ctx context.Context, req *CreateResourcePoolRequest, opts ...http.CallOption) (rsp *ResourcePoolReply, err error)
+	// CreateSecret 创建密钥，值加密后保存，任何接口都不返回值
+	CreateSecret(ctx context.Context, req *CreateSecretRequest, opts ...http.CallOption) (rsp *SecretReply, err error)
+	// CreateTask 创建任务
+	CreateTask(ctx context.Context, req *CreateTaskRequest, opts ...http.CallOption) (rsp *TaskReply, err error)
+	// DeleteCalendar 删除业务日历
+	DeleteCalendar(ctx context.Context, req *DeleteCalendarRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DeleteNamespace 删除命名空间，命名空间中不能有任务（包括已删除的任务）或密钥
+	DeleteNamespace(ctx context.Context, req *DeleteNamespaceRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DeleteResourcePool 删除资源池
+	DeleteResourcePool(ctx context.Context, req *DeleteResourcePoolRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DeleteSecret 删除密钥，引用该密钥的任务在执行时失败
+	DeleteSecret(ctx context.Context, req *DeleteSecretRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DeleteTask 删除任务（软删除），同时取消仍在排队中的执行记录
+	DeleteTask(ctx context.Context, req *DeleteTaskRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// ExecuteTask 立即执行任务
+	ExecuteTask(ctx context.Context, req *ExecuteTaskRequest, opts ...http.CallOption) (rsp *TaskExecutionReply, err error)
+	// GetCalendar 获取业务日历详情
+	GetCalendar(ctx context.Context, req *GetCalendarRequest, opts ...http.CallOption) (rsp *CalendarReply, err error)
+	// GetExecution 获取单次执行详情
+	GetExecution(ctx context.Context, req *GetExecutionRequest, opts ...http.CallOption) (rsp *ExecutionReply, err error)
+	// GetNamespace 获取命名空间详情
+	GetNamespace(ctx context.Context, req *GetNamespaceRequest, opts ...http.CallOption) (rsp *NamespaceReply, err error)
+	// GetQueueStats 获取执行队列统计
+	GetQueueStats(ctx context.Context, req *GetQueueStatsRequest, opts ...http.CallOption) (rsp *QueueStatsReply, err error)
+	// GetResourcePool 获取资源池详情
+	GetResourcePool(ctx context.Context, req *GetResourcePoolRequest, opts ...http.CallOption) (rsp *ResourcePoolReply, err error)
+	// GetSecret 获取密钥详情（不包括值）
+	GetSecret(ctx context.Context, req *GetSecretRequest, opts ...http.CallOption) (rsp *SecretReply, err error)
+	// GetTask 获取任务详情
+	GetTask(ctx context.Context, req *GetTaskRequest, opts ...http.CallOption) (rsp *TaskReply, err error)
+	// GetTaskExecutions 获取任务执行历史
+	GetTaskExecutions(ctx context.Context, req *GetTaskExecutionsRequest, opts ...http.CallOption) (rsp *ListExecutionsReply, err error)
+	// GetTaskRevision 获取任务指定版本的修订
+	GetTaskRevision(ctx context.Context, req *GetTaskRevisionRequest, opts ...http.CallOption) (rsp *TaskRevisionReply, err error)
+	// ImportCalendar 从 iCalendar（.ics）导入业务日历
+	ImportCalendar(ctx context.Context, req *ImportCalendarRequest, opts ...http.CallOption) (rsp *CalendarReply, err error)
+	// ListAuditEvents 审计事件列表，按时间倒序
+	ListAuditEvents(ctx context.Context, req *ListAuditEventsRequest, opts ...http.CallOption) (rsp *ListAuditEventsReply, err error)
+	// ListCalendars 业务日历列表查询
+	ListCalendars(ctx context.Context, req *ListCalendarsRequest, opts ...http.CallOption) (rsp *ListCalendarsReply, err error)
+	// ListNamespaces 命名空间列表，按名称排序
+	ListNamespaces(ctx context.Context, req *ListNamespacesRequest, opts ...http.CallOption) (rsp *ListNamespacesReply, err error)
+	// ListResourcePools 资源池列表
+	ListResourcePools(ctx context.Context, req *ListResourcePoolsRequest, opts ...http.CallOption) (rsp *ListResourcePoolsReply, err error)
+	// ListSecrets 密钥列表，按命名空间和名称排序
+	ListSecrets(ctx context.Context, req *ListSecretsRequest, opts ...http.CallOption) (rsp *ListSecretsReply, err error)
+	// ListTaskRevisions 任务修订列表，按版本倒序
+	ListTaskRevisions(ctx context.Context, req *ListTaskRevisionsRequest, opts ...http.CallOption) (rsp *ListTaskRevisionsReply, err error)
+	// ListTasks 任务列表查询
+	ListTasks(ctx context.Context, req *ListTasksRequest, opts ...http.CallOption) (rsp *ListTasksReply, err error)
+	// PauseTask 暂停任务
+	PauseTask(ctx context.Context, req *PauseTaskRequest, opts ...http.CallOption) (rsp *TaskReply, err error)
+	// PreviewSchedule 预览调度配置的后续触发时间
+	PreviewSchedule(ctx context.Context, req *PreviewScheduleRequest, opts ...http.CallOption) (rsp *PreviewScheduleReply, err error)
+	// PurgeTask 彻底删除已删除的任务及其执行记录
+	PurgeTask(ctx context.Context, req *PurgeTaskRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// RestoreTask 恢复已删除的任务
+	RestoreTask(ctx context.Context, req *RestoreTaskRequest, opts ...http.CallOption) (rsp *TaskReply, err error)
+	// ResumeTask 恢复任务
+	ResumeTask(ctx context.Context, req *ResumeTaskRequest, opts ...http.CallOption) (rsp *TaskReply, err error)
+	// RollbackTask 将任务定义回滚到指定版本，回滚本身记录为新的修订
+	RollbackTask(ctx context.Context, req *RollbackTaskRequest, opts ...http.CallOption) (rsp *TaskReply, err error)
+	// RotateSecretKeys 用主用密钥重新加密其他主密钥加密的数据密钥，用于轮换主密钥
+	RotateSecretKeys(ctx context.Context, req *RotateSecretKeysRequest, opts ...http.CallOption) (rsp *RotateSecretKeysReply, err error)
+	// UpdateCalendar 更新业务日历
+	UpdateCalendar(ctx context.Context, req *UpdateCalendarRequest, opts ...http.CallOption) (rsp *CalendarReply, err error)
+	// UpdateNamespace 更新命名空间的描述和配额
+	UpdateNamespace(ctx context.Context, req *UpdateNamespaceRequest, opts ...http.CallOption) (rsp *NamespaceReply, err error)
+	// UpdateResourcePool 更新资源池
+	UpdateResourcePool(ctx context.Context, req *UpdateResourcePoolRequest, opts ...http.CallOption) (rsp *ResourcePoolReply, err error)
+	// UpdateSecret 更新密钥的描述，value 不为空时替换值
+	UpdateSecret(ctx context.Context, req *UpdateSecretRequest, opts ...http.CallOption) (rsp *SecretReply, err error)
+	// UpdateTask 更新任务
+	UpdateTask(ctx context.Context, req *UpdateTaskRequest, opts ...http.CallOption) (rsp *TaskReply, err error)
+	// WhoAmI 当前调用方及其有效权限
+	WhoAmI(ctx context.Context, req *WhoAmIRequest, opts ...http.CallOption) (rsp *WhoAmIReply, err error)
+}
+
+type SchedulerHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewSchedulerHTTPClient(client *http.Client) SchedulerHTTPClient {
+	return &SchedulerHTTPClientImpl{client}
+}
+
+// CancelExecution 取消执行中的任务
+func (c *SchedulerHTTPClientImpl) CancelExecution(ctx context.Context, in *CancelExecutionRequest, opts ...http.CallOption) (*ExecutionReply, error) {
+	var out ExecutionReply
+	pattern := "/api/v1/executions/{id}/cancel"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSchedulerCancelExecution))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CancelTask 取消任务，已取消的任务不再调度且不能恢复
+func (c *SchedulerHTTPClientImpl) CancelTask(ctx context.Context, in *CancelTaskRequest, opts ...http.CallOption) (*TaskReply, error) {
+	var out TaskReply
+	pattern := "/api/v1/tasks/{id}/cancel"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSchedulerCancelTask))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateCalendar 创建业务日历
+func (c *SchedulerHTTPClientImpl) CreateCalendar(ctx context.Context, in *CreateCalendarRequest, opts ...http.CallOption) (*CalendarReply, error) {
+	var out CalendarReply
+	pattern := "/api/v1/calendars"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSchedulerCreateCalendar))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateNamespace 创建命名空间
+func (c *SchedulerHTTPClientImpl) CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...http.CallOption) (*NamespaceReply, error) {
+	var out NamespaceReply
+	pattern := "/api/v1/namespaces"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSchedulerCreateNamespace))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateResourcePool 创建资源池
+func (c *SchedulerHTTPClientImpl) CreateResourcePool(ctx context.Context, in *CreateResourcePoolRequest, opts ...http.CallOption) (*ResourcePoolReply, error) {
+	var out ResourcePoolReply
+	pattern := "/api/v1/pools"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSchedulerCreateResourcePool))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateSecret 创建密钥，值加密后保存，任何接口都不返回值
+func (c *SchedulerHTTPClientImpl) CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...http.CallOption) (*SecretReply, error) {
+	var out SecretReply
+	pattern := "/api/v1/secrets"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSchedulerCreateSecret))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateTask 创建任务
+func (c *SchedulerHTTPClientImpl) CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...http.CallOption) (*TaskReply, error) {
+	var out TaskReply
+	pattern := "/api/v1/tasks"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSchedulerCreateTask))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteCalendar 删除业务日历
+func (c *SchedulerHTTPClientImpl) DeleteCalendar(ctx context.Context, in *DeleteCalendarRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/calendars/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSchedulerDeleteCalendar))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteNamespace 删除命名空间，命名空间中不能有任务（包括已删除的任务）或密钥
+func (c *SchedulerHTTPClientImpl) DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/namespaces/{name}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSchedulerDeleteNamespace))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteResourcePool 删除资源池
+func (c *SchedulerHTTPClientImpl) DeleteResourcePool(ctx context.Context, in *DeleteResourcePoolRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/pools/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSchedulerDeleteResourcePool))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteSecret 删除密钥，引用该密钥的任务在执行时失败
+func (c *SchedulerHTTPClientImpl) DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/secrets/{name}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSchedulerDeleteSecret))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteTask 删除任务（软删除），同时取消仍在排队中的执行记录
+func (c *SchedulerHTTPClientImpl) DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/tasks/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSchedulerDeleteTask))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExecuteTask 立即执行任务
+func (c *SchedulerHTTPClientImpl) ExecuteTask(ctx context.Context, in *ExecuteTaskRequest, opts ...http.CallOption) (*TaskExecutionReply, error) {
+	var out TaskExecutionReply
+	pattern := "/api/v1/tasks/{id}/execute"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSchedulerExecuteTask))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCalendar 获取业务日历详情
+func (c *SchedulerHTTPClientImpl) GetCalendar(ctx context.Context, in *GetCalendarRequest, opts ...http.CallOption) (*CalendarReply, error) {
+	var out CalendarReply
+	pattern := "/api/v1/calendars/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSchedulerGetCalendar))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
 		return nil, err
 	}
 	return &out, nil
@@ -429,6 +1465,62 @@ func (c *SchedulerHTTPClientImpl) GetExecution(ctx context.Context, in *GetExecu
 	return &out, nil
 }
 
+// GetNamespace 获取命名空间详情
+func (c *SchedulerHTTPClientImpl) GetNamespace(ctx context.Context, in *GetNamespaceRequest, opts ...http.CallOption) (*NamespaceReply, error) {
+	var out NamespaceReply
+	pattern := "/api/v1/namespaces/{name}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSchedulerGetNamespace))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetQueueStats 获取执行队列统计
+func (c *SchedulerHTTPClientImpl) GetQueueStats(ctx context.Context, in *GetQueueStatsRequest, opts ...http.CallOption) (*QueueStatsReply, error) {
+	var out QueueStatsReply
+	pattern := "/api/v1/queue/stats"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSchedulerGetQueueStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetResourcePool 获取资源池详情
+func (c *SchedulerHTTPClientImpl) GetResourcePool(ctx context.Context, in *GetResourcePoolRequest, opts ...http.CallOption) (*ResourcePoolReply, error) {
+	var out ResourcePoolReply
+	pattern := "/api/v1/pools/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSchedulerGetResourcePool))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSecret 获取密钥详情（不包括值）
+func (c *SchedulerHTTPClientImpl) GetSecret(ctx context.Context, in *GetSecretRequest, opts ...http.CallOption) (*SecretReply, error) {
+	var out SecretReply
+	pattern := "/api/v1/secrets/{name}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSchedulerGetSecret))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetTask 获取任务详情
 func (c *SchedulerHTTPClientImpl) GetTask(ctx context.Context, in *GetTaskRequest, opts ...http.CallOption) (*TaskReply, error) {
 	var out TaskReply
@@ -457,6 +1549,118 @@ func (c *SchedulerHTTPClientImpl) GetTaskExecutions(ctx context.Context, in *Get
 	return &out, nil
 }
 
+// GetTaskRevision 获取任务指定版本的修订
+func (c *SchedulerHTTPClientImpl) GetTaskRevision(ctx context.Context, in *GetTaskRevisionRequest, opts ...http.CallOption) (*TaskRevisionReply, error) {
+	var out TaskRevisionReply
+	pattern := "/api/v1/tasks/{task_id}/revisions/{version}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSchedulerGetTaskRevision))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ImportCalendar 从 iCalendar（.ics）导入业务日历
+func (c *SchedulerHTTPClientImpl) ImportCalendar(ctx context.Context, in *ImportCalendarRequest, opts ...http.CallOption) (*CalendarReply, error) {
+	var out CalendarReply
+	pattern := "/api/v1/calendars/import"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSchedulerImportCalendar))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListAuditEvents 审计事件列表，按时间倒序
+func (c *SchedulerHTTPClientImpl) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...http.CallOption) (*ListAuditEventsReply, error) {
+	var out ListAuditEventsReply
+	pattern := "/api/v1/audit-events"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSchedulerListAuditEvents))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListCalendars 业务日历列表查询
+func (c *SchedulerHTTPClientImpl) ListCalendars(ctx context.Context, in *ListCalendarsRequest, opts ...http.CallOption) (*ListCalendarsReply, error) {
+	var out ListCalendarsReply
+	pattern := "/api/v1/calendars"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSchedulerListCalendars))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListNamespaces 命名空间列表，按名称排序
+func (c *SchedulerHTTPClientImpl) ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...http.CallOption) (*ListNamespacesReply, error) {
+	var out ListNamespacesReply
+	pattern := "/api/v1/namespaces"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSchedulerListNamespaces))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListResourcePools 资源池列表
+func (c *SchedulerHTTPClientImpl) ListResourcePools(ctx context.Context, in *ListResourcePoolsRequest, opts ...http.CallOption) (*ListResourcePoolsReply, error) {
+	var out ListResourcePoolsReply
+	pattern := "/api/v1/pools"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSchedulerListResourcePools))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListSecrets 密钥列表，按命名空间和名称排序
+func (c *SchedulerHTTPClientImpl) ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...http.CallOption) (*ListSecretsReply, error) {
+	var out ListSecretsReply
+	pattern := "/api/v1/secrets"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSchedulerListSecrets))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTaskRevisions 任务修订列表，按版本倒序
+func (c *SchedulerHTTPClientImpl) ListTaskRevisions(ctx context.Context, in *ListTaskRevisionsRequest, opts ...http.CallOption) (*ListTaskRevisionsReply, error) {
+	var out ListTaskRevisionsReply
+	pattern := "/api/v1/tasks/{task_id}/revisions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSchedulerListTaskRevisions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListTasks 任务列表查询
 func (c *SchedulerHTTPClientImpl) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...http.CallOption) (*ListTasksReply, error) {
 	var out ListTasksReply
@@ -485,6 +1689,48 @@ func (c *SchedulerHTTPClientImpl) PauseTask(ctx context.Context, in *PauseTaskRe
 	return &out, nil
 }
 
+// PreviewSchedule 预览调度配置的后续触发时间
+func (c *SchedulerHTTPClientImpl) PreviewSchedule(ctx context.Context, in *PreviewScheduleRequest, opts ...http.CallOption) (*PreviewScheduleReply, error) {
+	var out PreviewScheduleReply
+	pattern := "/api/v1/schedules/preview"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSchedulerPreviewSchedule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PurgeTask 彻底删除已删除的任务及其执行记录
+func (c *SchedulerHTTPClientImpl) PurgeTask(ctx context.Context, in *PurgeTaskRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/v1/tasks/{id}/purge"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSchedulerPurgeTask))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RestoreTask 恢复已删除的任务
+func (c *SchedulerHTTPClientImpl) RestoreTask(ctx context.Context, in *RestoreTaskRequest, opts ...http.CallOption) (*TaskReply, error) {
+	var out TaskReply
+	pattern := "/api/v1/tasks/{id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSchedulerRestoreTask))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ResumeTask 恢复任务
 func (c *SchedulerHTTPClientImpl) ResumeTask(ctx context.Context, in *ResumeTaskRequest, opts ...http.CallOption) (*TaskReply, error) {
 	var out TaskReply
@@ -499,6 +1745,90 @@ func (c *SchedulerHTTPClientImpl) ResumeTask(ctx context.Context, in *ResumeTask
 	return &out, nil
 }
 
+// RollbackTask 将任务定义回滚到指定版本，回滚本身记录为新的修订
+func (c *SchedulerHTTPClientImpl) RollbackTask(ctx context.Context, in *RollbackTaskRequest, opts ...http.CallOption) (*TaskReply, error) {
+	var out TaskReply
+	pattern := "/api/v1/tasks/{id}/rollback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSchedulerRollbackTask))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RotateSecretKeys 用主用密钥重新加密其他主密钥加密的数据密钥，用于轮换主密钥
+func (c *SchedulerHTTPClientImpl) RotateSecretKeys(ctx context.Context, in *RotateSecretKeysRequest, opts ...http.CallOption) (*RotateSecretKeysReply, error) {
+	var out RotateSecretKeysReply
+	pattern := "/api/v1/secrets/rotate-keys"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSchedulerRotateSecretKeys))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateCalendar 更新业务日历
+func (c *SchedulerHTTPClientImpl) UpdateCalendar(ctx context.Context, in *UpdateCalendarRequest, opts ...http.CallOption) (*CalendarReply, error) {
+	var out CalendarReply
+	pattern := "/api/v1/calendars/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSchedulerUpdateCalendar))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateNamespace 更新命名空间的描述和配额
+func (c *SchedulerHTTPClientImpl) UpdateNamespace(ctx context.Context, in *UpdateNamespaceRequest, opts ...http.CallOption) (*NamespaceReply, error) {
+	var out NamespaceReply
+	pattern := "/api/v1/namespaces/{name}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSchedulerUpdateNamespace))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateResourcePool 更新资源池
+func (c *SchedulerHTTPClientImpl) UpdateResourcePool(ctx context.Context, in *UpdateResourcePoolRequest, opts ...http.CallOption) (*ResourcePoolReply, error) {
+	var out ResourcePoolReply
+	pattern := "/api/v1/pools/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSchedulerUpdateResourcePool))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateSecret 更新密钥的描述，value 不为空时替换值
+func (c *SchedulerHTTPClientImpl) UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...http.CallOption) (*SecretReply, error) {
+	var out SecretReply
+	pattern := "/api/v1/secrets/{name}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSchedulerUpdateSecret))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateTask 更新任务
 func (c *SchedulerHTTPClientImpl) UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...http.CallOption) (*TaskReply, error) {
 	var out TaskReply
@@ -512,3 +1842,17 @@ func (c *SchedulerHTTPClientImpl) UpdateTask(ctx context.Context, in *UpdateTask
 	}
 	return &out, nil
 }
+
+// WhoAmI 当前调用方及其有效权限
+func (c *SchedulerHTTPClientImpl) WhoAmI(ctx context.Context, in *WhoAmIRequest, opts ...http.CallOption) (*WhoAmIReply, error) {
+	var out WhoAmIReply
+	pattern := "/api/v1/whoami"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSchedulerWhoAmI))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -2,9 +2,11 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
 
 	"heytom-scheduler/internal/conf"
+	"heytom-scheduler/internal/server"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -31,9 +33,13 @@ var (
 
 func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: heytom-scheduler [flags] [migrate <command>]\n")
+		flag.PrintDefaults()
+	}
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, ws *server.WorkerServer) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -43,6 +49,7 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			ws,
 		),
 	)
 }
@@ -74,7 +81,16 @@ func main() {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, logger)
+	// migrate 子命令只执行表结构迁移，不启动服务
+	if flag.Arg(0) == "migrate" {
+		if err := runMigrate(bc.Data, flag.Args()[1:], logger); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Scheduler, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"heytom-scheduler/internal/conf"
+	"heytom-scheduler/internal/data"
+	"heytom-scheduler/internal/data/migrate"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const migrateUsage = `usage: heytom-scheduler -conf <path> migrate <command>
+
+commands:
+  up        apply all pending migrations
+  down [n]  revert the last n applied migrations (default 1)
+  status    show applied and pending migrations`
+
+// runMigrate 执行 migrate 子命令
+func runMigrate(c *conf.Data, args []string, logger log.Logger) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing migrate command\n%s", migrateUsage)
+	}
+
+	db, err := data.NewDB(c)
+	if err != nil {
+		return err
+	}
+	if sqlDB, _ := db.DB(); sqlDB != nil {
+		defer sqlDB.Close()
+	}
+	m, err := migrate.New(db, logger)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		n, err := m.Up(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("applied %d migration(s), schema version %d\n", n, m.Latest())
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of migrations %q", args[1])
+			}
+		}
+		n, err := m.Down(ctx, steps)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("reverted %d migration(s)\n", n)
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+		for _, s := range statuses {
+			state, appliedAt := "pending", ""
+			if s.Applied {
+				state, appliedAt = "applied", s.AppliedAt.Format(time.RFC3339)
+			}
+			if s.Progress > 0 {
+				state = fmt.Sprintf("partial (%d statements applied)", s.Progress)
+			}
+			if s.Modified {
+				state = "modified"
+			}
+			if s.Missing {
+				state = "unknown"
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", s.Version, s.Name, state, appliedAt)
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown migrate command %q\n%s", args[0], migrateUsage)
+	}
+	return nil
+}
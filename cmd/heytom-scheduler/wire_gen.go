@@ -23,20 +23,77 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, scheduler *conf.Scheduler, logger log.Logger) (*kratos.App, func(), error) {
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
 	}
 	taskRepo := data.NewTaskRepo(dataData, logger)
-	taskUsecase := biz.NewTaskUsecase(taskRepo, logger)
 	executionRepo := data.NewExecutionRepo(dataData, logger)
-	executionUsecase := biz.NewExecutionUsecase(executionRepo, logger)
-	schedulerService := service.NewSchedulerService(taskUsecase, executionUsecase, logger)
-	grpcServer := server.NewGRPCServer(confServer, schedulerService, logger)
-	httpServer := server.NewHTTPServer(confServer, schedulerService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	taskRevisionRepo := data.NewTaskRevisionRepo(dataData, logger)
+	calendarRepo := data.NewCalendarRepo(dataData, logger)
+	executionQueue, err := data.NewExecutionQueue(confData, dataData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	namespaceRepo := data.NewNamespaceRepo(dataData, logger)
+	handlerRegistry, err := server.NewHandlerRegistry(scheduler, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	taskUsecase := biz.NewTaskUsecase(taskRepo, executionRepo, taskRevisionRepo, calendarRepo, namespaceRepo, executionQueue, handlerRegistry, logger)
+	executionUsecase := biz.NewExecutionUsecase(executionRepo, taskRepo, calendarRepo, logger)
+	calendarUsecase := biz.NewCalendarUsecase(calendarRepo, taskRepo, logger)
+	resourcePoolRepo := data.NewResourcePoolRepo(dataData, logger)
+	resourcePoolUsecase := biz.NewResourcePoolUsecase(resourcePoolRepo, logger)
+	auditRepo := data.NewAuditRepo(dataData, logger)
+	v, cleanup2, err := data.NewAuditSinks(confServer, auditRepo, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	auditUsecase := biz.NewAuditUsecase(auditRepo, v, logger)
+	v2, err := server.NewRoleBindings(confServer)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	authorizer := biz.NewAuthorizer(v2, logger)
+	secretRepo := data.NewSecretRepo(dataData, logger)
+	namespaceUsecase := biz.NewNamespaceUsecase(namespaceRepo, taskRepo, secretRepo, logger)
+	keyring, err := data.NewKeyring(confData)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	secretUsecase := biz.NewSecretUsecase(secretRepo, namespaceRepo, keyring, logger)
+	schedulerService := service.NewSchedulerService(taskUsecase, executionUsecase, calendarUsecase, resourcePoolUsecase, auditUsecase, namespaceUsecase, secretUsecase, authorizer, logger)
+	authenticator, err := server.NewAuthenticator(confServer)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	grpcServer := server.NewGRPCServer(confServer, schedulerService, auditUsecase, authenticator, logger)
+	httpServer := server.NewHTTPServer(confServer, schedulerService, auditUsecase, authenticator, logger)
+	dispatcher := biz.NewDispatcher(taskRepo, executionRepo, calendarRepo, executionQueue, logger)
+	lockRepo := data.NewLockRepo(dataData, logger)
+	executor := biz.NewExecutor(taskRepo, executionRepo, calendarRepo, resourcePoolRepo, namespaceRepo, secretRepo, lockRepo, executionQueue, handlerRegistry, keyring, logger)
+	executionArchiver, err := data.NewExecutionArchiver(scheduler, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	retentionJanitor := biz.NewRetentionJanitor(taskRepo, executionRepo, lockRepo, executionArchiver, logger)
+	workerServer := server.NewWorkerServer(scheduler, dispatcher, executor, retentionJanitor, logger)
+	app := newApp(logger, grpcServer, httpServer, workerServer)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,89 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// AuditEvent 审计事件，记录一次修改类接口调用
+type AuditEvent struct {
+	ID        int64
+	Time      time.Time
+	Actor     string   // 操作者
+	Operation string   // 接口名称，如 DeleteTask
+	TargetID  int64    // 操作对象ID，创建类接口为新对象的ID
+	Targets   []string // 请求中的所有ID字段，格式为 field=value
+	Request   string   // 请求摘要（JSON，敏感字段已脱敏，超长时截断）
+	Result    pb.AuditResult
+	Code      int32  // 失败时的 HTTP 状态码
+	Reason    string // 失败时的错误原因
+	Message   string // 失败时的错误信息
+	ClientIP  string
+	Transport string // http 或 grpc
+	Duration  int32  // 耗时（毫秒）
+}
+
+// AuditEventListFilter 审计事件列表过滤条件，按时间倒序返回
+type AuditEventListFilter struct {
+	Page      int32
+	PageSize  int32
+	Actor     string
+	Operation string
+	TargetID  int64
+	Result    pb.AuditResult
+	StartTime *time.Time // 包含
+	EndTime   *time.Time // 不包含
+}
+
+// AuditSink 审计事件的写入位置
+type AuditSink interface {
+	// WriteAuditEvent 写入审计事件
+	WriteAuditEvent(ctx context.Context, event *AuditEvent) error
+}
+
+// AuditRepo 审计事件仓储接口，同时作为数据库写入位置
+type AuditRepo interface {
+	AuditSink
+
+	// ListAuditEvents 审计事件列表查询
+	ListAuditEvents(ctx context.Context, filter *AuditEventListFilter) ([]*AuditEvent, int64, error)
+}
+
+// AuditUsecase 审计日志用例
+type AuditUsecase struct {
+	repo  AuditRepo
+	sinks []AuditSink
+	log   *log.Helper
+}
+
+// NewAuditUsecase 创建审计日志用例实例，sinks 为空时不记录审计事件
+func NewAuditUsecase(repo AuditRepo, sinks []AuditSink, logger log.Logger) *AuditUsecase {
+	return &AuditUsecase{
+		repo:  repo,
+		sinks: sinks,
+		log:   log.NewHelper(logger),
+	}
+}
+
+// Enabled 是否记录审计事件
+func (uc *AuditUsecase) Enabled() bool {
+	return len(uc.sinks) > 0
+}
+
+// Record 将审计事件写入所有写入位置，写入失败只记录日志，不影响接口调用结果
+func (uc *AuditUsecase) Record(ctx context.Context, event *AuditEvent) {
+	for _, sink := range uc.sinks {
+		if err := sink.WriteAuditEvent(ctx, event); err != nil {
+			uc.log.WithContext(ctx).Errorf("write audit event %s by %q: %v", event.Operation, event.Actor, err)
+		}
+	}
+}
+
+// ListAuditEvents 审计事件列表查询
+func (uc *AuditUsecase) ListAuditEvents(ctx context.Context, filter *AuditEventListFilter) ([]*AuditEvent, int64, error) {
+	return uc.repo.ListAuditEvents(ctx, filter)
+}
@@ -0,0 +1,35 @@
+package biz
+
+import "context"
+
+// 认证方式
+const (
+	AuthMethodAPIKey = "api_key"
+	AuthMethodJWT    = "jwt"
+	AuthMethodMTLS   = "mtls"
+)
+
+// Principal 已认证的调用方
+type Principal struct {
+	Name   string // 主体名称：API Key 名称、JWT 主体或客户端证书的 Common Name
+	Method string // 认证方式
+}
+
+// String 返回 "<认证方式>:<名称>" 形式的主体，与角色绑定中的主体比较，不同认证方式的同名调用方互不冒用
+func (p *Principal) String() string {
+	return p.Method + ":" + p.Name
+}
+
+type principalKey struct{}
+
+// NewPrincipalContext 返回携带已认证调用方的上下文，调用方同时作为操作者
+func NewPrincipalContext(ctx context.Context, principal *Principal) context.Context {
+	ctx = context.WithValue(ctx, principalKey{}, principal)
+	return NewAuthorContext(ctx, principal.Name)
+}
+
+// PrincipalFromContext 返回上下文中已认证的调用方，未启用认证时返回 nil
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(principalKey{}).(*Principal)
+	return principal
+}
@@ -3,4 +3,4 @@ package biz
 import "github.com/google/wire"
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewTaskUsecase, NewExecutionUsecase)
+var ProviderSet = wire.NewSet(NewTaskUsecase, NewExecutionUsecase, NewCalendarUsecase, NewResourcePoolUsecase, NewDispatcher, NewExecutor, NewRetentionJanitor, NewAuditUsecase, NewAuthorizer, NewNamespaceUsecase, NewSecretUsecase)
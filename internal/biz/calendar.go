@@ -0,0 +1,210 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// calendarDateLayout 日历日期格式
+const calendarDateLayout = "2006-01-02"
+
+// maxCalendarSkipDays 跳过排除日时最多向后查找的天数
+const maxCalendarSkipDays = 3660
+
+var (
+	// ErrCalendarNotFound 日历不存在
+	ErrCalendarNotFound = errors.NotFound(pb.ErrorReason_CALENDAR_NOT_FOUND.String(), "calendar not found")
+)
+
+// Calendar 业务日历
+type Calendar struct {
+	ID          int64
+	Name        string
+	Description string
+	Timezone    string
+	Rules       []*CalendarRule
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// CalendarRule 日历规则
+//
+// 未设置 Weekdays 时匹配 StartDate 至 EndDate 的日期（EndDate 为空表示单日）；
+// 设置 Weekdays 时按周匹配，StartDate/EndDate 可选地限定生效区间。
+type CalendarRule struct {
+	Action      pb.CalendarRuleAction
+	StartDate   string
+	EndDate     string
+	Weekdays    []time.Weekday
+	Description string
+}
+
+// CalendarListFilter 日历列表过滤条件
+type CalendarListFilter struct {
+	Page     int32
+	PageSize int32
+	Keyword  string
+}
+
+// CalendarRepo 日历仓储接口
+type CalendarRepo interface {
+	// CreateCalendar 创建日历
+	CreateCalendar(ctx context.Context, calendar *Calendar) (*Calendar, error)
+
+	// GetCalendar 获取日历详情
+	GetCalendar(ctx context.Context, id int64) (*Calendar, error)
+
+	// UpdateCalendar 更新日历
+	UpdateCalendar(ctx context.Context, calendar *Calendar) (*Calendar, error)
+
+	// DeleteCalendar 删除日历
+	DeleteCalendar(ctx context.Context, id int64) error
+
+	// ListCalendars 日历列表查询
+	ListCalendars(ctx context.Context, filter *CalendarListFilter) ([]*Calendar, int64, error)
+}
+
+// Validate 校验规则格式
+func (r *CalendarRule) Validate() error {
+	switch r.Action {
+	case pb.CalendarRuleAction_EXCLUDE, pb.CalendarRuleAction_INCLUDE:
+	default:
+		return fmt.Errorf("invalid rule action %s", r.Action)
+	}
+
+	var start, end time.Time
+	var err error
+	if r.StartDate != "" {
+		if start, err = time.Parse(calendarDateLayout, r.StartDate); err != nil {
+			return fmt.Errorf("invalid start date %q: expected YYYY-MM-DD", r.StartDate)
+		}
+	}
+	if r.EndDate != "" {
+		if end, err = time.Parse(calendarDateLayout, r.EndDate); err != nil {
+			return fmt.Errorf("invalid end date %q: expected YYYY-MM-DD", r.EndDate)
+		}
+	}
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		return fmt.Errorf("end date %s is before start date %s", r.EndDate, r.StartDate)
+	}
+	if len(r.Weekdays) == 0 && r.StartDate == "" {
+		return fmt.Errorf("rule must specify a start date or weekdays")
+	}
+	for _, wd := range r.Weekdays {
+		if wd < time.Sunday || wd > time.Saturday {
+			return fmt.Errorf("invalid weekday %d", wd)
+		}
+	}
+	return nil
+}
+
+// Matches 判断日期（YYYY-MM-DD）是否命中规则
+func (r *CalendarRule) Matches(date string, weekday time.Weekday) bool {
+	if len(r.Weekdays) == 0 {
+		if r.EndDate == "" {
+			return date == r.StartDate
+		}
+		return date >= r.StartDate && date <= r.EndDate
+	}
+
+	if r.StartDate != "" && date < r.StartDate {
+		return false
+	}
+	if r.EndDate != "" && date > r.EndDate {
+		return false
+	}
+	for _, wd := range r.Weekdays {
+		if wd == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate 校验日历配置
+func (c *Calendar) Validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("calendar name is required")
+	}
+	if _, err := c.location(); err != nil {
+		return err
+	}
+	for i, rule := range c.Rules {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("rule %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// location 日历时区，未设置时返回 nil
+func (c *Calendar) location() (*time.Location, error) {
+	if c.Timezone == "" {
+		return nil, nil
+	}
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %v", c.Timezone, err)
+	}
+	return loc, nil
+}
+
+// IsExcluded 判断时间点所在日期是否被排除，INCLUDE 规则优先于 EXCLUDE 规则
+func (c *Calendar) IsExcluded(t time.Time) bool {
+	if loc, err := c.location(); err == nil && loc != nil {
+		t = t.In(loc)
+	}
+	date := t.Format(calendarDateLayout)
+	weekday := t.Weekday()
+
+	excluded := false
+	for _, rule := range c.Rules {
+		if !rule.Matches(date, weekday) {
+			continue
+		}
+		if rule.Action == pb.CalendarRuleAction_INCLUDE {
+			return false
+		}
+		excluded = true
+	}
+	return excluded
+}
+
+// calendarSchedule 跳过日历排除日的调度计划
+type calendarSchedule struct {
+	schedule Schedule
+	calendar *Calendar
+}
+
+// WithCalendar 为调度计划附加日历，calendar 为空时原样返回
+func WithCalendar(schedule Schedule, calendar *Calendar) Schedule {
+	if calendar == nil {
+		return schedule
+	}
+	return calendarSchedule{schedule: schedule, calendar: calendar}
+}
+
+// Next 实现 Schedule 接口，落在排除日的触发时间顺延到下一个非排除日
+func (s calendarSchedule) Next(after time.Time) time.Time {
+	loc, _ := s.calendar.location()
+	if loc == nil {
+		loc = after.Location()
+	}
+
+	t := s.schedule.Next(after)
+	for skipped := 0; !t.IsZero() && s.calendar.IsExcluded(t); skipped++ {
+		if skipped >= maxCalendarSkipDays {
+			return time.Time{}
+		}
+		// 直接跳到排除日的末尾，避免逐个触发点遍历
+		local := t.In(loc)
+		endOfDay := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1).Add(-time.Nanosecond)
+		t = s.schedule.Next(endOfDay)
+	}
+	return t
+}
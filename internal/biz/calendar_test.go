@@ -0,0 +1,171 @@
+package biz
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+)
+
+func TestCalendarRuleValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    CalendarRule
+		wantErr string
+	}{
+		{name: "single date", rule: CalendarRule{Action: pb.CalendarRuleAction_EXCLUDE, StartDate: "2024-01-01"}},
+		{name: "date range", rule: CalendarRule{Action: pb.CalendarRuleAction_EXCLUDE, StartDate: "2024-01-01", EndDate: "2024-01-03"}},
+		{name: "weekdays", rule: CalendarRule{Action: pb.CalendarRuleAction_EXCLUDE, Weekdays: []time.Weekday{time.Saturday, time.Sunday}}},
+		{name: "no action", rule: CalendarRule{StartDate: "2024-01-01"}, wantErr: "invalid rule action"},
+		{name: "bad start", rule: CalendarRule{Action: pb.CalendarRuleAction_EXCLUDE, StartDate: "2024/01/01"}, wantErr: "invalid start date"},
+		{name: "bad end", rule: CalendarRule{Action: pb.CalendarRuleAction_EXCLUDE, StartDate: "2024-01-01", EndDate: "tomorrow"}, wantErr: "invalid end date"},
+		{name: "end before start", rule: CalendarRule{Action: pb.CalendarRuleAction_EXCLUDE, StartDate: "2024-01-03", EndDate: "2024-01-01"}, wantErr: "before start date"},
+		{name: "empty", rule: CalendarRule{Action: pb.CalendarRuleAction_EXCLUDE}, wantErr: "start date or weekdays"},
+		{name: "bad weekday", rule: CalendarRule{Action: pb.CalendarRuleAction_EXCLUDE, Weekdays: []time.Weekday{7}}, wantErr: "invalid weekday"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCalendarRuleMatches(t *testing.T) {
+	single := &CalendarRule{StartDate: "2024-01-01"}
+	rng := &CalendarRule{StartDate: "2024-01-01", EndDate: "2024-01-03"}
+	weekend := &CalendarRule{Weekdays: []time.Weekday{time.Saturday, time.Sunday}}
+	boundedWeekend := &CalendarRule{StartDate: "2024-01-06", EndDate: "2024-01-07", Weekdays: []time.Weekday{time.Saturday, time.Sunday}}
+
+	tests := []struct {
+		name string
+		rule *CalendarRule
+		date string
+		want bool
+	}{
+		{"single hit", single, "2024-01-01", true},
+		{"single miss", single, "2024-01-02", false},
+		{"range start", rng, "2024-01-01", true},
+		{"range end", rng, "2024-01-03", true},
+		{"range after", rng, "2024-01-04", false},
+		{"weekend saturday", weekend, "2024-01-06", true},
+		{"weekend monday", weekend, "2024-01-08", false},
+		{"bounded weekend inside", boundedWeekend, "2024-01-07", true},
+		{"bounded weekend before", boundedWeekend, "2023-12-31", false},
+		{"bounded weekend after", boundedWeekend, "2024-01-13", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			day, err := time.Parse(calendarDateLayout, tt.date)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := tt.rule.Matches(tt.date, day.Weekday()); got != tt.want {
+				t.Errorf("Matches(%s) = %v, want %v", tt.date, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalendarValidate(t *testing.T) {
+	if err := (&Calendar{Timezone: "UTC"}).Validate(); err == nil {
+		t.Errorf("expected error for calendar without name")
+	}
+	if err := (&Calendar{Name: "cn", Timezone: "Mars/Olympus"}).Validate(); err == nil || !strings.Contains(err.Error(), "invalid timezone") {
+		t.Errorf("err = %v, want invalid timezone", err)
+	}
+	bad := &Calendar{Name: "cn", Rules: []*CalendarRule{{Action: pb.CalendarRuleAction_EXCLUDE, StartDate: "x"}}}
+	if err := bad.Validate(); err == nil || !strings.Contains(err.Error(), "rule 0") {
+		t.Errorf("err = %v, want rule 0 error", err)
+	}
+}
+
+// workCalendar 排除周末和 2024-01-01，2024-01-06（周六）调休上班
+func workCalendar(timezone string) *Calendar {
+	return &Calendar{
+		Name:     "work",
+		Timezone: timezone,
+		Rules: []*CalendarRule{
+			{Action: pb.CalendarRuleAction_EXCLUDE, Weekdays: []time.Weekday{time.Saturday, time.Sunday}},
+			{Action: pb.CalendarRuleAction_EXCLUDE, StartDate: "2024-01-01"},
+			{Action: pb.CalendarRuleAction_INCLUDE, StartDate: "2024-01-06"},
+		},
+	}
+}
+
+func TestCalendarIsExcluded(t *testing.T) {
+	calendar := workCalendar("")
+	tests := []struct {
+		at   time.Time
+		want bool
+	}{
+		{time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2024, 1, 6, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2024, 1, 7, 12, 0, 0, 0, time.UTC), true},
+	}
+	for _, tt := range tests {
+		if got := calendar.IsExcluded(tt.at); got != tt.want {
+			t.Errorf("IsExcluded(%v) = %v, want %v", tt.at, got, tt.want)
+		}
+	}
+
+	// 2024-01-01 18:00 UTC 在上海已是 1 月 2 日
+	evening := time.Date(2024, 1, 1, 18, 0, 0, 0, time.UTC)
+	if !workCalendar("").IsExcluded(evening) {
+		t.Errorf("evening of 2024-01-01 UTC should be excluded without timezone")
+	}
+	if workCalendar("Asia/Shanghai").IsExcluded(evening) {
+		t.Errorf("evening of 2024-01-01 UTC is 2024-01-02 in Shanghai and should not be excluded")
+	}
+}
+
+func TestWithCalendar(t *testing.T) {
+	daily := mustSchedule(t, pb.TaskType_CRON, "0 9 * * *", time.UTC)
+	if WithCalendar(daily, nil) != daily {
+		t.Errorf("WithCalendar with nil calendar changed the schedule")
+	}
+
+	schedule := WithCalendar(daily, workCalendar("UTC"))
+	from := time.Date(2023, 12, 29, 10, 0, 0, 0, time.UTC)
+	want := []time.Time{
+		time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 4, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 5, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 6, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC),
+	}
+	got := NextRunTimes(schedule, from, len(want))
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("time %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestWithCalendarGivesUpWhenEverythingExcluded(t *testing.T) {
+	calendar := &Calendar{
+		Name: "closed",
+		Rules: []*CalendarRule{{
+			Action:   pb.CalendarRuleAction_EXCLUDE,
+			Weekdays: []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday},
+		}},
+	}
+	schedule := WithCalendar(mustSchedule(t, pb.TaskType_CRON, "@daily", time.UTC), calendar)
+	if next := schedule.Next(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)); !next.IsZero() {
+		t.Errorf("Next = %v, want zero", next)
+	}
+}
@@ -0,0 +1,113 @@
+package biz
+
+import (
+	"context"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// CalendarUsecase 业务日历用例
+type CalendarUsecase struct {
+	repo     CalendarRepo
+	taskRepo TaskRepo
+	log      *log.Helper
+}
+
+// NewCalendarUsecase 创建业务日历用例实例
+func NewCalendarUsecase(repo CalendarRepo, taskRepo TaskRepo, logger log.Logger) *CalendarUsecase {
+	return &CalendarUsecase{
+		repo:     repo,
+		taskRepo: taskRepo,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// CreateCalendar 创建日历
+func (uc *CalendarUsecase) CreateCalendar(ctx context.Context, calendar *Calendar) (*Calendar, error) {
+	uc.log.WithContext(ctx).Infof("CreateCalendar: %s", calendar.Name)
+
+	if err := calendar.Validate(); err != nil {
+		return nil, errors.BadRequest(pb.ErrorReason_INVALID_CALENDAR.String(), err.Error())
+	}
+	return uc.repo.CreateCalendar(ctx, calendar)
+}
+
+// GetCalendar 获取日历详情
+func (uc *CalendarUsecase) GetCalendar(ctx context.Context, id int64) (*Calendar, error) {
+	calendar, err := uc.repo.GetCalendar(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if calendar == nil {
+		return nil, ErrCalendarNotFound
+	}
+	return calendar, nil
+}
+
+// UpdateCalendar 更新日历，整体替换名称、描述、时区和规则
+func (uc *CalendarUsecase) UpdateCalendar(ctx context.Context, calendar *Calendar) (*Calendar, error) {
+	uc.log.WithContext(ctx).Infof("UpdateCalendar: %d", calendar.ID)
+
+	if _, err := uc.GetCalendar(ctx, calendar.ID); err != nil {
+		return nil, err
+	}
+	if err := calendar.Validate(); err != nil {
+		return nil, errors.BadRequest(pb.ErrorReason_INVALID_CALENDAR.String(), err.Error())
+	}
+	return uc.repo.UpdateCalendar(ctx, calendar)
+}
+
+// DeleteCalendar 删除日历，仍被任务引用的日历不允许删除
+func (uc *CalendarUsecase) DeleteCalendar(ctx context.Context, id int64) error {
+	uc.log.WithContext(ctx).Infof("DeleteCalendar: %d", id)
+
+	if _, err := uc.GetCalendar(ctx, id); err != nil {
+		return err
+	}
+
+	// 已删除的任务可能被恢复，同样视为引用
+	_, total, err := uc.taskRepo.ListTasks(ctx, &TaskListFilter{Page: 1, PageSize: 1, CalendarID: id, IncludeDeleted: true})
+	if err != nil {
+		return err
+	}
+	if total > 0 {
+		return errors.Conflict(pb.ErrorReason_CALENDAR_IN_USE.String(), "calendar is referenced by tasks")
+	}
+
+	return uc.repo.DeleteCalendar(ctx, id)
+}
+
+// ListCalendars 日历列表查询
+func (uc *CalendarUsecase) ListCalendars(ctx context.Context, filter *CalendarListFilter) ([]*Calendar, int64, error) {
+	return uc.repo.ListCalendars(ctx, filter)
+}
+
+// ImportCalendar 导入 iCalendar 内容
+//
+// id 大于 0 时将事件追加到已有日历，否则以 calendar 为模板新建日历。
+func (uc *CalendarUsecase) ImportCalendar(ctx context.Context, id int64, calendar *Calendar, ics string, action pb.CalendarRuleAction) (*Calendar, error) {
+	uc.log.WithContext(ctx).Infof("ImportCalendar: id=%d name=%s", id, calendar.Name)
+
+	if action == pb.CalendarRuleAction_CALENDAR_RULE_ACTION_UNSPECIFIED {
+		action = pb.CalendarRuleAction_EXCLUDE
+	}
+	rules, err := ParseICS(ics, action)
+	if err != nil {
+		return nil, errors.BadRequest(pb.ErrorReason_INVALID_ICALENDAR.String(), err.Error())
+	}
+
+	if id <= 0 {
+		calendar.Rules = append(calendar.Rules, rules...)
+		return uc.CreateCalendar(ctx, calendar)
+	}
+
+	existing, err := uc.GetCalendar(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	existing.Rules = append(existing.Rules, rules...)
+	return uc.UpdateCalendar(ctx, existing)
+}
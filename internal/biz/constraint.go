@@ -0,0 +1,161 @@
+package biz
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// timeWindowLayout 每日时间窗口格式
+const timeWindowLayout = "15:04"
+
+// maxLockGroupLength 互斥组名称的最大长度
+const maxLockGroupLength = 255
+
+// maxWindowSkips 查找落在时间窗口内的触发时间时最多跳过的窗口次数
+const maxWindowSkips = 3660
+
+// TimeWindow 每日时间窗口，End 早于 Start 表示跨越午夜（如 22:00-02:00）
+type TimeWindow struct {
+	Start string
+	End   string
+}
+
+// parse 解析窗口起止时间，返回相对当天零点的偏移
+func (w TimeWindow) parse() (time.Duration, time.Duration, error) {
+	start, err := time.Parse(timeWindowLayout, w.Start)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid window start %q: expected HH:MM", w.Start)
+	}
+	end, err := time.Parse(timeWindowLayout, w.End)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid window end %q: expected HH:MM", w.End)
+	}
+	if start.Equal(end) {
+		return 0, 0, fmt.Errorf("window %s-%s is empty", w.Start, w.End)
+	}
+	return time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute,
+		time.Duration(end.Hour())*time.Hour + time.Duration(end.Minute())*time.Minute, nil
+}
+
+// validateConstraints 校验任务的执行约束
+func (t *Task) validateConstraints() error {
+	if t.StartTime != nil && t.EndTime != nil && !t.EndTime.After(*t.StartTime) {
+		return fmt.Errorf("end time must be after start time")
+	}
+	if t.MaxRuns < 0 {
+		return fmt.Errorf("max runs must not be negative")
+	}
+	if len(t.LockGroup) > maxLockGroupLength {
+		return fmt.Errorf("lock group must be at most %d characters", maxLockGroupLength)
+	}
+	if strings.HasPrefix(t.LockGroup, reservedLockPrefix) {
+		return fmt.Errorf("lock group must not start with %q", reservedLockPrefix)
+	}
+	for _, w := range t.ActiveWindows {
+		if _, _, err := w.parse(); err != nil {
+			return err
+		}
+	}
+	return t.Retention.validate()
+}
+
+// window 解析后的时间窗口
+type window struct {
+	start time.Duration
+	end   time.Duration
+}
+
+// contains 判断当天偏移是否落在窗口内，窗口为左闭右开区间
+func (w window) contains(offset time.Duration) bool {
+	if w.start < w.end {
+		return offset >= w.start && offset < w.end
+	}
+	return offset >= w.start || offset < w.end
+}
+
+// constrainedSchedule 受起止时间和每日时间窗口约束的调度计划
+type constrainedSchedule struct {
+	schedule Schedule
+	start    *time.Time
+	end      *time.Time
+	windows  []window
+	loc      *time.Location
+}
+
+// WithConstraints 为调度计划附加任务的起止时间和每日时间窗口约束
+func WithConstraints(schedule Schedule, task *Task, loc *time.Location) (Schedule, error) {
+	if task.StartTime == nil && task.EndTime == nil && len(task.ActiveWindows) == 0 {
+		return schedule, nil
+	}
+	if loc == nil {
+		loc = time.Local
+	}
+
+	windows := make([]window, 0, len(task.ActiveWindows))
+	for _, w := range task.ActiveWindows {
+		start, end, err := w.parse()
+		if err != nil {
+			return nil, err
+		}
+		windows = append(windows, window{start: start, end: end})
+	}
+
+	return constrainedSchedule{
+		schedule: schedule,
+		start:    task.StartTime,
+		end:      task.EndTime,
+		windows:  windows,
+		loc:      loc,
+	}, nil
+}
+
+// Next 实现 Schedule 接口
+func (s constrainedSchedule) Next(after time.Time) time.Time {
+	if s.start != nil && after.Before(*s.start) {
+		after = s.start.Add(-time.Nanosecond)
+	}
+
+	t := s.schedule.Next(after)
+	for skipped := 0; !t.IsZero(); skipped++ {
+		if s.end != nil && t.After(*s.end) {
+			return time.Time{}
+		}
+		opening, ok := s.nextOpening(t)
+		if ok {
+			return t
+		}
+		if skipped >= maxWindowSkips {
+			return time.Time{}
+		}
+		// 跳到下一个窗口开启时刻之前，避免逐个触发点遍历
+		t = s.schedule.Next(opening.Add(-time.Nanosecond))
+	}
+	return t
+}
+
+// nextOpening 判断 t 是否落在某个窗口内；不在时返回之后最近的窗口开启时刻
+func (s constrainedSchedule) nextOpening(t time.Time) (time.Time, bool) {
+	if len(s.windows) == 0 {
+		return t, true
+	}
+
+	local := t.In(s.loc)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, s.loc)
+	offset := local.Sub(midnight)
+
+	var opening time.Time
+	for _, w := range s.windows {
+		if w.contains(offset) {
+			return t, true
+		}
+		candidate := midnight.Add(w.start)
+		if !candidate.After(local) {
+			candidate = time.Date(local.Year(), local.Month(), local.Day()+1, 0, 0, 0, 0, s.loc).Add(w.start)
+		}
+		if opening.IsZero() || candidate.Before(opening) {
+			opening = candidate
+		}
+	}
+	return opening, false
+}
@@ -0,0 +1,183 @@
+package biz
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+)
+
+func TestTimeWindowParse(t *testing.T) {
+	tests := []struct {
+		window    TimeWindow
+		wantStart time.Duration
+		wantEnd   time.Duration
+		wantErr   string
+	}{
+		{window: TimeWindow{Start: "09:00", End: "17:30"}, wantStart: 9 * time.Hour, wantEnd: 17*time.Hour + 30*time.Minute},
+		{window: TimeWindow{Start: "22:00", End: "02:00"}, wantStart: 22 * time.Hour, wantEnd: 2 * time.Hour},
+		{window: TimeWindow{Start: "9am", End: "17:00"}, wantErr: "invalid window start"},
+		{window: TimeWindow{Start: "09:00", End: "25:00"}, wantErr: "invalid window end"},
+		{window: TimeWindow{Start: "09:00", End: "09:00"}, wantErr: "is empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.window.Start+"-"+tt.window.End, func(t *testing.T) {
+			start, end, err := tt.window.parse()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			if start != tt.wantStart || end != tt.wantEnd {
+				t.Errorf("got %v-%v, want %v-%v", start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestWindowContains(t *testing.T) {
+	day := window{start: 9 * time.Hour, end: 17 * time.Hour}
+	night := window{start: 22 * time.Hour, end: 2 * time.Hour}
+	tests := []struct {
+		name   string
+		w      window
+		offset time.Duration
+		want   bool
+	}{
+		{"day start inclusive", day, 9 * time.Hour, true},
+		{"day end exclusive", day, 17 * time.Hour, false},
+		{"day before", day, 8 * time.Hour, false},
+		{"night before midnight", night, 23 * time.Hour, true},
+		{"night after midnight", night, time.Hour, true},
+		{"night end exclusive", night, 2 * time.Hour, false},
+		{"night gap", night, 12 * time.Hour, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.w.contains(tt.offset); got != tt.want {
+				t.Errorf("contains(%v) = %v, want %v", tt.offset, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateConstraints(t *testing.T) {
+	start := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	end := start.Add(-time.Hour)
+	tests := []struct {
+		name    string
+		task    Task
+		wantErr string
+	}{
+		{name: "valid", task: Task{MaxRuns: 3, ActiveWindows: []TimeWindow{{Start: "09:00", End: "17:00"}}}},
+		{name: "end before start", task: Task{StartTime: &start, EndTime: &end}, wantErr: "end time must be after start time"},
+		{name: "negative max runs", task: Task{MaxRuns: -1}, wantErr: "max runs"},
+		{name: "long lock group", task: Task{LockGroup: strings.Repeat("g", maxLockGroupLength+1)}, wantErr: "lock group"},
+		{name: "reserved lock group", task: Task{LockGroup: "__pool/1/0"}, wantErr: "must not start with"},
+		{name: "bad window", task: Task{ActiveWindows: []TimeWindow{{Start: "09:00", End: "09:00"}}}, wantErr: "is empty"},
+		{name: "negative retention", task: Task{Retention: RetentionPolicy{KeepLast: -1}}, wantErr: "retention"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.task.validateConstraints()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateConstraints: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// constrained 为每小时整点触发的 Cron 调度计划附加任务约束
+func constrained(t *testing.T, task *Task) Schedule {
+	t.Helper()
+	schedule, err := WithConstraints(mustSchedule(t, pb.TaskType_CRON, "0 * * * *", time.UTC), task, time.UTC)
+	if err != nil {
+		t.Fatalf("WithConstraints: %v", err)
+	}
+	return schedule
+}
+
+func TestWithConstraintsStartAndEnd(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC)
+	start := time.Date(2024, 1, 1, 5, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+	schedule := constrained(t, &Task{StartTime: &start, EndTime: &end})
+
+	got := NextRunTimes(schedule, from, 5)
+	want := []time.Time{start, start.Add(time.Hour), end}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("time %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestWithConstraintsActiveWindows(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC)
+	schedule := constrained(t, &Task{ActiveWindows: []TimeWindow{
+		{Start: "09:00", End: "11:00"},
+		{Start: "23:00", End: "01:00"},
+	}})
+
+	got := NextRunTimes(schedule, from, 6)
+	want := []time.Time{
+		time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("time %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestWithConstraintsWithoutConstraints(t *testing.T) {
+	schedule := mustSchedule(t, pb.TaskType_CRON, "@hourly", time.UTC)
+	got, err := WithConstraints(schedule, &Task{MaxRuns: 3}, time.UTC)
+	if err != nil {
+		t.Fatalf("WithConstraints: %v", err)
+	}
+	if got != schedule {
+		t.Errorf("WithConstraints wrapped a schedule without time constraints")
+	}
+}
+
+func TestRunLimitReached(t *testing.T) {
+	tests := []struct {
+		maxRuns  int64
+		runCount int64
+		want     bool
+	}{
+		{maxRuns: 0, runCount: 100, want: false},
+		{maxRuns: 1, runCount: 0, want: true},
+		{maxRuns: 3, runCount: 1, want: false},
+		{maxRuns: 3, runCount: 2, want: true},
+		{maxRuns: 3, runCount: 5, want: true},
+	}
+	for _, tt := range tests {
+		if got := runLimitReached(&Task{MaxRuns: tt.maxRuns, RunCount: tt.runCount}); got != tt.want {
+			t.Errorf("runLimitReached(max %d, count %d) = %v, want %v", tt.maxRuns, tt.runCount, got, tt.want)
+		}
+	}
+}
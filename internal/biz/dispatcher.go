@@ -0,0 +1,153 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Dispatcher 任务分发器，扫描到期任务并生成排队中的执行记录
+type Dispatcher struct {
+	taskRepo      TaskRepo
+	executionRepo ExecutionRepo
+	calendarRepo  CalendarRepo
+	queue         ExecutionQueue
+	log           *log.Helper
+}
+
+// NewDispatcher 创建任务分发器实例
+func NewDispatcher(taskRepo TaskRepo, executionRepo ExecutionRepo, calendarRepo CalendarRepo, queue ExecutionQueue, logger log.Logger) *Dispatcher {
+	return &Dispatcher{
+		taskRepo:      taskRepo,
+		executionRepo: executionRepo,
+		calendarRepo:  calendarRepo,
+		queue:         queue,
+		log:           log.NewHelper(logger),
+	}
+}
+
+// Dispatch 分发最多 limit 个到期任务，返回实际分发的数量
+func (d *Dispatcher) Dispatch(ctx context.Context, now time.Time, limit int) (int, error) {
+	tasks, err := d.taskRepo.ListDueTasks(ctx, now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	dispatched := 0
+	for _, task := range tasks {
+		ok, err := d.dispatchTask(ctx, task, now)
+		if err != nil {
+			d.log.WithContext(ctx).Errorf("dispatch task %d: %v", task.ID, err)
+			continue
+		}
+		if ok {
+			dispatched++
+		}
+	}
+	return dispatched, nil
+}
+
+// dispatchTask 推进任务的下次执行时间并创建执行记录，多节点并发时只有一个节点抢占成功
+func (d *Dispatcher) dispatchTask(ctx context.Context, task *Task, now time.Time) (bool, error) {
+	scheduled := *task.NextRunTime
+	status := task.Status
+	reason := ""
+
+	var next *time.Time
+	var err error
+	if task.isFixedDelay() {
+		// 固定延迟任务的下次执行时间在本次执行结束后由执行器计算
+		if runLimitReached(task) {
+			if status, err = NextTaskStatus(task.Status, TaskEventComplete); err != nil {
+				return false, err
+			}
+			reason = "max runs reached"
+		}
+	} else {
+		next, err = d.nextRunTime(ctx, task, scheduled, now)
+		if err != nil {
+			d.log.WithContext(ctx).Warnf("task %d has an invalid schedule, marking it failed: %v", task.ID, err)
+			reason := fmt.Sprintf("invalid schedule: %v", err)
+			if status, err = NextTaskStatus(task.Status, TaskEventFail); err != nil {
+				return false, err
+			}
+			_, err = d.taskRepo.AdvanceTask(ctx, task.ID, scheduled, nil, status, reason, false)
+			return false, err
+		}
+		if next == nil {
+			if status, err = NextTaskStatus(task.Status, TaskEventComplete); err != nil {
+				return false, err
+			}
+			reason = "no further runs"
+		}
+	}
+
+	ok, err := d.taskRepo.AdvanceTask(ctx, task.ID, scheduled, next, status, reason, true)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	if _, err := enqueueExecution(ctx, d.executionRepo, d.queue, &TaskExecution{
+		Namespace:   task.Namespace,
+		TaskID:      task.ID,
+		TaskName:    task.Name,
+		Payload:     task.Payload,
+		Priority:    task.Priority,
+		TaskVersion: task.Version,
+	}); err != nil {
+		// 入队失败时撤销推进，本次触发在下次分发时重试，且不计入调度次数
+		revertReason := ""
+		if status != task.Status {
+			revertReason = "enqueue failed, retrying dispatch"
+		}
+		if ok, rerr := d.taskRepo.RevertAdvance(ctx, task.ID, scheduled, next, status, revertReason, true); rerr != nil {
+			d.log.WithContext(ctx).Errorf("revert dispatch of task %d: %v", task.ID, rerr)
+		} else if !ok {
+			d.log.WithContext(ctx).Warnf("task %d changed concurrently, dispatch at %s is not retried", task.ID, scheduled.Format(time.RFC3339))
+		}
+		return false, err
+	}
+
+	if status == pb.TaskStatus_COMPLETED {
+		d.log.WithContext(ctx).Infof("task %d completed: no further runs", task.ID)
+	}
+	return true, nil
+}
+
+// runLimitReached 判断本次分发后任务是否达到最大执行次数
+// 按任务的调度次数判断，手动执行和执行记录被清理不影响计数
+func runLimitReached(task *Task) bool {
+	return task.MaxRuns > 0 && task.RunCount+1 >= task.MaxRuns
+}
+
+// nextRunTime 计算本次分发之后的下次执行时间，返回 nil 表示任务已无后续执行
+func (d *Dispatcher) nextRunTime(ctx context.Context, task *Task, scheduled, now time.Time) (*time.Time, error) {
+	// 达到最大执行次数（含本次）后不再调度
+	if runLimitReached(task) {
+		return nil, nil
+	}
+
+	calendar, err := getCalendar(ctx, d.calendarRepo, task.CalendarID)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := taskSchedule(task, calendar)
+	if err != nil {
+		return nil, err
+	}
+
+	// 错过的触发时间只补执行一次
+	next := schedule.Next(scheduled)
+	for !next.IsZero() && !next.After(now) {
+		next = schedule.Next(next)
+	}
+	if next.IsZero() {
+		return nil, nil
+	}
+	next = applyJitter(next, task)
+	return &next, nil
+}
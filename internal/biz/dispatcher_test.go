@@ -0,0 +1,186 @@
+package biz_test
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+	"heytom-scheduler/internal/data/memory"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// flakyQueue 在 err 不为空时拒绝入队的执行队列
+type flakyQueue struct {
+	biz.ExecutionQueue
+	err    error
+	pushed []int64
+	acked  []int64
+}
+
+func (q *flakyQueue) Push(_ context.Context, execution *biz.TaskExecution, _ time.Duration) error {
+	if q.err != nil {
+		return q.err
+	}
+	q.pushed = append(q.pushed, execution.ID)
+	return nil
+}
+
+func (q *flakyQueue) Ack(_ context.Context, id int64) error {
+	q.acked = append(q.acked, id)
+	return nil
+}
+
+func TestDispatchRevertsAdvanceWhenEnqueueFails(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	scheduled := now.Add(-time.Second)
+
+	tests := []struct {
+		name string
+		mode pb.IntervalMode
+	}{
+		{"fixed rate", pb.IntervalMode_FIXED_RATE},
+		{"fixed delay", pb.IntervalMode_FIXED_DELAY},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tasks, executions := memory.NewTaskRepo(), memory.NewExecutionRepo()
+			queue := &flakyQueue{err: stderrors.New("queue unavailable")}
+			d := biz.NewDispatcher(tasks, executions, nil, queue, log.DefaultLogger)
+
+			// 最后一次执行入队失败时不能计入调度次数并把任务标记为已完成
+			task, err := tasks.CreateTask(ctx, &biz.Task{
+				Name: "sync", Type: pb.TaskType_INTERVAL, Schedule: "1m", Handler: "http",
+				IntervalMode: tt.mode, MaxRuns: 1, NextRunTime: &scheduled,
+			})
+			if err != nil {
+				t.Fatalf("CreateTask: %v", err)
+			}
+
+			if n, err := d.Dispatch(ctx, now, 10); err != nil || n != 0 {
+				t.Fatalf("Dispatch with a failing queue = %d, %v", n, err)
+			}
+			got, _ := tasks.GetTask(ctx, task.ID)
+			if got.Status != pb.TaskStatus_PENDING || got.RunCount != 0 {
+				t.Fatalf("after failed enqueue status = %s, run count = %d; want PENDING, 0", got.Status, got.RunCount)
+			}
+			if got.NextRunTime == nil || !got.NextRunTime.Equal(scheduled) {
+				t.Fatalf("next run time = %v, want the missed fire %v retried", got.NextRunTime, scheduled)
+			}
+			failed, _, _ := executions.ListExecutions(ctx, &biz.ExecutionListFilter{TaskID: task.ID, PageSize: 10})
+			if len(failed) != 1 || failed[0].Status != pb.ExecutionStatus_EXECUTION_FAILED {
+				t.Fatalf("executions = %+v, want one failed execution", failed)
+			}
+
+			queue.err = nil
+			if n, err := d.Dispatch(ctx, now, 10); err != nil || n != 1 {
+				t.Fatalf("Dispatch after recovery = %d, %v", n, err)
+			}
+			got, _ = tasks.GetTask(ctx, task.ID)
+			if got.Status != pb.TaskStatus_COMPLETED || got.RunCount != 1 || len(queue.pushed) != 1 {
+				t.Errorf("after retry status = %s, run count = %d, pushed = %v", got.Status, got.RunCount, queue.pushed)
+			}
+		})
+	}
+}
+
+func TestDispatchIntervalModes(t *testing.T) {
+	ctx := context.Background()
+	created := time.Now().Add(-time.Hour).Truncate(time.Minute)
+
+	tests := []struct {
+		name     string
+		mode     pb.IntervalMode
+		now      time.Time
+		wantNext time.Time // 固定延迟任务为零值：分发后没有下次执行时间
+	}{
+		{"fixed rate", pb.IntervalMode_FIXED_RATE, created.Add(time.Minute + 10*time.Second), created.Add(2 * time.Minute)},
+		// 错过的触发时间只补执行一次，下次执行时间仍对齐到创建时间
+		{"fixed rate after missed fires", pb.IntervalMode_FIXED_RATE, created.Add(5*time.Minute + 10*time.Second), created.Add(6 * time.Minute)},
+		{"fixed delay", pb.IntervalMode_FIXED_DELAY, created.Add(time.Minute + 10*time.Second), time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tasks, executions := memory.NewTaskRepo(), memory.NewExecutionRepo()
+			queue := &flakyQueue{}
+			d := biz.NewDispatcher(tasks, executions, nil, queue, log.DefaultLogger)
+
+			first := created.Add(time.Minute)
+			task, err := tasks.CreateTask(ctx, &biz.Task{
+				Name: "sync", Type: pb.TaskType_INTERVAL, Schedule: "1m", Handler: "http",
+				IntervalMode: tt.mode, CreatedAt: created, NextRunTime: &first,
+			})
+			if err != nil {
+				t.Fatalf("CreateTask: %v", err)
+			}
+
+			if n, err := d.Dispatch(ctx, tt.now, 10); err != nil || n != 1 {
+				t.Fatalf("Dispatch = %d, %v", n, err)
+			}
+			got, _ := tasks.GetTask(ctx, task.ID)
+			if tt.wantNext.IsZero() {
+				if got.NextRunTime != nil {
+					t.Fatalf("next run time = %v, want none until the run finishes", got.NextRunTime)
+				}
+			} else if got.NextRunTime == nil || !got.NextRunTime.Equal(tt.wantNext) {
+				t.Fatalf("next run time = %v, want %v", got.NextRunTime, tt.wantNext)
+			}
+			if got.Status != pb.TaskStatus_PENDING || got.RunCount != 1 {
+				t.Errorf("status = %s, run count = %d; want PENDING, 1", got.Status, got.RunCount)
+			}
+		})
+	}
+}
+
+func TestFixedDelayReschedulesAfterRun(t *testing.T) {
+	ctx := context.Background()
+	tasks, executions := memory.NewTaskRepo(), memory.NewExecutionRepo()
+	queue := &flakyQueue{}
+	d := biz.NewDispatcher(tasks, executions, nil, queue, log.DefaultLogger)
+	handlers := biz.NewHandlerRegistry()
+	handlers.Register("sleep", biz.HandlerFunc(func(context.Context, *biz.TaskExecution) (string, error) {
+		time.Sleep(10 * time.Millisecond)
+		return "done", nil
+	}))
+	e := biz.NewExecutor(tasks, executions, nil, nil, nil, nil, nil, queue, handlers, nil, log.DefaultLogger)
+
+	scheduled := time.Now().Add(-time.Second)
+	task, err := tasks.CreateTask(ctx, &biz.Task{
+		Name: "sync", Type: pb.TaskType_INTERVAL, Schedule: "1m", Handler: "sleep",
+		IntervalMode: pb.IntervalMode_FIXED_DELAY, NextRunTime: &scheduled,
+	})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	if n, err := d.Dispatch(ctx, time.Now(), 10); err != nil || n != 1 {
+		t.Fatalf("Dispatch = %d, %v", n, err)
+	}
+
+	execution, err := executions.GetExecution(ctx, queue.pushed[0])
+	if err != nil {
+		t.Fatalf("GetExecution: %v", err)
+	}
+	start := time.Now()
+	execution.Status, execution.StartTime = pb.ExecutionStatus_EXECUTING, &start
+	if ok, err := executions.ClaimExecution(ctx, execution); err != nil || !ok {
+		t.Fatalf("ClaimExecution = %v, %v", ok, err)
+	}
+	e.Execute(ctx, execution)
+	end := time.Now()
+
+	// 下次执行时间以本次执行的结束时间为基准
+	got, _ := tasks.GetTask(ctx, task.ID)
+	if got.NextRunTime == nil {
+		t.Fatalf("next run time was not planned after the run")
+	}
+	if got.NextRunTime.Before(start.Add(10*time.Millisecond+time.Minute)) || got.NextRunTime.After(end.Add(time.Minute)) {
+		t.Errorf("next run time = %v, want one minute after the run ended (%v)", got.NextRunTime, end)
+	}
+	if got.ExecutionCount != 1 || got.SuccessCount != 1 {
+		t.Errorf("execution count = %d, success count = %d; want 1, 1", got.ExecutionCount, got.SuccessCount)
+	}
+}
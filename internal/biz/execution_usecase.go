@@ -2,6 +2,7 @@ package biz
 
 import (
 	"context"
+	"time"
 
 	pb "heytom-scheduler/api/scheduler/v1"
 
@@ -10,35 +11,110 @@ import (
 
 // ExecutionUsecase 执行记录用例
 type ExecutionUsecase struct {
-	repo ExecutionRepo
-	log  *log.Helper
+	repo         ExecutionRepo
+	taskRepo     TaskRepo
+	calendarRepo CalendarRepo
+	log          *log.Helper
 }
 
 // NewExecutionUsecase 创建执行记录用例实例
-func NewExecutionUsecase(repo ExecutionRepo, logger log.Logger) *ExecutionUsecase {
+func NewExecutionUsecase(repo ExecutionRepo, taskRepo TaskRepo, calendarRepo CalendarRepo, logger log.Logger) *ExecutionUsecase {
 	return &ExecutionUsecase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+		repo:         repo,
+		taskRepo:     taskRepo,
+		calendarRepo: calendarRepo,
+		log:          log.NewHelper(logger),
 	}
 }
 
-// GetExecution 获取执行记录详情
+// GetExecution 获取执行记录详情，不存在时返回 ErrExecutionNotFound
 func (uc *ExecutionUsecase) GetExecution(ctx context.Context, id int64) (*TaskExecution, error) {
-	return uc.repo.GetExecution(ctx, id)
+	execution, err := uc.repo.GetExecution(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if execution == nil {
+		return nil, ErrExecutionNotFound
+	}
+	return execution, nil
 }
 
-// ListExecutions 执行记录列表查询
-func (uc *ExecutionUsecase) ListExecutions(ctx context.Context, filter *ExecutionListFilter) ([]*TaskExecution, int64, error) {
-	return uc.repo.ListExecutions(ctx, filter)
+// ListExecutions 执行记录列表查询，分页方式与 TaskUsecase.ListTasks 相同
+func (uc *ExecutionUsecase) ListExecutions(ctx context.Context, filter *ExecutionListFilter, pageToken, orderBy string) ([]*TaskExecution, int64, string, error) {
+	order, err := parseListOrder(orderBy, executionOrderFields)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	fingerprint := filter.fingerprint()
+	after, err := pageCursorFor(filter.Page, pageToken, order, fingerprint)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	query := *filter
+	query.OrderBy = order
+	query.After = after
+	if filter.Page > 0 {
+		executions, total, err := uc.repo.ListExecutions(ctx, &query)
+		return executions, total, "", err
+	}
+
+	// 多取一条判断是否还有下一页
+	query.PageSize++
+	executions, total, err := uc.repo.ListExecutions(ctx, &query)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	var next string
+	if len(executions) > int(filter.PageSize) {
+		executions = executions[:filter.PageSize]
+		last := executions[len(executions)-1]
+		next = encodePageToken(order, fingerprint, ExecutionSortValue(last, order.Field), last.ID)
+	}
+	return executions, total, next, nil
 }
 
-// CancelExecution 取消执行中的任务
+// CancelExecution 取消排队中或执行中的任务，已结束的执行记录不能取消
 func (uc *ExecutionUsecase) CancelExecution(ctx context.Context, id int64) (*TaskExecution, error) {
 	uc.log.WithContext(ctx).Infof("CancelExecution: %d", id)
 
-	if err := uc.repo.UpdateExecutionStatus(ctx, id, pb.ExecutionStatus_EXECUTION_CANCELLED); err != nil {
+	execution, err := uc.GetExecution(ctx, id)
+	if err != nil {
 		return nil, err
 	}
+	switch execution.Status {
+	case pb.ExecutionStatus_EXECUTION_CANCELLED:
+		return execution, nil
+	case pb.ExecutionStatus_QUEUED, pb.ExecutionStatus_EXECUTING:
+	default:
+		return nil, invalidStateTransition("cancel execution", execution.Status)
+	}
+
+	// 条件更新，期间已结束的执行记录不会被覆盖为已取消
+	now := time.Now()
+	ok, err := uc.repo.CancelExecution(ctx, id, now)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		current, err := uc.GetExecution(ctx, id)
+		if err != nil || current.Status == pb.ExecutionStatus_EXECUTION_CANCELLED {
+			return current, err
+		}
+		return nil, invalidStateTransition("cancel execution", current.Status)
+	}
+
+	// 执行中的记录由执行器在处理器返回后重新调度，排队中的记录不会再被执行，在此计算固定延迟任务的下次执行时间
+	if execution.Status == pb.ExecutionStatus_QUEUED {
+		if err := rescheduleFixedDelay(ctx, uc.taskRepo, uc.calendarRepo, execution.TaskID, now); err != nil {
+			uc.log.WithContext(ctx).Errorf("reschedule task %d: %v", execution.TaskID, err)
+		}
+	}
+
+	return uc.GetExecution(ctx, id)
+}
 
-	return uc.repo.GetExecution(ctx, id)
+// GetQueueStats 获取执行队列统计
+func (uc *ExecutionUsecase) GetQueueStats(ctx context.Context) (*QueueStats, error) {
+	return uc.repo.GetQueueStats(ctx)
 }
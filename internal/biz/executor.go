@@ -0,0 +1,512 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// defaultTaskTimeout 任务未设置超时时间时的默认值
+	defaultTaskTimeout = 300 * time.Second
+	// claimRounds 每次认领最多出队的轮数，资源池容量或互斥组锁不可用的记录放回队列后继续出队后续记录
+	claimRounds = 10
+	// claimRetryDelay 资源池容量或互斥组锁不可用的记录重新可出队的延迟
+	claimRetryDelay = time.Second
+	// heldRetryDelay 任务暂停期间排队中的记录重新可出队的延迟
+	heldRetryDelay = 10 * time.Second
+)
+
+// claimResult 认领执行记录的结果
+type claimResult int
+
+const (
+	// claimAcquired 认领成功
+	claimAcquired claimResult = iota
+	// claimBusy 命名空间配额、资源池容量或互斥组锁不可用，稍后重试
+	claimBusy
+	// claimDropped 执行记录已被跳过或不再排队，从队列中移除
+	claimDropped
+	// claimHeld 任务已暂停，执行记录保持排队，恢复后再执行
+	claimHeld
+)
+
+// Executor 任务执行器，认领排队中的执行记录并调用处理器执行
+type Executor struct {
+	taskRepo      TaskRepo
+	executionRepo ExecutionRepo
+	calendarRepo  CalendarRepo
+	poolRepo      ResourcePoolRepo
+	namespaceRepo NamespaceRepo
+	secretRepo    SecretRepo
+	lockRepo      LockRepo
+	queue         ExecutionQueue
+	handlers      *HandlerRegistry
+	keyring       *Keyring
+	limiter       *PoolLimiter
+	log           *log.Helper
+
+	mu      sync.Mutex
+	leases  map[int64]func()
+	running map[int64]context.CancelFunc // 本节点正在执行的记录，用于发送心跳和取消处理器
+}
+
+// NewExecutor 创建任务执行器实例
+func NewExecutor(taskRepo TaskRepo, executionRepo ExecutionRepo, calendarRepo CalendarRepo, poolRepo ResourcePoolRepo, namespaceRepo NamespaceRepo, secretRepo SecretRepo, lockRepo LockRepo, queue ExecutionQueue, handlers *HandlerRegistry, keyring *Keyring, logger log.Logger) *Executor {
+	return &Executor{
+		taskRepo:      taskRepo,
+		executionRepo: executionRepo,
+		calendarRepo:  calendarRepo,
+		poolRepo:      poolRepo,
+		namespaceRepo: namespaceRepo,
+		secretRepo:    secretRepo,
+		lockRepo:      lockRepo,
+		queue:         queue,
+		handlers:      handlers,
+		keyring:       keyring,
+		limiter:       NewPoolLimiter(),
+		log:           log.NewHelper(logger),
+		leases:        make(map[int64]func()),
+		running:       make(map[int64]context.CancelFunc),
+	}
+}
+
+// Claim 为 nodeID 认领最多 limit 条排队中的执行记录
+// 按出队顺序检查命名空间配额、资源池容量和互斥组锁，不可用的记录放回队列，等待后续轮询
+func (e *Executor) Claim(ctx context.Context, nodeID string, limit int) ([]*TaskExecution, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	pools, err := e.poolRepo.ListAllResourcePools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	quotas, err := e.namespaceQuotas(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := make([]*TaskExecution, 0, limit)
+	tasks := make(map[int64]*Task)
+	for round := 0; round < claimRounds && len(claimed) < limit; round++ {
+		ids, err := e.queue.Pop(ctx, nodeID, limit-len(claimed))
+		if err != nil {
+			return claimed, err
+		}
+		if len(ids) == 0 {
+			break
+		}
+
+		for i, id := range ids {
+			execution, result, err := e.claimQueued(ctx, nodeID, id, tasks, pools, quotas)
+			if err != nil {
+				// 未处理的记录立即放回队列
+				for _, rest := range ids[i:] {
+					if nerr := e.queue.Nack(ctx, rest, 0); nerr != nil {
+						e.log.WithContext(ctx).Errorf("nack execution %d: %v", rest, nerr)
+					}
+				}
+				return claimed, err
+			}
+
+			switch result {
+			case claimBusy:
+				err = e.queue.Nack(ctx, id, claimRetryDelay)
+			case claimHeld:
+				err = e.queue.Nack(ctx, id, heldRetryDelay)
+			default:
+				err = e.queue.Ack(ctx, id)
+			}
+			if err != nil {
+				e.log.WithContext(ctx).Errorf("settle queued execution %d: %v", id, err)
+			}
+			if result == claimAcquired {
+				claimed = append(claimed, execution)
+			}
+		}
+	}
+
+	return claimed, nil
+}
+
+// claimQueued 认领出队的执行记录，执行记录不存在或不再排队时丢弃
+func (e *Executor) claimQueued(ctx context.Context, nodeID string, id int64, tasks map[int64]*Task, pools []*ResourcePool, quotas *namespaceQuotas) (*TaskExecution, claimResult, error) {
+	execution, err := e.executionRepo.GetExecution(ctx, id)
+	if err != nil {
+		return nil, claimBusy, err
+	}
+	if execution == nil || execution.Status != pb.ExecutionStatus_QUEUED {
+		return nil, claimDropped, nil
+	}
+
+	task, ok := tasks[execution.TaskID]
+	if !ok {
+		if task, err = e.taskRepo.GetTask(ctx, execution.TaskID); err != nil {
+			return nil, claimBusy, err
+		}
+		tasks[execution.TaskID] = task
+	}
+	if task != nil && task.Status == pb.TaskStatus_PAUSED {
+		return execution, claimHeld, nil
+	}
+
+	allowed, err := quotas.allow(ctx, execution.Namespace)
+	if err != nil || !allowed {
+		return execution, claimBusy, err
+	}
+	result, err := e.tryClaim(ctx, nodeID, execution, task, pools)
+	if result == claimAcquired {
+		quotas.add(execution.Namespace)
+	}
+	return execution, result, err
+}
+
+// namespaceQuotas 加载设置了执行配额的命名空间
+func (e *Executor) namespaceQuotas(ctx context.Context) (*namespaceQuotas, error) {
+	namespaces, err := e.namespaceRepo.ListAllNamespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	quotas := &namespaceQuotas{
+		repo:       e.executionRepo,
+		namespaces: make(map[string]*Namespace),
+		usage:      make(map[string]*NamespaceUsage),
+		since:      time.Now().Add(-time.Minute),
+	}
+	for _, namespace := range namespaces {
+		if namespace.HasExecutionQuota() {
+			quotas.namespaces[namespace.Name] = namespace
+		}
+	}
+	return quotas, nil
+}
+
+// tryClaim 在资源池容量和互斥组锁都可用时认领执行记录
+func (e *Executor) tryClaim(ctx context.Context, nodeID string, execution *TaskExecution, task *Task, pools []*ResourcePool) (claimResult, error) {
+	var matched []*ResourcePool
+	if task != nil {
+		matched = matchPools(pools, task)
+	}
+
+	now := time.Now()
+	if !e.limiter.TryTake(matched, now) {
+		return claimBusy, nil
+	}
+
+	// 资源池并发槽位和互斥组锁都以执行记录为持有者，执行结束或认领失败时释放
+	owner := lockOwner(nodeID, execution.ID)
+	var held []string
+	release := func() {
+		for _, group := range held {
+			if err := e.lockRepo.ReleaseLock(context.Background(), group, owner); err != nil {
+				e.log.Errorf("release lock %q of execution %d: %v", group, execution.ID, err)
+			}
+		}
+	}
+	abort := func() {
+		release()
+		e.limiter.Refund(matched)
+	}
+
+	for _, pool := range matched {
+		if pool.MaxConcurrency <= 0 {
+			continue
+		}
+		slot, ok, err := e.lockRepo.AcquireSlot(ctx, poolSlotPrefix(pool), int(pool.MaxConcurrency), owner, taskTimeout(task)+lockGracePeriod)
+		if err != nil {
+			abort()
+			return claimBusy, err
+		}
+		if !ok {
+			abort()
+			return claimBusy, nil
+		}
+		held = append(held, slot)
+	}
+
+	if task != nil && task.LockGroup != "" {
+		locked, err := e.lockRepo.AcquireLock(ctx, task.LockGroup, owner, taskTimeout(task)+lockGracePeriod)
+		if err != nil {
+			abort()
+			return claimBusy, err
+		}
+		if !locked {
+			abort()
+			if task.ConcurrencyPolicy == pb.ConcurrencyPolicy_SKIP {
+				return claimDropped, e.skip(ctx, nodeID, execution, task, now)
+			}
+			return claimBusy, nil
+		}
+		held = append(held, task.LockGroup)
+	}
+
+	execution.Status = pb.ExecutionStatus_EXECUTING
+	execution.NodeID = nodeID
+	execution.StartTime = &now
+	execution.HeartbeatAt = &now
+	if task != nil {
+		// 排队期间任务可能被更新，按认领时的任务版本执行
+		execution.TaskVersion = task.Version
+	}
+	execution.WaitTime = int32(now.Sub(execution.CreatedAt) / time.Millisecond)
+	ok, err := e.executionRepo.ClaimExecution(ctx, execution)
+	if err != nil {
+		abort()
+		return claimBusy, err
+	}
+	if !ok {
+		// 已被其他节点认领或已取消
+		abort()
+		return claimDropped, nil
+	}
+
+	e.mu.Lock()
+	e.leases[execution.ID] = release
+	e.mu.Unlock()
+	return claimAcquired, nil
+}
+
+// skip 互斥组被占用且并发策略为 SKIP 时跳过执行记录
+func (e *Executor) skip(ctx context.Context, nodeID string, execution *TaskExecution, task *Task, now time.Time) error {
+	execution.Status = pb.ExecutionStatus_EXECUTION_SKIPPED
+	execution.NodeID = nodeID
+	execution.EndTime = &now
+	execution.WaitTime = int32(now.Sub(execution.CreatedAt) / time.Millisecond)
+	execution.Error = fmt.Sprintf("skipped: lock group %q is busy", task.LockGroup)
+	ok, err := e.executionRepo.ClaimExecution(ctx, execution)
+	if err != nil || !ok {
+		return err
+	}
+
+	e.log.WithContext(ctx).Infof("execution %d of task %d skipped: lock group %q is busy", execution.ID, task.ID, task.LockGroup)
+	e.reschedule(ctx, task.ID, now)
+	return nil
+}
+
+// releaseLease 释放执行记录持有的资源池并发槽位和互斥组锁
+func (e *Executor) releaseLease(id int64) {
+	e.mu.Lock()
+	release, ok := e.leases[id]
+	delete(e.leases, id)
+	e.mu.Unlock()
+	if ok {
+		release()
+	}
+}
+
+// lockOwner 执行记录持有资源池并发槽位和互斥组锁时的持有者
+func lockOwner(nodeID string, executionID int64) string {
+	return fmt.Sprintf("%s/%d", nodeID, executionID)
+}
+
+// Execute 执行已认领的执行记录并保存结果，执行记录被取消或回收后在下次心跳时取消处理器的 context
+func (e *Executor) Execute(ctx context.Context, execution *TaskExecution) {
+	start := time.Now()
+	if execution.StartTime != nil {
+		start = *execution.StartTime
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	e.mu.Lock()
+	e.running[execution.ID] = cancel
+	e.mu.Unlock()
+
+	result, secrets, err := e.run(runCtx, execution)
+	e.releaseLease(execution.ID)
+	e.mu.Lock()
+	delete(e.running, execution.ID)
+	e.mu.Unlock()
+	cancel()
+
+	end := time.Now()
+	execution.EndTime = &end
+	execution.Duration = int32(end.Sub(start) / time.Millisecond)
+	// 处理器可能在结果或错误中回显请求，保存前移除密钥的值
+	execution.Result = secrets.redact(result)
+	switch {
+	case err == nil:
+		execution.Status = pb.ExecutionStatus_SUCCESS
+	case errors.Is(err, context.DeadlineExceeded):
+		execution.Status = pb.ExecutionStatus_TIMEOUT
+		execution.Error = secrets.redact(err.Error())
+	default:
+		execution.Status = pb.ExecutionStatus_EXECUTION_FAILED
+		execution.Error = secrets.redact(err.Error())
+	}
+
+	// 使用独立的 context 保存结果，避免停机时丢失执行结果
+	saveCtx := context.WithoutCancel(ctx)
+	ok, err := e.executionRepo.FinishExecution(saveCtx, execution)
+	if err != nil {
+		e.log.WithContext(ctx).Errorf("finish execution %d: %v", execution.ID, err)
+	} else if ok {
+		// 执行期间被取消的记录不计入执行次数
+		if err := e.taskRepo.IncrementExecutionCount(saveCtx, execution.TaskID, execution.Status == pb.ExecutionStatus_SUCCESS); err != nil {
+			e.log.WithContext(ctx).Errorf("increment execution count of task %d: %v", execution.TaskID, err)
+		}
+	}
+
+	e.reschedule(saveCtx, execution.TaskID, end)
+}
+
+// reschedule 固定延迟任务在执行结束后以结束时间为基准计算下次执行时间
+func (e *Executor) reschedule(ctx context.Context, taskID int64, end time.Time) {
+	if err := rescheduleFixedDelay(ctx, e.taskRepo, e.calendarRepo, taskID, end); err != nil {
+		e.log.WithContext(ctx).Errorf("reschedule task %d: %v", taskID, err)
+	}
+}
+
+// rescheduleFixedDelay 以 end 为基准计算固定延迟任务的下次执行时间，在执行结束或排队中的执行记录被取消后调用
+// 任务不是固定延迟、不处于待调度或已有下次执行时间时不更新；调度配置无效时任务变为失败，原因记录在任务状态中
+func rescheduleFixedDelay(ctx context.Context, taskRepo TaskRepo, calendarRepo CalendarRepo, taskID int64, end time.Time) error {
+	task, err := taskRepo.GetTask(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if task == nil || !task.isFixedDelay() || task.Status != pb.TaskStatus_PENDING || task.NextRunTime != nil {
+		return nil
+	}
+
+	calendar, err := getCalendar(ctx, calendarRepo, task.CalendarID)
+	if err != nil {
+		return err
+	}
+
+	status := task.Status
+	reason := ""
+	var next *time.Time
+	t, err := calculateNextRunTime(task, calendar, end)
+	switch {
+	case err != nil:
+		reason = fmt.Sprintf("invalid schedule: %v", err)
+		status, err = NextTaskStatus(task.Status, TaskEventFail)
+	case t.IsZero():
+		reason = "no further runs"
+		status, err = NextTaskStatus(task.Status, TaskEventComplete)
+	default:
+		t = applyJitter(t, task)
+		next = &t
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = taskRepo.RescheduleTask(ctx, taskID, next, status, reason)
+	return err
+}
+
+// run 查找任务处理器，解析负载中的密钥引用后在超时时间内执行，返回解析出的密钥值用于脱敏
+// 处理器收到的是执行记录的副本，保存的执行记录负载仍为密钥引用
+func (e *Executor) run(ctx context.Context, execution *TaskExecution) (string, secretValues, error) {
+	task, err := e.taskRepo.GetTask(ctx, execution.TaskID)
+	if err != nil {
+		return "", nil, err
+	}
+	if task == nil {
+		return "", nil, fmt.Errorf("task %d not found", execution.TaskID)
+	}
+
+	handler, ok := e.handlers.Get(task.Handler)
+	if !ok {
+		return "", nil, fmt.Errorf("handler %q is not registered", task.Handler)
+	}
+
+	payload, secrets, err := resolveSecrets(ctx, e.secretRepo, e.keyring, execution.Namespace, execution.Payload)
+	if err != nil {
+		return "", nil, fmt.Errorf("resolve secrets: %w", err)
+	}
+	resolved := *execution
+	resolved.Payload = payload
+
+	timeout := taskTimeout(task)
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result, err := handler.Handle(runCtx, &resolved)
+	if err != nil && runCtx.Err() == context.DeadlineExceeded {
+		return result, secrets, fmt.Errorf("execution timed out after %s: %w", timeout, context.DeadlineExceeded)
+	}
+	if err != nil && ctx.Err() == context.Canceled {
+		return result, secrets, fmt.Errorf("execution cancelled: %w", context.Canceled)
+	}
+	return result, secrets, err
+}
+
+// Heartbeat 更新本节点正在执行的记录的心跳时间，已被取消或被其他节点回收的记录取消其处理器的 context
+func (e *Executor) Heartbeat(ctx context.Context, now time.Time) error {
+	e.mu.Lock()
+	ids := make([]int64, 0, len(e.running))
+	for id := range e.running {
+		ids = append(ids, id)
+	}
+	e.mu.Unlock()
+	if len(ids) == 0 {
+		return nil
+	}
+
+	stopped, err := e.executionRepo.HeartbeatExecutions(ctx, ids, now)
+	if err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, id := range stopped {
+		if cancel, ok := e.running[id]; ok {
+			e.log.WithContext(ctx).Infof("execution %d is no longer executing, cancelling its handler", id)
+			cancel()
+		}
+	}
+	return nil
+}
+
+// ReclaimExpired 将心跳超过 lease 未更新的执行中记录标记为失败，返回回收的数量
+// 执行节点异常退出后这些记录不会再结束，回收后不再占用命名空间的并发配额、资源池并发槽位和互斥组锁，固定延迟任务重新调度
+func (e *Executor) ReclaimExpired(ctx context.Context, now time.Time, lease time.Duration, limit int) (int, error) {
+	expired, err := e.executionRepo.ListExpiredExecutions(ctx, now.Add(-lease), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	reclaimed := 0
+	for _, execution := range expired {
+		execution.Status = pb.ExecutionStatus_EXECUTION_FAILED
+		execution.EndTime = &now
+		if execution.StartTime != nil {
+			execution.Duration = int32(now.Sub(*execution.StartTime) / time.Millisecond)
+		}
+		execution.Error = fmt.Sprintf("execution lost: node %q stopped sending heartbeats", execution.NodeID)
+		ok, err := e.executionRepo.FinishExecution(ctx, execution)
+		if err != nil {
+			return reclaimed, err
+		}
+		if !ok {
+			continue
+		}
+		reclaimed++
+		e.log.WithContext(ctx).Warnf("execution %d of task %d on node %s missed its heartbeat lease, marked failed", execution.ID, execution.TaskID, execution.NodeID)
+
+		if err := e.taskRepo.IncrementExecutionCount(ctx, execution.TaskID, false); err != nil {
+			e.log.WithContext(ctx).Errorf("increment execution count of task %d: %v", execution.TaskID, err)
+		}
+		if err := e.lockRepo.ReleaseOwnerLocks(ctx, lockOwner(execution.NodeID, execution.ID)); err != nil {
+			e.log.WithContext(ctx).Errorf("release locks of execution %d: %v", execution.ID, err)
+		}
+		e.reschedule(ctx, execution.TaskID, now)
+	}
+	return reclaimed, nil
+}
+
+// taskTimeout 返回任务的执行超时时间
+func taskTimeout(task *Task) time.Duration {
+	if task.Timeout > 0 {
+		return time.Duration(task.Timeout) * time.Second
+	}
+	return defaultTaskTimeout
+}
@@ -0,0 +1,223 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// maxHandlerResultSize 处理器返回结果的最大保存长度
+	maxHandlerResultSize = 64 * 1024
+	// maxHTTPResponseHeaderSize http 处理器接受的响应头最大长度
+	maxHTTPResponseHeaderSize = 64 * 1024
+	// maxHTTPRedirects http 处理器最多跟随的重定向次数
+	maxHTTPRedirects = 5
+	// defaultHTTPHandlerTimeout http 处理器单次请求的默认超时时间
+	defaultHTTPHandlerTimeout = 30 * time.Second
+)
+
+// Handler 任务处理器
+type Handler interface {
+	// Handle 执行任务，返回执行结果
+	Handle(ctx context.Context, execution *TaskExecution) (string, error)
+}
+
+// HandlerFunc 函数形式的任务处理器
+type HandlerFunc func(ctx context.Context, execution *TaskExecution) (string, error)
+
+// Handle 实现 Handler 接口
+func (f HandlerFunc) Handle(ctx context.Context, execution *TaskExecution) (string, error) {
+	return f(ctx, execution)
+}
+
+// HandlerRegistry 任务处理器注册表，任务通过 Handler 字段按名称引用处理器
+type HandlerRegistry struct {
+	mu       sync.RWMutex
+	handlers map[string]Handler
+}
+
+// NewHandlerRegistry 创建空的处理器注册表，内置 http 处理器按配置注册
+func NewHandlerRegistry() *HandlerRegistry {
+	return &HandlerRegistry{handlers: make(map[string]Handler)}
+}
+
+// Register 注册处理器，同名处理器会被覆盖
+func (r *HandlerRegistry) Register(name string, handler Handler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers[name] = handler
+}
+
+// Get 按名称获取处理器
+func (r *HandlerRegistry) Get(name string) (Handler, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	h, ok := r.handlers[name]
+	return h, ok
+}
+
+// Names 返回已注册的处理器名称
+func (r *HandlerRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.handlers))
+	for name := range r.handlers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// httpRequest http 处理器的负载格式
+type httpRequest struct {
+	URL     string            `json:"url"`
+	Method  string            `json:"method"`
+	Headers map[string]string `json:"headers"`
+	Body    json.RawMessage   `json:"body"`
+}
+
+// HTTPHandlerOptions http 处理器的配置
+type HTTPHandlerOptions struct {
+	// AllowedHosts 允许调用的主机，host 匹配任意端口，host:port 只匹配该端口，*.example.com 匹配其子域名
+	AllowedHosts []string
+	// Timeout 单次请求（含重定向）的超时时间，为 0 时使用默认值
+	Timeout time.Duration
+}
+
+// httpHandler 以负载描述的请求调用 HTTP 接口，非 2xx 响应视为失败
+// 只允许以 http 或 https 调用 AllowedHosts 中的主机，重定向的目标同样需要在其中
+type httpHandler struct {
+	client       *http.Client
+	allowedHosts []string
+}
+
+// NewHTTPHandler 创建 http 处理器，使用独立的 HTTP 客户端
+func NewHTTPHandler(opts HTTPHandlerOptions) (Handler, error) {
+	h := &httpHandler{}
+	for _, host := range opts.AllowedHosts {
+		host = strings.ToLower(strings.TrimSpace(host))
+		if err := validateAllowedHost(host); err != nil {
+			return nil, err
+		}
+		h.allowedHosts = append(h.allowedHosts, host)
+	}
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = defaultHTTPHandlerTimeout
+	}
+	h.client = &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// 不使用环境变量中的代理，避免绕过主机白名单
+			Proxy:                  nil,
+			MaxResponseHeaderBytes: maxHTTPResponseHeaderSize,
+			TLSHandshakeTimeout:    10 * time.Second,
+			IdleConnTimeout:        90 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxHTTPRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxHTTPRedirects)
+			}
+			return h.checkURL(req.URL)
+		},
+	}
+	return h, nil
+}
+
+// validateAllowedHost 校验主机白名单的条目
+func validateAllowedHost(host string) error {
+	if host == "" || strings.Contains(host, "/") || strings.Contains(host, "@") {
+		return fmt.Errorf("invalid allowed host %q: use host, host:port or *.domain", host)
+	}
+	if strings.Contains(host, "*") && (!strings.HasPrefix(host, "*.") || strings.Count(host, "*") > 1 || strings.Contains(host, ":")) {
+		return fmt.Errorf("invalid allowed host %q: wildcards are only supported as *.domain", host)
+	}
+	return nil
+}
+
+// checkURL 校验请求地址的协议和主机
+func (h *httpHandler) checkURL(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("url scheme %q is not allowed, use http or https", u.Scheme)
+	}
+	hostname := strings.ToLower(u.Hostname())
+	hostport := hostname
+	if port := u.Port(); port != "" {
+		hostport = net.JoinHostPort(hostname, port)
+	}
+	for _, allowed := range h.allowedHosts {
+		switch {
+		case strings.HasPrefix(allowed, "*."):
+			if strings.HasSuffix(hostname, allowed[1:]) {
+				return nil
+			}
+		case allowed == hostname || allowed == hostport:
+			return nil
+		}
+	}
+	return fmt.Errorf("host %q is not in the allowed hosts of the http handler", u.Host)
+}
+
+// Handle 实现 Handler 接口
+func (h *httpHandler) Handle(ctx context.Context, execution *TaskExecution) (string, error) {
+	var req httpRequest
+	if err := json.Unmarshal([]byte(execution.Payload), &req); err != nil {
+		return "", fmt.Errorf("invalid http payload: %v", err)
+	}
+	if req.URL == "" {
+		return "", fmt.Errorf("invalid http payload: url is required")
+	}
+	if req.Method == "" {
+		req.Method = http.MethodPost
+	}
+
+	var body io.Reader
+	if len(req.Body) > 0 {
+		// 字符串类型的 body 原样发送，其他 JSON 值按 JSON 发送
+		var s string
+		if err := json.Unmarshal(req.Body, &s); err == nil {
+			body = strings.NewReader(s)
+		} else {
+			body = bytes.NewReader(req.Body)
+		}
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, strings.ToUpper(req.Method), req.URL, body)
+	if err != nil {
+		return "", err
+	}
+	if err := h.checkURL(httpReq.URL); err != nil {
+		return "", err
+	}
+	for k, v := range req.Headers {
+		httpReq.Header.Set(k, v)
+	}
+	if body != nil && httpReq.Header.Get("Content-Type") == "" {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := h.client.Do(httpReq)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxHandlerResultSize))
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return string(data), fmt.Errorf("http %s %s: unexpected status %s", httpReq.Method, req.URL, resp.Status)
+	}
+	return string(data), nil
+}
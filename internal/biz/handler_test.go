@@ -0,0 +1,123 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// mustHTTPHandler 创建 http 处理器，失败时终止测试
+func mustHTTPHandler(t *testing.T, opts HTTPHandlerOptions) Handler {
+	t.Helper()
+	h, err := NewHTTPHandler(opts)
+	if err != nil {
+		t.Fatalf("NewHTTPHandler: %v", err)
+	}
+	return h
+}
+
+// httpExecution 返回调用 rawURL 的执行记录
+func httpExecution(rawURL string) *TaskExecution {
+	return &TaskExecution{Payload: fmt.Sprintf(`{"url": %q, "method": "post", "body": {"a": 1}}`, rawURL)}
+}
+
+func TestNewHTTPHandlerRejectsInvalidHosts(t *testing.T) {
+	for _, host := range []string{"", "http://example.com", "example.com/path", "user@example.com", "*example.com", "a.*.example.com", "*.example.com:443"} {
+		if _, err := NewHTTPHandler(HTTPHandlerOptions{AllowedHosts: []string{host}}); err == nil {
+			t.Errorf("NewHTTPHandler accepted allowed host %q", host)
+		}
+	}
+}
+
+func TestHTTPHandlerCheckURL(t *testing.T) {
+	h := mustHTTPHandler(t, HTTPHandlerOptions{AllowedHosts: []string{"api.example.com", "Hooks.Example.com:8443", "*.internal.example.com"}}).(*httpHandler)
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{"https://api.example.com/hook", true},
+		{"http://API.example.com:8080/hook", true},
+		{"https://hooks.example.com:8443/x", true},
+		{"https://hooks.example.com/x", false},
+		{"https://a.internal.example.com/x", true},
+		{"https://internal.example.com/x", false},
+		{"https://evilinternal.example.com/x", false},
+		{"https://api.example.com.evil.test/x", false},
+		{"http://169.254.169.254/latest/meta-data", false},
+		{"file:///etc/passwd", false},
+		{"gopher://api.example.com/", false},
+	}
+	for _, tt := range tests {
+		u, err := url.Parse(tt.url)
+		if err != nil {
+			t.Fatalf("url.Parse(%q): %v", tt.url, err)
+		}
+		if err := h.checkURL(u); (err == nil) != tt.want {
+			t.Errorf("checkURL(%s) = %v, want allowed %v", tt.url, err, tt.want)
+		}
+	}
+}
+
+func TestHTTPHandler(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, "done")
+		case "/fail":
+			w.WriteHeader(http.StatusBadGateway)
+			fmt.Fprint(w, "upstream down")
+		case "/slow":
+			time.Sleep(200 * time.Millisecond)
+		case "/redirect":
+			// 跳转到同一服务的另一个主机名，不在白名单中
+			http.Redirect(w, r, strings.Replace("http://"+r.Host+"/ok", "127.0.0.1", "localhost", 1), http.StatusFound)
+		}
+	}))
+	defer srv.Close()
+
+	h := mustHTTPHandler(t, HTTPHandlerOptions{AllowedHosts: []string{"127.0.0.1"}, Timeout: 50 * time.Millisecond})
+	ctx := context.Background()
+
+	if result, err := h.Handle(ctx, httpExecution(srv.URL+"/ok")); err != nil || result != "done" {
+		t.Errorf("Handle(/ok) = %q, %v", result, err)
+	}
+	if result, err := h.Handle(ctx, httpExecution(srv.URL+"/fail")); err == nil || result != "upstream down" {
+		t.Errorf("Handle(/fail) = %q, %v, want the response body and an error", result, err)
+	}
+	if _, err := h.Handle(ctx, httpExecution(srv.URL+"/slow")); err == nil {
+		t.Errorf("Handle(/slow) did not time out")
+	}
+	if _, err := h.Handle(ctx, httpExecution(srv.URL+"/redirect")); err == nil || !strings.Contains(err.Error(), "not in the allowed hosts") {
+		t.Errorf("Handle(/redirect) err = %v, want the redirect target rejected", err)
+	}
+	if _, err := h.Handle(ctx, httpExecution(strings.Replace(srv.URL, "127.0.0.1", "localhost", 1)+"/ok")); err == nil {
+		t.Errorf("Handle called a host outside the allow list")
+	}
+	if _, err := h.Handle(ctx, &TaskExecution{Payload: `{"method": "GET"}`}); err == nil {
+		t.Errorf("Handle accepted a payload without url")
+	}
+}
+
+func TestHandlerRegistry(t *testing.T) {
+	r := NewHandlerRegistry()
+	if names := r.Names(); len(names) != 0 {
+		t.Errorf("new registry handlers = %v, want none", names)
+	}
+	r.Register("noop", HandlerFunc(func(context.Context, *TaskExecution) (string, error) { return "", nil }))
+	r.Register("http", mustHTTPHandler(t, HTTPHandlerOptions{AllowedHosts: []string{"api.example.com"}}))
+	if _, ok := r.Get("noop"); !ok {
+		t.Errorf("noop handler is not registered")
+	}
+	if names := r.Names(); strings.Join(names, ",") != "http,noop" {
+		t.Errorf("Names = %v, want sorted names", names)
+	}
+}
@@ -0,0 +1,290 @@
+package biz
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+)
+
+// icalWeekdays iCalendar BYDAY 取值与星期的对应关系
+var icalWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// icalProperty iCalendar 属性行
+type icalProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// ParseICS 将 iCalendar（.ics）内容中的 VEVENT 转换为日历规则
+//
+// 支持全天及跨天事件（DTEND 或 DURATION），以及 FREQ=WEEKLY 的 RRULE（可带 BYDAY、UNTIL）。
+// 按周规则无法表示间隔多周或跨天的重复事件，带 INTERVAL（不为 1）的 RRULE 和跨天的重复事件返回错误。
+func ParseICS(content string, action pb.CalendarRuleAction) ([]*CalendarRule, error) {
+	var rules []*CalendarRule
+	var event []icalProperty
+	inEvent := false
+
+	for _, line := range unfoldICSLines(content) {
+		switch {
+		case line == "":
+			continue
+		case strings.EqualFold(line, "BEGIN:VEVENT"):
+			inEvent = true
+			event = event[:0]
+		case strings.EqualFold(line, "END:VEVENT"):
+			if !inEvent {
+				return nil, fmt.Errorf("unexpected END:VEVENT")
+			}
+			rule, err := icsEventToRule(event, action)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, rule)
+			inEvent = false
+		case inEvent:
+			prop, err := parseICSProperty(line)
+			if err != nil {
+				return nil, err
+			}
+			event = append(event, prop)
+		}
+	}
+	if inEvent {
+		return nil, fmt.Errorf("unterminated VEVENT")
+	}
+	return rules, nil
+}
+
+// unfoldICSLines 按 RFC 5545 展开折行
+func unfoldICSLines(content string) []string {
+	raw := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	lines := make([]string, 0, len(raw))
+	for _, line := range raw {
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, strings.TrimRight(line, "\r"))
+	}
+	return lines
+}
+
+// parseICSProperty 解析属性行，如 DTSTART;VALUE=DATE:20240101
+func parseICSProperty(line string) (icalProperty, error) {
+	idx := strings.Index(line, ":")
+	if idx < 0 {
+		return icalProperty{}, fmt.Errorf("invalid iCalendar line %q", line)
+	}
+	parts := strings.Split(line[:idx], ";")
+	prop := icalProperty{
+		name:   strings.ToUpper(parts[0]),
+		params: make(map[string]string, len(parts)-1),
+		value:  line[idx+1:],
+	}
+	for _, p := range parts[1:] {
+		if kv := strings.SplitN(p, "=", 2); len(kv) == 2 {
+			prop.params[strings.ToUpper(kv[0])] = kv[1]
+		}
+	}
+	return prop, nil
+}
+
+// icsEventToRule 将单个 VEVENT 转换为日历规则
+func icsEventToRule(event []icalProperty, action pb.CalendarRuleAction) (*CalendarRule, error) {
+	rule := &CalendarRule{Action: action}
+	var start, end time.Time
+	var duration, rrule string
+
+	for _, prop := range event {
+		switch prop.name {
+		case "SUMMARY":
+			rule.Description = unescapeICSText(prop.value)
+		case "DTSTART":
+			t, _, err := parseICSDate(prop)
+			if err != nil {
+				return nil, err
+			}
+			start = t
+		case "DTEND":
+			t, _, err := parseICSDate(prop)
+			if err != nil {
+				return nil, err
+			}
+			end = t
+		case "DURATION":
+			duration = prop.value
+		case "RRULE":
+			rrule = prop.value
+		}
+	}
+	if start.IsZero() {
+		return nil, fmt.Errorf("VEVENT %q has no DTSTART", rule.Description)
+	}
+
+	if duration != "" {
+		if !end.IsZero() {
+			return nil, fmt.Errorf("VEVENT %q has both DTEND and DURATION", rule.Description)
+		}
+		days, clock, err := parseICSDuration(duration)
+		if err != nil {
+			return nil, fmt.Errorf("VEVENT %q: %v", rule.Description, err)
+		}
+		end = start.AddDate(0, 0, days).Add(clock)
+	}
+
+	rule.StartDate = start.Format(calendarDateLayout)
+	// DTEND 和 DURATION 得到的结束时间不包含在事件内，全天事件结束于次日零点
+	if end.After(start) {
+		if last := end.Add(-time.Nanosecond).Format(calendarDateLayout); last > rule.StartDate {
+			rule.EndDate = last
+		}
+	}
+
+	if rrule != "" {
+		if rule.EndDate != "" {
+			return nil, fmt.Errorf("VEVENT %q: recurring events spanning multiple days are not supported", rule.Description)
+		}
+		if err := applyICSRRule(rule, start, rrule); err != nil {
+			return nil, fmt.Errorf("VEVENT %q: %v", rule.Description, err)
+		}
+	}
+	return rule, nil
+}
+
+// applyICSRRule 将 RRULE 转换为按周规则
+func applyICSRRule(rule *CalendarRule, start time.Time, rrule string) error {
+	params := make(map[string]string)
+	for _, p := range strings.Split(rrule, ";") {
+		if kv := strings.SplitN(p, "=", 2); len(kv) == 2 {
+			params[strings.ToUpper(kv[0])] = strings.ToUpper(kv[1])
+		}
+	}
+	if params["FREQ"] != "WEEKLY" {
+		return fmt.Errorf("unsupported RRULE %q: only FREQ=WEEKLY is supported", rrule)
+	}
+	if interval := params["INTERVAL"]; interval != "" && interval != "1" {
+		return fmt.Errorf("unsupported RRULE %q: INTERVAL is not supported", rrule)
+	}
+	if _, ok := params["COUNT"]; ok {
+		return fmt.Errorf("unsupported RRULE %q: COUNT is not supported, use UNTIL", rrule)
+	}
+
+	rule.Weekdays = nil
+	if byDay := params["BYDAY"]; byDay != "" {
+		for _, d := range strings.Split(byDay, ",") {
+			wd, ok := icalWeekdays[d]
+			if !ok {
+				return fmt.Errorf("unsupported BYDAY value %q", d)
+			}
+			rule.Weekdays = append(rule.Weekdays, wd)
+		}
+	} else {
+		rule.Weekdays = []time.Weekday{start.Weekday()}
+	}
+
+	rule.EndDate = ""
+	if until := params["UNTIL"]; until != "" {
+		t, _, err := parseICSDate(icalProperty{params: map[string]string{}, value: until})
+		if err != nil {
+			return err
+		}
+		rule.EndDate = t.Format(calendarDateLayout)
+	}
+	return nil
+}
+
+// parseICSDate 解析 DATE 或 DATE-TIME 取值，返回是否为纯日期
+func parseICSDate(prop icalProperty) (time.Time, bool, error) {
+	value := prop.value
+	if len(value) == len("20060102") {
+		t, err := time.Parse("20060102", value)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid date %q", value)
+		}
+		return t, true, nil
+	}
+
+	loc := time.UTC
+	if tzid := prop.params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+	layout := "20060102T150405"
+	if strings.HasSuffix(value, "Z") {
+		layout += "Z"
+		loc = time.UTC
+	}
+	t, err := time.ParseInLocation(layout, value, loc)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid date-time %q", value)
+	}
+	return t, false, nil
+}
+
+// parseICSDuration 解析 DURATION 取值，如 P1D、PT2H30M、P1W，返回天数和不足一天的时长
+func parseICSDuration(value string) (int, time.Duration, error) {
+	s := strings.TrimPrefix(value, "+")
+	if len(s) < 2 || s[0] != 'P' {
+		return 0, 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	var (
+		days    int
+		clock   time.Duration
+		num     int
+		hasNum  bool
+		inClock bool
+		units   int
+	)
+	for _, c := range s[1:] {
+		if c >= '0' && c <= '9' {
+			num = num*10 + int(c-'0')
+			hasNum = true
+			continue
+		}
+		if c == 'T' && !inClock && !hasNum {
+			inClock = true
+			continue
+		}
+		if !hasNum {
+			return 0, 0, fmt.Errorf("invalid duration %q", value)
+		}
+		switch {
+		case !inClock && c == 'W':
+			days += num * 7
+		case !inClock && c == 'D':
+			days += num
+		case inClock && c == 'H':
+			clock += time.Duration(num) * time.Hour
+		case inClock && c == 'M':
+			clock += time.Duration(num) * time.Minute
+		case inClock && c == 'S':
+			clock += time.Duration(num) * time.Second
+		default:
+			return 0, 0, fmt.Errorf("invalid duration %q", value)
+		}
+		num, hasNum = 0, false
+		units++
+	}
+	if hasNum || units == 0 {
+		return 0, 0, fmt.Errorf("invalid duration %q", value)
+	}
+	return days, clock, nil
+}
+
+// unescapeICSText 还原 TEXT 取值中的转义字符
+func unescapeICSText(s string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(s)
+}
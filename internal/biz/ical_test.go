@@ -0,0 +1,227 @@
+package biz
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+)
+
+// icsCalendar 用给定的 VEVENT 属性行拼出 iCalendar 内容
+func icsCalendar(events ...[]string) string {
+	lines := []string{"BEGIN:VCALENDAR", "VERSION:2.0"}
+	for _, event := range events {
+		lines = append(lines, "BEGIN:VEVENT")
+		lines = append(lines, event...)
+		lines = append(lines, "END:VEVENT")
+	}
+	lines = append(lines, "END:VCALENDAR")
+	return strings.Join(lines, "\r\n")
+}
+
+func TestParseICS(t *testing.T) {
+	tests := []struct {
+		name  string
+		event []string
+		want  CalendarRule
+	}{
+		{
+			name:  "all-day single day",
+			event: []string{"SUMMARY:New Year", "DTSTART;VALUE=DATE:20240101", "DTEND;VALUE=DATE:20240102"},
+			want:  CalendarRule{Description: "New Year", StartDate: "2024-01-01"},
+		},
+		{
+			name:  "all-day without end",
+			event: []string{"DTSTART;VALUE=DATE:20240101"},
+			want:  CalendarRule{StartDate: "2024-01-01"},
+		},
+		{
+			name:  "all-day spanning days",
+			event: []string{"DTSTART;VALUE=DATE:20240210", "DTEND;VALUE=DATE:20240217"},
+			want:  CalendarRule{StartDate: "2024-02-10", EndDate: "2024-02-16"},
+		},
+		{
+			name:  "all-day duration",
+			event: []string{"DTSTART;VALUE=DATE:20240501", "DURATION:P3D"},
+			want:  CalendarRule{StartDate: "2024-05-01", EndDate: "2024-05-03"},
+		},
+		{
+			name:  "week duration",
+			event: []string{"DTSTART;VALUE=DATE:20240101", "DURATION:P1W"},
+			want:  CalendarRule{StartDate: "2024-01-01", EndDate: "2024-01-07"},
+		},
+		{
+			name:  "timed duration within a day",
+			event: []string{"DTSTART:20240301T090000Z", "DURATION:PT2H30M"},
+			want:  CalendarRule{StartDate: "2024-03-01"},
+		},
+		{
+			name:  "timed duration past midnight",
+			event: []string{"DTSTART:20240301T220000Z", "DURATION:PT4H"},
+			want:  CalendarRule{StartDate: "2024-03-01", EndDate: "2024-03-02"},
+		},
+		{
+			name:  "timed event ending at midnight",
+			event: []string{"DTSTART:20240301T090000Z", "DTEND:20240302T000000Z"},
+			want:  CalendarRule{StartDate: "2024-03-01"},
+		},
+		{
+			name:  "timezone",
+			event: []string{"DTSTART;TZID=Asia/Shanghai:20240301T070000", "DTEND;TZID=Asia/Shanghai:20240301T080000"},
+			want:  CalendarRule{StartDate: "2024-03-01"},
+		},
+		{
+			name:  "weekly rule with byday and until",
+			event: []string{"DTSTART;VALUE=DATE:20240101", "DTEND;VALUE=DATE:20240102", "RRULE:FREQ=WEEKLY;BYDAY=MO,FR;UNTIL=20241231"},
+			want:  CalendarRule{StartDate: "2024-01-01", EndDate: "2024-12-31", Weekdays: []time.Weekday{time.Monday, time.Friday}},
+		},
+		{
+			name:  "weekly rule defaults to start weekday",
+			event: []string{"DTSTART;VALUE=DATE:20240103", "RRULE:FREQ=WEEKLY;INTERVAL=1"},
+			want:  CalendarRule{StartDate: "2024-01-03", Weekdays: []time.Weekday{time.Wednesday}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := ParseICS(icsCalendar(tt.event), pb.CalendarRuleAction_EXCLUDE)
+			if err != nil {
+				t.Fatalf("ParseICS: %v", err)
+			}
+			if len(rules) != 1 {
+				t.Fatalf("got %d rules, want 1", len(rules))
+			}
+			want := tt.want
+			want.Action = pb.CalendarRuleAction_EXCLUDE
+			if !reflect.DeepEqual(*rules[0], want) {
+				t.Errorf("got %+v, want %+v", *rules[0], want)
+			}
+		})
+	}
+}
+
+func TestParseICSUnfoldsAndUnescapes(t *testing.T) {
+	content := icsCalendar(
+		[]string{"SUMMARY:Spring\\, Festival", " Holiday", "DTSTART;VALUE=DATE:20240210"},
+		[]string{"SUMMARY:Labour Day", "DTSTART;VALUE=DATE:20240501"},
+	)
+	rules, err := ParseICS(content, pb.CalendarRuleAction_INCLUDE)
+	if err != nil {
+		t.Fatalf("ParseICS: %v", err)
+	}
+	if len(rules) != 2 {
+		t.Fatalf("got %d rules, want 2", len(rules))
+	}
+	if rules[0].Description != "Spring, FestivalHoliday" {
+		t.Errorf("description = %q", rules[0].Description)
+	}
+	if rules[1].Description != "Labour Day" || rules[1].Action != pb.CalendarRuleAction_INCLUDE {
+		t.Errorf("second rule = %+v", *rules[1])
+	}
+}
+
+func TestParseICSRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "interval",
+			content: icsCalendar([]string{"DTSTART;VALUE=DATE:20240101", "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO"}),
+			wantErr: "INTERVAL",
+		},
+		{
+			name:    "recurring event spanning days by dtend",
+			content: icsCalendar([]string{"DTSTART;VALUE=DATE:20240105", "DTEND;VALUE=DATE:20240107", "RRULE:FREQ=WEEKLY"}),
+			wantErr: "spanning multiple days",
+		},
+		{
+			name:    "recurring event spanning days by duration",
+			content: icsCalendar([]string{"DTSTART;VALUE=DATE:20240105", "DURATION:P2D", "RRULE:FREQ=WEEKLY"}),
+			wantErr: "spanning multiple days",
+		},
+		{
+			name:    "dtend and duration",
+			content: icsCalendar([]string{"DTSTART;VALUE=DATE:20240101", "DTEND;VALUE=DATE:20240102", "DURATION:P1D"}),
+			wantErr: "both DTEND and DURATION",
+		},
+		{
+			name:    "invalid duration",
+			content: icsCalendar([]string{"DTSTART;VALUE=DATE:20240101", "DURATION:P1H"}),
+			wantErr: "invalid duration",
+		},
+		{
+			name:    "daily frequency",
+			content: icsCalendar([]string{"DTSTART;VALUE=DATE:20240101", "RRULE:FREQ=DAILY"}),
+			wantErr: "only FREQ=WEEKLY",
+		},
+		{
+			name:    "count",
+			content: icsCalendar([]string{"DTSTART;VALUE=DATE:20240101", "RRULE:FREQ=WEEKLY;COUNT=3"}),
+			wantErr: "COUNT",
+		},
+		{
+			name:    "unknown byday",
+			content: icsCalendar([]string{"DTSTART;VALUE=DATE:20240101", "RRULE:FREQ=WEEKLY;BYDAY=1MO"}),
+			wantErr: "BYDAY",
+		},
+		{
+			name:    "missing dtstart",
+			content: icsCalendar([]string{"SUMMARY:No start"}),
+			wantErr: "no DTSTART",
+		},
+		{
+			name:    "unterminated event",
+			content: "BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20240101",
+			wantErr: "unterminated VEVENT",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseICS(tt.content, pb.CalendarRuleAction_EXCLUDE)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseICSDuration(t *testing.T) {
+	tests := []struct {
+		value     string
+		wantDays  int
+		wantClock time.Duration
+		wantErr   bool
+	}{
+		{value: "P1D", wantDays: 1},
+		{value: "+P2W", wantDays: 14},
+		{value: "PT15M", wantClock: 15 * time.Minute},
+		{value: "P1DT2H3M4S", wantDays: 1, wantClock: 2*time.Hour + 3*time.Minute + 4*time.Second},
+		{value: "-P1D", wantErr: true},
+		{value: "P", wantErr: true},
+		{value: "PT", wantErr: true},
+		{value: "P1", wantErr: true},
+		{value: "P1H", wantErr: true},
+		{value: "PT1D", wantErr: true},
+		{value: "1D", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			days, clock, err := parseICSDuration(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %d days %v", days, clock)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseICSDuration: %v", err)
+			}
+			if days != tt.wantDays || clock != tt.wantClock {
+				t.Errorf("got %d days %v, want %d days %v", days, clock, tt.wantDays, tt.wantClock)
+			}
+		})
+	}
+}
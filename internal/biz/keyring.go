@@ -0,0 +1,153 @@
+package biz
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+)
+
+// masterKeySize 主密钥和数据密钥的长度（AES-256）
+const masterKeySize = 32
+
+// EncryptedValue 信封加密的密文：值用随机生成的数据密钥加密，数据密钥用主密钥加密
+type EncryptedValue struct {
+	KeyID        string // 加密数据密钥的主密钥ID
+	EncryptedKey []byte // 加密后的数据密钥
+	Ciphertext   []byte // 加密后的值
+}
+
+// Keyring 主密钥集合，新值使用主用密钥加密，其余密钥只用于解密轮换前加密的值
+type Keyring struct {
+	primary string
+	keys    map[string][]byte
+}
+
+// NewKeyring 创建主密钥集合，keys 为空时不启用密钥
+func NewKeyring(keys map[string][]byte, primary string) (*Keyring, error) {
+	if len(keys) == 0 {
+		return &Keyring{}, nil
+	}
+	for id, key := range keys {
+		if id == "" {
+			return nil, fmt.Errorf("master key id must not be empty")
+		}
+		if len(key) != masterKeySize {
+			return nil, fmt.Errorf("master key %q must be %d bytes, got %d", id, masterKeySize, len(key))
+		}
+	}
+	if _, ok := keys[primary]; !ok {
+		return nil, fmt.Errorf("primary master key %q is not defined", primary)
+	}
+	return &Keyring{primary: primary, keys: keys}, nil
+}
+
+// Enabled 是否配置了主密钥
+func (k *Keyring) Enabled() bool {
+	return k != nil && k.primary != ""
+}
+
+// PrimaryKeyID 主用密钥ID
+func (k *Keyring) PrimaryKeyID() string {
+	return k.primary
+}
+
+// Encrypt 生成数据密钥加密值，再用主用密钥加密数据密钥
+func (k *Keyring) Encrypt(plaintext []byte) (*EncryptedValue, error) {
+	if !k.Enabled() {
+		return nil, ErrSecretsDisabled
+	}
+	dataKey := make([]byte, masterKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, err
+	}
+	ciphertext, err := seal(dataKey, plaintext, nil)
+	if err != nil {
+		return nil, err
+	}
+	encryptedKey, err := seal(k.keys[k.primary], dataKey, []byte(k.primary))
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptedValue{KeyID: k.primary, EncryptedKey: encryptedKey, Ciphertext: ciphertext}, nil
+}
+
+// Decrypt 解密值，加密数据密钥的主密钥必须仍在密钥集合中
+func (k *Keyring) Decrypt(value *EncryptedValue) ([]byte, error) {
+	dataKey, err := k.dataKey(value)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := open(dataKey, value.Ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt value: %w", err)
+	}
+	return plaintext, nil
+}
+
+// Rewrap 用主用密钥重新加密数据密钥，值的密文不变；已使用主用密钥时返回 false
+func (k *Keyring) Rewrap(value *EncryptedValue) (*EncryptedValue, bool, error) {
+	if value.KeyID == k.primary {
+		return value, false, nil
+	}
+	dataKey, err := k.dataKey(value)
+	if err != nil {
+		return nil, false, err
+	}
+	encryptedKey, err := seal(k.keys[k.primary], dataKey, []byte(k.primary))
+	if err != nil {
+		return nil, false, err
+	}
+	return &EncryptedValue{KeyID: k.primary, EncryptedKey: encryptedKey, Ciphertext: value.Ciphertext}, true, nil
+}
+
+// dataKey 解密数据密钥
+func (k *Keyring) dataKey(value *EncryptedValue) ([]byte, error) {
+	if !k.Enabled() {
+		return nil, ErrSecretsDisabled
+	}
+	key, ok := k.keys[value.KeyID]
+	if !ok {
+		return nil, fmt.Errorf("master key %q is not in the key file", value.KeyID)
+	}
+	dataKey, err := open(key, value.EncryptedKey, []byte(value.KeyID))
+	if err != nil {
+		return nil, fmt.Errorf("decrypt data key with master key %q: %w", value.KeyID, err)
+	}
+	return dataKey, nil
+}
+
+// seal 使用 AES-GCM 加密，结果为 nonce 加密文
+func seal(key, plaintext, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open 解密 seal 的结果
+func open(key, sealed, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, fmt.Errorf("ciphertext is too short")
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, additionalData)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
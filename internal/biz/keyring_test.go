@@ -0,0 +1,169 @@
+package biz
+
+import (
+	"bytes"
+	stderrors "errors"
+	"strings"
+	"testing"
+)
+
+// testKey 生成内容为 b 的 32 字节主密钥
+func testKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, masterKeySize)
+}
+
+// mustKeyring 创建主密钥集合，失败时终止测试
+func mustKeyring(t *testing.T, keys map[string][]byte, primary string) *Keyring {
+	t.Helper()
+	k, err := NewKeyring(keys, primary)
+	if err != nil {
+		t.Fatalf("NewKeyring: %v", err)
+	}
+	return k
+}
+
+func TestNewKeyring(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    map[string][]byte
+		primary string
+		wantErr string
+	}{
+		{name: "disabled", keys: nil},
+		{name: "valid", keys: map[string][]byte{"k1": testKey(1)}, primary: "k1"},
+		{name: "empty id", keys: map[string][]byte{"": testKey(1)}, wantErr: "must not be empty"},
+		{name: "short key", keys: map[string][]byte{"k1": testKey(1)[:16]}, primary: "k1", wantErr: "must be 32 bytes"},
+		{name: "unknown primary", keys: map[string][]byte{"k1": testKey(1)}, primary: "k2", wantErr: "is not defined"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := NewKeyring(tt.keys, tt.primary)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewKeyring: %v", err)
+			}
+			if k.Enabled() != (len(tt.keys) > 0) {
+				t.Errorf("Enabled = %v", k.Enabled())
+			}
+		})
+	}
+}
+
+func TestKeyringEncryptDecrypt(t *testing.T) {
+	k := mustKeyring(t, map[string][]byte{"k1": testKey(1)}, "k1")
+	plaintext := []byte("s3cret-value")
+
+	a, err := k.Encrypt(plaintext)
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	if a.KeyID != "k1" {
+		t.Errorf("KeyID = %q, want k1", a.KeyID)
+	}
+	if bytes.Contains(a.Ciphertext, plaintext) {
+		t.Errorf("ciphertext contains the plaintext")
+	}
+	b, err := k.Encrypt(plaintext)
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	if bytes.Equal(a.Ciphertext, b.Ciphertext) || bytes.Equal(a.EncryptedKey, b.EncryptedKey) {
+		t.Errorf("encrypting twice produced the same ciphertext")
+	}
+
+	for _, value := range []*EncryptedValue{a, b} {
+		got, err := k.Decrypt(value)
+		if err != nil {
+			t.Fatalf("Decrypt: %v", err)
+		}
+		if !bytes.Equal(got, plaintext) {
+			t.Errorf("Decrypt = %q, want %q", got, plaintext)
+		}
+	}
+}
+
+func TestKeyringDecryptRejectsTampering(t *testing.T) {
+	k := mustKeyring(t, map[string][]byte{"k1": testKey(1), "k2": testKey(2)}, "k1")
+	value, err := k.Encrypt([]byte("value"))
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+
+	flipped := *value
+	flipped.Ciphertext = append([]byte(nil), value.Ciphertext...)
+	flipped.Ciphertext[len(flipped.Ciphertext)-1] ^= 1
+	if _, err := k.Decrypt(&flipped); err == nil {
+		t.Errorf("Decrypt accepted a modified ciphertext")
+	}
+
+	// 数据密钥绑定了主密钥ID，改写 KeyID 后无法解密
+	relabelled := *value
+	relabelled.KeyID = "k2"
+	if _, err := k.Decrypt(&relabelled); err == nil {
+		t.Errorf("Decrypt accepted a data key relabelled with another master key")
+	}
+
+	unknown := *value
+	unknown.KeyID = "k9"
+	if _, err := k.Decrypt(&unknown); err == nil || !strings.Contains(err.Error(), "not in the key file") {
+		t.Errorf("err = %v, want unknown master key", err)
+	}
+
+	short := *value
+	short.Ciphertext = []byte{1, 2, 3}
+	if _, err := k.Decrypt(&short); err == nil {
+		t.Errorf("Decrypt accepted a truncated ciphertext")
+	}
+}
+
+func TestKeyringDisabled(t *testing.T) {
+	k := mustKeyring(t, nil, "")
+	if _, err := k.Encrypt([]byte("value")); !stderrors.Is(err, ErrSecretsDisabled) {
+		t.Errorf("Encrypt err = %v, want ErrSecretsDisabled", err)
+	}
+	if _, err := k.Decrypt(&EncryptedValue{KeyID: "k1"}); !stderrors.Is(err, ErrSecretsDisabled) {
+		t.Errorf("Decrypt err = %v, want ErrSecretsDisabled", err)
+	}
+}
+
+func TestKeyringRewrap(t *testing.T) {
+	old := mustKeyring(t, map[string][]byte{"k1": testKey(1)}, "k1")
+	value, err := old.Encrypt([]byte("value"))
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+
+	if same, changed, err := old.Rewrap(value); err != nil || changed || same != value {
+		t.Errorf("Rewrap with the same primary = %v, %v, %v, want unchanged", same, changed, err)
+	}
+
+	rotated := mustKeyring(t, map[string][]byte{"k1": testKey(1), "k2": testKey(2)}, "k2")
+	rewrapped, changed, err := rotated.Rewrap(value)
+	if err != nil || !changed {
+		t.Fatalf("Rewrap = %v, %v", changed, err)
+	}
+	if rewrapped.KeyID != "k2" {
+		t.Errorf("KeyID = %q, want k2", rewrapped.KeyID)
+	}
+	if !bytes.Equal(rewrapped.Ciphertext, value.Ciphertext) {
+		t.Errorf("Rewrap changed the value ciphertext")
+	}
+
+	// 轮换完成后移除旧密钥，重新加密的值仍可解密
+	retired := mustKeyring(t, map[string][]byte{"k2": testKey(2)}, "k2")
+	got, err := retired.Decrypt(rewrapped)
+	if err != nil || string(got) != "value" {
+		t.Errorf("Decrypt after retiring the old key = %q, %v", got, err)
+	}
+	if _, err := retired.Decrypt(value); err == nil {
+		t.Errorf("Decrypt succeeded for a value still wrapped with the retired key")
+	}
+	if _, _, err := retired.Rewrap(value); err == nil {
+		t.Errorf("Rewrap succeeded without the old master key")
+	}
+}
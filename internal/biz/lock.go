@@ -0,0 +1,29 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// lockGracePeriod 互斥锁在任务超时时间之外额外保留的时长，节点异常退出时锁在过期后自动释放
+	lockGracePeriod = time.Minute
+	// reservedLockPrefix 调度器内部使用的锁名称前缀，任务的互斥组不能使用
+	reservedLockPrefix = "__"
+)
+
+// LockRepo 集群互斥锁仓储接口
+type LockRepo interface {
+	// AcquireLock 尝试获取互斥组的锁，锁不存在或已过期时获取成功
+	AcquireLock(ctx context.Context, group, owner string, ttl time.Duration) (bool, error)
+
+	// AcquireSlot 尝试获取 prefix 后接 0 到 size-1 编号的一组锁中的任意一个，返回获取到的锁名称
+	// 未过期的同前缀锁不少于 size 个时获取失败，用于限制集群内的并发数
+	AcquireSlot(ctx context.Context, prefix string, size int, owner string, ttl time.Duration) (string, bool, error)
+
+	// ReleaseLock 释放由 owner 持有的互斥组锁
+	ReleaseLock(ctx context.Context, group, owner string) error
+
+	// ReleaseOwnerLocks 释放 owner 持有的全部锁
+	ReleaseOwnerLocks(ctx context.Context, owner string) error
+}
@@ -0,0 +1,167 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// DefaultNamespace 默认命名空间，创建任务时未指定命名空间的任务属于该命名空间
+const DefaultNamespace = "default"
+
+var (
+	// ErrNamespaceNotFound 命名空间不存在
+	ErrNamespaceNotFound = errors.NotFound(pb.ErrorReason_NAMESPACE_NOT_FOUND.String(), "namespace not found")
+	// ErrNamespaceExists 命名空间已存在
+	ErrNamespaceExists = errors.Conflict(pb.ErrorReason_NAMESPACE_EXISTS.String(), "namespace already exists")
+	// ErrNamespaceNotEmpty 命名空间中还有任务（包括已删除的任务）或密钥，不能删除
+	ErrNamespaceNotEmpty = errors.Conflict(pb.ErrorReason_NAMESPACE_NOT_EMPTY.String(), "namespace still has tasks or secrets, purge or delete them first")
+	// ErrDefaultNamespace 默认命名空间不能删除
+	ErrDefaultNamespace = errors.BadRequest(pb.ErrorReason_DEFAULT_NAMESPACE.String(), "the default namespace cannot be deleted")
+	// ErrNamespaceMismatch 请求的命名空间与请求头 X-Namespace 不一致
+	ErrNamespaceMismatch = errors.BadRequest(pb.ErrorReason_NAMESPACE_MISMATCH.String(), "namespace does not match the request namespace")
+)
+
+// namespaceQuotaExceeded 超出命名空间配额，HTTP 429，gRPC ResourceExhausted
+func namespaceQuotaExceeded(namespace, quota string, limit int32) error {
+	return errors.New(429, pb.ErrorReason_NAMESPACE_QUOTA_EXCEEDED.String(), fmt.Sprintf("namespace %q reached its %s quota of %d", namespace, quota, limit)).
+		WithMetadata(map[string]string{"namespace": namespace, "quota": quota})
+}
+
+// namespaceNamePattern 命名空间名称：小写字母、数字和连字符，以字母或数字开头和结尾，最长 63 个字符
+var namespaceNamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// Namespace 命名空间业务模型，隔离不同团队的任务和执行记录，配额为 0 表示不限制
+type Namespace struct {
+	ID                      int64
+	Name                    string
+	Description             string
+	MaxTasks                int32 // 最大任务数（不包括已删除的任务）
+	MaxConcurrentExecutions int32 // 最大同时执行数，在所有工作节点上合计
+	MaxExecutionsPerMinute  int32 // 每分钟最多开始执行的次数，在所有工作节点上合计
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
+}
+
+// NamespaceListFilter 命名空间列表过滤条件
+type NamespaceListFilter struct {
+	Page     int32
+	PageSize int32
+	Keyword  string
+}
+
+// NamespaceUsage 命名空间的执行记录用量
+type NamespaceUsage struct {
+	Running      int64 // 执行中的记录数
+	StartedSince int64 // 指定时间之后开始执行的记录数
+}
+
+// NamespaceRepo 命名空间仓储接口
+type NamespaceRepo interface {
+	// CreateNamespace 创建命名空间
+	CreateNamespace(ctx context.Context, namespace *Namespace) (*Namespace, error)
+
+	// GetNamespace 按名称获取命名空间，不存在时返回 nil
+	GetNamespace(ctx context.Context, name string) (*Namespace, error)
+
+	// UpdateNamespace 更新命名空间的描述和配额，不存在时返回 nil
+	UpdateNamespace(ctx context.Context, namespace *Namespace) (*Namespace, error)
+
+	// DeleteNamespace 删除命名空间
+	DeleteNamespace(ctx context.Context, name string) error
+
+	// ListNamespaces 命名空间列表查询，按名称排序
+	ListNamespaces(ctx context.Context, filter *NamespaceListFilter) ([]*Namespace, int64, error)
+
+	// ListAllNamespaces 获取全部命名空间，供执行器检查配额
+	ListAllNamespaces(ctx context.Context) ([]*Namespace, error)
+}
+
+// Validate 校验命名空间
+func (n *Namespace) Validate() error {
+	if !namespaceNamePattern.MatchString(n.Name) {
+		return fmt.Errorf("name must consist of lower case letters, digits and '-', start and end with a letter or digit and be at most 63 characters")
+	}
+	if n.MaxTasks < 0 || n.MaxConcurrentExecutions < 0 || n.MaxExecutionsPerMinute < 0 {
+		return fmt.Errorf("quotas must not be negative")
+	}
+	return nil
+}
+
+// HasExecutionQuota 是否限制执行记录的并发数或速率
+func (n *Namespace) HasExecutionQuota() bool {
+	return n.MaxConcurrentExecutions > 0 || n.MaxExecutionsPerMinute > 0
+}
+
+type namespaceKey struct{}
+
+// NewNamespaceContext 返回限定命名空间的上下文，仓储只读写该命名空间中的任务和执行记录
+func NewNamespaceContext(ctx context.Context, namespace string) context.Context {
+	return context.WithValue(ctx, namespaceKey{}, namespace)
+}
+
+// NamespaceFromContext 返回上下文限定的命名空间，未限定时返回 false（后台任务可以访问所有命名空间）
+func NamespaceFromContext(ctx context.Context) (string, bool) {
+	namespace, ok := ctx.Value(namespaceKey{}).(string)
+	return namespace, ok && namespace != ""
+}
+
+// ResolveNamespace 返回新任务所属的命名空间：请求指定的命名空间、上下文限定的命名空间或默认命名空间
+func ResolveNamespace(ctx context.Context, requested string) (string, error) {
+	scoped, ok := NamespaceFromContext(ctx)
+	switch {
+	case ok && requested != "" && requested != scoped:
+		return "", ErrNamespaceMismatch
+	case requested != "":
+		return requested, nil
+	case ok:
+		return scoped, nil
+	default:
+		return DefaultNamespace, nil
+	}
+}
+
+// namespaceQuotas 一次认领中各命名空间的执行配额和用量
+// 用量在首次用到时从执行记录统计，之后按本次认领成功的记录累加；多个节点同时认领时配额为近似限制
+type namespaceQuotas struct {
+	repo       ExecutionRepo
+	namespaces map[string]*Namespace
+	usage      map[string]*NamespaceUsage
+	since      time.Time
+}
+
+// allow 判断命名空间是否还能开始一次执行
+func (q *namespaceQuotas) allow(ctx context.Context, name string) (bool, error) {
+	namespace, ok := q.namespaces[name]
+	if !ok {
+		return true, nil
+	}
+	usage, ok := q.usage[name]
+	if !ok {
+		var err error
+		if usage, err = q.repo.GetNamespaceUsage(ctx, name, q.since); err != nil {
+			return false, err
+		}
+		q.usage[name] = usage
+	}
+	if namespace.MaxConcurrentExecutions > 0 && usage.Running >= int64(namespace.MaxConcurrentExecutions) {
+		return false, nil
+	}
+	if namespace.MaxExecutionsPerMinute > 0 && usage.StartedSince >= int64(namespace.MaxExecutionsPerMinute) {
+		return false, nil
+	}
+	return true, nil
+}
+
+// add 记录命名空间开始了一次执行
+func (q *namespaceQuotas) add(name string) {
+	if usage, ok := q.usage[name]; ok {
+		usage.Running++
+		usage.StartedSince++
+	}
+}
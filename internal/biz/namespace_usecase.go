@@ -0,0 +1,106 @@
+package biz
+
+import (
+	"context"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NamespaceUsecase 命名空间用例
+type NamespaceUsecase struct {
+	repo       NamespaceRepo
+	taskRepo   TaskRepo
+	secretRepo SecretRepo
+	log        *log.Helper
+}
+
+// NewNamespaceUsecase 创建命名空间用例实例
+func NewNamespaceUsecase(repo NamespaceRepo, taskRepo TaskRepo, secretRepo SecretRepo, logger log.Logger) *NamespaceUsecase {
+	return &NamespaceUsecase{
+		repo:       repo,
+		taskRepo:   taskRepo,
+		secretRepo: secretRepo,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// CreateNamespace 创建命名空间
+func (uc *NamespaceUsecase) CreateNamespace(ctx context.Context, namespace *Namespace) (*Namespace, error) {
+	uc.log.WithContext(ctx).Infof("CreateNamespace: %s", namespace.Name)
+
+	if err := namespace.Validate(); err != nil {
+		return nil, errors.BadRequest(pb.ErrorReason_INVALID_NAMESPACE.String(), err.Error())
+	}
+	existing, err := uc.repo.GetNamespace(ctx, namespace.Name)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrNamespaceExists
+	}
+	return uc.repo.CreateNamespace(ctx, namespace)
+}
+
+// GetNamespace 获取命名空间详情
+func (uc *NamespaceUsecase) GetNamespace(ctx context.Context, name string) (*Namespace, error) {
+	namespace, err := uc.repo.GetNamespace(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if namespace == nil {
+		return nil, ErrNamespaceNotFound
+	}
+	return namespace, nil
+}
+
+// UpdateNamespace 更新命名空间的描述和配额，配额只限制之后创建的任务和开始的执行
+func (uc *NamespaceUsecase) UpdateNamespace(ctx context.Context, namespace *Namespace) (*Namespace, error) {
+	uc.log.WithContext(ctx).Infof("UpdateNamespace: %s", namespace.Name)
+
+	if err := namespace.Validate(); err != nil {
+		return nil, errors.BadRequest(pb.ErrorReason_INVALID_NAMESPACE.String(), err.Error())
+	}
+	updated, err := uc.repo.UpdateNamespace(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrNamespaceNotFound
+	}
+	return updated, nil
+}
+
+// DeleteNamespace 删除命名空间，默认命名空间和还有任务或密钥的命名空间不能删除
+func (uc *NamespaceUsecase) DeleteNamespace(ctx context.Context, name string) error {
+	uc.log.WithContext(ctx).Infof("DeleteNamespace: %s", name)
+
+	if name == DefaultNamespace {
+		return ErrDefaultNamespace
+	}
+	if _, err := uc.GetNamespace(ctx, name); err != nil {
+		return err
+	}
+	count, err := uc.taskRepo.CountTasks(NewNamespaceContext(ctx, name), name, true)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrNamespaceNotEmpty
+	}
+	secrets, err := uc.secretRepo.CountSecrets(NewNamespaceContext(ctx, name), name)
+	if err != nil {
+		return err
+	}
+	if secrets > 0 {
+		return ErrNamespaceNotEmpty
+	}
+	return uc.repo.DeleteNamespace(ctx, name)
+}
+
+// ListNamespaces 命名空间列表查询
+func (uc *NamespaceUsecase) ListNamespaces(ctx context.Context, filter *NamespaceListFilter) ([]*Namespace, int64, error) {
+	return uc.repo.ListNamespaces(ctx, filter)
+}
@@ -0,0 +1,200 @@
+package biz
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 列表排序字段
+const (
+	OrderByCreatedAt   = "created_at"
+	OrderByNextRunTime = "next_run_time"
+	OrderByName        = "name"
+	OrderByDuration    = "duration"
+)
+
+var (
+	// taskOrderFields 任务列表支持的排序字段
+	taskOrderFields = []string{OrderByCreatedAt, OrderByNextRunTime, OrderByName}
+	// executionOrderFields 执行记录列表支持的排序字段
+	executionOrderFields = []string{OrderByCreatedAt, OrderByDuration}
+)
+
+// UnscheduledSortTime 没有下次执行时间的任务按下次执行时间排序时使用的值，升序时排在最后
+var UnscheduledSortTime = time.Date(9999, 12, 31, 0, 0, 0, 0, time.Local)
+
+// ListOrder 列表排序，先按 Field 排序，值相同时按 ID 同向排序，保证分页顺序稳定
+// 零值表示按 ID 倒序
+type ListOrder struct {
+	Field string // 排序字段，为空时只按 ID 排序
+	Asc   bool   // 是否升序，默认倒序
+}
+
+// String 返回 "<字段> asc|desc" 形式的排序
+func (o ListOrder) String() string {
+	field := o.Field
+	if field == "" {
+		field = "id"
+	}
+	if o.Asc {
+		return field + " asc"
+	}
+	return field + " desc"
+}
+
+// parseListOrder 解析 order_by，格式为 "<字段>" 或 "<字段> asc|desc"，未指定方向时倒序，为空时按创建时间倒序
+func parseListOrder(orderBy string, fields []string) (ListOrder, error) {
+	parts := strings.Fields(strings.ToLower(orderBy))
+	if len(parts) == 0 {
+		return ListOrder{Field: OrderByCreatedAt}, nil
+	}
+	if len(parts) > 2 {
+		return ListOrder{}, invalidOrderBy(orderBy, fields)
+	}
+
+	order := ListOrder{Field: parts[0]}
+	if len(parts) == 2 {
+		switch parts[1] {
+		case "asc":
+			order.Asc = true
+		case "desc":
+		default:
+			return ListOrder{}, invalidOrderBy(orderBy, fields)
+		}
+	}
+	for _, field := range fields {
+		if field == order.Field {
+			return order, nil
+		}
+	}
+	return ListOrder{}, invalidOrderBy(orderBy, fields)
+}
+
+// invalidOrderBy 不支持的排序
+func invalidOrderBy(orderBy string, fields []string) error {
+	return errors.BadRequest(pb.ErrorReason_INVALID_ORDER_BY.String(),
+		fmt.Sprintf("invalid order_by %q, supported fields: %s, optionally followed by asc or desc", orderBy, strings.Join(fields, ", ")))
+}
+
+// PageCursor 游标分页的位置，即上一页最后一条记录的排序字段值和 ID
+type PageCursor struct {
+	Value interface{} // 排序字段的值，时间字段为 time.Time，耗时为 int64，名称为 string；按 ID 排序时为 nil
+	ID    int64
+}
+
+// pageToken 编码在 page_token 中的游标，order 和 filter 用于拒绝排序或筛选条件变化后继续使用的 token
+type pageToken struct {
+	Order  string `json:"o"`
+	Filter string `json:"f"`
+	Value  string `json:"v,omitempty"`
+	ID     int64  `json:"i"`
+}
+
+// encodePageToken 将排在下一页之前的最后一条记录编码为不透明的 page_token
+func encodePageToken(order ListOrder, filter string, value interface{}, id int64) string {
+	token := pageToken{Order: order.String(), Filter: filter, ID: id}
+	switch v := value.(type) {
+	case time.Time:
+		token.Value = v.UTC().Format(time.RFC3339Nano)
+	case int64:
+		token.Value = strconv.FormatInt(v, 10)
+	case string:
+		token.Value = v
+	}
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken 解析 page_token，token 不是本服务签发的、或排序和筛选条件与签发时不同返回 INVALID_PAGE_TOKEN
+func decodePageToken(s string, order ListOrder, filter string) (*PageCursor, error) {
+	var token pageToken
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err == nil {
+		err = json.Unmarshal(data, &token)
+	}
+	if err != nil || token.ID <= 0 {
+		return nil, invalidPageToken("malformed page_token")
+	}
+	if token.Order != order.String() || token.Filter != filter {
+		return nil, invalidPageToken("page_token does not match the order_by and filters of the request")
+	}
+
+	cursor := &PageCursor{ID: token.ID}
+	switch order.Field {
+	case OrderByCreatedAt, OrderByNextRunTime:
+		t, err := time.Parse(time.RFC3339Nano, token.Value)
+		if err != nil {
+			return nil, invalidPageToken("malformed page_token")
+		}
+		// 与写入的时间使用相同的时区，SQLite 按字符串比较时间
+		cursor.Value = t.In(time.Local)
+	case OrderByDuration:
+		n, err := strconv.ParseInt(token.Value, 10, 64)
+		if err != nil {
+			return nil, invalidPageToken("malformed page_token")
+		}
+		cursor.Value = n
+	case OrderByName:
+		cursor.Value = token.Value
+	}
+	return cursor, nil
+}
+
+// invalidPageToken 无法使用的 page_token
+func invalidPageToken(message string) error {
+	return errors.BadRequest(pb.ErrorReason_INVALID_PAGE_TOKEN.String(), message)
+}
+
+// filterFingerprint 筛选条件的摘要，记录在 page_token 中
+func filterFingerprint(fields ...interface{}) string {
+	h := fnv.New32a()
+	fmt.Fprintf(h, "%#v", fields)
+	return strconv.FormatUint(uint64(h.Sum32()), 36)
+}
+
+// pageCursorFor 解析分页参数：page_token 不为空时按游标分页，不能与页码同时使用
+func pageCursorFor(page int32, token string, order ListOrder, filter string) (*PageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	if page > 0 {
+		return nil, invalidPageToken("page and page_token cannot be used together")
+	}
+	return decodePageToken(token, order, filter)
+}
+
+// TaskSortValue 任务在排序字段上的值，没有下次执行时间时为 UnscheduledSortTime
+func TaskSortValue(task *Task, field string) interface{} {
+	switch field {
+	case OrderByCreatedAt:
+		return task.CreatedAt
+	case OrderByNextRunTime:
+		if task.NextRunTime == nil {
+			return UnscheduledSortTime
+		}
+		return *task.NextRunTime
+	case OrderByName:
+		return task.Name
+	}
+	return nil
+}
+
+// ExecutionSortValue 执行记录在排序字段上的值
+func ExecutionSortValue(execution *TaskExecution, field string) interface{} {
+	switch field {
+	case OrderByCreatedAt:
+		return execution.CreatedAt
+	case OrderByDuration:
+		return int64(execution.Duration)
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package biz
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestParseListOrder(t *testing.T) {
+	tests := []struct {
+		orderBy string
+		want    ListOrder
+		wantErr bool
+	}{
+		{orderBy: "", want: ListOrder{Field: OrderByCreatedAt}},
+		{orderBy: "name", want: ListOrder{Field: OrderByName}},
+		{orderBy: "  Name   ASC ", want: ListOrder{Field: OrderByName, Asc: true}},
+		{orderBy: "next_run_time desc", want: ListOrder{Field: OrderByNextRunTime}},
+		{orderBy: "duration", wantErr: true},
+		{orderBy: "name up", wantErr: true},
+		{orderBy: "name asc extra", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.orderBy, func(t *testing.T) {
+			got, err := parseListOrder(tt.orderBy, taskOrderFields)
+			if tt.wantErr {
+				if errors.Reason(err) != pb.ErrorReason_INVALID_ORDER_BY.String() {
+					t.Fatalf("err = %v, want INVALID_ORDER_BY", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseListOrder: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListOrderString(t *testing.T) {
+	tests := []struct {
+		order ListOrder
+		want  string
+	}{
+		{ListOrder{}, "id desc"},
+		{ListOrder{Asc: true}, "id asc"},
+		{ListOrder{Field: OrderByName}, "name desc"},
+		{ListOrder{Field: OrderByDuration, Asc: true}, "duration asc"},
+	}
+	for _, tt := range tests {
+		if got := tt.order.String(); got != tt.want {
+			t.Errorf("%+v.String() = %q, want %q", tt.order, got, tt.want)
+		}
+	}
+}
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC)
+	tests := []struct {
+		name  string
+		order ListOrder
+		value interface{}
+	}{
+		{"id", ListOrder{}, nil},
+		{"created_at", ListOrder{Field: OrderByCreatedAt}, created},
+		{"next_run_time", ListOrder{Field: OrderByNextRunTime, Asc: true}, UnscheduledSortTime},
+		{"name", ListOrder{Field: OrderByName, Asc: true}, "nightly backup"},
+		{"duration", ListOrder{Field: OrderByDuration}, int64(1500)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := filterFingerprint("ns", int32(1))
+			token := encodePageToken(tt.order, filter, tt.value, 42)
+			cursor, err := decodePageToken(token, tt.order, filter)
+			if err != nil {
+				t.Fatalf("decodePageToken: %v", err)
+			}
+			if cursor.ID != 42 {
+				t.Errorf("ID = %d, want 42", cursor.ID)
+			}
+			if want, ok := tt.value.(time.Time); ok {
+				got, ok := cursor.Value.(time.Time)
+				if !ok || !got.Equal(want) {
+					t.Errorf("Value = %v, want %v", cursor.Value, want)
+				}
+				if got.Location() != time.Local {
+					t.Errorf("Value location = %v, want Local", got.Location())
+				}
+				return
+			}
+			if !reflect.DeepEqual(cursor.Value, tt.value) {
+				t.Errorf("Value = %#v, want %#v", cursor.Value, tt.value)
+			}
+		})
+	}
+}
+
+func TestDecodePageTokenRejects(t *testing.T) {
+	order := ListOrder{Field: OrderByName}
+	filter := filterFingerprint("default")
+	valid := encodePageToken(order, filter, "a", 7)
+
+	tests := []struct {
+		name   string
+		token  string
+		order  ListOrder
+		filter string
+	}{
+		{"not base64", "***", order, filter},
+		{"not json", base64.RawURLEncoding.EncodeToString([]byte("{")), order, filter},
+		{"missing id", encodePageToken(order, filter, "a", 0), order, filter},
+		{"different order", valid, ListOrder{Field: OrderByName, Asc: true}, filter},
+		{"different filter", valid, order, filterFingerprint("other")},
+		{"bad time", encodePageToken(ListOrder{Field: OrderByCreatedAt}, filter, "yesterday", 7), ListOrder{Field: OrderByCreatedAt}, filter},
+		{"bad duration", encodePageToken(ListOrder{Field: OrderByDuration}, filter, "long", 7), ListOrder{Field: OrderByDuration}, filter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decodePageToken(tt.token, tt.order, tt.filter)
+			if errors.Reason(err) != pb.ErrorReason_INVALID_PAGE_TOKEN.String() {
+				t.Fatalf("err = %v, want INVALID_PAGE_TOKEN", err)
+			}
+		})
+	}
+}
+
+func TestPageCursorFor(t *testing.T) {
+	order := ListOrder{Field: OrderByCreatedAt}
+	token := encodePageToken(order, "f", time.Now(), 3)
+
+	if cursor, err := pageCursorFor(2, "", order, "f"); cursor != nil || err != nil {
+		t.Errorf("without token = %v, %v, want nil, nil", cursor, err)
+	}
+	if _, err := pageCursorFor(2, token, order, "f"); errors.Reason(err) != pb.ErrorReason_INVALID_PAGE_TOKEN.String() {
+		t.Errorf("page with token err = %v, want INVALID_PAGE_TOKEN", err)
+	}
+	cursor, err := pageCursorFor(0, token, order, "f")
+	if err != nil || cursor == nil || cursor.ID != 3 {
+		t.Errorf("token only = %+v, %v", cursor, err)
+	}
+}
+
+func TestFilterFingerprint(t *testing.T) {
+	if filterFingerprint("a", int32(1)) != filterFingerprint("a", int32(1)) {
+		t.Errorf("fingerprint is not deterministic")
+	}
+	if filterFingerprint("a", int32(1)) == filterFingerprint("a", int32(2)) {
+		t.Errorf("different filters share a fingerprint")
+	}
+}
+
+func TestSortValues(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	task := &Task{Name: "report", CreatedAt: created}
+	if got := TaskSortValue(task, OrderByNextRunTime); got != UnscheduledSortTime {
+		t.Errorf("unscheduled next_run_time sort value = %v", got)
+	}
+	next := created.Add(time.Hour)
+	task.NextRunTime = &next
+	if got := TaskSortValue(task, OrderByNextRunTime); got != next {
+		t.Errorf("next_run_time sort value = %v, want %v", got, next)
+	}
+	if got := TaskSortValue(task, OrderByName); got != "report" {
+		t.Errorf("name sort value = %v", got)
+	}
+	if got := TaskSortValue(task, ""); got != nil {
+		t.Errorf("id sort value = %v, want nil", got)
+	}
+
+	execution := &TaskExecution{Duration: 250, CreatedAt: created}
+	if got := ExecutionSortValue(execution, OrderByDuration); got != int64(250) {
+		t.Errorf("duration sort value = %#v", got)
+	}
+	if got := ExecutionSortValue(execution, OrderByCreatedAt); got != created {
+		t.Errorf("created_at sort value = %v", got)
+	}
+}
@@ -0,0 +1,203 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+var (
+	// ErrResourcePoolNotFound 资源池不存在
+	ErrResourcePoolNotFound = errors.NotFound(pb.ErrorReason_RESOURCE_POOL_NOT_FOUND.String(), "resource pool not found")
+)
+
+// ResourcePool 资源池，限制匹配任务的并发数和执行速率
+// 通过 Handler 匹配处理器，或通过 LabelKey/LabelValue 匹配任务元数据标签
+type ResourcePool struct {
+	ID             int64
+	Name           string
+	Description    string
+	Handler        string
+	LabelKey       string
+	LabelValue     string
+	MaxConcurrency int32   // 集群内的最大并发执行数，0 表示不限制
+	RateLimit      float64 // 每个工作节点上每秒允许开始的执行数，0 表示不限制
+	Burst          int32   // 令牌桶容量，默认为 1
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// ResourcePoolListFilter 资源池列表过滤条件
+type ResourcePoolListFilter struct {
+	Page     int32
+	PageSize int32
+	Keyword  string
+}
+
+// ResourcePoolRepo 资源池仓储接口
+type ResourcePoolRepo interface {
+	// CreateResourcePool 创建资源池
+	CreateResourcePool(ctx context.Context, pool *ResourcePool) (*ResourcePool, error)
+
+	// GetResourcePool 获取资源池详情
+	GetResourcePool(ctx context.Context, id int64) (*ResourcePool, error)
+
+	// UpdateResourcePool 更新资源池
+	UpdateResourcePool(ctx context.Context, pool *ResourcePool) (*ResourcePool, error)
+
+	// DeleteResourcePool 删除资源池
+	DeleteResourcePool(ctx context.Context, id int64) error
+
+	// ListResourcePools 资源池列表查询
+	ListResourcePools(ctx context.Context, filter *ResourcePoolListFilter) ([]*ResourcePool, int64, error)
+
+	// ListAllResourcePools 查询全部资源池
+	ListAllResourcePools(ctx context.Context) ([]*ResourcePool, error)
+}
+
+// Validate 校验资源池配置
+func (p *ResourcePool) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if p.Handler == "" && p.LabelKey == "" {
+		return fmt.Errorf("either handler or label_key is required")
+	}
+	if p.Handler != "" && p.LabelKey != "" {
+		return fmt.Errorf("handler and label_key are mutually exclusive")
+	}
+	if p.MaxConcurrency < 0 {
+		return fmt.Errorf("max_concurrency must not be negative")
+	}
+	if p.RateLimit < 0 {
+		return fmt.Errorf("rate_limit must not be negative")
+	}
+	if p.Burst < 0 {
+		return fmt.Errorf("burst must not be negative")
+	}
+	if p.MaxConcurrency == 0 && p.RateLimit == 0 {
+		return fmt.Errorf("at least one of max_concurrency and rate_limit is required")
+	}
+	return nil
+}
+
+// Matches 判断任务是否受该资源池限制
+func (p *ResourcePool) Matches(task *Task) bool {
+	if p.Handler != "" {
+		return task.Handler == p.Handler
+	}
+	value, ok := task.Metadata[p.LabelKey]
+	if !ok {
+		return false
+	}
+	return p.LabelValue == "" || value == p.LabelValue
+}
+
+// tokenBucket 令牌桶限速器
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// refill 按经过的时间补充令牌
+func (b *tokenBucket) refill(now time.Time) {
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+	}
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+}
+
+// PoolLimiter 资源池速率限制器，在执行记录开始执行前从令牌桶中取出令牌
+// 速率限制在每个工作节点上独立生效，并发数通过集群锁在整个集群内限制
+type PoolLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// NewPoolLimiter 创建资源池速率限制器
+func NewPoolLimiter() *PoolLimiter {
+	return &PoolLimiter{buckets: make(map[string]*tokenBucket)}
+}
+
+// TryTake 尝试同时从多个资源池的令牌桶中各取出一个令牌，任一资源池令牌不足时不取出
+func (l *PoolLimiter) TryTake(pools []*ResourcePool, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	buckets := make([]*tokenBucket, 0, len(pools))
+	for _, pool := range pools {
+		bucket := l.bucket(pool)
+		if bucket == nil {
+			continue
+		}
+		bucket.refill(now)
+		if bucket.tokens < 1 {
+			return false
+		}
+		buckets = append(buckets, bucket)
+	}
+
+	for _, bucket := range buckets {
+		bucket.tokens--
+	}
+	return true
+}
+
+// Refund 归还 TryTake 取出的令牌，用于执行记录最终未能开始执行的情况
+func (l *PoolLimiter) Refund(pools []*ResourcePool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, pool := range pools {
+		if bucket := l.bucket(pool); bucket != nil {
+			bucket.tokens = math.Min(bucket.tokens+1, bucket.burst)
+		}
+	}
+}
+
+// bucket 获取资源池的令牌桶，并同步最新的速率配置，未限制速率时返回 nil
+func (l *PoolLimiter) bucket(pool *ResourcePool) *tokenBucket {
+	if pool.RateLimit <= 0 {
+		delete(l.buckets, pool.Name)
+		return nil
+	}
+	burst := float64(pool.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	bucket, ok := l.buckets[pool.Name]
+	if !ok {
+		bucket = &tokenBucket{tokens: burst}
+		l.buckets[pool.Name] = bucket
+	}
+	bucket.rate = pool.RateLimit
+	bucket.burst = burst
+	return bucket
+}
+
+// poolSlotPrefix 资源池并发槽位的锁名称前缀，每个执行中的记录持有其中一个编号
+func poolSlotPrefix(pool *ResourcePool) string {
+	return fmt.Sprintf("%spool/%d/", reservedLockPrefix, pool.ID)
+}
+
+// matchPools 返回限制该任务的资源池
+func matchPools(pools []*ResourcePool, task *Task) []*ResourcePool {
+	var matched []*ResourcePool
+	for _, pool := range pools {
+		if pool.Matches(task) {
+			matched = append(matched, pool)
+		}
+	}
+	return matched
+}
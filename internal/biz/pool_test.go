@@ -0,0 +1,104 @@
+package biz
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestResourcePoolValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		pool    ResourcePool
+		wantErr string
+	}{
+		{name: "handler", pool: ResourcePool{Name: "api", Handler: "http", MaxConcurrency: 2}},
+		{name: "label", pool: ResourcePool{Name: "db", LabelKey: "db", RateLimit: 1}},
+		{name: "no name", pool: ResourcePool{Handler: "http", MaxConcurrency: 1}, wantErr: "name is required"},
+		{name: "no match", pool: ResourcePool{Name: "api", MaxConcurrency: 1}, wantErr: "either handler or label_key"},
+		{name: "both matches", pool: ResourcePool{Name: "api", Handler: "http", LabelKey: "db", MaxConcurrency: 1}, wantErr: "mutually exclusive"},
+		{name: "negative concurrency", pool: ResourcePool{Name: "api", Handler: "http", MaxConcurrency: -1}, wantErr: "max_concurrency"},
+		{name: "negative burst", pool: ResourcePool{Name: "api", Handler: "http", RateLimit: 1, Burst: -1}, wantErr: "burst"},
+		{name: "no limit", pool: ResourcePool{Name: "api", Handler: "http"}, wantErr: "at least one of"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.pool.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResourcePoolMatches(t *testing.T) {
+	task := &Task{Handler: "http", Metadata: map[string]string{"db": "orders"}}
+	tests := []struct {
+		name string
+		pool ResourcePool
+		want bool
+	}{
+		{name: "handler", pool: ResourcePool{Handler: "http"}, want: true},
+		{name: "other handler", pool: ResourcePool{Handler: "shell"}},
+		{name: "any label value", pool: ResourcePool{LabelKey: "db"}, want: true},
+		{name: "label value", pool: ResourcePool{LabelKey: "db", LabelValue: "orders"}, want: true},
+		{name: "other label value", pool: ResourcePool{LabelKey: "db", LabelValue: "users"}},
+		{name: "missing label", pool: ResourcePool{LabelKey: "team"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.pool.Matches(task); got != tt.want {
+				t.Errorf("Matches = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPoolLimiter(t *testing.T) {
+	limiter := NewPoolLimiter()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	fast := &ResourcePool{Name: "fast", RateLimit: 1, Burst: 2}
+	slow := &ResourcePool{Name: "slow", RateLimit: 0.5}
+	unlimited := &ResourcePool{Name: "unlimited", MaxConcurrency: 1}
+
+	take := func(pools []*ResourcePool, at time.Time, want bool) {
+		t.Helper()
+		if got := limiter.TryTake(pools, at); got != want {
+			t.Fatalf("TryTake at %v = %v, want %v", at.Sub(now), got, want)
+		}
+	}
+
+	// 只限制并发的资源池不消耗令牌
+	take([]*ResourcePool{unlimited}, now, true)
+	take([]*ResourcePool{unlimited}, now, true)
+
+	// 令牌桶初始为满，耗尽后按速率补充
+	take([]*ResourcePool{fast}, now, true)
+	take([]*ResourcePool{fast}, now, true)
+	take([]*ResourcePool{fast}, now, false)
+	take([]*ResourcePool{fast}, now.Add(time.Second), true)
+
+	// 任一资源池令牌不足时不从其他资源池取出令牌
+	take([]*ResourcePool{slow}, now, true)
+	take([]*ResourcePool{slow}, now.Add(2*time.Second), true)
+	take([]*ResourcePool{fast, slow}, now.Add(2*time.Second), false)
+	take([]*ResourcePool{fast}, now.Add(2*time.Second), true)
+
+	// 归还的令牌不超过令牌桶容量
+	limiter.Refund([]*ResourcePool{slow})
+	limiter.Refund([]*ResourcePool{slow})
+	take([]*ResourcePool{slow}, now.Add(2*time.Second), true)
+	take([]*ResourcePool{slow}, now.Add(2*time.Second), false)
+}
+
+func TestPoolSlotPrefix(t *testing.T) {
+	if got := poolSlotPrefix(&ResourcePool{ID: 12, Name: "api"}); got != "__pool/12/" {
+		t.Errorf("poolSlotPrefix = %q, want %q", got, "__pool/12/")
+	}
+}
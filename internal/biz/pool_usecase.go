@@ -0,0 +1,74 @@
+package biz
+
+import (
+	"context"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ResourcePoolUsecase 资源池用例
+type ResourcePoolUsecase struct {
+	repo ResourcePoolRepo
+	log  *log.Helper
+}
+
+// NewResourcePoolUsecase 创建资源池用例实例
+func NewResourcePoolUsecase(repo ResourcePoolRepo, logger log.Logger) *ResourcePoolUsecase {
+	return &ResourcePoolUsecase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateResourcePool 创建资源池
+func (uc *ResourcePoolUsecase) CreateResourcePool(ctx context.Context, pool *ResourcePool) (*ResourcePool, error) {
+	uc.log.WithContext(ctx).Infof("CreateResourcePool: %s", pool.Name)
+
+	if err := pool.Validate(); err != nil {
+		return nil, errors.BadRequest(pb.ErrorReason_INVALID_RESOURCE_POOL.String(), err.Error())
+	}
+	return uc.repo.CreateResourcePool(ctx, pool)
+}
+
+// GetResourcePool 获取资源池详情
+func (uc *ResourcePoolUsecase) GetResourcePool(ctx context.Context, id int64) (*ResourcePool, error) {
+	pool, err := uc.repo.GetResourcePool(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if pool == nil {
+		return nil, ErrResourcePoolNotFound
+	}
+	return pool, nil
+}
+
+// UpdateResourcePool 更新资源池，整体替换匹配条件和限制
+func (uc *ResourcePoolUsecase) UpdateResourcePool(ctx context.Context, pool *ResourcePool) (*ResourcePool, error) {
+	uc.log.WithContext(ctx).Infof("UpdateResourcePool: %d", pool.ID)
+
+	if _, err := uc.GetResourcePool(ctx, pool.ID); err != nil {
+		return nil, err
+	}
+	if err := pool.Validate(); err != nil {
+		return nil, errors.BadRequest(pb.ErrorReason_INVALID_RESOURCE_POOL.String(), err.Error())
+	}
+	return uc.repo.UpdateResourcePool(ctx, pool)
+}
+
+// DeleteResourcePool 删除资源池
+func (uc *ResourcePoolUsecase) DeleteResourcePool(ctx context.Context, id int64) error {
+	uc.log.WithContext(ctx).Infof("DeleteResourcePool: %d", id)
+
+	if _, err := uc.GetResourcePool(ctx, id); err != nil {
+		return err
+	}
+	return uc.repo.DeleteResourcePool(ctx, id)
+}
+
+// ListResourcePools 资源池列表查询
+func (uc *ResourcePoolUsecase) ListResourcePools(ctx context.Context, filter *ResourcePoolListFilter) ([]*ResourcePool, int64, error) {
+	return uc.repo.ListResourcePools(ctx, filter)
+}
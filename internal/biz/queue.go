@@ -0,0 +1,85 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+)
+
+const (
+	// MinPriority 最低优先级
+	MinPriority = 0
+	// MaxPriority 最高优先级
+	MaxPriority = 9
+	// PriorityAging 执行记录每排队该时长，有效优先级提升一级，避免低优先级任务饿死
+	PriorityAging = time.Minute
+)
+
+// PriorityQueueStats 单个优先级的队列统计
+type PriorityQueueStats struct {
+	Priority       int32
+	Queued         int64
+	OldestQueuedAt *time.Time
+}
+
+// QueueStats 执行队列统计
+type QueueStats struct {
+	Priorities  []*PriorityQueueStats
+	TotalQueued int64
+	Executing   int64
+}
+
+// ExecutionQueue 执行队列，保存等待执行器认领的执行记录ID，按 QueueScore 顺序出队
+// 出队的记录在 Ack 或 Nack 之前不会再次出队；超过可见性超时仍未确认的记录重新可出队，避免节点异常退出时丢失
+type ExecutionQueue interface {
+	// Push 将排队中的执行记录加入队列，delay 大于 0 时延迟到期后才可出队
+	Push(ctx context.Context, execution *TaskExecution, delay time.Duration) error
+
+	// Pop 为 consumer 取出最多 limit 条可出队的执行记录ID
+	Pop(ctx context.Context, consumer string, limit int) ([]int64, error)
+
+	// Ack 确认执行记录已处理（已认领、已跳过或不再排队），将其从队列中移除
+	Ack(ctx context.Context, id int64) error
+
+	// Nack 将取出但暂时无法处理的执行记录放回队列，delay 后可再次出队
+	Nack(ctx context.Context, id int64, delay time.Duration) error
+}
+
+// QueueScore 计算执行记录的出队顺序，值越小越先执行
+// 优先级每高一级相当于提前 PriorityAging 入队，因此排队足够久的低优先级记录终将排到前面
+func QueueScore(priority int32, enqueuedAt time.Time) int64 {
+	return enqueuedAt.UnixMilli() - int64(priority)*PriorityAging.Milliseconds()
+}
+
+// validatePriority 校验优先级范围
+func validatePriority(priority int32) error {
+	if priority < MinPriority || priority > MaxPriority {
+		return fmt.Errorf("priority must be between %d and %d", MinPriority, MaxPriority)
+	}
+	return nil
+}
+
+// enqueueExecution 创建排队中的执行记录并加入执行队列，入队失败时将执行记录标记为失败
+func enqueueExecution(ctx context.Context, repo ExecutionRepo, queue ExecutionQueue, execution *TaskExecution) (*TaskExecution, error) {
+	execution.Status = pb.ExecutionStatus_QUEUED
+	created, err := repo.CreateExecution(ctx, execution)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := queue.Push(ctx, created, 0); err != nil {
+		now := time.Now()
+		if _, uerr := repo.UpdateExecution(ctx, &TaskExecution{
+			ID:      created.ID,
+			Status:  pb.ExecutionStatus_EXECUTION_FAILED,
+			EndTime: &now,
+			Error:   fmt.Sprintf("enqueue execution: %v", err),
+		}); uerr != nil {
+			return nil, fmt.Errorf("enqueue execution %d: %v (mark failed: %v)", created.ID, err, uerr)
+		}
+		return nil, fmt.Errorf("enqueue execution %d: %w", created.ID, err)
+	}
+	return created, nil
+}
@@ -0,0 +1,208 @@
+// Package queuetest 提供 biz.ExecutionQueue 实现共用的一致性测试
+package queuetest
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"heytom-scheduler/internal/biz"
+)
+
+const (
+	// Visibility 测试使用的可见性超时
+	Visibility = 300 * time.Millisecond
+	// delay 测试使用的延迟时间
+	delay = 200 * time.Millisecond
+)
+
+// Factory 创建一个空的执行队列，visibility 为出队后未确认记录重新可出队的超时时间
+type Factory func(t *testing.T, visibility time.Duration) biz.ExecutionQueue
+
+// Run 运行执行队列一致性测试，每个子测试使用 newQueue 创建的新队列
+func Run(t *testing.T, newQueue Factory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, q biz.ExecutionQueue)
+	}{
+		{"FIFOWithinPriority", testFIFO},
+		{"HigherPriorityFirst", testPriority},
+		{"AgingPreventsStarvation", testAging},
+		{"PopRespectsLimit", testLimit},
+		{"PoppedNotRedelivered", testNoRedelivery},
+		{"AckRemoves", testAck},
+		{"NackRequeues", testNack},
+		{"NackWithDelay", testNackDelay},
+		{"DelayedPush", testDelayedPush},
+		{"VisibilityTimeout", testVisibilityTimeout},
+		{"CompetingConsumers", testCompetingConsumers},
+		{"UnknownID", testUnknownID},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newQueue(t, Visibility))
+		})
+	}
+}
+
+// execution 构造入队时间为 now 减去 age 的执行记录
+func execution(id int64, priority int32, age time.Duration) *biz.TaskExecution {
+	return &biz.TaskExecution{
+		ID:        id,
+		Priority:  priority,
+		CreatedAt: time.Now().Add(-age),
+	}
+}
+
+func push(t *testing.T, q biz.ExecutionQueue, executions ...*biz.TaskExecution) {
+	t.Helper()
+	for _, e := range executions {
+		if err := q.Push(context.Background(), e, 0); err != nil {
+			t.Fatalf("push %d: %v", e.ID, err)
+		}
+	}
+}
+
+func pop(t *testing.T, q biz.ExecutionQueue, consumer string, limit int) []int64 {
+	t.Helper()
+	ids, err := q.Pop(context.Background(), consumer, limit)
+	if err != nil {
+		t.Fatalf("pop: %v", err)
+	}
+	return ids
+}
+
+func expect(t *testing.T, got []int64, want ...int64) {
+	t.Helper()
+	if len(got) == 0 && len(want) == 0 {
+		return
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("popped %v, want %v", got, want)
+	}
+}
+
+func ack(t *testing.T, q biz.ExecutionQueue, ids ...int64) {
+	t.Helper()
+	for _, id := range ids {
+		if err := q.Ack(context.Background(), id); err != nil {
+			t.Fatalf("ack %d: %v", id, err)
+		}
+	}
+}
+
+func testFIFO(t *testing.T, q biz.ExecutionQueue) {
+	push(t, q, execution(1, 5, 3*time.Second), execution(2, 5, 2*time.Second), execution(3, 5, time.Second))
+	expect(t, pop(t, q, "node", 10), 1, 2, 3)
+}
+
+func testPriority(t *testing.T, q biz.ExecutionQueue) {
+	push(t, q, execution(1, 0, 2*time.Second), execution(2, 9, time.Second), execution(3, 5, 0))
+	expect(t, pop(t, q, "node", 10), 2, 3, 1)
+}
+
+func testAging(t *testing.T, q biz.ExecutionQueue) {
+	// 排队超过 9 个 PriorityAging 的最低优先级记录排在新入队的最高优先级记录之前
+	push(t, q, execution(1, 9, 0), execution(2, 0, 10*biz.PriorityAging))
+	expect(t, pop(t, q, "node", 10), 2, 1)
+}
+
+func testLimit(t *testing.T, q biz.ExecutionQueue) {
+	push(t, q, execution(1, 0, 3*time.Second), execution(2, 0, 2*time.Second), execution(3, 0, time.Second))
+	expect(t, pop(t, q, "node", 2), 1, 2)
+	expect(t, pop(t, q, "node", 2), 3)
+	expect(t, pop(t, q, "node", 0))
+}
+
+func testNoRedelivery(t *testing.T, q biz.ExecutionQueue) {
+	push(t, q, execution(1, 0, 0))
+	expect(t, pop(t, q, "node", 10), 1)
+	expect(t, pop(t, q, "node", 10))
+	expect(t, pop(t, q, "other", 10))
+}
+
+func testAck(t *testing.T, q biz.ExecutionQueue) {
+	push(t, q, execution(1, 0, 0), execution(2, 0, 0))
+	expect(t, pop(t, q, "node", 10), 1, 2)
+	ack(t, q, 1, 2)
+
+	time.Sleep(Visibility + delay)
+	expect(t, pop(t, q, "node", 10))
+}
+
+func testNack(t *testing.T, q biz.ExecutionQueue) {
+	push(t, q, execution(1, 0, 0))
+	expect(t, pop(t, q, "node", 10), 1)
+	if err := q.Nack(context.Background(), 1, 0); err != nil {
+		t.Fatalf("nack: %v", err)
+	}
+	expect(t, pop(t, q, "other", 10), 1)
+}
+
+func testNackDelay(t *testing.T, q biz.ExecutionQueue) {
+	push(t, q, execution(1, 0, 0))
+	expect(t, pop(t, q, "node", 10), 1)
+	if err := q.Nack(context.Background(), 1, delay); err != nil {
+		t.Fatalf("nack: %v", err)
+	}
+	expect(t, pop(t, q, "node", 10))
+
+	time.Sleep(delay + 50*time.Millisecond)
+	expect(t, pop(t, q, "node", 10), 1)
+}
+
+func testDelayedPush(t *testing.T, q biz.ExecutionQueue) {
+	if err := q.Push(context.Background(), execution(1, 9, 0), delay); err != nil {
+		t.Fatalf("push: %v", err)
+	}
+	push(t, q, execution(2, 0, 0))
+	expect(t, pop(t, q, "node", 10), 2)
+
+	time.Sleep(delay + 50*time.Millisecond)
+	expect(t, pop(t, q, "node", 10), 1)
+}
+
+func testVisibilityTimeout(t *testing.T, q biz.ExecutionQueue) {
+	push(t, q, execution(1, 0, 0))
+	expect(t, pop(t, q, "crashed", 10), 1)
+	expect(t, pop(t, q, "node", 10))
+
+	// 出队节点未确认，超过可见性超时后由其他节点取出
+	time.Sleep(Visibility + delay)
+	expect(t, pop(t, q, "node", 10), 1)
+	ack(t, q, 1)
+	expect(t, pop(t, q, "node", 10))
+}
+
+func testCompetingConsumers(t *testing.T, q biz.ExecutionQueue) {
+	for id := int64(1); id <= 20; id++ {
+		push(t, q, execution(id, int32(id%10), 0))
+	}
+
+	seen := make(map[int64]string)
+	for i := 0; i < 10; i++ {
+		for _, consumer := range []string{"a", "b"} {
+			for _, id := range pop(t, q, consumer, 3) {
+				if prev, ok := seen[id]; ok {
+					t.Fatalf("execution %d popped by %s and %s", id, prev, consumer)
+				}
+				seen[id] = consumer
+			}
+		}
+	}
+	if len(seen) != 20 {
+		t.Fatalf("popped %d executions, want 20", len(seen))
+	}
+}
+
+func testUnknownID(t *testing.T, q biz.ExecutionQueue) {
+	if err := q.Ack(context.Background(), 42); err != nil {
+		t.Fatalf("ack unknown execution: %v", err)
+	}
+	if err := q.Nack(context.Background(), 42, 0); err != nil {
+		t.Fatalf("nack unknown execution: %v", err)
+	}
+	expect(t, pop(t, q, "node", 10))
+}
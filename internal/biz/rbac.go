@@ -0,0 +1,312 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Role 角色
+type Role string
+
+// 内置角色
+const (
+	RoleViewer   Role = "viewer"   // 只读
+	RoleOperator Role = "operator" // 只读，执行、暂停、恢复任务和取消执行
+	RoleEditor   Role = "editor"   // 只读，创建、修改、删除和恢复任务，管理密钥
+	RoleAdmin    Role = "admin"    // 全部权限
+)
+
+// Permission 权限，格式为 资源.操作
+type Permission string
+
+// 权限列表
+const (
+	PermTasksRead        Permission = "tasks.read"
+	PermTasksCreate      Permission = "tasks.create"
+	PermTasksUpdate      Permission = "tasks.update"
+	PermTasksDelete      Permission = "tasks.delete"
+	PermTasksPurge       Permission = "tasks.purge"
+	PermTasksExecute     Permission = "tasks.execute"
+	PermTasksPause       Permission = "tasks.pause"
+	PermExecutionsRead   Permission = "executions.read"
+	PermExecutionsCancel Permission = "executions.cancel"
+	PermQueueRead        Permission = "queue.read"
+	PermCalendarsRead    Permission = "calendars.read"
+	PermCalendarsWrite   Permission = "calendars.write"
+	PermPoolsRead        Permission = "pools.read"
+	PermPoolsWrite       Permission = "pools.write"
+	PermAuditRead        Permission = "audit.read"
+	PermNamespacesRead   Permission = "namespaces.read"
+	PermNamespacesWrite  Permission = "namespaces.write"
+	PermSecretsRead      Permission = "secrets.read"
+	PermSecretsWrite     Permission = "secrets.write"
+)
+
+// taskPermissions 作用于单个任务或命名空间中密钥的权限，可以被绑定的范围限制；其他权限只能由不限范围的绑定授予
+var taskPermissions = map[Permission]bool{
+	PermTasksRead:        true,
+	PermTasksCreate:      true,
+	PermTasksUpdate:      true,
+	PermTasksDelete:      true,
+	PermTasksExecute:     true,
+	PermTasksPause:       true,
+	PermExecutionsRead:   true,
+	PermExecutionsCancel: true,
+	PermSecretsRead:      true,
+	PermSecretsWrite:     true,
+}
+
+var viewerPermissions = []Permission{PermTasksRead, PermExecutionsRead, PermQueueRead, PermCalendarsRead, PermPoolsRead, PermNamespacesRead, PermSecretsRead}
+
+// rolePermissions 角色拥有的权限
+var rolePermissions = map[Role][]Permission{
+	RoleViewer:   viewerPermissions,
+	RoleOperator: append([]Permission{PermTasksExecute, PermTasksPause, PermExecutionsCancel}, viewerPermissions...),
+	RoleEditor:   append([]Permission{PermTasksCreate, PermTasksUpdate, PermTasksDelete, PermSecretsWrite}, viewerPermissions...),
+	RoleAdmin: {
+		PermTasksRead, PermTasksCreate, PermTasksUpdate, PermTasksDelete, PermTasksPurge, PermTasksExecute, PermTasksPause,
+		PermExecutionsRead, PermExecutionsCancel, PermQueueRead, PermCalendarsRead, PermCalendarsWrite,
+		PermPoolsRead, PermPoolsWrite, PermAuditRead, PermNamespacesRead, PermNamespacesWrite, PermSecretsRead, PermSecretsWrite,
+	},
+}
+
+// AnyPrincipal 匹配所有已认证调用方的绑定主体
+const AnyPrincipal = "*"
+
+// ValidateBindingPrincipal 校验角色绑定的主体为 AnyPrincipal 或 "<认证方式>:<名称>"，如 api_key:ops、jwt:alice、mtls:billing
+func ValidateBindingPrincipal(principal string) error {
+	if principal == AnyPrincipal {
+		return nil
+	}
+	method, name, ok := strings.Cut(principal, ":")
+	if !ok || name == "" {
+		return fmt.Errorf("principal %q must be %q or <method>:<name>", principal, AnyPrincipal)
+	}
+	switch method {
+	case AuthMethodAPIKey, AuthMethodJWT, AuthMethodMTLS:
+		return nil
+	}
+	return fmt.Errorf("principal %q has unknown authentication method %q, want %s, %s or %s", principal, method, AuthMethodAPIKey, AuthMethodJWT, AuthMethodMTLS)
+}
+
+// ParseRole 解析角色名称
+func ParseRole(name string) (Role, error) {
+	role := Role(name)
+	if _, ok := rolePermissions[role]; !ok {
+		return "", fmt.Errorf("unknown role %q", name)
+	}
+	return role, nil
+}
+
+// Permissions 角色拥有的权限，按名称排序
+func (r Role) Permissions() []Permission {
+	perms := append([]Permission{}, rolePermissions[r]...)
+	sort.Slice(perms, func(i, j int) bool { return perms[i] < perms[j] })
+	return perms
+}
+
+// Grants 判断角色是否拥有权限
+func (r Role) Grants(perm Permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// AccessScope 绑定的作用范围，Namespaces 和 Labels 都为空时不限范围，
+// 否则只作用于指定命名空间中、元数据包含全部标签的任务及其执行记录
+type AccessScope struct {
+	Namespaces []string
+	Labels     map[string]string
+}
+
+// Unrestricted 是否不限范围
+func (s AccessScope) Unrestricted() bool {
+	return len(s.Namespaces) == 0 && len(s.Labels) == 0
+}
+
+// Matches 判断任务是否在范围内
+func (s AccessScope) Matches(task *Task) bool {
+	if len(s.Namespaces) > 0 && !slices.Contains(s.Namespaces, task.Namespace) {
+		return false
+	}
+	for key, value := range s.Labels {
+		if v, ok := task.Metadata[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// RoleBinding 将角色绑定到调用方
+type RoleBinding struct {
+	Principal string // "<认证方式>:<名称>" 形式的主体，AnyPrincipal 匹配所有已认证的调用方
+	Role      Role
+	Scope     AccessScope
+}
+
+// Permissions 绑定授予的权限，按名称排序；范围受限的绑定只授予作用于任务的权限
+func (b RoleBinding) Permissions() []Permission {
+	var perms []Permission
+	for _, p := range b.Role.Permissions() {
+		if b.Scope.Unrestricted() || taskPermissions[p] {
+			perms = append(perms, p)
+		}
+	}
+	return perms
+}
+
+// ErrUnauthenticatedPrincipal 启用授权时请求未携带已认证的调用方
+var ErrUnauthenticatedPrincipal = errors.Unauthorized(pb.ErrorReason_UNAUTHENTICATED.String(), "authentication is required")
+
+// permissionDenied 权限不足
+func permissionDenied(principal *Principal, perm Permission) error {
+	return errors.Forbidden(pb.ErrorReason_PERMISSION_DENIED.String(), fmt.Sprintf("%s is not allowed to %s", principal, perm)).
+		WithMetadata(map[string]string{"permission": string(perm)})
+}
+
+// Authorizer 基于角色绑定的授权，未配置绑定时允许所有操作
+type Authorizer struct {
+	bindings []RoleBinding
+	log      *log.Helper
+}
+
+// NewAuthorizer 创建授权实例
+func NewAuthorizer(bindings []RoleBinding, logger log.Logger) *Authorizer {
+	return &Authorizer{
+		bindings: bindings,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Enabled 是否启用授权
+func (a *Authorizer) Enabled() bool {
+	return len(a.bindings) > 0
+}
+
+// Bindings 返回调用方的角色绑定
+func (a *Authorizer) Bindings(principal *Principal) []RoleBinding {
+	if principal == nil {
+		return nil
+	}
+	var result []RoleBinding
+	for _, b := range a.bindings {
+		if b.Principal == principal.String() || b.Principal == AnyPrincipal {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// Check 校验调用方拥有不限范围的权限
+func (a *Authorizer) Check(ctx context.Context, perm Permission) error {
+	return a.check(ctx, perm, func(s AccessScope) bool { return s.Unrestricted() })
+}
+
+// CheckTask 校验调用方在任务上拥有权限
+func (a *Authorizer) CheckTask(ctx context.Context, perm Permission, task *Task) error {
+	return a.check(ctx, perm, func(s AccessScope) bool { return s.Matches(task) })
+}
+
+// CheckNamespace 校验调用方在命名空间上拥有权限，只限定标签的绑定不匹配
+func (a *Authorizer) CheckNamespace(ctx context.Context, perm Permission, namespace string) error {
+	return a.CheckTask(ctx, perm, &Task{Namespace: namespace})
+}
+
+// CheckAny 校验调用方在任意范围内拥有权限
+func (a *Authorizer) CheckAny(ctx context.Context, perm Permission) error {
+	return a.check(ctx, perm, func(AccessScope) bool { return true })
+}
+
+// Unrestricted 判断调用方是否拥有不限范围的权限，为 false 时需要加载任务按范围校验
+func (a *Authorizer) Unrestricted(ctx context.Context, perm Permission) bool {
+	return !a.Enabled() || a.allowed(PrincipalFromContext(ctx), perm, func(s AccessScope) bool { return s.Unrestricted() })
+}
+
+// Scopes 返回调用方拥有权限的范围，不限范围时返回 nil，没有权限时返回 PermissionDenied
+func (a *Authorizer) Scopes(ctx context.Context, perm Permission) ([]AccessScope, error) {
+	if !a.Enabled() {
+		return nil, nil
+	}
+	principal := PrincipalFromContext(ctx)
+	if principal == nil {
+		return nil, ErrUnauthenticatedPrincipal
+	}
+	var scopes []AccessScope
+	for _, b := range a.Bindings(principal) {
+		if !b.Role.Grants(perm) {
+			continue
+		}
+		if b.Scope.Unrestricted() {
+			return nil, nil
+		}
+		if !taskPermissions[perm] {
+			continue
+		}
+		scopes = append(scopes, b.Scope)
+	}
+	if len(scopes) == 0 {
+		return nil, permissionDenied(principal, perm)
+	}
+	return scopes, nil
+}
+
+// check 校验调用方的权限，未携带已认证的调用方时返回 Unauthenticated，权限不足时返回 PermissionDenied
+func (a *Authorizer) check(ctx context.Context, perm Permission, inScope func(AccessScope) bool) error {
+	if !a.Enabled() {
+		return nil
+	}
+	principal := PrincipalFromContext(ctx)
+	if principal == nil {
+		return ErrUnauthenticatedPrincipal
+	}
+	if !a.allowed(principal, perm, inScope) {
+		a.log.WithContext(ctx).Infof("permission denied: %s %s", principal, perm)
+		return permissionDenied(principal, perm)
+	}
+	return nil
+}
+
+// allowed 判断调用方存在授予权限且范围满足条件的绑定，范围受限的绑定只授予作用于任务的权限
+func (a *Authorizer) allowed(principal *Principal, perm Permission, inScope func(AccessScope) bool) bool {
+	for _, b := range a.Bindings(principal) {
+		if !b.Role.Grants(perm) {
+			continue
+		}
+		if b.Scope.Unrestricted() || (taskPermissions[perm] && inScope(b.Scope)) {
+			return true
+		}
+	}
+	return false
+}
+
+// EffectivePermissions 返回调用方不限范围的权限，按名称排序；未启用授权时返回全部权限
+func (a *Authorizer) EffectivePermissions(principal *Principal) []Permission {
+	if !a.Enabled() {
+		return RoleAdmin.Permissions()
+	}
+	set := make(map[Permission]bool)
+	for _, b := range a.Bindings(principal) {
+		if b.Scope.Unrestricted() {
+			for _, p := range rolePermissions[b.Role] {
+				set[p] = true
+			}
+		}
+	}
+	perms := make([]Permission, 0, len(set))
+	for p := range set {
+		perms = append(perms, p)
+	}
+	sort.Slice(perms, func(i, j int) bool { return perms[i] < perms[j] })
+	return perms
+}
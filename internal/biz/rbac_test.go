@@ -0,0 +1,247 @@
+package biz
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// principalContext 返回携带指定认证方式和名称的调用方的上下文
+func principalContext(method, name string) context.Context {
+	return NewPrincipalContext(context.Background(), &Principal{Method: method, Name: name})
+}
+
+func TestValidateBindingPrincipal(t *testing.T) {
+	for _, principal := range []string{AnyPrincipal, "api_key:ops", "jwt:alice@example.com", "mtls:billing"} {
+		if err := ValidateBindingPrincipal(principal); err != nil {
+			t.Errorf("ValidateBindingPrincipal(%q): %v", principal, err)
+		}
+	}
+	for _, principal := range []string{"", "ops", "api_key:", "oauth:ops", ":ops"} {
+		if err := ValidateBindingPrincipal(principal); err == nil {
+			t.Errorf("ValidateBindingPrincipal(%q) accepted an invalid principal", principal)
+		}
+	}
+}
+
+func TestParseRole(t *testing.T) {
+	for _, name := range []string{"viewer", "operator", "editor", "admin"} {
+		if role, err := ParseRole(name); err != nil || string(role) != name {
+			t.Errorf("ParseRole(%q) = %q, %v", name, role, err)
+		}
+	}
+	if _, err := ParseRole("root"); err == nil {
+		t.Errorf("ParseRole accepted an unknown role")
+	}
+}
+
+func TestRoleGrants(t *testing.T) {
+	tests := []struct {
+		role Role
+		perm Permission
+		want bool
+	}{
+		{RoleViewer, PermTasksRead, true},
+		{RoleViewer, PermTasksExecute, false},
+		{RoleOperator, PermTasksExecute, true},
+		{RoleOperator, PermTasksCreate, false},
+		{RoleOperator, PermSecretsWrite, false},
+		{RoleEditor, PermSecretsWrite, true},
+		{RoleEditor, PermTasksPurge, false},
+		{RoleAdmin, PermTasksPurge, true},
+		{Role("unknown"), PermTasksRead, false},
+	}
+	for _, tt := range tests {
+		if got := tt.role.Grants(tt.perm); got != tt.want {
+			t.Errorf("%s.Grants(%s) = %v, want %v", tt.role, tt.perm, got, tt.want)
+		}
+	}
+	if perms := RoleAdmin.Permissions(); len(perms) != len(rolePermissions[RoleAdmin]) || perms[0] != PermAuditRead {
+		t.Errorf("admin permissions = %v, want all permissions sorted by name", perms)
+	}
+}
+
+func TestAccessScopeMatches(t *testing.T) {
+	task := &Task{Namespace: "team-a", Metadata: map[string]string{"env": "prod", "tier": "1"}}
+	tests := []struct {
+		name  string
+		scope AccessScope
+		want  bool
+	}{
+		{"unrestricted", AccessScope{}, true},
+		{"namespace", AccessScope{Namespaces: []string{"team-b", "team-a"}}, true},
+		{"other namespace", AccessScope{Namespaces: []string{"team-b"}}, false},
+		{"labels", AccessScope{Labels: map[string]string{"env": "prod"}}, true},
+		{"label value differs", AccessScope{Labels: map[string]string{"env": "dev"}}, false},
+		{"label missing", AccessScope{Labels: map[string]string{"owner": "x"}}, false},
+		{"namespace and labels", AccessScope{Namespaces: []string{"team-a"}, Labels: map[string]string{"env": "prod", "tier": "1"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.scope.Matches(task); got != tt.want {
+				t.Errorf("Matches = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoleBindingPermissions(t *testing.T) {
+	scoped := RoleBinding{Principal: "jwt:alice", Role: RoleAdmin, Scope: AccessScope{Namespaces: []string{"team-a"}}}
+	for _, p := range scoped.Permissions() {
+		if !taskPermissions[p] {
+			t.Errorf("scoped binding grants %s, which is not a task permission", p)
+		}
+	}
+	unrestricted := RoleBinding{Principal: "jwt:alice", Role: RoleAdmin}
+	if !reflect.DeepEqual(unrestricted.Permissions(), RoleAdmin.Permissions()) {
+		t.Errorf("unrestricted binding permissions = %v", unrestricted.Permissions())
+	}
+}
+
+func TestAuthorizerBindings(t *testing.T) {
+	a := NewAuthorizer([]RoleBinding{
+		{Principal: "api_key:ops", Role: RoleOperator},
+		{Principal: "jwt:alice", Role: RoleEditor},
+		{Principal: AnyPrincipal, Role: RoleViewer},
+	}, log.DefaultLogger)
+
+	tests := []struct {
+		principal *Principal
+		want      []Role
+	}{
+		{&Principal{Method: AuthMethodAPIKey, Name: "ops"}, []Role{RoleOperator, RoleViewer}},
+		// 同名但认证方式不同的调用方不能冒用绑定
+		{&Principal{Method: AuthMethodJWT, Name: "ops"}, []Role{RoleViewer}},
+		{&Principal{Method: AuthMethodJWT, Name: "alice"}, []Role{RoleEditor, RoleViewer}},
+		{&Principal{Method: AuthMethodMTLS, Name: "alice"}, []Role{RoleViewer}},
+		{nil, nil},
+	}
+	for _, tt := range tests {
+		var got []Role
+		for _, b := range a.Bindings(tt.principal) {
+			got = append(got, b.Role)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Bindings(%v) = %v, want %v", tt.principal, got, tt.want)
+		}
+	}
+}
+
+func TestAuthorizerCheck(t *testing.T) {
+	disabled := NewAuthorizer(nil, log.DefaultLogger)
+	if err := disabled.Check(context.Background(), PermTasksPurge); err != nil {
+		t.Errorf("disabled authorizer denied: %v", err)
+	}
+
+	a := NewAuthorizer([]RoleBinding{{Principal: "api_key:ops", Role: RoleOperator}}, log.DefaultLogger)
+	if err := a.Check(context.Background(), PermTasksRead); !errors.IsUnauthorized(err) {
+		t.Errorf("err = %v, want Unauthorized without principal", err)
+	}
+	if err := a.Check(principalContext(AuthMethodAPIKey, "ops"), PermTasksExecute); err != nil {
+		t.Errorf("operator denied tasks.execute: %v", err)
+	}
+
+	err := a.Check(principalContext(AuthMethodJWT, "ops"), PermTasksExecute)
+	if !errors.IsForbidden(err) || errors.Reason(err) != pb.ErrorReason_PERMISSION_DENIED.String() {
+		t.Fatalf("err = %v, want PERMISSION_DENIED", err)
+	}
+	if !strings.Contains(errors.FromError(err).GetMessage(), "jwt:ops") {
+		t.Errorf("message %q does not name the qualified principal", errors.FromError(err).GetMessage())
+	}
+	if errors.FromError(err).GetMetadata()["permission"] != string(PermTasksExecute) {
+		t.Errorf("metadata = %v", errors.FromError(err).GetMetadata())
+	}
+}
+
+func TestAuthorizerScopedBindings(t *testing.T) {
+	a := NewAuthorizer([]RoleBinding{
+		{Principal: "jwt:alice", Role: RoleAdmin, Scope: AccessScope{Namespaces: []string{"team-a"}}},
+		{Principal: "jwt:alice", Role: RoleOperator, Scope: AccessScope{Labels: map[string]string{"env": "dev"}}},
+	}, log.DefaultLogger)
+	ctx := principalContext(AuthMethodJWT, "alice")
+
+	inTeam := &Task{Namespace: "team-a"}
+	devElsewhere := &Task{Namespace: "team-b", Metadata: map[string]string{"env": "dev"}}
+	prodElsewhere := &Task{Namespace: "team-b", Metadata: map[string]string{"env": "prod"}}
+
+	if err := a.CheckTask(ctx, PermTasksDelete, inTeam); err != nil {
+		t.Errorf("delete in team-a denied: %v", err)
+	}
+	if err := a.CheckTask(ctx, PermTasksExecute, devElsewhere); err != nil {
+		t.Errorf("execute dev task denied: %v", err)
+	}
+	if err := a.CheckTask(ctx, PermTasksDelete, devElsewhere); !errors.IsForbidden(err) {
+		t.Errorf("delete dev task err = %v, want Forbidden", err)
+	}
+	if err := a.CheckTask(ctx, PermTasksRead, prodElsewhere); !errors.IsForbidden(err) {
+		t.Errorf("read prod task err = %v, want Forbidden", err)
+	}
+
+	// 范围受限的绑定不授予不作用于任务的权限，也不授予不限范围的权限
+	if err := a.CheckAny(ctx, PermCalendarsWrite); !errors.IsForbidden(err) {
+		t.Errorf("calendars.write err = %v, want Forbidden", err)
+	}
+	if err := a.Check(ctx, PermTasksRead); !errors.IsForbidden(err) {
+		t.Errorf("unrestricted tasks.read err = %v, want Forbidden", err)
+	}
+	if err := a.CheckAny(ctx, PermTasksRead); err != nil {
+		t.Errorf("CheckAny tasks.read denied: %v", err)
+	}
+	if err := a.CheckNamespace(ctx, PermSecretsWrite, "team-a"); err != nil {
+		t.Errorf("secrets.write in team-a denied: %v", err)
+	}
+	if err := a.CheckNamespace(ctx, PermTasksExecute, "team-b"); !errors.IsForbidden(err) {
+		t.Errorf("label-only binding matched a namespace check: %v", err)
+	}
+	if a.Unrestricted(ctx, PermTasksRead) {
+		t.Errorf("Unrestricted = true for scoped bindings")
+	}
+
+	scopes, err := a.Scopes(ctx, PermTasksExecute)
+	if err != nil {
+		t.Fatalf("Scopes: %v", err)
+	}
+	if len(scopes) != 2 {
+		t.Errorf("Scopes(tasks.execute) = %v, want both bindings", scopes)
+	}
+	if scopes, err := a.Scopes(ctx, PermTasksDelete); err != nil || len(scopes) != 1 || scopes[0].Namespaces[0] != "team-a" {
+		t.Errorf("Scopes(tasks.delete) = %v, %v", scopes, err)
+	}
+	if _, err := a.Scopes(ctx, PermAuditRead); !errors.IsForbidden(err) {
+		t.Errorf("Scopes(audit.read) err = %v, want Forbidden", err)
+	}
+	if _, err := a.Scopes(context.Background(), PermTasksRead); !errors.IsUnauthorized(err) {
+		t.Errorf("Scopes without principal err = %v, want Unauthorized", err)
+	}
+	if perms := a.EffectivePermissions(&Principal{Method: AuthMethodJWT, Name: "alice"}); len(perms) != 0 {
+		t.Errorf("EffectivePermissions = %v, want none for scoped bindings", perms)
+	}
+}
+
+func TestAuthorizerUnrestrictedBinding(t *testing.T) {
+	a := NewAuthorizer([]RoleBinding{
+		{Principal: "api_key:ops", Role: RoleOperator},
+		{Principal: "api_key:ops", Role: RoleViewer, Scope: AccessScope{Namespaces: []string{"team-a"}}},
+	}, log.DefaultLogger)
+	ctx := principalContext(AuthMethodAPIKey, "ops")
+
+	if scopes, err := a.Scopes(ctx, PermTasksRead); err != nil || scopes != nil {
+		t.Errorf("Scopes = %v, %v, want nil for an unrestricted binding", scopes, err)
+	}
+	if !a.Unrestricted(ctx, PermTasksExecute) {
+		t.Errorf("Unrestricted = false for an unrestricted binding")
+	}
+	want := RoleOperator.Permissions()
+	if got := a.EffectivePermissions(&Principal{Method: AuthMethodAPIKey, Name: "ops"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("EffectivePermissions = %v, want %v", got, want)
+	}
+	if got := NewAuthorizer(nil, log.DefaultLogger).EffectivePermissions(nil); !reflect.DeepEqual(got, RoleAdmin.Permissions()) {
+		t.Errorf("EffectivePermissions without bindings = %v, want all", got)
+	}
+}
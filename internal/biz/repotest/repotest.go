@@ -0,0 +1,1763 @@
+// Package repotest 提供 biz.TaskRepo、biz.ExecutionRepo、biz.TaskRevisionRepo、biz.NamespaceRepo 和 biz.SecretRepo 实现共用的一致性测试
+package repotest
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+)
+
+// TaskRepoFactory 创建一个空的任务仓储
+type TaskRepoFactory func(t *testing.T) biz.TaskRepo
+
+// ExecutionRepoFactory 创建一个空的执行记录仓储
+type ExecutionRepoFactory func(t *testing.T) biz.ExecutionRepo
+
+// RunTaskRepo 运行任务仓储一致性测试，每个子测试使用 newRepo 创建的新仓储
+func RunTaskRepo(t *testing.T, newRepo TaskRepoFactory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, r biz.TaskRepo)
+	}{
+		{"CreateAndGet", testCreateTask},
+		{"GetMissing", testGetMissingTask},
+		{"UpdateNonZeroFields", testUpdateTask},
+		{"UpdateVersion", testUpdateTaskVersion},
+		{"UpdateFieldMask", testUpdateTaskFields},
+		{"UpdateScheduling", testUpdateTaskScheduling},
+		{"Delete", testDeleteTask},
+		{"SoftDeleteRestorePurge", testSoftDeleteTask},
+		{"ListFilters", testListTaskFilters},
+		{"ListPagination", testListTaskPagination},
+		{"ListOrderAndCursor", testListTaskOrder},
+		{"StatusAndCounters", testTaskStatusAndCounters},
+		{"ListDueTasks", testListDueTasks},
+		{"AdvanceTask", testAdvanceTask},
+		{"RevertAdvance", testRevertAdvance},
+		{"RescheduleTask", testRescheduleTask},
+		{"TransitionTaskStatus", testTransitionTaskStatus},
+		{"NamespaceIsolation", testTaskNamespaces},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newRepo(t))
+		})
+	}
+}
+
+// RunExecutionRepo 运行执行记录仓储一致性测试，每个子测试使用 newRepo 创建的新仓储
+func RunExecutionRepo(t *testing.T, newRepo ExecutionRepoFactory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, r biz.ExecutionRepo)
+	}{
+		{"CreateAndGet", testCreateExecution},
+		{"GetMissing", testGetMissingExecution},
+		{"UpdateNonZeroFields", testUpdateExecution},
+		{"ListFiltersAndPagination", testListExecutions},
+		{"ListOrderAndCursor", testListExecutionOrder},
+		{"ClaimExecution", testClaimExecution},
+		{"FinishExecution", testFinishExecution},
+		{"HeartbeatAndExpiry", testExecutionHeartbeat},
+		{"QueueStats", testQueueStats},
+		{"PruneExecutions", testPruneExecutions},
+		{"CancelExecution", testCancelExecution},
+		{"CancelQueuedExecutions", testCancelQueuedExecutions},
+		{"DeleteTaskExecutions", testDeleteTaskExecutions},
+		{"NamespaceIsolationAndUsage", testExecutionNamespaces},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newRepo(t))
+		})
+	}
+}
+
+// TaskRevisionRepoFactory 创建一个空的任务修订仓储
+type TaskRevisionRepoFactory func(t *testing.T) biz.TaskRevisionRepo
+
+// RunTaskRevisionRepo 运行任务修订仓储一致性测试，每个子测试使用 newRepo 创建的新仓储
+func RunTaskRevisionRepo(t *testing.T, newRepo TaskRevisionRepoFactory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, r biz.TaskRevisionRepo)
+	}{
+		{"CreateAndGet", testCreateRevision},
+		{"ListAndDelete", testListRevisions},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newRepo(t))
+		})
+	}
+}
+
+// NamespaceRepoFactory 创建一个只有默认命名空间的命名空间仓储
+type NamespaceRepoFactory func(t *testing.T) biz.NamespaceRepo
+
+// RunNamespaceRepo 运行命名空间仓储一致性测试，每个子测试使用 newRepo 创建的新仓储
+func RunNamespaceRepo(t *testing.T, newRepo NamespaceRepoFactory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, r biz.NamespaceRepo)
+	}{
+		{"CreateGetUpdateDelete", testNamespaceCRUD},
+		{"List", testListNamespaces},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newRepo(t))
+		})
+	}
+}
+
+// SecretRepoFactory 创建一个空的密钥仓储
+type SecretRepoFactory func(t *testing.T) biz.SecretRepo
+
+// RunSecretRepo 运行密钥仓储一致性测试，每个子测试使用 newRepo 创建的新仓储
+func RunSecretRepo(t *testing.T, newRepo SecretRepoFactory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, r biz.SecretRepo)
+	}{
+		{"CreateGetUpdateDelete", testSecretCRUD},
+		{"ListAndCount", testListSecrets},
+		{"ListToRewrap", testListSecretsToRewrap},
+		{"NamespaceIsolation", testSecretNamespaces},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newRepo(t))
+		})
+	}
+}
+
+var ctx = context.Background()
+
+// now 返回精确到秒的当前时间，数据库按秒保存时间字段
+func now() time.Time {
+	return time.Now().Truncate(time.Second)
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func sameTime(t *testing.T, field string, got, want *time.Time) {
+	t.Helper()
+	if (got == nil) != (want == nil) || (got != nil && !got.Equal(*want)) {
+		t.Fatalf("%s = %v, want %v", field, got, want)
+	}
+}
+
+func createTask(t *testing.T, r biz.TaskRepo, task *biz.Task) *biz.Task {
+	t.Helper()
+	if task.Type == pb.TaskType_TASK_TYPE_UNSPECIFIED {
+		task.Type = pb.TaskType_CRON
+	}
+	if task.Status == pb.TaskStatus_TASK_STATUS_UNSPECIFIED {
+		task.Status = pb.TaskStatus_PENDING
+	}
+	if task.Handler == "" {
+		task.Handler = "http"
+	}
+	created, err := r.CreateTask(ctx, task)
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	if created.ID <= 0 {
+		t.Fatalf("created task has id %d", created.ID)
+	}
+	return created
+}
+
+func getTask(t *testing.T, r biz.TaskRepo, id int64) *biz.Task {
+	t.Helper()
+	task, err := r.GetTask(ctx, id)
+	if err != nil {
+		t.Fatalf("get task %d: %v", id, err)
+	}
+	if task == nil {
+		t.Fatalf("task %d not found", id)
+	}
+	return task
+}
+
+func taskIDs(tasks []*biz.Task) []int64 {
+	ids := make([]int64, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	return ids
+}
+
+func equalIDs(got, want []int64) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func testCreateTask(t *testing.T, r biz.TaskRepo) {
+	start := now().Add(time.Hour)
+	next := now().Add(2 * time.Hour)
+	created := createTask(t, r, &biz.Task{
+		Name:              "report",
+		Description:       "daily report",
+		Type:              pb.TaskType_INTERVAL,
+		Schedule:          "1h",
+		Handler:           "http",
+		Payload:           `{"url":"http://example.com"}`,
+		Timeout:           60,
+		Metadata:          map[string]string{"team": "ops"},
+		CalendarID:        7,
+		StartTime:         &start,
+		ActiveWindows:     []biz.TimeWindow{{Start: "01:00", End: "05:00"}},
+		MaxRuns:           10,
+		IntervalMode:      pb.IntervalMode_FIXED_DELAY,
+		InitialDelay:      1500 * time.Millisecond,
+		Jitter:            2 * time.Second,
+		Priority:          7,
+		LockGroup:         "reports",
+		ConcurrencyPolicy: pb.ConcurrencyPolicy_SKIP,
+		Retention:         biz.RetentionPolicy{KeepLast: 20, FailedKeepDays: 30},
+		NextRunTime:       &next,
+	})
+
+	got := getTask(t, r, created.ID)
+	if got.Name != "report" || got.Description != "daily report" || got.Type != pb.TaskType_INTERVAL ||
+		got.Status != pb.TaskStatus_PENDING || got.Schedule != "1h" || got.Handler != "http" ||
+		got.Payload != `{"url":"http://example.com"}` || got.Timeout != 60 || got.CalendarID != 7 {
+		t.Fatalf("unexpected task %+v", got)
+	}
+	if got.Metadata["team"] != "ops" || len(got.Metadata) != 1 {
+		t.Fatalf("metadata = %v", got.Metadata)
+	}
+	if len(got.ActiveWindows) != 1 || got.ActiveWindows[0] != (biz.TimeWindow{Start: "01:00", End: "05:00"}) {
+		t.Fatalf("active windows = %v", got.ActiveWindows)
+	}
+	if got.MaxRuns != 10 || got.IntervalMode != pb.IntervalMode_FIXED_DELAY || got.InitialDelay != 1500*time.Millisecond ||
+		got.Jitter != 2*time.Second || got.Priority != 7 || got.LockGroup != "reports" ||
+		got.ConcurrencyPolicy != pb.ConcurrencyPolicy_SKIP || got.Retention != (biz.RetentionPolicy{KeepLast: 20, FailedKeepDays: 30}) {
+		t.Fatalf("unexpected task options %+v", got)
+	}
+	sameTime(t, "start_time", got.StartTime, &start)
+	sameTime(t, "end_time", got.EndTime, nil)
+	sameTime(t, "next_run_time", got.NextRunTime, &next)
+	if got.RunCount != 0 || got.ExecutionCount != 0 || got.SuccessCount != 0 || got.FailedCount != 0 {
+		t.Fatalf("new task has counters %d/%d/%d/%d", got.RunCount, got.ExecutionCount, got.SuccessCount, got.FailedCount)
+	}
+	if got.CreatedAt.IsZero() || got.UpdatedAt.IsZero() {
+		t.Fatalf("timestamps not set: %v %v", got.CreatedAt, got.UpdatedAt)
+	}
+
+	// 未设置超时时间时使用默认值
+	defaults := createTask(t, r, &biz.Task{Name: "defaults"})
+	if got := getTask(t, r, defaults.ID); got.Timeout != 300 {
+		t.Fatalf("default timeout = %d, want 300", got.Timeout)
+	}
+
+	// 返回的任务与仓储中保存的数据相互独立
+	got.Metadata["team"] = "dev"
+	if again := getTask(t, r, created.ID); again.Metadata["team"] != "ops" {
+		t.Fatalf("modifying a returned task changed the stored task")
+	}
+}
+
+func testGetMissingTask(t *testing.T, r biz.TaskRepo) {
+	task, err := r.GetTask(ctx, 404)
+	if err != nil || task != nil {
+		t.Fatalf("get missing task = %v, %v; want nil, nil", task, err)
+	}
+}
+
+func testUpdateTask(t *testing.T, r biz.TaskRepo) {
+	created := createTask(t, r, &biz.Task{
+		Name:        "before",
+		Description: "kept",
+		Schedule:    "0 * * * * *",
+		Timeout:     30,
+		Priority:    3,
+	})
+
+	end := now().Add(24 * time.Hour)
+	updated, err := r.UpdateTask(ctx, &biz.Task{
+		ID:       created.ID,
+		Name:     "after",
+		Type:     pb.TaskType_INTERVAL,
+		Status:   pb.TaskStatus_PAUSED,
+		Handler:  "other",
+		Priority: 5,
+		EndTime:  &end,
+	}, nil)
+	if err != nil {
+		t.Fatalf("update task: %v", err)
+	}
+	if updated == nil || updated.ID != created.ID {
+		t.Fatalf("update returned %+v", updated)
+	}
+
+	got := getTask(t, r, created.ID)
+	if got.Name != "after" || got.Priority != 5 {
+		t.Fatalf("updated fields not saved: %+v", got)
+	}
+	sameTime(t, "end_time", got.EndTime, &end)
+	// 零值字段保持不变，类型、状态和处理器不能通过 UpdateTask 修改
+	if got.Description != "kept" || got.Schedule != "0 * * * * *" || got.Timeout != 30 {
+		t.Fatalf("zero-valued fields were overwritten: %+v", got)
+	}
+	if got.Type != pb.TaskType_CRON || got.Status != pb.TaskStatus_PENDING || got.Handler != "http" {
+		t.Fatalf("type, status or handler changed: %+v", got)
+	}
+
+	missing, err := r.UpdateTask(ctx, &biz.Task{ID: 404, Name: "missing"}, nil)
+	if err != nil || missing != nil {
+		t.Fatalf("update missing task = %v, %v; want nil, nil", missing, err)
+	}
+}
+
+func testUpdateTaskVersion(t *testing.T, r biz.TaskRepo) {
+	created := createTask(t, r, &biz.Task{Name: "versioned"})
+	if created.Version != 1 || getTask(t, r, created.ID).Version != 1 {
+		t.Fatalf("new task version = %d, want 1", created.Version)
+	}
+
+	updated, err := r.UpdateTask(ctx, &biz.Task{ID: created.ID, Version: 1, Name: "first"}, nil)
+	if err != nil || updated == nil || updated.Version != 2 || updated.Name != "first" {
+		t.Fatalf("update with current version = %+v, %v", updated, err)
+	}
+
+	// 使用过期的版本号更新不生效
+	stale, err := r.UpdateTask(ctx, &biz.Task{ID: created.ID, Version: 1, Name: "stale"}, nil)
+	if err != nil || stale != nil {
+		t.Fatalf("update with stale version = %+v, %v; want nil, nil", stale, err)
+	}
+	if got := getTask(t, r, created.ID); got.Name != "first" || got.Version != 2 {
+		t.Fatalf("stale update changed task: %+v", got)
+	}
+
+	// 状态和调度推进不改变版本号
+	if ok, err := r.TransitionTaskStatus(ctx, created.ID, pb.TaskStatus_PENDING, pb.TaskStatus_PAUSED, "paused", now()); err != nil || !ok {
+		t.Fatalf("transition task status = %v, %v", ok, err)
+	}
+	if got := getTask(t, r, created.ID); got.Version != 2 {
+		t.Fatalf("version after status change = %d, want 2", got.Version)
+	}
+}
+
+func testUpdateTaskFields(t *testing.T, r biz.TaskRepo) {
+	start := now()
+	created := createTask(t, r, &biz.Task{
+		Name:          "masked",
+		Description:   "to be cleared",
+		Payload:       "kept",
+		Metadata:      map[string]string{"team": "ops"},
+		StartTime:     &start,
+		ActiveWindows: []biz.TimeWindow{{Start: "01:00", End: "05:00"}},
+		Jitter:        time.Second,
+		Priority:      4,
+		LockGroup:     "reports",
+		Retention:     biz.RetentionPolicy{KeepLast: 5, KeepDays: 7},
+	})
+
+	updated, err := r.UpdateTask(ctx, &biz.Task{
+		ID:        created.ID,
+		Version:   created.Version,
+		Name:      "renamed",
+		Payload:   "ignored",
+		Retention: biz.RetentionPolicy{KeepDays: 3},
+	}, []string{"name", "description", "metadata", "start_time", "active_windows", "jitter", "priority", "lock_group", "retention"})
+	if err != nil || updated == nil {
+		t.Fatalf("update task with fields = %+v, %v", updated, err)
+	}
+
+	got := getTask(t, r, created.ID)
+	if got.Name != "renamed" || got.Description != "" || len(got.Metadata) != 0 || len(got.ActiveWindows) != 0 ||
+		got.Jitter != 0 || got.Priority != 0 || got.LockGroup != "" || got.Retention != (biz.RetentionPolicy{KeepDays: 3}) {
+		t.Fatalf("masked fields not written: %+v", got)
+	}
+	sameTime(t, "start_time", got.StartTime, nil)
+	// 不在掩码中的字段保持不变
+	if got.Payload != "kept" || got.Version != created.Version+1 {
+		t.Fatalf("unmasked fields changed: %+v", got)
+	}
+}
+
+func testUpdateTaskScheduling(t *testing.T, r biz.TaskRepo) {
+	next := now().Add(time.Hour)
+	created := createTask(t, r, &biz.Task{
+		Name:        "retyped",
+		Type:        pb.TaskType_INTERVAL,
+		Schedule:    "1m",
+		Handler:     "http",
+		Status:      pb.TaskStatus_COMPLETED,
+		NextRunTime: &next,
+	})
+
+	// 未设置字段掩码时类型、处理器、状态和下次执行时间不会被修改
+	updated, err := r.UpdateTask(ctx, &biz.Task{
+		ID:      created.ID,
+		Type:    pb.TaskType_CRON,
+		Handler: "shell",
+		Status:  pb.TaskStatus_PENDING,
+	}, nil)
+	if err != nil || updated == nil {
+		t.Fatalf("update task = %+v, %v", updated, err)
+	}
+	got := getTask(t, r, created.ID)
+	if got.Type != pb.TaskType_INTERVAL || got.Handler != "http" || got.Status != pb.TaskStatus_COMPLETED {
+		t.Fatalf("non-zero update changed protected fields: %+v", got)
+	}
+
+	moved := next.Add(time.Hour)
+	updated, err = r.UpdateTask(ctx, &biz.Task{
+		ID:          created.ID,
+		Type:        pb.TaskType_CRON,
+		Schedule:    "0 * * * *",
+		Handler:     "shell",
+		Status:      pb.TaskStatus_PENDING,
+		NextRunTime: &moved,
+	}, []string{"type", "schedule", "handler", "status", "next_run_time"})
+	if err != nil || updated == nil {
+		t.Fatalf("update task with fields = %+v, %v", updated, err)
+	}
+	got = getTask(t, r, created.ID)
+	if got.Type != pb.TaskType_CRON || got.Schedule != "0 * * * *" || got.Handler != "shell" || got.Status != pb.TaskStatus_PENDING {
+		t.Fatalf("masked scheduling fields not written: %+v", got)
+	}
+	sameTime(t, "next_run_time", got.NextRunTime, &moved)
+
+	// 字段掩码中的下次执行时间为空时清空
+	if updated, err = r.UpdateTask(ctx, &biz.Task{ID: created.ID}, []string{"next_run_time"}); err != nil || updated == nil {
+		t.Fatalf("clear next_run_time = %+v, %v", updated, err)
+	}
+	sameTime(t, "next_run_time", getTask(t, r, created.ID).NextRunTime, nil)
+}
+
+func testDeleteTask(t *testing.T, r biz.TaskRepo) {
+	created := createTask(t, r, &biz.Task{Name: "doomed"})
+	if err := r.DeleteTask(ctx, created.ID); err != nil {
+		t.Fatalf("delete task: %v", err)
+	}
+	if task, err := r.GetTask(ctx, created.ID); err != nil || task != nil {
+		t.Fatalf("get deleted task = %v, %v; want nil, nil", task, err)
+	}
+	if err := r.DeleteTask(ctx, 404); err != nil {
+		t.Fatalf("delete missing task: %v", err)
+	}
+}
+
+func testSoftDeleteTask(t *testing.T, r biz.TaskRepo) {
+	next := now().Add(-time.Minute)
+	kept := createTask(t, r, &biz.Task{Name: "kept", NextRunTime: &next})
+	deleted := createTask(t, r, &biz.Task{Name: "deleted", NextRunTime: &next})
+	if err := r.DeleteTask(ctx, deleted.ID); err != nil {
+		t.Fatalf("delete task: %v", err)
+	}
+
+	got, err := r.GetDeletedTask(ctx, deleted.ID)
+	if err != nil || got == nil || got.DeletedAt == nil || got.Name != "deleted" {
+		t.Fatalf("get deleted task = %+v, %v", got, err)
+	}
+	if got, err := r.GetDeletedTask(ctx, kept.ID); err != nil || got != nil {
+		t.Fatalf("get deleted task of live task = %v, %v; want nil, nil", got, err)
+	}
+	if getTask(t, r, kept.ID).DeletedAt != nil {
+		t.Fatalf("live task has deleted_at")
+	}
+
+	// 已删除的任务默认不出现在列表中，也不参与调度和状态更新
+	tasks, total, err := r.ListTasks(ctx, &biz.TaskListFilter{Page: 1, PageSize: 10})
+	if err != nil || !equalIDs(taskIDs(tasks), []int64{kept.ID}) || total != 1 {
+		t.Fatalf("list tasks = %v (total %d), %v; want [%d]", taskIDs(tasks), total, err, kept.ID)
+	}
+	tasks, total, err = r.ListTasks(ctx, &biz.TaskListFilter{Page: 1, PageSize: 10, IncludeDeleted: true})
+	if err != nil || !equalIDs(taskIDs(tasks), []int64{deleted.ID, kept.ID}) || total != 2 {
+		t.Fatalf("list tasks including deleted = %v (total %d), %v", taskIDs(tasks), total, err)
+	}
+	due, err := r.ListDueTasks(ctx, now(), 10)
+	if err != nil || !equalIDs(taskIDs(due), []int64{kept.ID}) {
+		t.Fatalf("due tasks = %v, %v; want [%d]", taskIDs(due), err, kept.ID)
+	}
+	if _, err := r.TransitionTaskStatus(ctx, deleted.ID, pb.TaskStatus_PENDING, pb.TaskStatus_PAUSED, "paused", now()); err != nil {
+		t.Fatalf("transition deleted task status: %v", err)
+	}
+
+	if ok, err := r.RestoreTask(ctx, kept.ID); err != nil || ok {
+		t.Fatalf("restore live task = %v, %v; want false, nil", ok, err)
+	}
+	if ok, err := r.PurgeTask(ctx, kept.ID); err != nil || ok {
+		t.Fatalf("purge live task = %v, %v; want false, nil", ok, err)
+	}
+	if ok, err := r.RestoreTask(ctx, deleted.ID); err != nil || !ok {
+		t.Fatalf("restore task = %v, %v; want true, nil", ok, err)
+	}
+	restored := getTask(t, r, deleted.ID)
+	if restored.DeletedAt != nil || restored.Status != pb.TaskStatus_PENDING {
+		t.Fatalf("restored task = %+v", restored)
+	}
+	if ok, err := r.RestoreTask(ctx, deleted.ID); err != nil || ok {
+		t.Fatalf("restore twice = %v, %v; want false, nil", ok, err)
+	}
+
+	if err := r.DeleteTask(ctx, deleted.ID); err != nil {
+		t.Fatalf("delete task again: %v", err)
+	}
+	if ok, err := r.PurgeTask(ctx, deleted.ID); err != nil || !ok {
+		t.Fatalf("purge task = %v, %v; want true, nil", ok, err)
+	}
+	if got, err := r.GetDeletedTask(ctx, deleted.ID); err != nil || got != nil {
+		t.Fatalf("get purged task = %v, %v; want nil, nil", got, err)
+	}
+	if ok, err := r.RestoreTask(ctx, deleted.ID); err != nil || ok {
+		t.Fatalf("restore purged task = %v, %v; want false, nil", ok, err)
+	}
+}
+
+func testListTaskFilters(t *testing.T, r biz.TaskRepo) {
+	a := createTask(t, r, &biz.Task{Name: "Nightly Backup", Type: pb.TaskType_CRON, CalendarID: 1, Metadata: map[string]string{"team": "infra", "env": "prod"}})
+	b := createTask(t, r, &biz.Task{Name: "cleanup", Description: "remove old BACKUP files", Type: pb.TaskType_INTERVAL, Metadata: map[string]string{"team": "infra"}})
+	c := createTask(t, r, &biz.Task{Name: "report", Type: pb.TaskType_CRON, Status: pb.TaskStatus_PAUSED, CalendarID: 1, Metadata: map[string]string{"team": "data"}})
+
+	tests := []struct {
+		name   string
+		filter biz.TaskListFilter
+		want   []int64
+	}{
+		{"all", biz.TaskListFilter{}, []int64{c.ID, b.ID, a.ID}},
+		{"status", biz.TaskListFilter{Status: pb.TaskStatus_PAUSED}, []int64{c.ID}},
+		{"type", biz.TaskListFilter{Type: pb.TaskType_CRON}, []int64{c.ID, a.ID}},
+		{"calendar", biz.TaskListFilter{CalendarID: 1}, []int64{c.ID, a.ID}},
+		{"keyword in name or description, case-insensitive", biz.TaskListFilter{Keyword: "backup"}, []int64{b.ID, a.ID}},
+		{"combined", biz.TaskListFilter{Type: pb.TaskType_CRON, Status: pb.TaskStatus_PENDING, Keyword: "night"}, []int64{a.ID}},
+		{"no match", biz.TaskListFilter{Keyword: "missing"}, nil},
+		{"scope", biz.TaskListFilter{Scopes: []biz.AccessScope{{Labels: map[string]string{"team": "infra"}}}}, []int64{b.ID, a.ID}},
+		{"scope with all labels", biz.TaskListFilter{Scopes: []biz.AccessScope{{Labels: map[string]string{"team": "infra", "env": "prod"}}}}, []int64{a.ID}},
+		{"any scope", biz.TaskListFilter{Scopes: []biz.AccessScope{{Labels: map[string]string{"env": "prod"}}, {Labels: map[string]string{"team": "data"}}}}, []int64{c.ID, a.ID}},
+		{"unrestricted scope", biz.TaskListFilter{Scopes: []biz.AccessScope{{Labels: map[string]string{"team": "data"}}, {}}}, []int64{c.ID, b.ID, a.ID}},
+		{"no scopes", biz.TaskListFilter{Scopes: []biz.AccessScope{}}, nil},
+	}
+	for _, tt := range tests {
+		filter := tt.filter
+		filter.Page, filter.PageSize = 1, 10
+		tasks, total, err := r.ListTasks(ctx, &filter)
+		if err != nil {
+			t.Fatalf("%s: list tasks: %v", tt.name, err)
+		}
+		if got := taskIDs(tasks); !equalIDs(got, tt.want) || total != int64(len(tt.want)) {
+			t.Fatalf("%s: got %v (total %d), want %v", tt.name, got, total, tt.want)
+		}
+	}
+}
+
+func testListTaskPagination(t *testing.T, r biz.TaskRepo) {
+	var ids []int64
+	for i := 0; i < 5; i++ {
+		ids = append([]int64{createTask(t, r, &biz.Task{Name: "task"}).ID}, ids...)
+	}
+
+	tests := []struct {
+		page, pageSize int32
+		want           []int64
+	}{
+		{1, 2, ids[0:2]},
+		{2, 2, ids[2:4]},
+		{3, 2, ids[4:5]},
+		{4, 2, nil},
+		{1, 10, ids},
+		{1, 0, nil},
+	}
+	for _, tt := range tests {
+		tasks, total, err := r.ListTasks(ctx, &biz.TaskListFilter{Page: tt.page, PageSize: tt.pageSize})
+		if err != nil {
+			t.Fatalf("list tasks: %v", err)
+		}
+		if got := taskIDs(tasks); !equalIDs(got, tt.want) || total != 5 {
+			t.Fatalf("page %d size %d: got %v (total %d), want %v (total 5)", tt.page, tt.pageSize, got, total, tt.want)
+		}
+	}
+}
+
+func testListTaskOrder(t *testing.T, r biz.TaskRepo) {
+	base := now()
+	a := createTask(t, r, &biz.Task{Name: "charlie", NextRunTime: timePtr(base.Add(2 * time.Minute))})
+	b := createTask(t, r, &biz.Task{Name: "alpha"})
+	c := createTask(t, r, &biz.Task{Name: "bravo", NextRunTime: timePtr(base.Add(time.Minute))})
+	d := createTask(t, r, &biz.Task{Name: "alpha", NextRunTime: timePtr(base.Add(time.Minute))})
+
+	tests := []struct {
+		order biz.ListOrder
+		want  []int64
+	}{
+		{biz.ListOrder{}, []int64{d.ID, c.ID, b.ID, a.ID}},
+		{biz.ListOrder{Field: biz.OrderByCreatedAt}, []int64{d.ID, c.ID, b.ID, a.ID}},
+		{biz.ListOrder{Field: biz.OrderByCreatedAt, Asc: true}, []int64{a.ID, b.ID, c.ID, d.ID}},
+		{biz.ListOrder{Field: biz.OrderByName, Asc: true}, []int64{b.ID, d.ID, c.ID, a.ID}},
+		{biz.ListOrder{Field: biz.OrderByName}, []int64{a.ID, c.ID, d.ID, b.ID}},
+		// 没有下次执行时间的任务升序时排在最后
+		{biz.ListOrder{Field: biz.OrderByNextRunTime, Asc: true}, []int64{c.ID, d.ID, a.ID, b.ID}},
+		{biz.ListOrder{Field: biz.OrderByNextRunTime}, []int64{b.ID, a.ID, d.ID, c.ID}},
+	}
+	for _, tt := range tests {
+		tasks, total, err := r.ListTasks(ctx, &biz.TaskListFilter{PageSize: 10, OrderBy: tt.order})
+		if err != nil {
+			t.Fatalf("%s: list tasks: %v", tt.order, err)
+		}
+		if got := taskIDs(tasks); !equalIDs(got, tt.want) || total != 4 {
+			t.Fatalf("%s: got %v (total %d), want %v (total 4)", tt.order, got, total, tt.want)
+		}
+
+		// 每页一条，按游标逐页读取得到相同的顺序
+		var got []int64
+		var after *biz.PageCursor
+		for i := 0; i <= len(tt.want); i++ {
+			tasks, total, err := r.ListTasks(ctx, &biz.TaskListFilter{PageSize: 1, OrderBy: tt.order, After: after, SkipTotal: true})
+			if err != nil {
+				t.Fatalf("%s: list tasks after %+v: %v", tt.order, after, err)
+			}
+			if total != 0 {
+				t.Fatalf("%s: total = %d with SkipTotal, want 0", tt.order, total)
+			}
+			if len(tasks) == 0 {
+				break
+			}
+			got = append(got, tasks[0].ID)
+			after = &biz.PageCursor{Value: biz.TaskSortValue(tasks[0], tt.order.Field), ID: tasks[0].ID}
+		}
+		if !equalIDs(got, tt.want) {
+			t.Fatalf("%s: cursor pages = %v, want %v", tt.order, got, tt.want)
+		}
+	}
+}
+
+func testTaskStatusAndCounters(t *testing.T, r biz.TaskRepo) {
+	created := createTask(t, r, &biz.Task{Name: "counted"})
+
+	if ok, err := r.TransitionTaskStatus(ctx, created.ID, pb.TaskStatus_PENDING, pb.TaskStatus_PAUSED, "paused", now()); err != nil || !ok {
+		t.Fatalf("transition status = %v, %v", ok, err)
+	}
+	next := now().Add(time.Minute)
+	if err := r.UpdateTaskNextRunTime(ctx, created.ID, next); err != nil {
+		t.Fatalf("update next run time: %v", err)
+	}
+	for _, success := range []bool{true, true, false} {
+		if err := r.IncrementExecutionCount(ctx, created.ID, success); err != nil {
+			t.Fatalf("increment execution count: %v", err)
+		}
+	}
+
+	got := getTask(t, r, created.ID)
+	if got.Status != pb.TaskStatus_PAUSED {
+		t.Fatalf("status = %v, want PAUSED", got.Status)
+	}
+	sameTime(t, "next_run_time", got.NextRunTime, &next)
+	if got.ExecutionCount != 3 || got.SuccessCount != 2 || got.FailedCount != 1 {
+		t.Fatalf("counters = %d/%d/%d, want 3/2/1", got.ExecutionCount, got.SuccessCount, got.FailedCount)
+	}
+}
+
+func testListDueTasks(t *testing.T, r biz.TaskRepo) {
+	base := now()
+	later := createTask(t, r, &biz.Task{Name: "later", NextRunTime: timePtr(base.Add(-time.Minute))})
+	earlier := createTask(t, r, &biz.Task{Name: "earlier", NextRunTime: timePtr(base.Add(-time.Hour))})
+	createTask(t, r, &biz.Task{Name: "future", NextRunTime: timePtr(base.Add(time.Hour))})
+	createTask(t, r, &biz.Task{Name: "paused", Status: pb.TaskStatus_PAUSED, NextRunTime: timePtr(base.Add(-time.Hour))})
+	createTask(t, r, &biz.Task{Name: "unscheduled"})
+	exact := createTask(t, r, &biz.Task{Name: "exact", NextRunTime: timePtr(base)})
+
+	due, err := r.ListDueTasks(ctx, base, 10)
+	if err != nil {
+		t.Fatalf("list due tasks: %v", err)
+	}
+	if got, want := taskIDs(due), []int64{earlier.ID, later.ID, exact.ID}; !equalIDs(got, want) {
+		t.Fatalf("due tasks = %v, want %v", got, want)
+	}
+
+	due, err = r.ListDueTasks(ctx, base, 1)
+	if err != nil {
+		t.Fatalf("list due tasks: %v", err)
+	}
+	if got, want := taskIDs(due), []int64{earlier.ID}; !equalIDs(got, want) {
+		t.Fatalf("due tasks with limit = %v, want %v", got, want)
+	}
+}
+
+func testAdvanceTask(t *testing.T, r biz.TaskRepo) {
+	current := now()
+	created := createTask(t, r, &biz.Task{Name: "advance", NextRunTime: &current})
+
+	next := current.Add(time.Minute)
+	ok, err := r.AdvanceTask(ctx, created.ID, current.Add(-time.Second), &next, pb.TaskStatus_PENDING, "", true)
+	if err != nil || ok {
+		t.Fatalf("advance with stale current = %v, %v; want false, nil", ok, err)
+	}
+
+	ok, err = r.AdvanceTask(ctx, created.ID, current, &next, pb.TaskStatus_PENDING, "", true)
+	if err != nil || !ok {
+		t.Fatalf("advance = %v, %v; want true, nil", ok, err)
+	}
+	sameTime(t, "next_run_time", getTask(t, r, created.ID).NextRunTime, &next)
+	if got := getTask(t, r, created.ID).RunCount; got != 1 {
+		t.Fatalf("run count = %d, want 1", got)
+	}
+
+	// 同一个 current 只能推进一次
+	if ok, err = r.AdvanceTask(ctx, created.ID, current, &next, pb.TaskStatus_PENDING, "", true); err != nil || ok {
+		t.Fatalf("second advance = %v, %v; want false, nil", ok, err)
+	}
+
+	if got := getTask(t, r, created.ID); got.StatusReason != "" || got.StatusChangedAt != nil {
+		t.Fatalf("status change without reason recorded: %q at %v", got.StatusReason, got.StatusChangedAt)
+	}
+
+	// 未生成执行记录的推进不计入调度次数
+	ok, err = r.AdvanceTask(ctx, created.ID, next, nil, pb.TaskStatus_COMPLETED, "no further runs", false)
+	if err != nil || !ok {
+		t.Fatalf("advance to completion = %v, %v; want true, nil", ok, err)
+	}
+	got := getTask(t, r, created.ID)
+	sameTime(t, "next_run_time", got.NextRunTime, nil)
+	if got.Status != pb.TaskStatus_COMPLETED {
+		t.Fatalf("status = %v, want COMPLETED", got.Status)
+	}
+	if got.StatusReason != "no further runs" || got.StatusChangedAt == nil {
+		t.Fatalf("status reason = %q at %v, want no further runs", got.StatusReason, got.StatusChangedAt)
+	}
+	if got.RunCount != 1 {
+		t.Fatalf("run count = %d, want 1", got.RunCount)
+	}
+
+	// 并发暂停或取消的任务不能被分发推进回待调度
+	paused := createTask(t, r, &biz.Task{Name: "paused", Status: pb.TaskStatus_PAUSED, NextRunTime: &current})
+	if ok, err = r.AdvanceTask(ctx, paused.ID, current, &next, pb.TaskStatus_PENDING, "", true); err != nil || ok {
+		t.Fatalf("advance paused task = %v, %v; want false, nil", ok, err)
+	}
+	got = getTask(t, r, paused.ID)
+	sameTime(t, "next_run_time", got.NextRunTime, &current)
+	if got.Status != pb.TaskStatus_PAUSED {
+		t.Fatalf("status = %v, want PAUSED", got.Status)
+	}
+}
+
+func testRevertAdvance(t *testing.T, r biz.TaskRepo) {
+	current := now()
+	next := current.Add(time.Minute)
+	created := createTask(t, r, &biz.Task{Name: "revert", NextRunTime: &current})
+	if ok, err := r.AdvanceTask(ctx, created.ID, current, &next, pb.TaskStatus_PENDING, "", true); err != nil || !ok {
+		t.Fatalf("advance = %v, %v; want true, nil", ok, err)
+	}
+
+	if ok, err := r.RevertAdvance(ctx, created.ID, current, nil, pb.TaskStatus_PENDING, "", true); err != nil || ok {
+		t.Fatalf("revert with stale next = %v, %v; want false, nil", ok, err)
+	}
+	if ok, err := r.RevertAdvance(ctx, created.ID, current, &next, pb.TaskStatus_PENDING, "", true); err != nil || !ok {
+		t.Fatalf("revert = %v, %v; want true, nil", ok, err)
+	}
+	got := getTask(t, r, created.ID)
+	sameTime(t, "next_run_time", got.NextRunTime, &current)
+	if got.RunCount != 0 || got.Status != pb.TaskStatus_PENDING {
+		t.Fatalf("after revert run count = %d, status = %v; want 0, PENDING", got.RunCount, got.Status)
+	}
+
+	// 达到最大执行次数而完成的推进撤销后回到待调度
+	if ok, err := r.AdvanceTask(ctx, created.ID, current, nil, pb.TaskStatus_COMPLETED, "max runs reached", true); err != nil || !ok {
+		t.Fatalf("advance to completion = %v, %v; want true, nil", ok, err)
+	}
+	if ok, err := r.RevertAdvance(ctx, created.ID, current, nil, pb.TaskStatus_COMPLETED, "dispatch failed, retrying", true); err != nil || !ok {
+		t.Fatalf("revert completion = %v, %v; want true, nil", ok, err)
+	}
+	got = getTask(t, r, created.ID)
+	sameTime(t, "next_run_time", got.NextRunTime, &current)
+	if got.Status != pb.TaskStatus_PENDING || got.RunCount != 0 || got.StatusReason != "dispatch failed, retrying" {
+		t.Fatalf("after revert status = %v, run count = %d, reason = %q", got.Status, got.RunCount, got.StatusReason)
+	}
+
+	// 推进之后被暂停的任务不会被撤销回待调度
+	if ok, err := r.AdvanceTask(ctx, created.ID, current, &next, pb.TaskStatus_PENDING, "", true); err != nil || !ok {
+		t.Fatalf("advance = %v, %v; want true, nil", ok, err)
+	}
+	if ok, err := r.TransitionTaskStatus(ctx, created.ID, pb.TaskStatus_PENDING, pb.TaskStatus_PAUSED, "paused", time.Now()); err != nil || !ok {
+		t.Fatalf("pause = %v, %v", ok, err)
+	}
+	if ok, err := r.RevertAdvance(ctx, created.ID, current, &next, pb.TaskStatus_PENDING, "", true); err != nil || ok {
+		t.Fatalf("revert paused task = %v, %v; want false, nil", ok, err)
+	}
+	if got := getTask(t, r, created.ID); got.Status != pb.TaskStatus_PAUSED || got.RunCount != 1 {
+		t.Fatalf("status = %v, run count = %d; want PAUSED, 1", got.Status, got.RunCount)
+	}
+}
+
+func testRescheduleTask(t *testing.T, r biz.TaskRepo) {
+	scheduled := now().Add(time.Hour)
+	busy := createTask(t, r, &biz.Task{Name: "scheduled", NextRunTime: &scheduled})
+	idle := createTask(t, r, &biz.Task{Name: "idle"})
+	paused := createTask(t, r, &biz.Task{Name: "paused", Status: pb.TaskStatus_PAUSED})
+
+	next := now().Add(time.Minute)
+	for _, id := range []int64{busy.ID, paused.ID, 404} {
+		if ok, err := r.RescheduleTask(ctx, id, &next, pb.TaskStatus_PENDING, ""); err != nil || ok {
+			t.Fatalf("reschedule task %d = %v, %v; want false, nil", id, ok, err)
+		}
+	}
+	sameTime(t, "next_run_time", getTask(t, r, busy.ID).NextRunTime, &scheduled)
+
+	if ok, err := r.RescheduleTask(ctx, idle.ID, &next, pb.TaskStatus_PENDING, ""); err != nil || !ok {
+		t.Fatalf("reschedule idle task = %v, %v; want true, nil", ok, err)
+	}
+	sameTime(t, "next_run_time", getTask(t, r, idle.ID).NextRunTime, &next)
+
+	// 没有后续执行时间的任务只更新状态
+	other := createTask(t, r, &biz.Task{Name: "finished"})
+	if ok, err := r.RescheduleTask(ctx, other.ID, nil, pb.TaskStatus_COMPLETED, "no further runs"); err != nil || !ok {
+		t.Fatalf("complete task = %v, %v; want true, nil", ok, err)
+	}
+	got := getTask(t, r, other.ID)
+	if got.Status != pb.TaskStatus_COMPLETED || got.NextRunTime != nil || got.StatusReason != "no further runs" {
+		t.Fatalf("completed task = %+v", got)
+	}
+}
+
+func testTransitionTaskStatus(t *testing.T, r biz.TaskRepo) {
+	created := createTask(t, r, &biz.Task{Name: "transition"})
+	at := now()
+
+	// 状态已变化时不更新
+	if ok, err := r.TransitionTaskStatus(ctx, created.ID, pb.TaskStatus_PAUSED, pb.TaskStatus_PENDING, "resumed", at); err != nil || ok {
+		t.Fatalf("transition from stale status = %v, %v; want false, nil", ok, err)
+	}
+	if ok, err := r.TransitionTaskStatus(ctx, 404, pb.TaskStatus_PENDING, pb.TaskStatus_PAUSED, "paused", at); err != nil || ok {
+		t.Fatalf("transition missing task = %v, %v; want false, nil", ok, err)
+	}
+
+	if ok, err := r.TransitionTaskStatus(ctx, created.ID, pb.TaskStatus_PENDING, pb.TaskStatus_PAUSED, "maintenance", at); err != nil || !ok {
+		t.Fatalf("transition = %v, %v; want true, nil", ok, err)
+	}
+	got := getTask(t, r, created.ID)
+	if got.Status != pb.TaskStatus_PAUSED || got.StatusReason != "maintenance" {
+		t.Fatalf("transitioned task status = %v (%q), want PAUSED (maintenance)", got.Status, got.StatusReason)
+	}
+	sameTime(t, "status_changed_at", got.StatusChangedAt, &at)
+}
+
+func createExecution(t *testing.T, r biz.ExecutionRepo, execution *biz.TaskExecution) *biz.TaskExecution {
+	t.Helper()
+	if execution.TaskName == "" {
+		execution.TaskName = "task"
+	}
+	if execution.Status == pb.ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED {
+		execution.Status = pb.ExecutionStatus_QUEUED
+	}
+	created, err := r.CreateExecution(ctx, execution)
+	if err != nil {
+		t.Fatalf("create execution: %v", err)
+	}
+	if created.ID <= 0 {
+		t.Fatalf("created execution has id %d", created.ID)
+	}
+	return created
+}
+
+func getExecution(t *testing.T, r biz.ExecutionRepo, id int64) *biz.TaskExecution {
+	t.Helper()
+	execution, err := r.GetExecution(ctx, id)
+	if err != nil {
+		t.Fatalf("get execution %d: %v", id, err)
+	}
+	if execution == nil {
+		t.Fatalf("execution %d not found", id)
+	}
+	return execution
+}
+
+func executionIDs(executions []*biz.TaskExecution) []int64 {
+	ids := make([]int64, 0, len(executions))
+	for _, execution := range executions {
+		ids = append(ids, execution.ID)
+	}
+	return ids
+}
+
+func testCreateExecution(t *testing.T, r biz.ExecutionRepo) {
+	created := createExecution(t, r, &biz.TaskExecution{
+		TaskID:   1,
+		TaskName: "report",
+		Payload:  `{"a":1}`,
+		Priority: 4,
+	})
+
+	got := getExecution(t, r, created.ID)
+	if got.TaskID != 1 || got.TaskName != "report" || got.Status != pb.ExecutionStatus_QUEUED ||
+		got.Payload != `{"a":1}` || got.Priority != 4 || got.WaitTime != 0 {
+		t.Fatalf("unexpected execution %+v", got)
+	}
+	if got.CreatedAt.IsZero() {
+		t.Fatalf("created_at not set")
+	}
+}
+
+func testGetMissingExecution(t *testing.T, r biz.ExecutionRepo) {
+	execution, err := r.GetExecution(ctx, 404)
+	if err != nil || execution != nil {
+		t.Fatalf("get missing execution = %v, %v; want nil, nil", execution, err)
+	}
+}
+
+func testUpdateExecution(t *testing.T, r biz.ExecutionRepo) {
+	created := createExecution(t, r, &biz.TaskExecution{TaskID: 1, Payload: "kept"})
+
+	end := now()
+	updated, err := r.UpdateExecution(ctx, &biz.TaskExecution{
+		ID:       created.ID,
+		Status:   pb.ExecutionStatus_EXECUTION_FAILED,
+		EndTime:  &end,
+		Duration: 120,
+		Error:    "boom",
+		Payload:  "ignored",
+	})
+	if err != nil {
+		t.Fatalf("update execution: %v", err)
+	}
+	if updated == nil || updated.ID != created.ID {
+		t.Fatalf("update returned %+v", updated)
+	}
+
+	got := getExecution(t, r, created.ID)
+	if got.Status != pb.ExecutionStatus_EXECUTION_FAILED || got.Duration != 120 || got.Error != "boom" || got.Payload != "kept" {
+		t.Fatalf("unexpected execution %+v", got)
+	}
+	sameTime(t, "end_time", got.EndTime, &end)
+
+	if err := r.UpdateExecutionStatus(ctx, created.ID, pb.ExecutionStatus_EXECUTION_CANCELLED); err != nil {
+		t.Fatalf("update execution status: %v", err)
+	}
+	if got := getExecution(t, r, created.ID); got.Status != pb.ExecutionStatus_EXECUTION_CANCELLED {
+		t.Fatalf("status = %v, want EXECUTION_CANCELLED", got.Status)
+	}
+}
+
+func testListExecutions(t *testing.T, r biz.ExecutionRepo) {
+	a := createExecution(t, r, &biz.TaskExecution{TaskID: 1})
+	b := createExecution(t, r, &biz.TaskExecution{TaskID: 2})
+	c := createExecution(t, r, &biz.TaskExecution{TaskID: 1, Status: pb.ExecutionStatus_SUCCESS})
+	d := createExecution(t, r, &biz.TaskExecution{TaskID: 1})
+
+	tests := []struct {
+		name   string
+		filter biz.ExecutionListFilter
+		want   []int64
+		total  int64
+	}{
+		{"all", biz.ExecutionListFilter{Page: 1, PageSize: 10}, []int64{d.ID, c.ID, b.ID, a.ID}, 4},
+		{"task", biz.ExecutionListFilter{TaskID: 1, Page: 1, PageSize: 10}, []int64{d.ID, c.ID, a.ID}, 3},
+		{"status", biz.ExecutionListFilter{Status: pb.ExecutionStatus_QUEUED, Page: 1, PageSize: 10}, []int64{d.ID, b.ID, a.ID}, 3},
+		{"task and status", biz.ExecutionListFilter{TaskID: 1, Status: pb.ExecutionStatus_SUCCESS, Page: 1, PageSize: 10}, []int64{c.ID}, 1},
+		{"first page", biz.ExecutionListFilter{TaskID: 1, Page: 1, PageSize: 2}, []int64{d.ID, c.ID}, 3},
+		{"second page", biz.ExecutionListFilter{TaskID: 1, Page: 2, PageSize: 2}, []int64{a.ID}, 3},
+		{"past the end", biz.ExecutionListFilter{TaskID: 1, Page: 3, PageSize: 2}, nil, 3},
+	}
+	for _, tt := range tests {
+		filter := tt.filter
+		executions, total, err := r.ListExecutions(ctx, &filter)
+		if err != nil {
+			t.Fatalf("%s: list executions: %v", tt.name, err)
+		}
+		if got := executionIDs(executions); !equalIDs(got, tt.want) || total != tt.total {
+			t.Fatalf("%s: got %v (total %d), want %v (total %d)", tt.name, got, total, tt.want, tt.total)
+		}
+	}
+}
+
+func testListExecutionOrder(t *testing.T, r biz.ExecutionRepo) {
+	a := createExecution(t, r, &biz.TaskExecution{TaskID: 1, Duration: 30})
+	b := createExecution(t, r, &biz.TaskExecution{TaskID: 1, Duration: 10})
+	c := createExecution(t, r, &biz.TaskExecution{TaskID: 2, Duration: 30})
+	d := createExecution(t, r, &biz.TaskExecution{TaskID: 1, Duration: 20})
+
+	tests := []struct {
+		taskID int64
+		order  biz.ListOrder
+		want   []int64
+	}{
+		{0, biz.ListOrder{Field: biz.OrderByCreatedAt}, []int64{d.ID, c.ID, b.ID, a.ID}},
+		{0, biz.ListOrder{Field: biz.OrderByDuration}, []int64{c.ID, a.ID, d.ID, b.ID}},
+		{0, biz.ListOrder{Field: biz.OrderByDuration, Asc: true}, []int64{b.ID, d.ID, a.ID, c.ID}},
+		{1, biz.ListOrder{Field: biz.OrderByDuration}, []int64{a.ID, d.ID, b.ID}},
+		{1, biz.ListOrder{Field: biz.OrderByCreatedAt, Asc: true}, []int64{a.ID, b.ID, d.ID}},
+	}
+	for _, tt := range tests {
+		var got []int64
+		var after *biz.PageCursor
+		for i := 0; i <= len(tt.want); i++ {
+			executions, _, err := r.ListExecutions(ctx, &biz.ExecutionListFilter{TaskID: tt.taskID, PageSize: 2, OrderBy: tt.order, After: after})
+			if err != nil {
+				t.Fatalf("%s: list executions after %+v: %v", tt.order, after, err)
+			}
+			if len(executions) == 0 {
+				break
+			}
+			got = append(got, executionIDs(executions)...)
+			last := executions[len(executions)-1]
+			after = &biz.PageCursor{Value: biz.ExecutionSortValue(last, tt.order.Field), ID: last.ID}
+		}
+		if !equalIDs(got, tt.want) {
+			t.Fatalf("task %d, %s: cursor pages = %v, want %v", tt.taskID, tt.order, got, tt.want)
+		}
+	}
+}
+
+func testClaimExecution(t *testing.T, r biz.ExecutionRepo) {
+	created := createExecution(t, r, &biz.TaskExecution{TaskID: 1, TaskVersion: 1})
+	if created.TaskVersion != 1 {
+		t.Fatalf("task_version = %d, want 1", created.TaskVersion)
+	}
+
+	start := now()
+	claim := &biz.TaskExecution{
+		ID:          created.ID,
+		Status:      pb.ExecutionStatus_EXECUTING,
+		NodeID:      "node-1",
+		StartTime:   &start,
+		HeartbeatAt: &start,
+		WaitTime:    250,
+		TaskVersion: 2,
+	}
+	if ok, err := r.ClaimExecution(ctx, claim); err != nil || !ok {
+		t.Fatalf("claim = %v, %v; want true, nil", ok, err)
+	}
+	got := getExecution(t, r, created.ID)
+	if got.Status != pb.ExecutionStatus_EXECUTING || got.NodeID != "node-1" || got.WaitTime != 250 || got.TaskVersion != 2 {
+		t.Fatalf("unexpected claimed execution %+v", got)
+	}
+	sameTime(t, "start_time", got.StartTime, &start)
+	sameTime(t, "heartbeat_at", got.HeartbeatAt, &start)
+
+	// 已认领的记录不能再次认领
+	claim.NodeID = "node-2"
+	if ok, err := r.ClaimExecution(ctx, claim); err != nil || ok {
+		t.Fatalf("second claim = %v, %v; want false, nil", ok, err)
+	}
+	if got := getExecution(t, r, created.ID); got.NodeID != "node-1" {
+		t.Fatalf("node_id = %q, want node-1", got.NodeID)
+	}
+}
+
+func testExecutionHeartbeat(t *testing.T, r biz.ExecutionRepo) {
+	start := now().Add(-time.Hour)
+	fresh := createExecution(t, r, &biz.TaskExecution{TaskID: 1, Status: pb.ExecutionStatus_EXECUTING, StartTime: &start, HeartbeatAt: &start})
+	stale := createExecution(t, r, &biz.TaskExecution{TaskID: 1, Status: pb.ExecutionStatus_EXECUTING, StartTime: &start, HeartbeatAt: &start})
+	legacy := createExecution(t, r, &biz.TaskExecution{TaskID: 2, Status: pb.ExecutionStatus_EXECUTING, StartTime: &start})
+	cancelled := createExecution(t, r, &biz.TaskExecution{TaskID: 2, Status: pb.ExecutionStatus_EXECUTION_CANCELLED, StartTime: &start})
+
+	at := now()
+	stopped, err := r.HeartbeatExecutions(ctx, []int64{fresh.ID, cancelled.ID, 404}, at)
+	if err != nil {
+		t.Fatalf("heartbeat executions: %v", err)
+	}
+	if !equalIDs(stopped, []int64{cancelled.ID, 404}) {
+		t.Fatalf("stopped = %v, want [%d 404]", stopped, cancelled.ID)
+	}
+	sameTime(t, "heartbeat_at", getExecution(t, r, fresh.ID).HeartbeatAt, &at)
+	sameTime(t, "heartbeat_at", getExecution(t, r, cancelled.ID).HeartbeatAt, nil)
+
+	// 没有心跳的记录按开始时间判断
+	expired, err := r.ListExpiredExecutions(ctx, at.Add(-time.Minute), 10)
+	if err != nil {
+		t.Fatalf("list expired executions: %v", err)
+	}
+	if got := executionIDs(expired); !equalIDs(got, []int64{stale.ID, legacy.ID}) {
+		t.Fatalf("expired = %v, want [%d %d]", got, stale.ID, legacy.ID)
+	}
+	if expired, err = r.ListExpiredExecutions(ctx, at.Add(-time.Minute), 1); err != nil || len(expired) != 1 || expired[0].ID != stale.ID {
+		t.Fatalf("list expired with limit 1 = %v, %v; want [%d]", executionIDs(expired), err, stale.ID)
+	}
+}
+
+func testFinishExecution(t *testing.T, r biz.ExecutionRepo) {
+	queued := createExecution(t, r, &biz.TaskExecution{TaskID: 1})
+	running := createExecution(t, r, &biz.TaskExecution{TaskID: 1, Status: pb.ExecutionStatus_EXECUTING})
+
+	end := now()
+	finish := func(id int64) (bool, error) {
+		return r.FinishExecution(ctx, &biz.TaskExecution{
+			ID:       id,
+			Status:   pb.ExecutionStatus_SUCCESS,
+			EndTime:  &end,
+			Duration: 42,
+			Result:   "ok",
+		})
+	}
+
+	if ok, err := finish(queued.ID); err != nil || ok {
+		t.Fatalf("finish queued execution = %v, %v; want false, nil", ok, err)
+	}
+	if ok, err := finish(running.ID); err != nil || !ok {
+		t.Fatalf("finish running execution = %v, %v; want true, nil", ok, err)
+	}
+	got := getExecution(t, r, running.ID)
+	if got.Status != pb.ExecutionStatus_SUCCESS || got.Duration != 42 || got.Result != "ok" {
+		t.Fatalf("unexpected finished execution %+v", got)
+	}
+	sameTime(t, "end_time", got.EndTime, &end)
+
+	if ok, err := finish(running.ID); err != nil || ok {
+		t.Fatalf("finish twice = %v, %v; want false, nil", ok, err)
+	}
+}
+
+func testQueueStats(t *testing.T, r biz.ExecutionRepo) {
+	stats, err := r.GetQueueStats(ctx)
+	if err != nil {
+		t.Fatalf("queue stats: %v", err)
+	}
+	if stats.TotalQueued != 0 || stats.Executing != 0 || len(stats.Priorities) != 0 {
+		t.Fatalf("empty repo stats = %+v", stats)
+	}
+
+	createExecution(t, r, &biz.TaskExecution{TaskID: 1, Priority: 1})
+	createExecution(t, r, &biz.TaskExecution{TaskID: 1, Priority: 5})
+	createExecution(t, r, &biz.TaskExecution{TaskID: 1, Priority: 1})
+	createExecution(t, r, &biz.TaskExecution{TaskID: 1, Priority: 9, Status: pb.ExecutionStatus_EXECUTING})
+	createExecution(t, r, &biz.TaskExecution{TaskID: 1, Priority: 9, Status: pb.ExecutionStatus_SUCCESS})
+
+	stats, err = r.GetQueueStats(ctx)
+	if err != nil {
+		t.Fatalf("queue stats: %v", err)
+	}
+	if stats.TotalQueued != 3 || stats.Executing != 1 || len(stats.Priorities) != 2 {
+		t.Fatalf("stats = %+v", stats)
+	}
+	if p := stats.Priorities[0]; p.Priority != 5 || p.Queued != 1 || p.OldestQueuedAt == nil {
+		t.Fatalf("first priority stats = %+v", p)
+	}
+	if p := stats.Priorities[1]; p.Priority != 1 || p.Queued != 2 || p.OldestQueuedAt == nil {
+		t.Fatalf("second priority stats = %+v", p)
+	}
+}
+
+func testPruneExecutions(t *testing.T, r biz.ExecutionRepo) {
+	a := createExecution(t, r, &biz.TaskExecution{TaskID: 1, Status: pb.ExecutionStatus_SUCCESS})
+	b := createExecution(t, r, &biz.TaskExecution{TaskID: 1, Status: pb.ExecutionStatus_EXECUTION_FAILED})
+	createExecution(t, r, &biz.TaskExecution{TaskID: 1})
+	d := createExecution(t, r, &biz.TaskExecution{TaskID: 1, Status: pb.ExecutionStatus_TIMEOUT})
+	e := createExecution(t, r, &biz.TaskExecution{TaskID: 1, Status: pb.ExecutionStatus_EXECUTION_SKIPPED})
+	f := createExecution(t, r, &biz.TaskExecution{TaskID: 2, Status: pb.ExecutionStatus_SUCCESS})
+
+	taskIDs, err := r.ListExecutionTaskIDs(ctx)
+	if err != nil {
+		t.Fatalf("list execution task ids: %v", err)
+	}
+	if !equalIDs(taskIDs, []int64{1, 2}) {
+		t.Fatalf("task ids = %v, want [1 2]", taskIDs)
+	}
+
+	past, future := now().Add(-time.Hour), now().Add(time.Hour)
+	tests := []struct {
+		name   string
+		filter biz.ExecutionPruneFilter
+		limit  int
+		want   []int64
+	}{
+		{"nothing expired", biz.ExecutionPruneFilter{TaskID: 1, CreatedBefore: past, FailedCreatedBefore: past}, 10, nil},
+		{"failures kept longer", biz.ExecutionPruneFilter{TaskID: 1, CreatedBefore: future, FailedCreatedBefore: past}, 10, []int64{a.ID, e.ID}},
+		{"all finished", biz.ExecutionPruneFilter{TaskID: 1, CreatedBefore: future, FailedCreatedBefore: future}, 10, []int64{a.ID, b.ID, d.ID, e.ID}},
+		{"limit", biz.ExecutionPruneFilter{TaskID: 1, CreatedBefore: future, FailedCreatedBefore: future}, 2, []int64{a.ID, b.ID}},
+		{"keep last", biz.ExecutionPruneFilter{TaskID: 1, KeepLast: 2, CreatedBefore: future, FailedCreatedBefore: future}, 10, []int64{a.ID, b.ID}},
+		{"keep more than exist", biz.ExecutionPruneFilter{TaskID: 1, KeepLast: 10, CreatedBefore: future, FailedCreatedBefore: future}, 10, nil},
+		{"other task", biz.ExecutionPruneFilter{TaskID: 2, CreatedBefore: future, FailedCreatedBefore: future}, 10, []int64{f.ID}},
+	}
+	for _, tt := range tests {
+		filter := tt.filter
+		executions, err := r.ListPrunableExecutions(ctx, &filter, tt.limit)
+		if err != nil {
+			t.Fatalf("%s: list prunable executions: %v", tt.name, err)
+		}
+		if got := executionIDs(executions); !equalIDs(got, tt.want) {
+			t.Fatalf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	deleted, err := r.DeleteExecutions(ctx, []int64{a.ID, b.ID, 404})
+	if err != nil || deleted != 2 {
+		t.Fatalf("delete executions = %d, %v; want 2, nil", deleted, err)
+	}
+	if execution, err := r.GetExecution(ctx, a.ID); err != nil || execution != nil {
+		t.Fatalf("deleted execution = %v, %v; want nil, nil", execution, err)
+	}
+	getExecution(t, r, d.ID)
+}
+
+func testCancelExecution(t *testing.T, r biz.ExecutionRepo) {
+	queued := createExecution(t, r, &biz.TaskExecution{TaskID: 1})
+	running := createExecution(t, r, &biz.TaskExecution{TaskID: 1, Status: pb.ExecutionStatus_EXECUTING})
+	finished := createExecution(t, r, &biz.TaskExecution{TaskID: 1, Status: pb.ExecutionStatus_SUCCESS})
+
+	end := now()
+	for _, id := range []int64{queued.ID, running.ID} {
+		if ok, err := r.CancelExecution(ctx, id, end); err != nil || !ok {
+			t.Fatalf("cancel execution %d = %v, %v; want true, nil", id, ok, err)
+		}
+		got := getExecution(t, r, id)
+		if got.Status != pb.ExecutionStatus_EXECUTION_CANCELLED {
+			t.Fatalf("status = %v, want EXECUTION_CANCELLED", got.Status)
+		}
+		sameTime(t, "end_time", got.EndTime, &end)
+	}
+
+	// 已结束和已取消的执行记录不会被覆盖
+	for _, id := range []int64{finished.ID, queued.ID, 404} {
+		if ok, err := r.CancelExecution(ctx, id, end.Add(time.Minute)); err != nil || ok {
+			t.Fatalf("cancel execution %d = %v, %v; want false, nil", id, ok, err)
+		}
+	}
+	if got := getExecution(t, r, finished.ID); got.Status != pb.ExecutionStatus_SUCCESS || got.EndTime != nil {
+		t.Fatalf("finished execution changed: %v at %v", got.Status, got.EndTime)
+	}
+	sameTime(t, "end_time", getExecution(t, r, queued.ID).EndTime, &end)
+}
+
+func testCancelQueuedExecutions(t *testing.T, r biz.ExecutionRepo) {
+	a := createExecution(t, r, &biz.TaskExecution{TaskID: 1})
+	running := createExecution(t, r, &biz.TaskExecution{TaskID: 1, Status: pb.ExecutionStatus_EXECUTING})
+	b := createExecution(t, r, &biz.TaskExecution{TaskID: 1})
+	other := createExecution(t, r, &biz.TaskExecution{TaskID: 2})
+
+	end := now()
+	cancelled, err := r.CancelQueuedExecutions(ctx, 1, end)
+	if err != nil {
+		t.Fatalf("cancel queued executions: %v", err)
+	}
+	if !equalIDs(cancelled, []int64{a.ID, b.ID}) {
+		t.Fatalf("cancelled = %v, want [%d %d]", cancelled, a.ID, b.ID)
+	}
+	got := getExecution(t, r, a.ID)
+	if got.Status != pb.ExecutionStatus_EXECUTION_CANCELLED {
+		t.Fatalf("status = %v, want EXECUTION_CANCELLED", got.Status)
+	}
+	sameTime(t, "end_time", got.EndTime, &end)
+	if got := getExecution(t, r, running.ID); got.Status != pb.ExecutionStatus_EXECUTING {
+		t.Fatalf("running execution status = %v", got.Status)
+	}
+	if got := getExecution(t, r, other.ID); got.Status != pb.ExecutionStatus_QUEUED {
+		t.Fatalf("other task execution status = %v", got.Status)
+	}
+
+	if cancelled, err := r.CancelQueuedExecutions(ctx, 1, end); err != nil || len(cancelled) != 0 {
+		t.Fatalf("cancel twice = %v, %v; want none", cancelled, err)
+	}
+}
+
+func testDeleteTaskExecutions(t *testing.T, r biz.ExecutionRepo) {
+	a := createExecution(t, r, &biz.TaskExecution{TaskID: 1})
+	createExecution(t, r, &biz.TaskExecution{TaskID: 1, Status: pb.ExecutionStatus_SUCCESS})
+	other := createExecution(t, r, &biz.TaskExecution{TaskID: 2})
+
+	deleted, err := r.DeleteTaskExecutions(ctx, 1)
+	if err != nil || deleted != 2 {
+		t.Fatalf("delete task executions = %d, %v; want 2, nil", deleted, err)
+	}
+	if execution, err := r.GetExecution(ctx, a.ID); err != nil || execution != nil {
+		t.Fatalf("deleted execution = %v, %v; want nil, nil", execution, err)
+	}
+	getExecution(t, r, other.ID)
+}
+
+func createRevision(t *testing.T, r biz.TaskRevisionRepo, taskID, version int64) *biz.TaskRevision {
+	t.Helper()
+	created, err := r.CreateRevision(ctx, &biz.TaskRevision{
+		TaskID:   taskID,
+		Version:  version,
+		Action:   pb.TaskRevisionAction_REVISION_UPDATED,
+		Snapshot: &biz.Task{Name: "task", Type: pb.TaskType_CRON, Handler: "http"},
+	})
+	if err != nil {
+		t.Fatalf("create revision: %v", err)
+	}
+	return created
+}
+
+func testCreateRevision(t *testing.T, r biz.TaskRevisionRepo) {
+	start := now()
+	created, err := r.CreateRevision(ctx, &biz.TaskRevision{
+		TaskID:  1,
+		Version: 2,
+		Action:  pb.TaskRevisionAction_REVISION_ROLLED_BACK,
+		Author:  "alice",
+		Snapshot: &biz.Task{
+			Name:              "report",
+			Type:              pb.TaskType_INTERVAL,
+			Schedule:          "5m",
+			Handler:           "http",
+			Payload:           `{"a":1}`,
+			Metadata:          map[string]string{"team": "ops"},
+			StartTime:         &start,
+			ActiveWindows:     []biz.TimeWindow{{Start: "01:00", End: "05:00"}},
+			IntervalMode:      pb.IntervalMode_FIXED_DELAY,
+			Jitter:            1500 * time.Millisecond,
+			ConcurrencyPolicy: pb.ConcurrencyPolicy_SKIP,
+			Retention:         biz.RetentionPolicy{KeepLast: 3},
+		},
+		Changes:       []biz.TaskFieldChange{{Field: "schedule", OldValue: "1m", NewValue: "5m"}},
+		SourceVersion: 1,
+	})
+	if err != nil || created.ID <= 0 || created.CreatedAt.IsZero() {
+		t.Fatalf("create revision = %+v, %v", created, err)
+	}
+
+	got, err := r.GetRevision(ctx, 1, 2)
+	if err != nil || got == nil {
+		t.Fatalf("get revision = %v, %v", got, err)
+	}
+	s := got.Snapshot
+	if got.Action != pb.TaskRevisionAction_REVISION_ROLLED_BACK || got.Author != "alice" || got.SourceVersion != 1 ||
+		s.Name != "report" || s.Type != pb.TaskType_INTERVAL || s.Schedule != "5m" || s.Payload != `{"a":1}` ||
+		s.Metadata["team"] != "ops" || len(s.ActiveWindows) != 1 || s.IntervalMode != pb.IntervalMode_FIXED_DELAY ||
+		s.Jitter != 1500*time.Millisecond || s.ConcurrencyPolicy != pb.ConcurrencyPolicy_SKIP || s.Retention.KeepLast != 3 {
+		t.Fatalf("unexpected revision %+v (snapshot %+v)", got, s)
+	}
+	sameTime(t, "start_time", s.StartTime, &start)
+	if len(got.Changes) != 1 || got.Changes[0] != (biz.TaskFieldChange{Field: "schedule", OldValue: "1m", NewValue: "5m"}) {
+		t.Fatalf("changes = %+v", got.Changes)
+	}
+
+	// 同一任务的版本不能重复
+	if _, err := r.CreateRevision(ctx, &biz.TaskRevision{TaskID: 1, Version: 2, Snapshot: &biz.Task{}}); err == nil {
+		t.Fatalf("duplicate revision created")
+	}
+	if got, err := r.GetRevision(ctx, 1, 404); err != nil || got != nil {
+		t.Fatalf("get missing revision = %v, %v; want nil, nil", got, err)
+	}
+}
+
+func testListRevisions(t *testing.T, r biz.TaskRevisionRepo) {
+	for version := int64(1); version <= 3; version++ {
+		createRevision(t, r, 1, version)
+	}
+	createRevision(t, r, 2, 1)
+
+	revisions, total, err := r.ListRevisions(ctx, &biz.TaskRevisionListFilter{TaskID: 1, Page: 1, PageSize: 2})
+	if err != nil || total != 3 || len(revisions) != 2 || revisions[0].Version != 3 || revisions[1].Version != 2 {
+		t.Fatalf("list revisions = %+v, %d, %v", revisions, total, err)
+	}
+	revisions, _, err = r.ListRevisions(ctx, &biz.TaskRevisionListFilter{TaskID: 1, Page: 2, PageSize: 2})
+	if err != nil || len(revisions) != 1 || revisions[0].Version != 1 {
+		t.Fatalf("list revisions page 2 = %+v, %v", revisions, err)
+	}
+
+	if deleted, err := r.DeleteTaskRevisions(ctx, 1); err != nil || deleted != 3 {
+		t.Fatalf("delete revisions = %d, %v; want 3", deleted, err)
+	}
+	if _, total, _ := r.ListRevisions(ctx, &biz.TaskRevisionListFilter{TaskID: 2, Page: 1, PageSize: 10}); total != 1 {
+		t.Fatalf("revisions of other task deleted: total = %d", total)
+	}
+}
+
+func testTaskNamespaces(t *testing.T, r biz.TaskRepo) {
+	teamA := biz.NewNamespaceContext(ctx, "team-a")
+	teamB := biz.NewNamespaceContext(ctx, "team-b")
+
+	a := createTask(t, r, &biz.Task{Name: "a", Namespace: "team-a"})
+	b := createTask(t, r, &biz.Task{Name: "b"})
+	c, err := r.CreateTask(teamA, &biz.Task{Name: "c", Namespace: "team-b", Type: pb.TaskType_CRON, Handler: "http"})
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	if a.Namespace != "team-a" || b.Namespace != biz.DefaultNamespace || c.Namespace != "team-a" {
+		t.Fatalf("namespaces = %q, %q, %q, want team-a, default, team-a", a.Namespace, b.Namespace, c.Namespace)
+	}
+
+	// 其他命名空间的任务不可见，也不能修改
+	if got, err := r.GetTask(teamB, a.ID); err != nil || got != nil {
+		t.Fatalf("get task from another namespace = %v, %v, want nil", got, err)
+	}
+	if got, err := r.UpdateTask(teamB, &biz.Task{ID: a.ID, Name: "renamed"}, nil); err != nil || got != nil {
+		t.Fatalf("update task from another namespace = %v, %v, want nil", got, err)
+	}
+	if ok, err := r.TransitionTaskStatus(teamB, a.ID, pb.TaskStatus_PENDING, pb.TaskStatus_PAUSED, "paused", now()); err != nil || ok {
+		t.Fatalf("transition status from another namespace = %v, %v; want false, nil", ok, err)
+	}
+	if err := r.DeleteTask(teamB, a.ID); err != nil {
+		t.Fatalf("delete task: %v", err)
+	}
+	if got := getTask(t, r, a.ID); got.Name != "a" || got.Status != pb.TaskStatus_PENDING {
+		t.Fatalf("task changed from another namespace: %+v", got)
+	}
+	if got, err := r.GetTask(teamA, a.ID); err != nil || got == nil {
+		t.Fatalf("get task from its namespace = %v, %v", got, err)
+	}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		filter biz.TaskListFilter
+		want   []int64
+	}{
+		{"unscoped", ctx, biz.TaskListFilter{}, []int64{c.ID, b.ID, a.ID}},
+		{"scoped context", teamA, biz.TaskListFilter{}, []int64{c.ID, a.ID}},
+		{"empty namespace", teamB, biz.TaskListFilter{}, nil},
+		{"namespace filter", ctx, biz.TaskListFilter{Namespace: biz.DefaultNamespace}, []int64{b.ID}},
+		{"filter outside scoped context", teamA, biz.TaskListFilter{Namespace: biz.DefaultNamespace}, nil},
+		{"namespace scope", ctx, biz.TaskListFilter{Scopes: []biz.AccessScope{{Namespaces: []string{"team-a"}}}}, []int64{c.ID, a.ID}},
+		{"any namespace scope", ctx, biz.TaskListFilter{Scopes: []biz.AccessScope{{Namespaces: []string{"team-b", biz.DefaultNamespace}}}}, []int64{b.ID}},
+	}
+	for _, tt := range tests {
+		filter := tt.filter
+		filter.Page, filter.PageSize = 1, 10
+		tasks, total, err := r.ListTasks(tt.ctx, &filter)
+		if err != nil {
+			t.Fatalf("%s: list tasks: %v", tt.name, err)
+		}
+		if got := taskIDs(tasks); !equalIDs(got, tt.want) || total != int64(len(tt.want)) {
+			t.Fatalf("%s: got %v (total %d), want %v", tt.name, got, total, tt.want)
+		}
+	}
+
+	if err := r.DeleteTask(teamA, a.ID); err != nil {
+		t.Fatalf("delete task: %v", err)
+	}
+	counts := []struct {
+		ctx            context.Context
+		includeDeleted bool
+		want           int64
+	}{
+		{ctx, false, 1},
+		{ctx, true, 2},
+		{teamA, true, 2},
+		{teamB, true, 0},
+	}
+	for _, tt := range counts {
+		count, err := r.CountTasks(tt.ctx, "team-a", tt.includeDeleted)
+		if err != nil {
+			t.Fatalf("count tasks: %v", err)
+		}
+		if count != tt.want {
+			t.Fatalf("count tasks (include deleted %v) = %d, want %d", tt.includeDeleted, count, tt.want)
+		}
+	}
+}
+
+func testExecutionNamespaces(t *testing.T, r biz.ExecutionRepo) {
+	teamA := biz.NewNamespaceContext(ctx, "team-a")
+	teamB := biz.NewNamespaceContext(ctx, "team-b")
+	start := now()
+
+	a := createExecution(t, r, &biz.TaskExecution{TaskID: 1, Namespace: "team-a", Status: pb.ExecutionStatus_EXECUTING, StartTime: timePtr(start)})
+	b := createExecution(t, r, &biz.TaskExecution{TaskID: 1, Namespace: "team-a", Status: pb.ExecutionStatus_SUCCESS, StartTime: timePtr(start.Add(-time.Hour))})
+	c := createExecution(t, r, &biz.TaskExecution{TaskID: 2})
+	if c.Namespace != biz.DefaultNamespace {
+		t.Fatalf("namespace = %q, want default", c.Namespace)
+	}
+
+	if got, err := r.GetExecution(teamB, a.ID); err != nil || got != nil {
+		t.Fatalf("get execution from another namespace = %v, %v, want nil", got, err)
+	}
+	if ok, err := r.FinishExecution(teamB, &biz.TaskExecution{ID: a.ID, Status: pb.ExecutionStatus_SUCCESS}); err != nil || ok {
+		t.Fatalf("finish execution from another namespace = %v, %v, want false", ok, err)
+	}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		filter biz.ExecutionListFilter
+		want   []int64
+	}{
+		{"unscoped", ctx, biz.ExecutionListFilter{}, []int64{c.ID, b.ID, a.ID}},
+		{"scoped context", teamA, biz.ExecutionListFilter{}, []int64{b.ID, a.ID}},
+		{"empty namespace", teamB, biz.ExecutionListFilter{}, nil},
+		{"namespace filter", ctx, biz.ExecutionListFilter{Namespace: biz.DefaultNamespace}, []int64{c.ID}},
+	}
+	for _, tt := range tests {
+		filter := tt.filter
+		filter.Page, filter.PageSize = 1, 10
+		executions, total, err := r.ListExecutions(tt.ctx, &filter)
+		if err != nil {
+			t.Fatalf("%s: list executions: %v", tt.name, err)
+		}
+		if got := executionIDs(executions); !equalIDs(got, tt.want) || total != int64(len(tt.want)) {
+			t.Fatalf("%s: got %v (total %d), want %v", tt.name, got, total, tt.want)
+		}
+	}
+
+	usage, err := r.GetNamespaceUsage(ctx, "team-a", start.Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("namespace usage: %v", err)
+	}
+	if usage.Running != 1 || usage.StartedSince != 1 {
+		t.Fatalf("usage = %+v, want 1 running and 1 started", usage)
+	}
+	usage, err = r.GetNamespaceUsage(teamB, "team-a", start.Add(-2*time.Hour))
+	if err != nil {
+		t.Fatalf("namespace usage: %v", err)
+	}
+	if usage.Running != 0 || usage.StartedSince != 0 {
+		t.Fatalf("usage from another namespace = %+v, want none", usage)
+	}
+}
+
+func testNamespaceCRUD(t *testing.T, r biz.NamespaceRepo) {
+	def, err := r.GetNamespace(ctx, biz.DefaultNamespace)
+	if err != nil || def == nil {
+		t.Fatalf("default namespace = %v, %v", def, err)
+	}
+
+	created, err := r.CreateNamespace(ctx, &biz.Namespace{Name: "team-a", Description: "Team A", MaxTasks: 10, MaxConcurrentExecutions: 2, MaxExecutionsPerMinute: 30})
+	if err != nil {
+		t.Fatalf("create namespace: %v", err)
+	}
+	if created.ID <= 0 || created.CreatedAt.IsZero() {
+		t.Fatalf("created namespace = %+v", created)
+	}
+	got, err := r.GetNamespace(ctx, "team-a")
+	if err != nil || got == nil {
+		t.Fatalf("get namespace = %v, %v", got, err)
+	}
+	if got.Description != "Team A" || got.MaxTasks != 10 || got.MaxConcurrentExecutions != 2 || got.MaxExecutionsPerMinute != 30 {
+		t.Fatalf("namespace = %+v", got)
+	}
+
+	updated, err := r.UpdateNamespace(ctx, &biz.Namespace{Name: "team-a", MaxTasks: 5})
+	if err != nil || updated == nil {
+		t.Fatalf("update namespace = %v, %v", updated, err)
+	}
+	if updated.Description != "" || updated.MaxTasks != 5 || updated.MaxConcurrentExecutions != 0 || updated.MaxExecutionsPerMinute != 0 {
+		t.Fatalf("updated namespace = %+v", updated)
+	}
+	if missing, err := r.UpdateNamespace(ctx, &biz.Namespace{Name: "missing"}); err != nil || missing != nil {
+		t.Fatalf("update missing namespace = %v, %v, want nil", missing, err)
+	}
+
+	if err := r.DeleteNamespace(ctx, "team-a"); err != nil {
+		t.Fatalf("delete namespace: %v", err)
+	}
+	if got, err := r.GetNamespace(ctx, "team-a"); err != nil || got != nil {
+		t.Fatalf("deleted namespace = %v, %v, want nil", got, err)
+	}
+}
+
+func testListNamespaces(t *testing.T, r biz.NamespaceRepo) {
+	for _, name := range []string{"team-b", "team-a", "data"} {
+		if _, err := r.CreateNamespace(ctx, &biz.Namespace{Name: name}); err != nil {
+			t.Fatalf("create namespace: %v", err)
+		}
+	}
+
+	names := func(namespaces []*biz.Namespace) []string {
+		result := make([]string, 0, len(namespaces))
+		for _, n := range namespaces {
+			result = append(result, n.Name)
+		}
+		return result
+	}
+	tests := []struct {
+		name   string
+		filter biz.NamespaceListFilter
+		want   []string
+		total  int64
+	}{
+		{"all by name", biz.NamespaceListFilter{Page: 1, PageSize: 10}, []string{"data", "default", "team-a", "team-b"}, 4},
+		{"keyword", biz.NamespaceListFilter{Keyword: "TEAM", Page: 1, PageSize: 10}, []string{"team-a", "team-b"}, 2},
+		{"second page", biz.NamespaceListFilter{Page: 2, PageSize: 3}, []string{"team-b"}, 4},
+	}
+	for _, tt := range tests {
+		filter := tt.filter
+		namespaces, total, err := r.ListNamespaces(ctx, &filter)
+		if err != nil {
+			t.Fatalf("%s: list namespaces: %v", tt.name, err)
+		}
+		if got := names(namespaces); strings.Join(got, ",") != strings.Join(tt.want, ",") || total != tt.total {
+			t.Fatalf("%s: got %v (total %d), want %v (total %d)", tt.name, got, total, tt.want, tt.total)
+		}
+	}
+
+	all, err := r.ListAllNamespaces(ctx)
+	if err != nil {
+		t.Fatalf("list all namespaces: %v", err)
+	}
+	if got := names(all); strings.Join(got, ",") != "data,default,team-a,team-b" {
+		t.Fatalf("all namespaces = %v", got)
+	}
+}
+
+func createSecret(t *testing.T, r biz.SecretRepo, namespace, name, keyID string) *biz.Secret {
+	t.Helper()
+	created, err := r.CreateSecret(ctx, &biz.Secret{
+		Namespace: namespace,
+		Name:      name,
+		Version:   1,
+		Value:     biz.EncryptedValue{KeyID: keyID, EncryptedKey: []byte{1, 2, 3}, Ciphertext: []byte(name)},
+	})
+	if err != nil {
+		t.Fatalf("create secret: %v", err)
+	}
+	return created
+}
+
+func secretNames(secrets []*biz.Secret) []string {
+	names := make([]string, 0, len(secrets))
+	for _, s := range secrets {
+		names = append(names, s.Namespace+"/"+s.Name)
+	}
+	return names
+}
+
+func testSecretCRUD(t *testing.T, r biz.SecretRepo) {
+	created, err := r.CreateSecret(ctx, &biz.Secret{
+		Namespace:   "team-a",
+		Name:        "api.token",
+		Description: "API token",
+		Version:     1,
+		Value:       biz.EncryptedValue{KeyID: "k1", EncryptedKey: []byte{0, 1, 2}, Ciphertext: []byte{3, 4, 5, 0}},
+	})
+	if err != nil {
+		t.Fatalf("create secret: %v", err)
+	}
+	if created.ID <= 0 || created.CreatedAt.IsZero() {
+		t.Fatalf("created secret = %+v", created)
+	}
+
+	got, err := r.GetSecret(ctx, "team-a", "api.token")
+	if err != nil || got == nil {
+		t.Fatalf("get secret = %v, %v", got, err)
+	}
+	if got.Description != "API token" || got.Version != 1 || got.Value.KeyID != "k1" ||
+		string(got.Value.EncryptedKey) != string([]byte{0, 1, 2}) || string(got.Value.Ciphertext) != string([]byte{3, 4, 5, 0}) {
+		t.Fatalf("secret = %+v", got)
+	}
+	if missing, err := r.GetSecret(ctx, biz.DefaultNamespace, "api.token"); err != nil || missing != nil {
+		t.Fatalf("secret in another namespace = %v, %v, want nil", missing, err)
+	}
+
+	got.Description = ""
+	got.Version = 2
+	got.Value = biz.EncryptedValue{KeyID: "k2", EncryptedKey: []byte{9}, Ciphertext: []byte{8}}
+	updated, err := r.UpdateSecret(ctx, got)
+	if err != nil || updated == nil {
+		t.Fatalf("update secret = %v, %v", updated, err)
+	}
+	if updated.Description != "" || updated.Version != 2 || updated.Value.KeyID != "k2" || string(updated.Value.Ciphertext) != string([]byte{8}) {
+		t.Fatalf("updated secret = %+v", updated)
+	}
+	if missing, err := r.UpdateSecret(ctx, &biz.Secret{Namespace: "team-a", Name: "missing"}); err != nil || missing != nil {
+		t.Fatalf("update missing secret = %v, %v, want nil", missing, err)
+	}
+
+	if err := r.DeleteSecret(ctx, "team-a", "api.token"); err != nil {
+		t.Fatalf("delete secret: %v", err)
+	}
+	if got, err := r.GetSecret(ctx, "team-a", "api.token"); err != nil || got != nil {
+		t.Fatalf("deleted secret = %v, %v, want nil", got, err)
+	}
+}
+
+func testListSecrets(t *testing.T, r biz.SecretRepo) {
+	createSecret(t, r, "team-b", "token", "k1")
+	createSecret(t, r, "team-a", "token", "k1")
+	createSecret(t, r, "team-a", "db-password", "k1")
+
+	tests := []struct {
+		name   string
+		filter biz.SecretListFilter
+		want   []string
+		total  int64
+	}{
+		{"all by namespace and name", biz.SecretListFilter{Page: 1, PageSize: 10}, []string{"team-a/db-password", "team-a/token", "team-b/token"}, 3},
+		{"namespace", biz.SecretListFilter{Namespace: "team-a", Page: 1, PageSize: 10}, []string{"team-a/db-password", "team-a/token"}, 2},
+		{"keyword", biz.SecretListFilter{Keyword: "TOK", Page: 1, PageSize: 10}, []string{"team-a/token", "team-b/token"}, 2},
+		{"second page", biz.SecretListFilter{Page: 2, PageSize: 2}, []string{"team-b/token"}, 3},
+	}
+	for _, tt := range tests {
+		filter := tt.filter
+		secrets, total, err := r.ListSecrets(ctx, &filter)
+		if err != nil {
+			t.Fatalf("%s: list secrets: %v", tt.name, err)
+		}
+		if got := secretNames(secrets); strings.Join(got, ",") != strings.Join(tt.want, ",") || total != tt.total {
+			t.Fatalf("%s: got %v (total %d), want %v (total %d)", tt.name, got, total, tt.want, tt.total)
+		}
+	}
+
+	if count, err := r.CountSecrets(ctx, "team-a"); err != nil || count != 2 {
+		t.Fatalf("count secrets = %d, %v, want 2", count, err)
+	}
+	if count, err := r.CountSecrets(ctx, "empty"); err != nil || count != 0 {
+		t.Fatalf("count secrets of empty namespace = %d, %v, want 0", count, err)
+	}
+}
+
+func testListSecretsToRewrap(t *testing.T, r biz.SecretRepo) {
+	createSecret(t, r, "team-a", "a", "old")
+	createSecret(t, r, "team-a", "b", "new")
+	createSecret(t, r, "team-b", "c", "old")
+	createSecret(t, r, "team-b", "d", "older")
+
+	secrets, err := r.ListSecretsToRewrap(ctx, "new", 10)
+	if err != nil {
+		t.Fatalf("list secrets to rewrap: %v", err)
+	}
+	if got := secretNames(secrets); strings.Join(got, ",") != "team-a/a,team-b/c,team-b/d" {
+		t.Fatalf("secrets to rewrap = %v", got)
+	}
+	if len(secrets) == 0 || len(secrets[0].Value.EncryptedKey) == 0 || len(secrets[0].Value.Ciphertext) == 0 {
+		t.Fatalf("secrets to rewrap must include the encrypted value: %+v", secrets)
+	}
+
+	secrets, err = r.ListSecretsToRewrap(ctx, "new", 2)
+	if err != nil {
+		t.Fatalf("list secrets to rewrap: %v", err)
+	}
+	if got := secretNames(secrets); strings.Join(got, ",") != "team-a/a,team-b/c" {
+		t.Fatalf("limited secrets to rewrap = %v", got)
+	}
+}
+
+func testSecretNamespaces(t *testing.T, r biz.SecretRepo) {
+	createSecret(t, r, "team-a", "token", "k1")
+	createSecret(t, r, "team-b", "token", "k1")
+
+	scoped := biz.NewNamespaceContext(ctx, "team-a")
+	if got, err := r.GetSecret(scoped, "team-b", "token"); err != nil || got != nil {
+		t.Fatalf("secret from another namespace = %v, %v, want nil", got, err)
+	}
+	secrets, total, err := r.ListSecrets(scoped, &biz.SecretListFilter{Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("list secrets: %v", err)
+	}
+	if got := secretNames(secrets); strings.Join(got, ",") != "team-a/token" || total != 1 {
+		t.Fatalf("scoped secrets = %v (total %d)", got, total)
+	}
+	if secrets, err := r.ListSecretsToRewrap(scoped, "k2", 10); err != nil || len(secrets) != 1 {
+		t.Fatalf("scoped secrets to rewrap = %d, %v, want 1", len(secrets), err)
+	}
+
+	// 限定命名空间时新密钥写入该命名空间，不能修改或删除其他命名空间中的密钥
+	created, err := r.CreateSecret(scoped, &biz.Secret{Namespace: "team-b", Name: "other", Version: 1, Value: biz.EncryptedValue{KeyID: "k1", EncryptedKey: []byte{1}, Ciphertext: []byte{1}}})
+	if err != nil {
+		t.Fatalf("create scoped secret: %v", err)
+	}
+	if created.Namespace != "team-a" {
+		t.Fatalf("scoped secret namespace = %q, want team-a", created.Namespace)
+	}
+	if updated, err := r.UpdateSecret(scoped, &biz.Secret{Namespace: "team-b", Name: "token", Description: "changed", Version: 2}); err != nil || updated != nil {
+		t.Fatalf("update secret in another namespace = %v, %v, want nil", updated, err)
+	}
+	if err := r.DeleteSecret(scoped, "team-b", "token"); err != nil {
+		t.Fatalf("delete secret in another namespace: %v", err)
+	}
+	got, err := r.GetSecret(ctx, "team-b", "token")
+	if err != nil || got == nil || got.Description != "" || got.Version != 1 {
+		t.Fatalf("secret in another namespace after scoped update and delete = %+v, %v", got, err)
+	}
+}
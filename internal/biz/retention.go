@@ -0,0 +1,197 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// retentionLockGroup 清理执行记录时持有的集群锁，同一时间只有一个节点清理
+	retentionLockGroup = "__retention_janitor"
+	// retentionLockTTL 清理锁的有效期，节点异常退出时锁在过期后可被其他节点获取
+	retentionLockTTL = 10 * time.Minute
+)
+
+// RetentionPolicy 执行记录保留策略，字段为 0 表示不启用该规则
+// 只清理已结束的执行记录，执行记录在任一已启用的规则内即保留
+type RetentionPolicy struct {
+	KeepLast       int32 // 保留最近的 N 条执行记录
+	KeepDays       int32 // 保留最近 N 天的执行记录
+	FailedKeepDays int32 // 失败和超时的执行记录保留天数，为 0 时使用 KeepDays
+}
+
+// IsZero 判断是否未启用任何规则
+func (p RetentionPolicy) IsZero() bool {
+	return p.KeepLast == 0 && p.KeepDays == 0 && p.FailedKeepDays == 0
+}
+
+// Merge 以 p 中非零字段覆盖 defaults 的对应字段
+func (p RetentionPolicy) Merge(defaults RetentionPolicy) RetentionPolicy {
+	if p.KeepLast != 0 {
+		defaults.KeepLast = p.KeepLast
+	}
+	if p.KeepDays != 0 {
+		defaults.KeepDays = p.KeepDays
+	}
+	if p.FailedKeepDays != 0 {
+		defaults.FailedKeepDays = p.FailedKeepDays
+	}
+	return defaults
+}
+
+// validate 校验保留策略
+func (p RetentionPolicy) validate() error {
+	if p.KeepLast < 0 || p.KeepDays < 0 || p.FailedKeepDays < 0 {
+		return fmt.Errorf("retention values must not be negative")
+	}
+	return nil
+}
+
+// pruneFilter 按策略构造 taskID 的清理条件，未启用的保留天数规则不限制创建时间
+func (p RetentionPolicy) pruneFilter(taskID int64, now time.Time) *ExecutionPruneFilter {
+	filter := &ExecutionPruneFilter{
+		TaskID:              taskID,
+		KeepLast:            p.KeepLast,
+		CreatedBefore:       now,
+		FailedCreatedBefore: now,
+	}
+	if p.KeepDays > 0 {
+		filter.CreatedBefore = now.AddDate(0, 0, -int(p.KeepDays))
+		filter.FailedCreatedBefore = filter.CreatedBefore
+	}
+	if p.FailedKeepDays > 0 {
+		filter.FailedCreatedBefore = now.AddDate(0, 0, -int(p.FailedKeepDays))
+	}
+	return filter
+}
+
+// ExecutionPruneFilter 执行记录清理条件，只匹配已结束的执行记录
+type ExecutionPruneFilter struct {
+	TaskID              int64
+	KeepLast            int32     // 大于 0 时保留该任务最近的 KeepLast 条执行记录
+	CreatedBefore       time.Time // 成功、取消和跳过的执行记录创建时间早于该时间时可清理
+	FailedCreatedBefore time.Time // 失败和超时的执行记录创建时间早于该时间时可清理
+}
+
+// IsFailedExecutionStatus 判断执行状态是否按失败记录保留
+func IsFailedExecutionStatus(status pb.ExecutionStatus) bool {
+	return status == pb.ExecutionStatus_EXECUTION_FAILED || status == pb.ExecutionStatus_TIMEOUT
+}
+
+// IsFinishedExecutionStatus 判断执行记录是否已结束
+func IsFinishedExecutionStatus(status pb.ExecutionStatus) bool {
+	switch status {
+	case pb.ExecutionStatus_SUCCESS, pb.ExecutionStatus_EXECUTION_FAILED, pb.ExecutionStatus_TIMEOUT,
+		pb.ExecutionStatus_EXECUTION_CANCELLED, pb.ExecutionStatus_EXECUTION_SKIPPED:
+		return true
+	default:
+		return false
+	}
+}
+
+// ExecutionArchiver 执行记录归档接口，清理前写入归档，写入成功后才删除
+type ExecutionArchiver interface {
+	// Archive 持久化归档一批执行记录
+	Archive(ctx context.Context, executions []*TaskExecution) error
+}
+
+// RetentionJanitor 执行记录清理器，按保留策略分批删除过期的执行记录
+type RetentionJanitor struct {
+	taskRepo      TaskRepo
+	executionRepo ExecutionRepo
+	lockRepo      LockRepo
+	archiver      ExecutionArchiver
+	log           *log.Helper
+}
+
+// NewRetentionJanitor 创建执行记录清理器实例，archiver 为 nil 时不归档
+func NewRetentionJanitor(taskRepo TaskRepo, executionRepo ExecutionRepo, lockRepo LockRepo, archiver ExecutionArchiver, logger log.Logger) *RetentionJanitor {
+	return &RetentionJanitor{
+		taskRepo:      taskRepo,
+		executionRepo: executionRepo,
+		lockRepo:      lockRepo,
+		archiver:      archiver,
+		log:           log.NewHelper(logger),
+	}
+}
+
+// Prune 按全局策略和任务策略清理执行记录，每批最多删除 batchSize 条，返回删除的记录数
+// 多节点同时调用时只有获取到清理锁的节点执行清理
+func (j *RetentionJanitor) Prune(ctx context.Context, nodeID string, defaults RetentionPolicy, now time.Time, batchSize int) (int64, error) {
+	acquired, err := j.lockRepo.AcquireLock(ctx, retentionLockGroup, nodeID, retentionLockTTL)
+	if err != nil || !acquired {
+		return 0, err
+	}
+	defer func() {
+		if err := j.lockRepo.ReleaseLock(context.Background(), retentionLockGroup, nodeID); err != nil {
+			j.log.WithContext(ctx).Errorf("release retention lock: %v", err)
+		}
+	}()
+
+	taskIDs, err := j.executionRepo.ListExecutionTaskIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var pruned int64
+	for _, taskID := range taskIDs {
+		policy := defaults
+		task, err := j.taskRepo.GetTask(ctx, taskID)
+		if err != nil {
+			return pruned, err
+		}
+		if task != nil {
+			policy = task.Retention.Merge(defaults)
+		}
+		if policy.IsZero() {
+			continue
+		}
+
+		n, err := j.pruneTask(ctx, policy.pruneFilter(taskID, now), batchSize)
+		pruned += n
+		if err != nil {
+			return pruned, fmt.Errorf("prune executions of task %d: %w", taskID, err)
+		}
+	}
+	if pruned > 0 {
+		j.log.WithContext(ctx).Infof("pruned %d executions", pruned)
+	}
+	return pruned, nil
+}
+
+// pruneTask 分批归档并删除符合条件的执行记录，直到没有可清理的记录或 ctx 被取消
+func (j *RetentionJanitor) pruneTask(ctx context.Context, filter *ExecutionPruneFilter, batchSize int) (int64, error) {
+	var pruned int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return pruned, err
+		}
+		executions, err := j.executionRepo.ListPrunableExecutions(ctx, filter, batchSize)
+		if err != nil || len(executions) == 0 {
+			return pruned, err
+		}
+
+		if j.archiver != nil {
+			if err := j.archiver.Archive(ctx, executions); err != nil {
+				return pruned, fmt.Errorf("archive executions: %w", err)
+			}
+		}
+		ids := make([]int64, 0, len(executions))
+		for _, execution := range executions {
+			ids = append(ids, execution.ID)
+		}
+		n, err := j.executionRepo.DeleteExecutions(ctx, ids)
+		pruned += n
+		if err != nil || n == 0 {
+			return pruned, err
+		}
+		if len(executions) < batchSize {
+			return pruned, nil
+		}
+	}
+}
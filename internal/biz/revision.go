@@ -0,0 +1,195 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ErrTaskRevisionNotFound 任务修订不存在
+var ErrTaskRevisionNotFound = errors.NotFound(pb.ErrorReason_TASK_REVISION_NOT_FOUND.String(), "task revision not found")
+
+// TaskRevision 任务修订，每次创建、更新或回滚任务定义时记录一条，记录后不再修改
+type TaskRevision struct {
+	ID            int64
+	TaskID        int64
+	Version       int64 // 修订对应的任务版本
+	Action        pb.TaskRevisionAction
+	Author        string
+	Snapshot      *Task             // 任务定义快照，只包含 TaskUpdateFields 中的字段
+	Changes       []TaskFieldChange // 相对上一版本变化的字段
+	SourceVersion int64             // 回滚时的目标版本
+	CreatedAt     time.Time
+}
+
+// TaskFieldChange 任务定义的字段变化，值为便于阅读的文本
+type TaskFieldChange struct {
+	Field    string
+	OldValue string
+	NewValue string
+}
+
+// TaskRevisionListFilter 任务修订列表过滤条件，按版本倒序返回
+type TaskRevisionListFilter struct {
+	TaskID   int64
+	Page     int32
+	PageSize int32
+}
+
+// TaskRevisionRepo 任务修订仓储接口
+type TaskRevisionRepo interface {
+	// CreateRevision 记录任务修订，同一任务的版本不能重复
+	CreateRevision(ctx context.Context, revision *TaskRevision) (*TaskRevision, error)
+
+	// GetRevision 获取任务指定版本的修订，不存在时返回 nil
+	GetRevision(ctx context.Context, taskID, version int64) (*TaskRevision, error)
+
+	// ListRevisions 任务修订列表查询
+	ListRevisions(ctx context.Context, filter *TaskRevisionListFilter) ([]*TaskRevision, int64, error)
+
+	// DeleteTaskRevisions 删除任务的全部修订，返回删除的数量
+	DeleteTaskRevisions(ctx context.Context, taskID int64) (int64, error)
+}
+
+type authorKey struct{}
+
+// NewAuthorContext 返回携带操作者的上下文，记录任务修订时作为修订的作者
+func NewAuthorContext(ctx context.Context, author string) context.Context {
+	return context.WithValue(ctx, authorKey{}, author)
+}
+
+// AuthorFromContext 返回上下文中的操作者
+func AuthorFromContext(ctx context.Context) string {
+	author, _ := ctx.Value(authorKey{}).(string)
+	return author
+}
+
+// newTaskRevision 创建任务修订，before 为空时表示新建任务
+func newTaskRevision(ctx context.Context, before, after *Task, action pb.TaskRevisionAction) *TaskRevision {
+	if before == nil {
+		before = &Task{}
+	}
+	snapshot := &Task{ID: after.ID, Version: after.Version}
+	applyTaskFields(snapshot, after, TaskUpdateFields)
+	return &TaskRevision{
+		TaskID:   after.ID,
+		Version:  after.Version,
+		Action:   action,
+		Author:   AuthorFromContext(ctx),
+		Snapshot: snapshot,
+		Changes:  diffTasks(before, after),
+	}
+}
+
+// diffTasks 比较两个任务定义，返回变化的字段
+func diffTasks(before, after *Task) []TaskFieldChange {
+	var changes []TaskFieldChange
+	for _, field := range TaskUpdateFields {
+		oldValue, newValue := taskFieldValue(before, field), taskFieldValue(after, field)
+		if oldValue != newValue {
+			changes = append(changes, TaskFieldChange{Field: field, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+	return changes
+}
+
+// taskFieldValue 返回任务字段的文本表示，零值为空字符串
+func taskFieldValue(task *Task, field string) string {
+	switch field {
+	case "name":
+		return task.Name
+	case "description":
+		return task.Description
+	case "type":
+		return enumValue(task.Type)
+	case "schedule":
+		return task.Schedule
+	case "handler":
+		return task.Handler
+	case "payload":
+		return task.Payload
+	case "timeout":
+		return intValue(int64(task.Timeout))
+	case "metadata":
+		if len(task.Metadata) == 0 {
+			return ""
+		}
+		return jsonValue(task.Metadata)
+	case "calendar_id":
+		return intValue(task.CalendarID)
+	case "start_time":
+		return timeValue(task.StartTime)
+	case "end_time":
+		return timeValue(task.EndTime)
+	case "active_windows":
+		if len(task.ActiveWindows) == 0 {
+			return ""
+		}
+		return jsonValue(task.ActiveWindows)
+	case "max_runs":
+		return intValue(task.MaxRuns)
+	case "interval_mode":
+		return enumValue(task.IntervalMode)
+	case "initial_delay":
+		return durationValue(task.InitialDelay)
+	case "jitter":
+		return durationValue(task.Jitter)
+	case "priority":
+		return intValue(int64(task.Priority))
+	case "lock_group":
+		return task.LockGroup
+	case "concurrency_policy":
+		return enumValue(task.ConcurrencyPolicy)
+	case "retention":
+		if task.Retention.IsZero() {
+			return ""
+		}
+		return jsonValue(task.Retention)
+	}
+	return ""
+}
+
+// enumValue 返回枚举的名称，未指定时为空字符串
+func enumValue[E interface {
+	~int32
+	String() string
+}](e E) string {
+	if e == 0 {
+		return ""
+	}
+	return e.String()
+}
+
+func intValue(v int64) string {
+	if v == 0 {
+		return ""
+	}
+	return strconv.FormatInt(v, 10)
+}
+
+func timeValue(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func durationValue(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+	return d.String()
+}
+
+func jsonValue(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
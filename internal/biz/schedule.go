@@ -0,0 +1,289 @@
+package biz
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/robfig/cron/v3"
+)
+
+// MinInterval 间隔任务的最小间隔，更短的间隔会让分发器每次扫描都分发该任务
+const MinInterval = time.Second
+
+// cronParser Cron 表达式解析器，秒字段可选，支持 @daily 等描述符
+var cronParser = cron.NewParser(
+	cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
+)
+
+// Schedule 调度计划，负责计算任务的触发时间
+type Schedule interface {
+	// Next 返回严格晚于 after 的下一次触发时间，没有后续触发时返回零值
+	Next(after time.Time) time.Time
+}
+
+// onceSchedule 只触发一次的调度计划
+type onceSchedule struct {
+	at time.Time
+}
+
+// Next 实现 Schedule 接口
+func (s onceSchedule) Next(after time.Time) time.Time {
+	if s.at.IsZero() || !s.at.After(after) {
+		return time.Time{}
+	}
+	return s.at
+}
+
+// cronSchedule Cron 表达式调度计划
+type cronSchedule struct {
+	schedule cron.Schedule
+	loc      *time.Location
+}
+
+// Next 实现 Schedule 接口
+func (s cronSchedule) Next(after time.Time) time.Time {
+	return s.schedule.Next(after.In(s.loc))
+}
+
+// intervalSchedule 固定间隔调度计划
+// anchor 非零时触发时间对齐到 anchor + k*interval，否则为 after + interval
+type intervalSchedule struct {
+	interval time.Duration
+	anchor   time.Time
+}
+
+// Next 实现 Schedule 接口
+func (s intervalSchedule) Next(after time.Time) time.Time {
+	if s.anchor.IsZero() {
+		return after.Add(s.interval)
+	}
+	if s.anchor.After(after) {
+		return s.anchor
+	}
+	k := after.Sub(s.anchor)/s.interval + 1
+	return s.anchor.Add(k * s.interval)
+}
+
+// withIntervalAnchor 为固定间隔调度计划设置对齐基准，其他调度计划原样返回
+func withIntervalAnchor(schedule Schedule, anchor time.Time) Schedule {
+	if s, ok := schedule.(intervalSchedule); ok {
+		s.anchor = anchor
+		return s
+	}
+	return schedule
+}
+
+// ParseSchedule 按任务类型解析调度配置，loc 为空时使用本地时区
+func ParseSchedule(taskType pb.TaskType, spec string, loc *time.Location) (Schedule, error) {
+	if loc == nil {
+		loc = time.Local
+	}
+	spec = strings.TrimSpace(spec)
+
+	switch taskType {
+	case pb.TaskType_IMMEDIATE:
+		return onceSchedule{}, nil
+	case pb.TaskType_SCHEDULED:
+		at, err := parseScheduledTime(spec, loc)
+		if err != nil {
+			return nil, err
+		}
+		return onceSchedule{at: at}, nil
+	case pb.TaskType_CRON:
+		if spec == "" {
+			return nil, fmt.Errorf("cron expression is required")
+		}
+		schedule, err := cronParser.Parse(spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %v", spec, err)
+		}
+		return cronSchedule{schedule: schedule, loc: loc}, nil
+	case pb.TaskType_INTERVAL:
+		interval, err := parseInterval(spec)
+		if err != nil {
+			return nil, err
+		}
+		return intervalSchedule{interval: interval}, nil
+	default:
+		return nil, fmt.Errorf("unsupported task type %s", taskType)
+	}
+}
+
+// parseScheduledTime 解析指定执行时间，未带时区偏移时按 loc 解释
+func parseScheduledTime(spec string, loc *time.Location) (time.Time, error) {
+	if spec == "" {
+		return time.Time{}, fmt.Errorf("scheduled time is required")
+	}
+	if at, err := time.Parse(time.RFC3339, spec); err == nil {
+		return at, nil
+	}
+	for _, layout := range []string{"2006-01-02T15:04:05", "2006-01-02 15:04:05"} {
+		if at, err := time.ParseInLocation(layout, spec, loc); err == nil {
+			return at, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid scheduled time %q: expected RFC3339 format", spec)
+}
+
+// parseInterval 解析间隔，支持 Go 时长格式（如 90s、1h30m）和纯数字秒数
+func parseInterval(spec string) (time.Duration, error) {
+	if spec == "" {
+		return 0, fmt.Errorf("interval is required")
+	}
+	var interval time.Duration
+	if seconds, err := strconv.ParseInt(spec, 10, 64); err == nil {
+		interval = time.Duration(seconds) * time.Second
+	} else if d, err := time.ParseDuration(spec); err == nil {
+		interval = d
+	} else {
+		return 0, fmt.Errorf("invalid interval %q: expected a duration such as 90s or 1h30m", spec)
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("invalid interval %q: must be positive", spec)
+	}
+	if interval < MinInterval {
+		return 0, fmt.Errorf("invalid interval %q: must be at least %s", spec, MinInterval)
+	}
+	return interval, nil
+}
+
+// isFixedDelay 判断任务是否为固定延迟的间隔任务
+func (t *Task) isFixedDelay() bool {
+	return t.Type == pb.TaskType_INTERVAL && t.IntervalMode == pb.IntervalMode_FIXED_DELAY
+}
+
+// validateIntervalOptions 校验首次延迟和随机延迟
+func (t *Task) validateIntervalOptions() error {
+	if t.InitialDelay < 0 {
+		return fmt.Errorf("initial delay must not be negative")
+	}
+	if t.Jitter < 0 {
+		return fmt.Errorf("jitter must not be negative")
+	}
+	return nil
+}
+
+// intervalAnchor 间隔任务的对齐基准：创建时间加首次延迟，且不早于开始时间
+func intervalAnchor(task *Task) time.Time {
+	anchor := task.CreatedAt.Add(task.InitialDelay)
+	if task.StartTime != nil && task.StartTime.After(anchor) {
+		anchor = *task.StartTime
+	}
+	return anchor
+}
+
+// applyJitter 为执行时间附加 [0, jitter) 的随机延迟，超出结束时间时不附加
+func applyJitter(t time.Time, task *Task) time.Time {
+	if task.Jitter <= 0 {
+		return t
+	}
+	jittered := t.Add(time.Duration(rand.Int63n(int64(task.Jitter))))
+	if task.EndTime != nil && jittered.After(*task.EndTime) {
+		return t
+	}
+	return jittered
+}
+
+// NextRunTimes 从 from 开始计算最多 n 次触发时间
+func NextRunTimes(schedule Schedule, from time.Time, n int) []time.Time {
+	times := make([]time.Time, 0, n)
+	t := from
+	for len(times) < n {
+		t = schedule.Next(t)
+		if t.IsZero() {
+			break
+		}
+		times = append(times, t)
+	}
+	return times
+}
+
+// DescribeSchedule 生成调度配置的可读描述
+func DescribeSchedule(taskType pb.TaskType, spec string) string {
+	spec = strings.TrimSpace(spec)
+
+	switch taskType {
+	case pb.TaskType_IMMEDIATE:
+		return "Runs once, immediately after creation"
+	case pb.TaskType_SCHEDULED:
+		return fmt.Sprintf("Runs once at %s", spec)
+	case pb.TaskType_CRON:
+		return describeCron(spec)
+	case pb.TaskType_INTERVAL:
+		interval, err := parseInterval(spec)
+		if err != nil {
+			return ""
+		}
+		return fmt.Sprintf("Every %s", interval)
+	default:
+		return ""
+	}
+}
+
+// cronDescriptors Cron 描述符对应的描述
+var cronDescriptors = map[string]string{
+	"@yearly":   "At 00:00 on January 1st",
+	"@annually": "At 00:00 on January 1st",
+	"@monthly":  "At 00:00 on day 1 of every month",
+	"@weekly":   "At 00:00 every Sunday",
+	"@daily":    "At 00:00 every day",
+	"@midnight": "At 00:00 every day",
+	"@hourly":   "At minute 0 of every hour",
+}
+
+// cronFieldNames Cron 各字段名称（含秒）
+var cronFieldNames = []string{"second", "minute", "hour", "day-of-month", "month", "day-of-week"}
+
+// describeCron 生成 Cron 表达式的可读描述
+func describeCron(spec string) string {
+	if desc, ok := cronDescriptors[spec]; ok {
+		return desc
+	}
+	if strings.HasPrefix(spec, "@every ") {
+		return "Every " + strings.TrimSpace(strings.TrimPrefix(spec, "@every "))
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) == 5 {
+		fields = append([]string{"0"}, fields...)
+	}
+	if len(fields) != 6 {
+		return ""
+	}
+
+	parts := make([]string, 0, len(fields))
+	for i := len(fields) - 1; i >= 0; i-- {
+		if desc := describeCronField(cronFieldNames[i], fields[i]); desc != "" {
+			parts = append(parts, desc)
+		}
+	}
+	if len(parts) == 0 {
+		return "Every second"
+	}
+	desc := strings.Join(parts, ", ")
+	return strings.ToUpper(desc[:1]) + desc[1:]
+}
+
+// describeCronField 生成单个 Cron 字段的描述，通配字段返回空字符串
+func describeCronField(name, field string) string {
+	switch {
+	case field == "*" || field == "?":
+		return ""
+	case strings.HasPrefix(field, "*/"):
+		return fmt.Sprintf("every %s %ss", strings.TrimPrefix(field, "*/"), name)
+	case strings.Contains(field, "/"):
+		parts := strings.SplitN(field, "/", 2)
+		return fmt.Sprintf("every %s %ss starting at %s", parts[1], name, parts[0])
+	case strings.Contains(field, ","):
+		return fmt.Sprintf("at %s %s", name, strings.ReplaceAll(field, ",", ", "))
+	case strings.Contains(field, "-"):
+		return fmt.Sprintf("%s %s", name, strings.Replace(field, "-", " through ", 1))
+	default:
+		return fmt.Sprintf("at %s %s", name, field)
+	}
+}
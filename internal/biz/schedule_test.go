@@ -0,0 +1,253 @@
+package biz
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+)
+
+// mustLocation 加载时区，失败时终止测试
+func mustLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("LoadLocation(%q): %v", name, err)
+	}
+	return loc
+}
+
+// mustSchedule 解析调度配置，失败时终止测试
+func mustSchedule(t *testing.T, taskType pb.TaskType, spec string, loc *time.Location) Schedule {
+	t.Helper()
+	schedule, err := ParseSchedule(taskType, spec, loc)
+	if err != nil {
+		t.Fatalf("ParseSchedule(%s, %q): %v", taskType, spec, err)
+	}
+	return schedule
+}
+
+func TestParseScheduleErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		taskType pb.TaskType
+		spec     string
+		wantErr  string
+	}{
+		{"scheduled without time", pb.TaskType_SCHEDULED, " ", "scheduled time is required"},
+		{"scheduled with bad time", pb.TaskType_SCHEDULED, "tomorrow", "invalid scheduled time"},
+		{"cron without expression", pb.TaskType_CRON, "", "cron expression is required"},
+		{"cron with bad expression", pb.TaskType_CRON, "61 * * * *", "invalid cron expression"},
+		{"interval without value", pb.TaskType_INTERVAL, "", "interval is required"},
+		{"interval with bad value", pb.TaskType_INTERVAL, "soon", "invalid interval"},
+		{"interval zero", pb.TaskType_INTERVAL, "0", "must be positive"},
+		{"interval negative", pb.TaskType_INTERVAL, "-5m", "must be positive"},
+		{"interval below minimum", pb.TaskType_INTERVAL, "1ns", "must be at least 1s"},
+		{"interval in milliseconds", pb.TaskType_INTERVAL, "500ms", "must be at least 1s"},
+		{"interval fraction without unit", pb.TaskType_INTERVAL, "0.001", "invalid interval"},
+		{"unspecified type", pb.TaskType_TASK_TYPE_UNSPECIFIED, "", "unsupported task type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSchedule(tt.taskType, tt.spec, time.UTC)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestImmediateSchedule(t *testing.T) {
+	schedule := mustSchedule(t, pb.TaskType_IMMEDIATE, "", time.UTC)
+	if next := schedule.Next(time.Now()); !next.IsZero() {
+		t.Errorf("Next = %v, want zero", next)
+	}
+}
+
+func TestScheduledSchedule(t *testing.T) {
+	shanghai := mustLocation(t, "Asia/Shanghai")
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{"2024-06-01T08:00:00Z", time.Date(2024, 6, 1, 8, 0, 0, 0, time.UTC)},
+		{"2024-06-01T08:00:00+08:00", time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{"2024-06-01T08:00:00", time.Date(2024, 6, 1, 8, 0, 0, 0, shanghai)},
+		{"2024-06-01 08:00:00", time.Date(2024, 6, 1, 8, 0, 0, 0, shanghai)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			schedule := mustSchedule(t, pb.TaskType_SCHEDULED, tt.spec, shanghai)
+			if next := schedule.Next(tt.want.Add(-time.Second)); !next.Equal(tt.want) {
+				t.Errorf("Next = %v, want %v", next, tt.want)
+			}
+			if next := schedule.Next(tt.want); !next.IsZero() {
+				t.Errorf("Next at the scheduled time = %v, want zero", next)
+			}
+		})
+	}
+}
+
+func TestCronSchedule(t *testing.T) {
+	shanghai := mustLocation(t, "Asia/Shanghai")
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		spec string
+		loc  *time.Location
+		want []time.Time
+	}{
+		{
+			name: "five fields",
+			spec: "*/15 * * * *",
+			loc:  time.UTC,
+			want: []time.Time{from.Add(15 * time.Minute), from.Add(30 * time.Minute), from.Add(45 * time.Minute)},
+		},
+		{
+			name: "six fields with seconds",
+			spec: "30 0 * * * *",
+			loc:  time.UTC,
+			want: []time.Time{from.Add(30 * time.Second), from.Add(time.Hour + 30*time.Second), from.Add(2*time.Hour + 30*time.Second)},
+		},
+		{
+			name: "descriptor",
+			spec: "@daily",
+			loc:  time.UTC,
+			want: []time.Time{from.AddDate(0, 0, 1), from.AddDate(0, 0, 2), from.AddDate(0, 0, 3)},
+		},
+		{
+			name: "evaluated in location",
+			spec: "0 9 * * *",
+			loc:  shanghai,
+			want: []time.Time{
+				time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 2, 1, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 3, 1, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NextRunTimes(mustSchedule(t, pb.TaskType_CRON, tt.spec, tt.loc), from, len(tt.want))
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d times, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("time %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestIntervalSchedule(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, spec := range []string{"90", "90s", "1m30s"} {
+		schedule := mustSchedule(t, pb.TaskType_INTERVAL, spec, time.UTC)
+		if next := schedule.Next(from); !next.Equal(from.Add(90 * time.Second)) {
+			t.Errorf("%q: Next = %v, want %v", spec, next, from.Add(90*time.Second))
+		}
+	}
+
+	anchor := from.Add(10 * time.Second)
+	anchored := withIntervalAnchor(mustSchedule(t, pb.TaskType_INTERVAL, "1m", time.UTC), anchor)
+	tests := []struct {
+		after time.Time
+		want  time.Time
+	}{
+		{from, anchor},
+		{anchor, anchor.Add(time.Minute)},
+		{anchor.Add(59 * time.Second), anchor.Add(time.Minute)},
+		{anchor.Add(time.Minute), anchor.Add(2 * time.Minute)},
+		{anchor.Add(10*time.Minute + time.Second), anchor.Add(11 * time.Minute)},
+	}
+	for _, tt := range tests {
+		if next := anchored.Next(tt.after); !next.Equal(tt.want) {
+			t.Errorf("anchored Next(%v) = %v, want %v", tt.after, next, tt.want)
+		}
+	}
+
+	cron := mustSchedule(t, pb.TaskType_CRON, "@hourly", time.UTC)
+	if withIntervalAnchor(cron, anchor) != cron {
+		t.Errorf("withIntervalAnchor changed a cron schedule")
+	}
+}
+
+func TestIntervalAnchor(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	task := &Task{CreatedAt: created, InitialDelay: 5 * time.Minute}
+	if got := intervalAnchor(task); !got.Equal(created.Add(5 * time.Minute)) {
+		t.Errorf("anchor = %v, want creation time plus initial delay", got)
+	}
+
+	start := created.Add(time.Hour)
+	task.StartTime = &start
+	if got := intervalAnchor(task); !got.Equal(start) {
+		t.Errorf("anchor = %v, want start time %v", got, start)
+	}
+}
+
+func TestApplyJitter(t *testing.T) {
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	if got := applyJitter(at, &Task{}); !got.Equal(at) {
+		t.Errorf("applyJitter without jitter = %v, want %v", got, at)
+	}
+
+	task := &Task{Jitter: time.Second}
+	for i := 0; i < 100; i++ {
+		got := applyJitter(at, task)
+		if got.Before(at) || !got.Before(at.Add(time.Second)) {
+			t.Fatalf("applyJitter = %v, want within [%v, %v)", got, at, at.Add(time.Second))
+		}
+	}
+
+	end := at.Add(time.Nanosecond)
+	task.EndTime = &end
+	task.Jitter = time.Hour
+	for i := 0; i < 100; i++ {
+		if got := applyJitter(at, task); got.After(end) {
+			t.Fatalf("applyJitter = %v, past end time %v", got, end)
+		}
+	}
+}
+
+func TestNextRunTimesStopsAtEnd(t *testing.T) {
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule := mustSchedule(t, pb.TaskType_SCHEDULED, at.Format(time.RFC3339), time.UTC)
+	got := NextRunTimes(schedule, at.Add(-time.Hour), 5)
+	if !reflect.DeepEqual(got, []time.Time{at}) {
+		t.Errorf("NextRunTimes = %v, want [%v]", got, at)
+	}
+}
+
+func TestDescribeSchedule(t *testing.T) {
+	tests := []struct {
+		taskType pb.TaskType
+		spec     string
+		want     string
+	}{
+		{pb.TaskType_IMMEDIATE, "", "Runs once, immediately after creation"},
+		{pb.TaskType_SCHEDULED, "2024-06-01T08:00:00Z", "Runs once at 2024-06-01T08:00:00Z"},
+		{pb.TaskType_INTERVAL, "90", "Every 1m30s"},
+		{pb.TaskType_INTERVAL, "never", ""},
+		{pb.TaskType_CRON, "@daily", "At 00:00 every day"},
+		{pb.TaskType_CRON, "@every 5m", "Every 5m"},
+		{pb.TaskType_CRON, "* * * * * *", "Every second"},
+		{pb.TaskType_CRON, "*/5 * * * *", "Every 5 minutes, at second 0"},
+		{pb.TaskType_CRON, "0 30 9 * * 1-5", "Day-of-week 1 through 5, at hour 9, at minute 30, at second 0"},
+		{pb.TaskType_CRON, "0 0 8,18 * * *", "At hour 8, 18, at minute 0, at second 0"},
+		{pb.TaskType_CRON, "0 5/10 * * * *", "Every 10 minutes starting at 5, at second 0"},
+		{pb.TaskType_CRON, "* *", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			if got := DescribeSchedule(tt.taskType, tt.spec); got != tt.want {
+				t.Errorf("DescribeSchedule(%s, %q) = %q, want %q", tt.taskType, tt.spec, got, tt.want)
+			}
+		})
+	}
+}
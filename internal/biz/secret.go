@@ -0,0 +1,178 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+var (
+	// ErrSecretNotFound 密钥不存在
+	ErrSecretNotFound = errors.NotFound(pb.ErrorReason_SECRET_NOT_FOUND.String(), "secret not found")
+	// ErrSecretExists 同一命名空间中已存在同名密钥
+	ErrSecretExists = errors.Conflict(pb.ErrorReason_SECRET_EXISTS.String(), "secret already exists")
+	// ErrSecretsDisabled 未配置主密钥文件，不能保存和读取密钥
+	ErrSecretsDisabled = errors.New(501, pb.ErrorReason_SECRETS_DISABLED.String(), "secrets are disabled, configure data.secrets.key_file")
+)
+
+// secretNamePattern 密钥名称：字母、数字、'_'、'-' 和 '.'，以字母或数字开头，最长 128 个字符
+var secretNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]{0,127}$`)
+
+var (
+	// secretReferencePattern 负载中的密钥引用 {{ secret "name" }}，在 JSON 字符串中引号转义为 \"
+	secretReferencePattern = regexp.MustCompile(`\{\{\s*secret\s+(\\?)"([^"\\]*)\\?"\s*\}\}`)
+	// secretReferenceLike 形如密钥引用的片段，用于发现写错的引用
+	secretReferenceLike = regexp.MustCompile(`\{\{\s*secret\b[^}]*\}\}`)
+)
+
+// redactedValue 执行结果和错误信息中替换密钥值的文本
+const redactedValue = "[REDACTED]"
+
+// Secret 密钥业务模型，值只以密文保存，接口不返回值
+type Secret struct {
+	ID          int64
+	Namespace   string
+	Name        string
+	Description string
+	Version     int32 // 值的版本，每次修改值时加一
+	Value       EncryptedValue
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// SecretListFilter 密钥列表过滤条件
+type SecretListFilter struct {
+	Page      int32
+	PageSize  int32
+	Namespace string
+	Keyword   string
+}
+
+// SecretRepo 密钥仓储接口，上下文限定命名空间时只能访问该命名空间中的密钥
+type SecretRepo interface {
+	// CreateSecret 创建密钥
+	CreateSecret(ctx context.Context, secret *Secret) (*Secret, error)
+
+	// GetSecret 按命名空间和名称获取密钥，不存在时返回 nil
+	GetSecret(ctx context.Context, namespace, name string) (*Secret, error)
+
+	// UpdateSecret 更新密钥的描述、版本和密文，不存在时返回 nil
+	UpdateSecret(ctx context.Context, secret *Secret) (*Secret, error)
+
+	// DeleteSecret 删除密钥
+	DeleteSecret(ctx context.Context, namespace, name string) error
+
+	// ListSecrets 密钥列表查询，按命名空间和名称排序
+	ListSecrets(ctx context.Context, filter *SecretListFilter) ([]*Secret, int64, error)
+
+	// ListSecretsToRewrap 获取数据密钥不是由主密钥 primaryKeyID 加密的密钥，最多 limit 条，供主密钥轮换使用
+	ListSecretsToRewrap(ctx context.Context, primaryKeyID string, limit int) ([]*Secret, error)
+
+	// CountSecrets 统计命名空间中的密钥数
+	CountSecrets(ctx context.Context, namespace string) (int64, error)
+}
+
+// validateSecretName 校验密钥名称
+func validateSecretName(name string) error {
+	if !secretNamePattern.MatchString(name) {
+		return fmt.Errorf("secret name must consist of letters, digits, '_', '-' and '.', start with a letter or digit and be at most 128 characters")
+	}
+	return nil
+}
+
+// validateSecretReferences 校验负载中的密钥引用格式，引用的密钥在执行时才解析
+func validateSecretReferences(payload string) error {
+	for _, ref := range secretReferenceLike.FindAllString(payload, -1) {
+		m := secretReferencePattern.FindStringSubmatch(ref)
+		if m == nil || m[0] != ref {
+			return fmt.Errorf(`invalid secret reference %s, want {{ secret "name" }}`, ref)
+		}
+		if err := validateSecretName(m[2]); err != nil {
+			return fmt.Errorf("invalid secret reference %s: %v", ref, err)
+		}
+	}
+	return nil
+}
+
+// HasSecretReferences 判断负载中是否包含密钥引用，包括格式错误的引用
+func HasSecretReferences(payload string) bool {
+	return secretReferenceLike.MatchString(payload)
+}
+
+// secretValues 执行时解析出的密钥值，用于从执行结果中脱敏
+type secretValues []string
+
+// redact 将文本中出现的密钥值替换为 [REDACTED]
+func (v secretValues) redact(s string) string {
+	for _, value := range v {
+		s = strings.ReplaceAll(s, value, redactedValue)
+	}
+	return s
+}
+
+// resolveSecrets 将负载中的密钥引用替换为命名空间中密钥的值，JSON 字符串中的引用替换为转义后的值
+func resolveSecrets(ctx context.Context, repo SecretRepo, keyring *Keyring, namespace, payload string) (string, secretValues, error) {
+	matches := secretReferencePattern.FindAllStringSubmatchIndex(payload, -1)
+	if len(matches) == 0 {
+		return payload, nil, nil
+	}
+
+	plaintexts := make(map[string]string)
+	var b strings.Builder
+	last := 0
+	for _, m := range matches {
+		escaped := m[3] > m[2]
+		name := payload[m[4]:m[5]]
+		value, ok := plaintexts[name]
+		if !ok {
+			secret, err := repo.GetSecret(ctx, namespace, name)
+			if err != nil {
+				return "", nil, err
+			}
+			if secret == nil {
+				return "", nil, fmt.Errorf("secret %q not found in namespace %q", name, namespace)
+			}
+			plaintext, err := keyring.Decrypt(&secret.Value)
+			if err != nil {
+				return "", nil, fmt.Errorf("secret %q: %w", name, err)
+			}
+			value = string(plaintext)
+			plaintexts[name] = value
+		}
+		if escaped {
+			value = jsonEscape(value)
+		}
+		b.WriteString(payload[last:m[0]])
+		b.WriteString(value)
+		last = m[1]
+	}
+	b.WriteString(payload[last:])
+
+	// 同时脱敏原始值和 JSON 转义后的值，较长的值先替换
+	var values secretValues
+	for _, value := range plaintexts {
+		if value == "" {
+			continue
+		}
+		values = append(values, value)
+		if escaped := jsonEscape(value); escaped != value {
+			values = append(values, escaped)
+		}
+	}
+	sort.Slice(values, func(i, j int) bool { return len(values[i]) > len(values[j]) })
+	return b.String(), values, nil
+}
+
+// jsonEscape 返回值在 JSON 字符串中的转义形式（不含两侧引号）
+func jsonEscape(value string) string {
+	data, _ := json.Marshal(value)
+	return string(data[1 : len(data)-1])
+}
@@ -0,0 +1,151 @@
+package biz
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// fakeSecretRepo 只实现 GetSecret 的密钥仓储，记录每个名称的查询次数
+type fakeSecretRepo struct {
+	SecretRepo
+	secrets map[string]*Secret
+	gets    map[string]int
+}
+
+func (r *fakeSecretRepo) GetSecret(_ context.Context, namespace, name string) (*Secret, error) {
+	r.gets[name]++
+	return r.secrets[namespace+"/"+name], nil
+}
+
+// newFakeSecretRepo 用 keyring 加密 values 并保存在命名空间 namespace 中
+func newFakeSecretRepo(t *testing.T, keyring *Keyring, namespace string, values map[string]string) *fakeSecretRepo {
+	t.Helper()
+	r := &fakeSecretRepo{secrets: make(map[string]*Secret), gets: make(map[string]int)}
+	for name, value := range values {
+		encrypted, err := keyring.Encrypt([]byte(value))
+		if err != nil {
+			t.Fatalf("Encrypt: %v", err)
+		}
+		r.secrets[namespace+"/"+name] = &Secret{Namespace: namespace, Name: name, Value: *encrypted}
+	}
+	return r
+}
+
+func TestValidateSecretName(t *testing.T) {
+	for _, name := range []string{"db", "DB_PASSWORD", "api.key-2", "0token", strings.Repeat("a", 128)} {
+		if err := validateSecretName(name); err != nil {
+			t.Errorf("validateSecretName(%q): %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "_db", "-db", "db password", "db/password", strings.Repeat("a", 129)} {
+		if err := validateSecretName(name); err == nil {
+			t.Errorf("validateSecretName(%q) accepted an invalid name", name)
+		}
+	}
+}
+
+func TestValidateSecretReferences(t *testing.T) {
+	valid := []string{
+		`plain payload`,
+		`{"password": "{{ secret \"db\" }}"}`,
+		`token={{secret "api.key"}}`,
+		`{{ secret "a" }} and {{ secret "b" }}`,
+	}
+	for _, payload := range valid {
+		if err := validateSecretReferences(payload); err != nil {
+			t.Errorf("validateSecretReferences(%q): %v", payload, err)
+		}
+		if strings.Contains(payload, "secret") != HasSecretReferences(payload) {
+			t.Errorf("HasSecretReferences(%q) = %v", payload, HasSecretReferences(payload))
+		}
+	}
+
+	invalid := []string{
+		`{{ secret db }}`,
+		`{{ secret "db" "extra" }}`,
+		`{{ secret "bad name" }}`,
+		`{{ secret '' }}`,
+	}
+	for _, payload := range invalid {
+		if err := validateSecretReferences(payload); err == nil {
+			t.Errorf("validateSecretReferences(%q) accepted an invalid reference", payload)
+		}
+		if !HasSecretReferences(payload) {
+			t.Errorf("HasSecretReferences(%q) = false for a malformed reference", payload)
+		}
+	}
+}
+
+func TestResolveSecrets(t *testing.T) {
+	keyring := mustKeyring(t, map[string][]byte{"k1": testKey(1)}, "k1")
+	repo := newFakeSecretRepo(t, keyring, "team-a", map[string]string{
+		"db":    "p@ss",
+		"quote": `a"b\c`,
+	})
+
+	payload := `{"password": "{{ secret \"db\" }}", "again": "{{secret \"db\"}}", "raw": {{ secret "quote" }}, "json": "{{ secret \"quote\" }}"}`
+	got, values, err := resolveSecrets(context.Background(), repo, keyring, "team-a", payload)
+	if err != nil {
+		t.Fatalf("resolveSecrets: %v", err)
+	}
+	want := `{"password": "p@ss", "again": "p@ss", "raw": a"b\c, "json": "a\"b\\c"}`
+	if got != want {
+		t.Errorf("resolved payload = %s, want %s", got, want)
+	}
+	if repo.gets["db"] != 1 || repo.gets["quote"] != 1 {
+		t.Errorf("GetSecret calls = %v, want one per secret", repo.gets)
+	}
+
+	// 原始值和 JSON 转义后的值都会脱敏，较长的值先替换
+	wantValues := secretValues{`a\"b\\c`, `a"b\c`, "p@ss"}
+	if !reflect.DeepEqual(values, wantValues) {
+		t.Errorf("values = %q, want %q", values, wantValues)
+	}
+	output := `connected with p@ss, echoed a\"b\\c and a"b\c`
+	if redacted := values.redact(output); redacted != "connected with [REDACTED], echoed [REDACTED] and [REDACTED]" {
+		t.Errorf("redact = %q", redacted)
+	}
+}
+
+func TestResolveSecretsWithoutReferences(t *testing.T) {
+	repo := &fakeSecretRepo{gets: make(map[string]int)}
+	got, values, err := resolveSecrets(context.Background(), repo, nil, "default", `{"a": 1}`)
+	if err != nil || got != `{"a": 1}` || values != nil {
+		t.Errorf("resolveSecrets = %q, %v, %v", got, values, err)
+	}
+	if len(repo.gets) != 0 {
+		t.Errorf("GetSecret was called for a payload without references")
+	}
+}
+
+func TestResolveSecretsErrors(t *testing.T) {
+	keyring := mustKeyring(t, map[string][]byte{"k1": testKey(1)}, "k1")
+	repo := newFakeSecretRepo(t, keyring, "team-a", map[string]string{"db": "p@ss"})
+
+	// 其他命名空间中的密钥不可见
+	if _, _, err := resolveSecrets(context.Background(), repo, keyring, "team-b", `{{ secret "db" }}`); err == nil || !strings.Contains(err.Error(), `not found in namespace "team-b"`) {
+		t.Errorf("err = %v, want not found in team-b", err)
+	}
+
+	other := mustKeyring(t, map[string][]byte{"k2": testKey(2)}, "k2")
+	if _, _, err := resolveSecrets(context.Background(), repo, other, "team-a", `{{ secret "db" }}`); err == nil || !strings.Contains(err.Error(), `secret "db"`) {
+		t.Errorf("err = %v, want decrypt error for db", err)
+	}
+}
+
+func TestRedactSkipsEmptyValues(t *testing.T) {
+	keyring := mustKeyring(t, map[string][]byte{"k1": testKey(1)}, "k1")
+	repo := newFakeSecretRepo(t, keyring, "default", map[string]string{"empty": ""})
+	got, values, err := resolveSecrets(context.Background(), repo, keyring, "default", `x{{ secret "empty" }}y`)
+	if err != nil {
+		t.Fatalf("resolveSecrets: %v", err)
+	}
+	if got != "xy" || len(values) != 0 {
+		t.Errorf("resolveSecrets = %q, %q", got, values)
+	}
+	if redacted := values.redact("xy"); redacted != "xy" {
+		t.Errorf("redact = %q", redacted)
+	}
+}
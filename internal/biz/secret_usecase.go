@@ -0,0 +1,168 @@
+package biz
+
+import (
+	"context"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// rewrapBatchSize 主密钥轮换时每批重新加密的密钥数
+const rewrapBatchSize = 100
+
+// SecretUsecase 密钥用例，值加密后保存，只在执行时解密
+type SecretUsecase struct {
+	repo          SecretRepo
+	namespaceRepo NamespaceRepo
+	keyring       *Keyring
+	log           *log.Helper
+}
+
+// NewSecretUsecase 创建密钥用例实例
+func NewSecretUsecase(repo SecretRepo, namespaceRepo NamespaceRepo, keyring *Keyring, logger log.Logger) *SecretUsecase {
+	return &SecretUsecase{
+		repo:          repo,
+		namespaceRepo: namespaceRepo,
+		keyring:       keyring,
+		log:           log.NewHelper(logger),
+	}
+}
+
+// CreateSecret 加密并保存密钥，namespace 为空时使用上下文限定的命名空间或默认命名空间
+func (uc *SecretUsecase) CreateSecret(ctx context.Context, secret *Secret, value string) (*Secret, error) {
+	uc.log.WithContext(ctx).Infof("CreateSecret: %s/%s", secret.Namespace, secret.Name)
+
+	if err := validateSecretName(secret.Name); err != nil {
+		return nil, errors.BadRequest(pb.ErrorReason_INVALID_SECRET.String(), err.Error())
+	}
+	if value == "" {
+		return nil, errors.BadRequest(pb.ErrorReason_INVALID_SECRET.String(), "value is required")
+	}
+	namespace, err := ResolveNamespace(ctx, secret.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	secret.Namespace = namespace
+	if ns, err := uc.namespaceRepo.GetNamespace(ctx, namespace); err != nil {
+		return nil, err
+	} else if ns == nil {
+		return nil, ErrNamespaceNotFound
+	}
+
+	existing, err := uc.repo.GetSecret(ctx, namespace, secret.Name)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrSecretExists
+	}
+
+	encrypted, err := uc.keyring.Encrypt([]byte(value))
+	if err != nil {
+		return nil, err
+	}
+	secret.Value = *encrypted
+	secret.Version = 1
+	return uc.repo.CreateSecret(ctx, secret)
+}
+
+// GetSecret 获取密钥详情，不包括值
+func (uc *SecretUsecase) GetSecret(ctx context.Context, namespace, name string) (*Secret, error) {
+	namespace, err := ResolveNamespace(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := uc.repo.GetSecret(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil {
+		return nil, ErrSecretNotFound
+	}
+	return secret, nil
+}
+
+// UpdateSecret 更新密钥的描述，value 不为空时用新的数据密钥加密新值并增加版本
+func (uc *SecretUsecase) UpdateSecret(ctx context.Context, secret *Secret, value string) (*Secret, error) {
+	uc.log.WithContext(ctx).Infof("UpdateSecret: %s/%s", secret.Namespace, secret.Name)
+
+	existing, err := uc.GetSecret(ctx, secret.Namespace, secret.Name)
+	if err != nil {
+		return nil, err
+	}
+	existing.Description = secret.Description
+	if value != "" {
+		encrypted, err := uc.keyring.Encrypt([]byte(value))
+		if err != nil {
+			return nil, err
+		}
+		existing.Value = *encrypted
+		existing.Version++
+	}
+
+	updated, err := uc.repo.UpdateSecret(ctx, existing)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrSecretNotFound
+	}
+	return updated, nil
+}
+
+// DeleteSecret 删除密钥，引用该密钥的任务在执行时失败
+func (uc *SecretUsecase) DeleteSecret(ctx context.Context, namespace, name string) error {
+	uc.log.WithContext(ctx).Infof("DeleteSecret: %s/%s", namespace, name)
+
+	secret, err := uc.GetSecret(ctx, namespace, name)
+	if err != nil {
+		return err
+	}
+	return uc.repo.DeleteSecret(ctx, secret.Namespace, secret.Name)
+}
+
+// ListSecrets 密钥列表查询
+func (uc *SecretUsecase) ListSecrets(ctx context.Context, filter *SecretListFilter) ([]*Secret, int64, error) {
+	return uc.repo.ListSecrets(ctx, filter)
+}
+
+// PrimaryKeyID 主用密钥ID，未配置主密钥时为空
+func (uc *SecretUsecase) PrimaryKeyID() string {
+	return uc.keyring.PrimaryKeyID()
+}
+
+// RotateKeys 用主用密钥重新加密所有其他主密钥加密的数据密钥，返回重新加密的密钥数
+// 轮换主密钥时先在密钥文件中加入新密钥并设为主用密钥，重新加密后再移除旧密钥
+func (uc *SecretUsecase) RotateKeys(ctx context.Context) (int64, error) {
+	if !uc.keyring.Enabled() {
+		return 0, ErrSecretsDisabled
+	}
+	primary := uc.keyring.PrimaryKeyID()
+	uc.log.WithContext(ctx).Infof("RotateKeys: %s", primary)
+
+	var rewrapped int64
+	for {
+		secrets, err := uc.repo.ListSecretsToRewrap(ctx, primary, rewrapBatchSize)
+		if err != nil {
+			return rewrapped, err
+		}
+		if len(secrets) == 0 {
+			return rewrapped, nil
+		}
+		for _, secret := range secrets {
+			value, _, err := uc.keyring.Rewrap(&secret.Value)
+			if err != nil {
+				// 无法解密的记录会在下一批中再次出现，立即停止
+				return rewrapped, errors.InternalServer(pb.ErrorReason_SECRET_REWRAP_FAILED.String(), err.Error()).
+					WithMetadata(map[string]string{"namespace": secret.Namespace, "name": secret.Name})
+			}
+			secret.Value = *value
+			if _, err := uc.repo.UpdateSecret(ctx, secret); err != nil {
+				return rewrapped, err
+			}
+			rewrapped++
+		}
+	}
+}
@@ -2,63 +2,159 @@ package biz
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"time"
 
 	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+var (
+	// ErrTaskNotFound 任务不存在
+	ErrTaskNotFound = errors.NotFound(pb.ErrorReason_TASK_NOT_FOUND.String(), "task not found")
+	// ErrTaskNotDeleted 任务未被删除，不能恢复或彻底删除
+	ErrTaskNotDeleted = errors.Conflict(pb.ErrorReason_TASK_NOT_DELETED.String(), "task is not deleted")
+	// ErrTaskVersionRequired 更新任务时未携带版本号
+	ErrTaskVersionRequired = errors.BadRequest(pb.ErrorReason_TASK_VERSION_REQUIRED.String(), "task version is required, pass version or an If-Match header")
+	// ErrExecutionNotFound 执行记录不存在
+	ErrExecutionNotFound = errors.NotFound(pb.ErrorReason_EXECUTION_NOT_FOUND.String(), "execution not found")
 )
 
+// TaskUpdateFields 更新任务时字段掩码支持的字段，名称与 UpdateTaskRequest 的字段名一致
+var TaskUpdateFields = []string{
+	"name", "description", "type", "schedule", "handler", "payload", "timeout", "metadata", "calendar_id",
+	"start_time", "end_time", "active_windows", "max_runs", "interval_mode", "initial_delay",
+	"jitter", "priority", "lock_group", "concurrency_policy", "retention",
+}
+
+// ErrTaskVersionMismatch 任务已被其他请求修改，返回当前版本号
+func ErrTaskVersionMismatch(current int64) error {
+	return errors.Conflict(pb.ErrorReason_TASK_VERSION_MISMATCH.String(), "task was modified by another request, reload it and retry").
+		WithMetadata(map[string]string{"current_version": strconv.FormatInt(current, 10)})
+}
+
+// invalidStateTransition 执行记录的当前状态不允许该操作，任务的状态变化由 NextTaskStatus 校验
+func invalidStateTransition(action string, status fmt.Stringer) error {
+	return errors.Conflict(pb.ErrorReason_INVALID_STATE_TRANSITION.String(), fmt.Sprintf("cannot %s in status %s", action, status)).
+		WithMetadata(map[string]string{"status": status.String()})
+}
+
 // Task 任务业务模型
 type Task struct {
-	ID             int64
-	Name           string
-	Description    string
-	Type           pb.TaskType
-	Status         pb.TaskStatus
-	Schedule       string
-	Handler        string
-	Payload        string
-	Timeout        int32
-	Metadata       map[string]string
-	NextRunTime    *time.Time
-	ExecutionCount int64
-	SuccessCount   int64
-	FailedCount    int64
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	ID                int64
+	Namespace         string // 所属命名空间，创建后不能修改
+	Name              string
+	Description       string
+	Type              pb.TaskType
+	Status            pb.TaskStatus
+	StatusReason      string     // 最近一次状态变化的原因
+	StatusChangedAt   *time.Time // 最近一次状态变化的时间
+	Schedule          string
+	Handler           string
+	Payload           string
+	Timeout           int32
+	Metadata          map[string]string
+	CalendarID        int64
+	StartTime         *time.Time
+	EndTime           *time.Time
+	ActiveWindows     []TimeWindow
+	MaxRuns           int64
+	RunCount          int64 // 调度次数，每次分发生成执行记录时递增，不含手动执行
+	IntervalMode      pb.IntervalMode
+	InitialDelay      time.Duration
+	Jitter            time.Duration
+	Priority          int32
+	LockGroup         string
+	ConcurrencyPolicy pb.ConcurrencyPolicy
+	Retention         RetentionPolicy // 执行记录保留策略，未设置的字段使用全局配置
+	NextRunTime       *time.Time
+	ExecutionCount    int64
+	SuccessCount      int64
+	FailedCount       int64
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	DeletedAt         *time.Time // 删除时间，未删除时为 nil
+	Version           int64      // 版本号，每次更新任务定义时递增
 }
 
 // TaskExecution 任务执行记录业务模型
 type TaskExecution struct {
-	ID         int64
-	TaskID     int64
-	TaskName   string
-	Status     pb.ExecutionStatus
-	NodeID     string
-	StartTime  *time.Time
-	EndTime    *time.Time
-	Duration   int32
-	Result     string
-	Error      string
-	RetryCount int32
-	Payload    string
-	CreatedAt  time.Time
+	ID          int64
+	Namespace   string // 所属任务的命名空间
+	TaskID      int64
+	TaskName    string
+	Status      pb.ExecutionStatus
+	NodeID      string
+	StartTime   *time.Time
+	EndTime     *time.Time
+	HeartbeatAt *time.Time // 执行节点最近一次心跳的时间，仅执行中的记录更新
+	Duration    int32
+	Result      string
+	Error       string
+	RetryCount  int32
+	Payload     string
+	Priority    int32
+	WaitTime    int32 // 开始执行前的排队等待耗时（毫秒）
+	TaskVersion int64 // 执行时的任务版本，对应任务修订
+	CreatedAt   time.Time
 }
 
 // TaskListFilter 任务列表过滤条件
 type TaskListFilter struct {
-	Page     int32
-	PageSize int32
-	Status   pb.TaskStatus
-	Type     pb.TaskType
-	Keyword  string
+	Page           int32
+	PageSize       int32
+	Namespace      string
+	Status         pb.TaskStatus
+	Type           pb.TaskType
+	Keyword        string
+	CalendarID     int64
+	IncludeDeleted bool          // 是否包含已删除的任务
+	Scopes         []AccessScope // 非 nil 时只返回至少在一个范围内的任务
+	OrderBy        ListOrder     // 排序，零值按 ID 倒序
+	After          *PageCursor   // 非 nil 时只返回按 OrderBy 排在游标之后的任务，忽略 Page
+	SkipTotal      bool          // 为 true 时不统计总数，返回的总数为 0
+}
+
+// fingerprint 筛选条件的摘要，不包括随调用方变化的访问范围
+func (f *TaskListFilter) fingerprint() string {
+	return filterFingerprint(f.Namespace, f.Status, f.Type, f.Keyword, f.CalendarID, f.IncludeDeleted)
 }
 
 // ExecutionListFilter 执行记录列表过滤条件
 type ExecutionListFilter struct {
-	TaskID   int64
-	Page     int32
-	PageSize int32
-	Status   pb.ExecutionStatus
+	Namespace string
+	TaskID    int64
+	Page      int32
+	PageSize  int32
+	Status    pb.ExecutionStatus
+	OrderBy   ListOrder   // 排序，零值按 ID 倒序
+	After     *PageCursor // 非 nil 时只返回按 OrderBy 排在游标之后的记录，忽略 Page
+	SkipTotal bool        // 为 true 时不统计总数，返回的总数为 0
+}
+
+// fingerprint 筛选条件的摘要
+func (f *ExecutionListFilter) fingerprint() string {
+	return filterFingerprint(f.Namespace, f.TaskID, f.Status)
+}
+
+// SchedulePreviewRequest 调度预览请求
+type SchedulePreviewRequest struct {
+	Type       pb.TaskType
+	Schedule   string
+	Timezone   string
+	CalendarID int64
+	Count      int32
+}
+
+// SchedulePreview 调度预览结果
+type SchedulePreview struct {
+	Valid        bool
+	Errors       []string
+	Description  string
+	Timezone     string
+	NextRunTimes []time.Time
 }
 
 // TaskRepo 任务仓储接口
@@ -66,26 +162,56 @@ type TaskRepo interface {
 	// CreateTask 创建任务
 	CreateTask(ctx context.Context, task *Task) (*Task, error)
 
-	// GetTask 获取任务详情
+	// GetTask 获取任务详情，任务不存在或已删除时返回 nil
 	GetTask(ctx context.Context, id int64) (*Task, error)
 
-	// UpdateTask 更新任务
-	UpdateTask(ctx context.Context, task *Task) (*Task, error)
+	// GetDeletedTask 获取已删除的任务，任务不存在或未删除时返回 nil
+	GetDeletedTask(ctx context.Context, id int64) (*Task, error)
 
-	// DeleteTask 删除任务
+	// UpdateTask 更新任务定义并递增版本号，task.Version 大于 0 时仅在版本一致时更新
+	// fields 非空时只更新其中列出的字段（包括零值），除 TaskUpdateFields 外还可以包含 status 和 next_run_time
+	// fields 为空时只更新非零值字段；任务不存在、已删除或版本不一致时返回 nil
+	UpdateTask(ctx context.Context, task *Task, fields []string) (*Task, error)
+
+	// DeleteTask 软删除任务，已删除的任务不再调度，也不出现在默认的任务列表中
 	DeleteTask(ctx context.Context, id int64) error
 
-	// ListTasks 任务列表查询
+	// RestoreTask 恢复已删除的任务，返回是否恢复成功
+	RestoreTask(ctx context.Context, id int64) (bool, error)
+
+	// PurgeTask 从存储中彻底删除已删除的任务，返回是否删除成功
+	PurgeTask(ctx context.Context, id int64) (bool, error)
+
+	// ListTasks 任务列表查询，按 filter.OrderBy 排序，filter.After 不为空时从游标之后开始
 	ListTasks(ctx context.Context, filter *TaskListFilter) ([]*Task, int64, error)
 
-	// UpdateTaskStatus 更新任务状态
-	UpdateTaskStatus(ctx context.Context, id int64, status pb.TaskStatus) error
+	// CountTasks 统计命名空间中的任务数，includeDeleted 为 true 时包括已删除的任务
+	CountTasks(ctx context.Context, namespace string, includeDeleted bool) (int64, error)
+
+	// TransitionTaskStatus 在任务状态仍为 from 时更新为 to，并记录状态变化的原因和时间，返回是否更新成功
+	TransitionTaskStatus(ctx context.Context, id int64, from, to pb.TaskStatus, reason string, at time.Time) (bool, error)
 
 	// UpdateTaskNextRunTime 更新任务下次执行时间
 	UpdateTaskNextRunTime(ctx context.Context, id int64, nextRunTime time.Time) error
 
 	// IncrementExecutionCount 增加执行次数
 	IncrementExecutionCount(ctx context.Context, id int64, success bool) error
+
+	// ListDueTasks 查询下次执行时间不晚于 now 的待调度任务
+	ListDueTasks(ctx context.Context, now time.Time, limit int) ([]*Task, error)
+
+	// AdvanceTask 在任务仍处于待调度且下次执行时间仍为 current 时推进到 next 并更新状态，返回是否抢占成功
+	// 任务已被暂停或取消时不更新，避免分发覆盖并发的状态变化
+	// reason 不为空时记录为状态变化的原因，状态变化时间为当前时间；run 为 true 时调度次数加一
+	AdvanceTask(ctx context.Context, id int64, current time.Time, next *time.Time, status pb.TaskStatus, reason string, run bool) (bool, error)
+
+	// RevertAdvance 撤销分发时的 AdvanceTask：任务状态仍为 status 且下次执行时间仍为 next 时恢复为待调度，下次执行时间恢复为 current
+	// 任务已被并发修改时不更新；reason 不为空时记录为状态变化的原因；run 为 true 时调度次数减一
+	RevertAdvance(ctx context.Context, id int64, current time.Time, next *time.Time, status pb.TaskStatus, reason string, run bool) (bool, error)
+
+	// RescheduleTask 在任务处于待调度且没有下次执行时间时设置下次执行时间并更新状态，返回是否更新成功
+	// reason 不为空时记录为状态变化的原因，状态变化时间为当前时间
+	RescheduleTask(ctx context.Context, id int64, next *time.Time, status pb.TaskStatus, reason string) (bool, error)
 }
 
 // ExecutionRepo 执行记录仓储接口
@@ -99,9 +225,45 @@ type ExecutionRepo interface {
 	// UpdateExecution 更新执行记录
 	UpdateExecution(ctx context.Context, execution *TaskExecution) (*TaskExecution, error)
 
-	// ListExecutions 执行记录列表查询
+	// ListExecutions 执行记录列表查询，按 filter.OrderBy 排序，filter.After 不为空时从游标之后开始
 	ListExecutions(ctx context.Context, filter *ExecutionListFilter) ([]*TaskExecution, int64, error)
 
 	// UpdateExecutionStatus 更新执行状态
 	UpdateExecutionStatus(ctx context.Context, id int64, status pb.ExecutionStatus) error
+
+	// ClaimExecution 将仍在排队中的执行记录更新为 execution.Status，记录执行节点、开始/结束时间、心跳时间、等待耗时、错误信息和非零的任务版本，返回是否认领成功
+	ClaimExecution(ctx context.Context, execution *TaskExecution) (bool, error)
+
+	// HeartbeatExecutions 更新 ids 中仍在执行中的记录的心跳时间，返回其余不再执行中（已取消、已被回收或已删除）的执行记录ID
+	HeartbeatExecutions(ctx context.Context, ids []int64, at time.Time) ([]int64, error)
+
+	// ListExpiredExecutions 查询心跳时间早于 before 的执行中记录，没有心跳时按开始时间判断，按 ID 升序，最多返回 limit 条
+	ListExpiredExecutions(ctx context.Context, before time.Time, limit int) ([]*TaskExecution, error)
+
+	// FinishExecution 记录执行结果，仅当执行记录仍处于执行中时生效，返回是否更新成功
+	FinishExecution(ctx context.Context, execution *TaskExecution) (bool, error)
+
+	// GetQueueStats 获取执行队列统计
+	GetQueueStats(ctx context.Context) (*QueueStats, error)
+
+	// GetNamespaceUsage 统计命名空间中执行中的记录数和 since 之后开始执行的记录数
+	GetNamespaceUsage(ctx context.Context, namespace string, since time.Time) (*NamespaceUsage, error)
+
+	// ListExecutionTaskIDs 返回存在执行记录的任务ID，按 ID 升序
+	ListExecutionTaskIDs(ctx context.Context) ([]int64, error)
+
+	// ListPrunableExecutions 查询符合清理条件的已结束执行记录，按 ID 升序，最多返回 limit 条
+	ListPrunableExecutions(ctx context.Context, filter *ExecutionPruneFilter, limit int) ([]*TaskExecution, error)
+
+	// DeleteExecutions 删除执行记录，返回删除的记录数
+	DeleteExecutions(ctx context.Context, ids []int64) (int64, error)
+
+	// DeleteTaskExecutions 删除任务的所有执行记录，返回删除的记录数
+	DeleteTaskExecutions(ctx context.Context, taskID int64) (int64, error)
+
+	// CancelExecution 将仍在排队中或执行中的执行记录更新为已取消并记录结束时间，返回是否取消成功
+	CancelExecution(ctx context.Context, id int64, endTime time.Time) (bool, error)
+
+	// CancelQueuedExecutions 将任务仍在排队中的执行记录更新为已取消并记录结束时间，返回被取消的执行记录ID
+	CancelQueuedExecutions(ctx context.Context, taskID int64, endTime time.Time) ([]int64, error)
 }
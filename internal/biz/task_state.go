@@ -0,0 +1,75 @@
+package biz
+
+import (
+	"fmt"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// TaskEvent 引起任务状态变化的事件
+type TaskEvent string
+
+const (
+	// TaskEventPause 暂停：等待中或运行中的任务不再调度，排队中的执行记录暂缓执行
+	TaskEventPause TaskEvent = "pause"
+	// TaskEventResume 恢复：已暂停的任务重新等待调度
+	TaskEventResume TaskEvent = "resume"
+	// TaskEventCancel 取消：任务不再调度，排队中的执行记录被取消，不能再恢复
+	TaskEventCancel TaskEvent = "cancel"
+	// TaskEventComplete 完成：没有后续触发时间或达到最大执行次数
+	TaskEventComplete TaskEvent = "complete"
+	// TaskEventFail 失败：调度配置无法计算下次执行时间
+	TaskEventFail TaskEvent = "fail"
+	// TaskEventReschedule 重新调度：已完成或失败的任务更新后有了新的触发时间
+	TaskEventReschedule TaskEvent = "reschedule"
+)
+
+// taskTransition 事件允许的源状态和目标状态
+type taskTransition struct {
+	from []pb.TaskStatus
+	to   pb.TaskStatus
+}
+
+// taskTransitions 任务状态机，未列出的状态不能响应该事件；已取消是终止状态
+var taskTransitions = map[TaskEvent]taskTransition{
+	TaskEventPause:      {from: []pb.TaskStatus{pb.TaskStatus_PENDING, pb.TaskStatus_RUNNING}, to: pb.TaskStatus_PAUSED},
+	TaskEventResume:     {from: []pb.TaskStatus{pb.TaskStatus_PAUSED}, to: pb.TaskStatus_PENDING},
+	TaskEventCancel:     {from: []pb.TaskStatus{pb.TaskStatus_PENDING, pb.TaskStatus_RUNNING, pb.TaskStatus_PAUSED, pb.TaskStatus_FAILED}, to: pb.TaskStatus_CANCELLED},
+	TaskEventComplete:   {from: []pb.TaskStatus{pb.TaskStatus_PENDING, pb.TaskStatus_RUNNING}, to: pb.TaskStatus_COMPLETED},
+	TaskEventFail:       {from: []pb.TaskStatus{pb.TaskStatus_PENDING, pb.TaskStatus_RUNNING}, to: pb.TaskStatus_FAILED},
+	TaskEventReschedule: {from: []pb.TaskStatus{pb.TaskStatus_COMPLETED, pb.TaskStatus_FAILED}, to: pb.TaskStatus_PENDING},
+}
+
+// TaskTransitionError 任务当前状态不能响应事件，作为 INVALID_STATE_TRANSITION 错误的 cause 返回
+type TaskTransitionError struct {
+	Event TaskEvent
+	From  pb.TaskStatus
+}
+
+// Error 实现 error 接口
+func (e *TaskTransitionError) Error() string {
+	return fmt.Sprintf("cannot %s a task in status %s", e.Event, e.From)
+}
+
+// NextTaskStatus 返回任务在 from 状态下响应事件后的状态，状态机不允许时返回 INVALID_STATE_TRANSITION 错误
+// 任务已处于目标状态时返回目标状态，调用方可据此将重复的操作视为成功
+func NextTaskStatus(from pb.TaskStatus, event TaskEvent) (pb.TaskStatus, error) {
+	transition, ok := taskTransitions[event]
+	if !ok {
+		return from, fmt.Errorf("unknown task event %q", event)
+	}
+	if from == transition.to {
+		return from, nil
+	}
+	for _, status := range transition.from {
+		if status == from {
+			return transition.to, nil
+		}
+	}
+	cause := &TaskTransitionError{Event: event, From: from}
+	return from, errors.Conflict(pb.ErrorReason_INVALID_STATE_TRANSITION.String(), cause.Error()).
+		WithMetadata(map[string]string{"status": from.String(), "event": string(event)}).
+		WithCause(cause)
+}
@@ -0,0 +1,90 @@
+package biz
+
+import (
+	stderrors "errors"
+	"testing"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestNextTaskStatus(t *testing.T) {
+	tests := []struct {
+		from  pb.TaskStatus
+		event TaskEvent
+		want  pb.TaskStatus
+	}{
+		{pb.TaskStatus_PENDING, TaskEventPause, pb.TaskStatus_PAUSED},
+		{pb.TaskStatus_RUNNING, TaskEventPause, pb.TaskStatus_PAUSED},
+		{pb.TaskStatus_PAUSED, TaskEventResume, pb.TaskStatus_PENDING},
+		{pb.TaskStatus_PENDING, TaskEventCancel, pb.TaskStatus_CANCELLED},
+		{pb.TaskStatus_PAUSED, TaskEventCancel, pb.TaskStatus_CANCELLED},
+		{pb.TaskStatus_FAILED, TaskEventCancel, pb.TaskStatus_CANCELLED},
+		{pb.TaskStatus_PENDING, TaskEventComplete, pb.TaskStatus_COMPLETED},
+		{pb.TaskStatus_RUNNING, TaskEventFail, pb.TaskStatus_FAILED},
+		{pb.TaskStatus_COMPLETED, TaskEventReschedule, pb.TaskStatus_PENDING},
+		{pb.TaskStatus_FAILED, TaskEventReschedule, pb.TaskStatus_PENDING},
+		// 已处于目标状态时视为成功
+		{pb.TaskStatus_PAUSED, TaskEventPause, pb.TaskStatus_PAUSED},
+		{pb.TaskStatus_CANCELLED, TaskEventCancel, pb.TaskStatus_CANCELLED},
+		{pb.TaskStatus_PENDING, TaskEventResume, pb.TaskStatus_PENDING},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.event)+"/"+tt.from.String(), func(t *testing.T) {
+			got, err := NextTaskStatus(tt.from, tt.event)
+			if err != nil {
+				t.Fatalf("NextTaskStatus: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextTaskStatusRejects(t *testing.T) {
+	tests := []struct {
+		from  pb.TaskStatus
+		event TaskEvent
+	}{
+		{pb.TaskStatus_CANCELLED, TaskEventResume},
+		{pb.TaskStatus_CANCELLED, TaskEventPause},
+		{pb.TaskStatus_CANCELLED, TaskEventReschedule},
+		{pb.TaskStatus_COMPLETED, TaskEventPause},
+		{pb.TaskStatus_COMPLETED, TaskEventCancel},
+		{pb.TaskStatus_PAUSED, TaskEventComplete},
+		{pb.TaskStatus_PAUSED, TaskEventFail},
+		{pb.TaskStatus_RUNNING, TaskEventReschedule},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.event)+"/"+tt.from.String(), func(t *testing.T) {
+			got, err := NextTaskStatus(tt.from, tt.event)
+			if err == nil {
+				t.Fatalf("expected error, got %s", got)
+			}
+			if got != tt.from {
+				t.Errorf("status = %s, want unchanged %s", got, tt.from)
+			}
+			if reason := errors.Reason(err); reason != pb.ErrorReason_INVALID_STATE_TRANSITION.String() {
+				t.Errorf("reason = %q, want INVALID_STATE_TRANSITION", reason)
+			}
+			if code := errors.Code(err); code != 409 {
+				t.Errorf("code = %d, want 409", code)
+			}
+			var cause *TaskTransitionError
+			if !stderrors.As(err, &cause) || cause.Event != tt.event || cause.From != tt.from {
+				t.Errorf("cause = %+v, want TaskTransitionError{%s, %s}", cause, tt.event, tt.from)
+			}
+			if md := errors.FromError(err).GetMetadata(); md["status"] != tt.from.String() || md["event"] != string(tt.event) {
+				t.Errorf("metadata = %v", md)
+			}
+		})
+	}
+}
+
+func TestNextTaskStatusUnknownEvent(t *testing.T) {
+	if _, err := NextTaskStatus(pb.TaskStatus_PENDING, TaskEvent("explode")); err == nil {
+		t.Fatalf("expected error for unknown event")
+	}
+}
@@ -0,0 +1,204 @@
+package biz
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+)
+
+// schedulingFields 影响下次执行时间的字段，更新后需要重新计算下次执行时间
+var schedulingFields = []string{
+	"type", "schedule", "calendar_id", "start_time", "end_time", "active_windows", "max_runs",
+	"interval_mode", "initial_delay", "jitter",
+}
+
+// applyTaskFields 将 src 中 fields 列出的字段写入 dst，零值用于清空字段
+// 除 TaskUpdateFields 外还支持仓储内部使用的 status 和 next_run_time
+func applyTaskFields(dst, src *Task, fields []string) {
+	for _, field := range fields {
+		switch field {
+		case "name":
+			dst.Name = src.Name
+		case "description":
+			dst.Description = src.Description
+		case "type":
+			dst.Type = src.Type
+		case "schedule":
+			dst.Schedule = src.Schedule
+		case "handler":
+			dst.Handler = src.Handler
+		case "payload":
+			dst.Payload = src.Payload
+		case "timeout":
+			dst.Timeout = src.Timeout
+		case "metadata":
+			dst.Metadata = src.Metadata
+		case "calendar_id":
+			dst.CalendarID = src.CalendarID
+		case "start_time":
+			dst.StartTime = src.StartTime
+		case "end_time":
+			dst.EndTime = src.EndTime
+		case "active_windows":
+			dst.ActiveWindows = src.ActiveWindows
+		case "max_runs":
+			dst.MaxRuns = src.MaxRuns
+		case "interval_mode":
+			dst.IntervalMode = src.IntervalMode
+		case "initial_delay":
+			dst.InitialDelay = src.InitialDelay
+		case "jitter":
+			dst.Jitter = src.Jitter
+		case "priority":
+			dst.Priority = src.Priority
+		case "lock_group":
+			dst.LockGroup = src.LockGroup
+		case "concurrency_policy":
+			dst.ConcurrencyPolicy = src.ConcurrencyPolicy
+		case "retention":
+			dst.Retention = src.Retention
+		case "status":
+			dst.Status = src.Status
+		case "next_run_time":
+			dst.NextRunTime = src.NextRunTime
+		}
+	}
+}
+
+// nonZeroTaskFields 返回 task 中非零值的可更新字段，用于未设置字段掩码的更新请求
+func nonZeroTaskFields(task *Task) []string {
+	nonZero := map[string]bool{
+		"name":               task.Name != "",
+		"description":        task.Description != "",
+		"type":               task.Type != pb.TaskType_TASK_TYPE_UNSPECIFIED,
+		"schedule":           task.Schedule != "",
+		"handler":            task.Handler != "",
+		"payload":            task.Payload != "",
+		"timeout":            task.Timeout != 0,
+		"metadata":           task.Metadata != nil,
+		"calendar_id":        task.CalendarID != 0,
+		"start_time":         task.StartTime != nil,
+		"end_time":           task.EndTime != nil,
+		"active_windows":     task.ActiveWindows != nil,
+		"max_runs":           task.MaxRuns != 0,
+		"interval_mode":      task.IntervalMode != pb.IntervalMode_INTERVAL_MODE_UNSPECIFIED,
+		"initial_delay":      task.InitialDelay != 0,
+		"jitter":             task.Jitter != 0,
+		"priority":           task.Priority != 0,
+		"lock_group":         task.LockGroup != "",
+		"concurrency_policy": task.ConcurrencyPolicy != pb.ConcurrencyPolicy_CONCURRENCY_POLICY_UNSPECIFIED,
+		"retention":          !task.Retention.IsZero(),
+	}
+	var fields []string
+	for _, field := range TaskUpdateFields {
+		if nonZero[field] {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// validateDefinition 校验更新后的任务定义
+func (t *Task) validateDefinition() error {
+	if t.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if t.Handler == "" {
+		return fmt.Errorf("handler is required")
+	}
+	if t.Type == pb.TaskType_TASK_TYPE_UNSPECIFIED {
+		return fmt.Errorf("type is required")
+	}
+	if err := t.validateConstraints(); err != nil {
+		return err
+	}
+	if err := t.validateIntervalOptions(); err != nil {
+		return err
+	}
+	if err := validateSecretReferences(t.Payload); err != nil {
+		return err
+	}
+	return validatePriority(t.Priority)
+}
+
+// validatePayload 校验负载为空或是合法的 JSON，且其中的密钥引用格式正确
+func validatePayload(payload string) error {
+	if payload == "" {
+		return nil
+	}
+	if !json.Valid([]byte(payload)) {
+		return fmt.Errorf("payload must be valid JSON")
+	}
+	return validateSecretReferences(payload)
+}
+
+// validateUpdateFields 校验字段掩码中的字段都可更新
+func validateUpdateFields(fields []string) error {
+	for _, field := range fields {
+		if !containsField(TaskUpdateFields, field) {
+			return fmt.Errorf("field %q cannot be updated", field)
+		}
+	}
+	return nil
+}
+
+// touchesScheduling 判断更新的字段中是否有影响下次执行时间的字段
+func touchesScheduling(fields []string) bool {
+	for _, field := range fields {
+		if containsField(schedulingFields, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsField 判断 fields 是否包含 field
+func containsField(fields []string, field string) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// planNextRun 按调度配置计算任务的下次执行时间并更新状态
+// 立即执行的任务从当前时间（或开始时间）执行；已过结束时间、达到最大执行次数或已无触发时间的任务标记为已完成
+func planNextRun(task *Task, calendar *Calendar, now time.Time) error {
+	task.NextRunTime = nil
+	if (task.EndTime != nil && task.EndTime.Before(now)) || (task.MaxRuns > 0 && task.RunCount >= task.MaxRuns) {
+		completePlannedTask(task)
+		return nil
+	}
+
+	if task.Type == pb.TaskType_IMMEDIATE {
+		nextRunTime := now
+		if task.StartTime != nil && task.StartTime.After(now) {
+			nextRunTime = *task.StartTime
+		}
+		task.NextRunTime = &nextRunTime
+		return nil
+	}
+
+	nextRunTime, err := firstRunTime(task, calendar, now)
+	if err != nil {
+		return err
+	}
+	if nextRunTime.IsZero() {
+		completePlannedTask(task)
+		return nil
+	}
+	nextRunTime = applyJitter(nextRunTime, task)
+	task.NextRunTime = &nextRunTime
+	return nil
+}
+
+// completePlannedTask 将已无后续执行的任务标记为已完成，状态机不允许时（如已暂停）保持原状态
+func completePlannedTask(task *Task) {
+	if status, err := NextTaskStatus(task.Status, TaskEventComplete); err == nil && status != task.Status {
+		task.Status = status
+		task.StatusReason = "no further runs"
+	}
+}
@@ -0,0 +1,398 @@
+package biz
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeTaskRepo 只保存一个任务的任务仓储，beforeUpdate 用于模拟并发的更新
+type fakeTaskRepo struct {
+	TaskRepo
+	task         *Task
+	beforeUpdate func()
+}
+
+func (r *fakeTaskRepo) GetTask(_ context.Context, id int64) (*Task, error) {
+	if r.task == nil || r.task.ID != id {
+		return nil, nil
+	}
+	task := *r.task
+	return &task, nil
+}
+
+func (r *fakeTaskRepo) UpdateTask(_ context.Context, task *Task, fields []string) (*Task, error) {
+	if r.beforeUpdate != nil {
+		r.beforeUpdate()
+	}
+	if r.task == nil || r.task.ID != task.ID || r.task.Version != task.Version {
+		return nil, nil
+	}
+	applyTaskFields(r.task, task, fields)
+	r.task.Version++
+	updated := *r.task
+	return &updated, nil
+}
+
+// fakeRevisionRepo 记录写入的修订
+type fakeRevisionRepo struct {
+	TaskRevisionRepo
+	revisions []*TaskRevision
+}
+
+func (r *fakeRevisionRepo) CreateRevision(_ context.Context, revision *TaskRevision) (*TaskRevision, error) {
+	r.revisions = append(r.revisions, revision)
+	return revision, nil
+}
+
+// fakeExecutionRepo 按状态返回 active 中的执行记录
+type fakeExecutionRepo struct {
+	ExecutionRepo
+	active []*TaskExecution
+}
+
+func (r *fakeExecutionRepo) ListExecutions(_ context.Context, filter *ExecutionListFilter) ([]*TaskExecution, int64, error) {
+	var result []*TaskExecution
+	for _, execution := range r.active {
+		if execution.TaskID == filter.TaskID && execution.Status == filter.Status {
+			result = append(result, execution)
+		}
+	}
+	return result, int64(len(result)), nil
+}
+
+// newUpdateUsecase 返回操作 task 的任务用例
+func newUpdateUsecase(task *Task) (*TaskUsecase, *fakeTaskRepo, *fakeRevisionRepo) {
+	repo := &fakeTaskRepo{task: task}
+	revisions := &fakeRevisionRepo{}
+	handlers := NewHandlerRegistry()
+	handlers.Register("http", HandlerFunc(func(context.Context, *TaskExecution) (string, error) { return "", nil }))
+	uc := NewTaskUsecase(repo, nil, revisions, nil, nil, nil, handlers, log.DefaultLogger)
+	return uc, repo, revisions
+}
+
+// intervalTask 返回每 5 分钟执行一次的任务
+func intervalTask(status pb.TaskStatus) *Task {
+	return &Task{
+		ID:          1,
+		Namespace:   DefaultNamespace,
+		Name:        "sync",
+		Description: "sync inventory",
+		Type:        pb.TaskType_INTERVAL,
+		Schedule:    "5m",
+		Handler:     "http",
+		Payload:     `{"url": "http://localhost"}`,
+		Metadata:    map[string]string{"team": "a"},
+		Status:      status,
+		Version:     3,
+	}
+}
+
+func TestValidateUpdateFields(t *testing.T) {
+	if err := validateUpdateFields(nil); err != nil {
+		t.Errorf("empty mask: %v", err)
+	}
+	if err := validateUpdateFields(TaskUpdateFields); err != nil {
+		t.Errorf("all update fields: %v", err)
+	}
+	// status 和 next_run_time 只供仓储内部使用，不能通过字段掩码更新
+	for _, field := range []string{"status", "next_run_time", "version", "Name", ""} {
+		if err := validateUpdateFields([]string{"name", field}); err == nil {
+			t.Errorf("validateUpdateFields accepted %q", field)
+		}
+	}
+}
+
+func TestTouchesScheduling(t *testing.T) {
+	tests := []struct {
+		fields []string
+		want   bool
+	}{
+		{nil, false},
+		{[]string{"name", "payload", "priority", "retention"}, false},
+		{[]string{"name", "schedule"}, true},
+		{[]string{"max_runs"}, true},
+		{[]string{"calendar_id"}, true},
+		{[]string{"jitter"}, true},
+	}
+	for _, tt := range tests {
+		if got := touchesScheduling(tt.fields); got != tt.want {
+			t.Errorf("touchesScheduling(%v) = %v, want %v", tt.fields, got, tt.want)
+		}
+	}
+}
+
+func TestApplyTaskFields(t *testing.T) {
+	dst := intervalTask(pb.TaskStatus_PENDING)
+	src := &Task{Name: "renamed", Schedule: "1m"}
+
+	applyTaskFields(dst, src, []string{"name", "description", "metadata"})
+	if dst.Name != "renamed" {
+		t.Errorf("Name = %q, want renamed", dst.Name)
+	}
+	// 掩码中的零值字段会被清空，掩码外的字段保持不变
+	if dst.Description != "" || dst.Metadata != nil {
+		t.Errorf("Description = %q, Metadata = %v, want cleared", dst.Description, dst.Metadata)
+	}
+	if dst.Schedule != "5m" || dst.Handler != "http" {
+		t.Errorf("fields outside the mask changed: schedule %q, handler %q", dst.Schedule, dst.Handler)
+	}
+}
+
+func TestNonZeroTaskFields(t *testing.T) {
+	if fields := nonZeroTaskFields(&Task{}); fields != nil {
+		t.Errorf("zero task fields = %v, want none", fields)
+	}
+	task := &Task{
+		Name:      "sync",
+		Schedule:  "1m",
+		Metadata:  map[string]string{},
+		Retention: RetentionPolicy{KeepLast: 10},
+		Status:    pb.TaskStatus_PAUSED,
+		Version:   2,
+	}
+	want := []string{"name", "schedule", "metadata", "retention"}
+	if fields := nonZeroTaskFields(task); !reflect.DeepEqual(fields, want) {
+		t.Errorf("nonZeroTaskFields = %v, want %v", fields, want)
+	}
+}
+
+func TestPlanNextRun(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.Local)
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+	tests := []struct {
+		name       string
+		task       Task
+		wantStatus pb.TaskStatus
+		wantNext   *time.Time
+	}{
+		{"immediate", Task{Type: pb.TaskType_IMMEDIATE}, pb.TaskStatus_PENDING, &now},
+		{"immediate with start", Task{Type: pb.TaskType_IMMEDIATE, StartTime: &future}, pb.TaskStatus_PENDING, &future},
+		{"immediate after end", Task{Type: pb.TaskType_IMMEDIATE, EndTime: &past}, pb.TaskStatus_COMPLETED, nil},
+		{"scheduled", Task{Type: pb.TaskType_SCHEDULED, Schedule: future.Format(time.RFC3339)}, pb.TaskStatus_PENDING, &future},
+		{"scheduled in the past", Task{Type: pb.TaskType_SCHEDULED, Schedule: past.Format(time.RFC3339)}, pb.TaskStatus_COMPLETED, nil},
+		{"end before next fire", Task{Type: pb.TaskType_CRON, Schedule: "0 0 * * *", EndTime: &future}, pb.TaskStatus_COMPLETED, nil},
+		{"max runs reached", Task{Type: pb.TaskType_INTERVAL, Schedule: "1m", MaxRuns: 3, RunCount: 3}, pb.TaskStatus_COMPLETED, nil},
+		{"paused without further runs", Task{Type: pb.TaskType_SCHEDULED, Schedule: past.Format(time.RFC3339), Status: pb.TaskStatus_PAUSED}, pb.TaskStatus_PAUSED, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := tt.task
+			if task.Status == pb.TaskStatus_TASK_STATUS_UNSPECIFIED {
+				task.Status = pb.TaskStatus_PENDING
+			}
+			if err := planNextRun(&task, nil, now); err != nil {
+				t.Fatalf("planNextRun: %v", err)
+			}
+			if task.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", task.Status, tt.wantStatus)
+			}
+			switch {
+			case tt.wantNext == nil && task.NextRunTime != nil:
+				t.Errorf("next run time = %v, want none", task.NextRunTime)
+			case tt.wantNext != nil && (task.NextRunTime == nil || !task.NextRunTime.Equal(*tt.wantNext)):
+				t.Errorf("next run time = %v, want %v", task.NextRunTime, tt.wantNext)
+			}
+			if task.Status == pb.TaskStatus_COMPLETED && task.StatusReason != "no further runs" {
+				t.Errorf("status reason = %q", task.StatusReason)
+			}
+		})
+	}
+}
+
+func TestUpdateTaskVersion(t *testing.T) {
+	ctx := context.Background()
+
+	uc, repo, revisions := newUpdateUsecase(intervalTask(pb.TaskStatus_PENDING))
+	if _, err := uc.UpdateTask(ctx, &Task{ID: 1, Name: "renamed"}, nil); errors.Reason(err) != pb.ErrorReason_TASK_VERSION_REQUIRED.String() {
+		t.Errorf("without version err = %v, want TASK_VERSION_REQUIRED", err)
+	}
+
+	_, err := uc.UpdateTask(ctx, &Task{ID: 1, Name: "renamed", Version: 2}, nil)
+	if !errors.IsConflict(err) || errors.Reason(err) != pb.ErrorReason_TASK_VERSION_MISMATCH.String() {
+		t.Fatalf("stale version err = %v, want TASK_VERSION_MISMATCH", err)
+	}
+	if v := errors.FromError(err).GetMetadata()["current_version"]; v != "3" {
+		t.Errorf("current_version = %q, want 3", v)
+	}
+
+	updated, err := uc.UpdateTask(ctx, &Task{ID: 1, Name: "renamed", Version: 3}, nil)
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	if updated.Version != 4 || updated.Name != "renamed" || updated.Description != "sync inventory" {
+		t.Errorf("updated = version %d, name %q, description %q", updated.Version, updated.Name, updated.Description)
+	}
+	if len(revisions.revisions) != 1 || revisions.revisions[0].Action != pb.TaskRevisionAction_REVISION_UPDATED {
+		t.Errorf("revisions = %+v, want one update revision", revisions.revisions)
+	}
+
+	// 读取之后、写入之前被其他请求修改时返回最新的版本号
+	repo.beforeUpdate = func() { repo.task.Version = 7 }
+	_, err = uc.UpdateTask(ctx, &Task{ID: 1, Name: "again", Version: 4}, nil)
+	if errors.Reason(err) != pb.ErrorReason_TASK_VERSION_MISMATCH.String() {
+		t.Fatalf("concurrent update err = %v, want TASK_VERSION_MISMATCH", err)
+	}
+	if v := errors.FromError(err).GetMetadata()["current_version"]; v != "7" {
+		t.Errorf("current_version = %q, want 7", v)
+	}
+	if len(revisions.revisions) != 1 {
+		t.Errorf("a failed update recorded a revision")
+	}
+
+	if _, err := uc.UpdateTask(ctx, &Task{ID: 2, Version: 1}, nil); !errors.IsNotFound(err) {
+		t.Errorf("missing task err = %v, want NotFound", err)
+	}
+}
+
+func TestUpdateTaskMask(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		update     *Task
+		fields     []string
+		wantReason string
+	}{
+		{"unknown field", &Task{Name: "x"}, []string{"name", "owner"}, pb.ErrorReason_INVALID_UPDATE_MASK.String()},
+		{"internal field", &Task{}, []string{"status"}, pb.ErrorReason_INVALID_UPDATE_MASK.String()},
+		{"clear required field", &Task{}, []string{"name"}, pb.ErrorReason_INVALID_TASK.String()},
+		{"unregistered handler", &Task{Handler: "ftp"}, nil, pb.ErrorReason_INVALID_TASK.String()},
+		{"invalid payload", &Task{Payload: "{"}, []string{"payload"}, pb.ErrorReason_INVALID_PAYLOAD.String()},
+		{"invalid schedule", &Task{Schedule: "soon"}, nil, pb.ErrorReason_INVALID_SCHEDULE.String()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, _ := newUpdateUsecase(intervalTask(pb.TaskStatus_PENDING))
+			tt.update.ID, tt.update.Version = 1, 3
+			_, err := uc.UpdateTask(ctx, tt.update, tt.fields)
+			if errors.Reason(err) != tt.wantReason {
+				t.Fatalf("err = %v, want %s", err, tt.wantReason)
+			}
+			if repo.task.Version != 3 {
+				t.Errorf("rejected update was saved")
+			}
+		})
+	}
+
+	// 掩码中的零值字段清空，未设置掩码时零值字段保持不变
+	uc, _, _ := newUpdateUsecase(intervalTask(pb.TaskStatus_PENDING))
+	updated, err := uc.UpdateTask(ctx, &Task{ID: 1, Version: 3}, []string{"description", "metadata"})
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	if updated.Description != "" || updated.Metadata != nil || updated.Name != "sync" {
+		t.Errorf("updated = description %q, metadata %v, name %q", updated.Description, updated.Metadata, updated.Name)
+	}
+	updated, err = uc.UpdateTask(ctx, &Task{ID: 1, Version: 4, Description: "nightly"}, nil)
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	if updated.Description != "nightly" || updated.Schedule != "5m" || updated.Payload == "" {
+		t.Errorf("updated = description %q, schedule %q, payload %q", updated.Description, updated.Schedule, updated.Payload)
+	}
+}
+
+func TestUpdateTaskLegacyHandler(t *testing.T) {
+	ctx := context.Background()
+	// 升级前创建的任务引用了未注册的处理器
+	legacy := func() *Task {
+		task := intervalTask(pb.TaskStatus_PENDING)
+		task.Handler = "shell"
+		return task
+	}
+
+	// 处理器未变化时只记录警告，其他字段照常更新
+	for _, fields := range [][]string{nil, {"description"}, {"description", "handler"}} {
+		uc, _, _ := newUpdateUsecase(legacy())
+		updated, err := uc.UpdateTask(ctx, &Task{ID: 1, Version: 3, Description: "nightly", Handler: "shell"}, fields)
+		if err != nil {
+			t.Fatalf("fields %v: UpdateTask: %v", fields, err)
+		}
+		if updated.Description != "nightly" || updated.Handler != "shell" {
+			t.Errorf("fields %v: updated = description %q, handler %q", fields, updated.Description, updated.Handler)
+		}
+	}
+
+	// 改为其他未注册的处理器仍然拒绝
+	uc, _, _ := newUpdateUsecase(legacy())
+	_, err := uc.UpdateTask(ctx, &Task{ID: 1, Version: 3, Handler: "ftp"}, []string{"handler"})
+	if errors.Reason(err) != pb.ErrorReason_INVALID_TASK.String() {
+		t.Fatalf("err = %v, want %s", err, pb.ErrorReason_INVALID_TASK)
+	}
+}
+
+func TestUpdateTaskReschedules(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		status pb.TaskStatus
+		want   pb.TaskStatus
+	}{
+		{"completed", pb.TaskStatus_COMPLETED, pb.TaskStatus_PENDING},
+		{"failed", pb.TaskStatus_FAILED, pb.TaskStatus_PENDING},
+		{"paused", pb.TaskStatus_PAUSED, pb.TaskStatus_PAUSED},
+		{"cancelled", pb.TaskStatus_CANCELLED, pb.TaskStatus_CANCELLED},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _, _ := newUpdateUsecase(intervalTask(tt.status))
+			updated, err := uc.UpdateTask(ctx, &Task{ID: 1, Version: 3, Schedule: "1m"}, []string{"schedule"})
+			if err != nil {
+				t.Fatalf("UpdateTask: %v", err)
+			}
+			if updated.Status != tt.want {
+				t.Errorf("status = %s, want %s", updated.Status, tt.want)
+			}
+			if updated.NextRunTime == nil {
+				t.Errorf("next run time was not recomputed")
+			}
+		})
+	}
+
+	// 固定延迟任务正在执行时不重新计划，由执行结束后的重新调度按新配置计算
+	running := intervalTask(pb.TaskStatus_PENDING)
+	running.IntervalMode = pb.IntervalMode_FIXED_DELAY
+	uc, _, _ := newUpdateUsecase(running)
+	uc.executionRepo = &fakeExecutionRepo{active: []*TaskExecution{{TaskID: 1, Status: pb.ExecutionStatus_EXECUTING}}}
+	updated, err := uc.UpdateTask(ctx, &Task{ID: 1, Version: 3, Schedule: "1m"}, []string{"schedule"})
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	if updated.NextRunTime != nil || updated.Schedule != "1m" {
+		t.Errorf("next run time = %v, schedule = %q, want no run planned while executing", updated.NextRunTime, updated.Schedule)
+	}
+	uc.executionRepo = &fakeExecutionRepo{}
+	if updated, err = uc.UpdateTask(ctx, &Task{ID: 1, Version: 4, Schedule: "2m"}, []string{"schedule"}); err != nil || updated.NextRunTime == nil {
+		t.Errorf("idle fixed-delay task next run time = %v, %v, want planned", updated, err)
+	}
+
+	// 提高最大执行次数后已完成的任务重新等待调度
+	completed := intervalTask(pb.TaskStatus_COMPLETED)
+	completed.MaxRuns, completed.RunCount = 2, 2
+	uc, _, _ = newUpdateUsecase(completed)
+	updated, err = uc.UpdateTask(ctx, &Task{ID: 1, Version: 3, MaxRuns: 5}, []string{"max_runs"})
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	if updated.Status != pb.TaskStatus_PENDING || updated.NextRunTime == nil {
+		t.Errorf("status = %s, next run time = %v, want the task revived", updated.Status, updated.NextRunTime)
+	}
+
+	// 不影响调度的字段不会改变状态和下次执行时间
+	uc, _, _ = newUpdateUsecase(intervalTask(pb.TaskStatus_COMPLETED))
+	updated, err = uc.UpdateTask(ctx, &Task{ID: 1, Version: 3, Name: "renamed"}, []string{"name"})
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+	if updated.Status != pb.TaskStatus_COMPLETED || updated.NextRunTime != nil {
+		t.Errorf("status = %s, next run time = %v, want unchanged", updated.Status, updated.NextRunTime)
+	}
+}
@@ -2,24 +2,46 @@ package biz
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	pb "heytom-scheduler/api/scheduler/v1"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
+const (
+	// defaultPreviewCount 调度预览默认返回的触发次数
+	defaultPreviewCount = 5
+	// maxPreviewCount 调度预览最多返回的触发次数
+	maxPreviewCount = 100
+)
+
 // TaskUsecase 任务用例
 type TaskUsecase struct {
-	repo TaskRepo
-	log  *log.Helper
+	repo          TaskRepo
+	executionRepo ExecutionRepo
+	revisionRepo  TaskRevisionRepo
+	calendarRepo  CalendarRepo
+	namespaceRepo NamespaceRepo
+	queue         ExecutionQueue
+	handlers      *HandlerRegistry
+	log           *log.Helper
 }
 
 // NewTaskUsecase 创建任务用例实例
-func NewTaskUsecase(repo TaskRepo, logger log.Logger) *TaskUsecase {
+func NewTaskUsecase(repo TaskRepo, executionRepo ExecutionRepo, revisionRepo TaskRevisionRepo, calendarRepo CalendarRepo, namespaceRepo NamespaceRepo, queue ExecutionQueue, handlers *HandlerRegistry, logger log.Logger) *TaskUsecase {
 	return &TaskUsecase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+		repo:          repo,
+		executionRepo: executionRepo,
+		revisionRepo:  revisionRepo,
+		calendarRepo:  calendarRepo,
+		namespaceRepo: namespaceRepo,
+		queue:         queue,
+		handlers:      handlers,
+		log:           log.NewHelper(logger),
 	}
 }
 
@@ -32,44 +54,442 @@ func (uc *TaskUsecase) CreateTask(ctx context.Context, task *Task) (*Task, error
 		task.Status = pb.TaskStatus_PENDING
 	}
 
+	if err := task.validateConstraints(); err != nil {
+		return nil, errors.BadRequest(pb.ErrorReason_INVALID_TASK.String(), err.Error())
+	}
+	if err := task.validateIntervalOptions(); err != nil {
+		return nil, errors.BadRequest(pb.ErrorReason_INVALID_TASK.String(), err.Error())
+	}
+	if err := validatePriority(task.Priority); err != nil {
+		return nil, errors.BadRequest(pb.ErrorReason_INVALID_TASK.String(), err.Error())
+	}
+	if err := validateSecretReferences(task.Payload); err != nil {
+		return nil, errors.BadRequest(pb.ErrorReason_INVALID_TASK.String(), err.Error())
+	}
+	if err := uc.validateTaskFields(ctx, task, nil, nil); err != nil {
+		return nil, err
+	}
+
+	namespace, err := ResolveNamespace(ctx, task.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	task.Namespace = namespace
+	if err := uc.checkTaskQuota(ctx, namespace); err != nil {
+		return nil, err
+	}
+
+	calendar, err := getCalendar(ctx, uc.calendarRepo, task.CalendarID)
+	if err != nil {
+		return nil, err
+	}
+
 	// 计算下次执行时间
-	if task.Type == pb.TaskType_CRON || task.Type == pb.TaskType_INTERVAL {
-		nextRunTime := calculateNextRunTime(task.Type, task.Schedule)
-		task.NextRunTime = &nextRunTime
-	} else if task.Type == pb.TaskType_SCHEDULED {
-		scheduledTime, err := time.Parse(time.RFC3339, task.Schedule)
-		if err == nil {
-			task.NextRunTime = &scheduledTime
-		}
+	now := time.Now()
+	if task.CreatedAt.IsZero() {
+		task.CreatedAt = now.Truncate(time.Second)
+	}
+	if err := planNextRun(task, calendar, now); err != nil {
+		return nil, errors.BadRequest(pb.ErrorReason_INVALID_SCHEDULE.String(), err.Error())
 	}
 
-	return uc.repo.CreateTask(ctx, task)
+	created, err := uc.repo.CreateTask(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+	uc.recordRevision(ctx, nil, created, pb.TaskRevisionAction_REVISION_CREATED, 0)
+	return created, nil
 }
 
-// GetTask 获取任务详情
+// GetTask 获取任务详情，已删除的任务视为不存在
 func (uc *TaskUsecase) GetTask(ctx context.Context, id int64) (*Task, error) {
-	return uc.repo.GetTask(ctx, id)
+	return uc.getTask(ctx, id)
+}
+
+// UpdateTask 更新任务，task.Version 为更新前读取到的版本，与当前版本不一致时返回 ErrTaskVersionMismatch
+// fields 为字段掩码，非空时只更新其中列出的字段（可用于清空字段），为空时只更新非零值字段
+// 更新后的任务整体校验通过才会保存，调度相关字段变化时重新计算下次执行时间
+func (uc *TaskUsecase) UpdateTask(ctx context.Context, task *Task, fields []string) (*Task, error) {
+	uc.log.WithContext(ctx).Infof("UpdateTask: %d (version %d)", task.ID, task.Version)
+	return uc.updateTask(ctx, task, fields, pb.TaskRevisionAction_REVISION_UPDATED, 0)
 }
 
-// UpdateTask 更新任务
-func (uc *TaskUsecase) UpdateTask(ctx context.Context, task *Task) (*Task, error) {
-	uc.log.WithContext(ctx).Infof("UpdateTask: %d", task.ID)
-	return uc.repo.UpdateTask(ctx, task)
+// updateTask 更新任务并记录修订，sourceVersion 为回滚的目标版本
+func (uc *TaskUsecase) updateTask(ctx context.Context, task *Task, fields []string, action pb.TaskRevisionAction, sourceVersion int64) (*Task, error) {
+	if task.Version <= 0 {
+		return nil, ErrTaskVersionRequired
+	}
+	if err := validateUpdateFields(fields); err != nil {
+		return nil, errors.BadRequest(pb.ErrorReason_INVALID_UPDATE_MASK.String(), err.Error())
+	}
+
+	current, err := uc.getTask(ctx, task.ID)
+	if err != nil {
+		return nil, err
+	}
+	if current.Version != task.Version {
+		return nil, ErrTaskVersionMismatch(current.Version)
+	}
+
+	if len(fields) == 0 {
+		fields = nonZeroTaskFields(task)
+		// 未设置的保留规则沿用当前值
+		task.Retention = task.Retention.Merge(current.Retention)
+	}
+	merged := *current
+	applyTaskFields(&merged, task, fields)
+	if err := merged.validateDefinition(); err != nil {
+		return nil, errors.BadRequest(pb.ErrorReason_INVALID_TASK.String(), err.Error())
+	}
+	if err := uc.validateTaskFields(ctx, &merged, current, fields); err != nil {
+		return nil, err
+	}
+
+	calendar, err := getCalendar(ctx, uc.calendarRepo, merged.CalendarID)
+	if err != nil {
+		return nil, err
+	}
+	replan := touchesScheduling(fields)
+	if replan && merged.isFixedDelay() && current.NextRunTime == nil {
+		// 固定延迟任务分发后到执行结束前没有下次执行时间，由执行结束后的重新调度按新配置计算，避免重叠执行
+		active, err := uc.hasActiveExecution(ctx, task.ID)
+		if err != nil {
+			return nil, err
+		}
+		replan = !active
+	}
+	if replan {
+		if err := planNextRun(&merged, calendar, time.Now()); err != nil {
+			return nil, errors.BadRequest(pb.ErrorReason_INVALID_SCHEDULE.String(), err.Error())
+		}
+		switch {
+		case current.Status == pb.TaskStatus_PAUSED || current.Status == pb.TaskStatus_CANCELLED:
+			// 暂停的任务保持暂停，恢复时按新的下次执行时间调度；已取消的任务不再调度
+			merged.Status = current.Status
+		case merged.NextRunTime != nil:
+			// 已完成或失败的任务有了新的触发时间后重新等待调度
+			if status, err := NextTaskStatus(merged.Status, TaskEventReschedule); err == nil {
+				merged.Status = status
+			}
+		}
+		fields = append(fields, "next_run_time")
+		if merged.Status != current.Status {
+			now := time.Now()
+			merged.StatusReason = "no further runs"
+			if merged.Status == pb.TaskStatus_PENDING {
+				merged.StatusReason = "rescheduled by update"
+			}
+			merged.StatusChangedAt = &now
+			fields = append(fields, "status")
+		}
+	}
+
+	updated, err := uc.repo.UpdateTask(ctx, &merged, fields)
+	if err != nil {
+		return nil, err
+	}
+	if updated != nil {
+		uc.recordRevision(ctx, current, updated, action, sourceVersion)
+		return updated, nil
+	}
+
+	// 区分任务不存在和版本冲突
+	latest, err := uc.getTask(ctx, task.ID)
+	if err != nil {
+		return nil, err
+	}
+	return nil, ErrTaskVersionMismatch(latest.Version)
 }
 
-// DeleteTask 删除任务
+// DeleteTask 软删除任务并取消其仍在排队中的执行记录，执行中的记录继续执行到结束
 func (uc *TaskUsecase) DeleteTask(ctx context.Context, id int64) error {
 	uc.log.WithContext(ctx).Infof("DeleteTask: %d", id)
-	return uc.repo.DeleteTask(ctx, id)
+
+	if _, err := uc.getTask(ctx, id); err != nil {
+		return err
+	}
+	if err := uc.repo.DeleteTask(ctx, id); err != nil {
+		return err
+	}
+
+	return uc.cancelQueuedExecutions(ctx, "DeleteTask", id)
+}
+
+// cancelQueuedExecutions 取消任务排队中的执行记录并移出队列
+func (uc *TaskUsecase) cancelQueuedExecutions(ctx context.Context, op string, id int64) error {
+	cancelled, err := uc.executionRepo.CancelQueuedExecutions(ctx, id, time.Now())
+	if err != nil {
+		return err
+	}
+	for _, executionID := range cancelled {
+		// 出队时会丢弃不再排队的执行记录，移出队列失败不影响操作结果
+		if err := uc.queue.Ack(ctx, executionID); err != nil {
+			uc.log.WithContext(ctx).Warnf("%s: remove execution %d from queue: %v", op, executionID, err)
+		}
+	}
+	if len(cancelled) > 0 {
+		uc.log.WithContext(ctx).Infof("%s: cancelled %d queued executions of task %d", op, len(cancelled), id)
+	}
+	return nil
+}
+
+// RestoreTask 恢复已删除的任务，任务按删除前的状态和下次执行时间继续调度
+func (uc *TaskUsecase) RestoreTask(ctx context.Context, id int64) (*Task, error) {
+	uc.log.WithContext(ctx).Infof("RestoreTask: %d", id)
+
+	deleted, err := uc.repo.GetDeletedTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if deleted == nil {
+		return nil, uc.notDeletedError(ctx, id)
+	}
+	if err := uc.checkTaskQuota(ctx, deleted.Namespace); err != nil {
+		return nil, err
+	}
+
+	restored, err := uc.repo.RestoreTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !restored {
+		return nil, uc.notDeletedError(ctx, id)
+	}
+
+	return uc.getTask(ctx, id)
+}
+
+// PurgeTask 彻底删除已删除的任务及其执行记录，不可恢复
+func (uc *TaskUsecase) PurgeTask(ctx context.Context, id int64) error {
+	uc.log.WithContext(ctx).Infof("PurgeTask: %d", id)
+
+	task, err := uc.repo.GetDeletedTask(ctx, id)
+	if err != nil {
+		return err
+	}
+	if task == nil {
+		return uc.notDeletedError(ctx, id)
+	}
+
+	// 先删除执行记录，失败时任务仍可再次彻底删除
+	deleted, err := uc.executionRepo.DeleteTaskExecutions(ctx, id)
+	if err != nil {
+		return err
+	}
+	if _, err := uc.revisionRepo.DeleteTaskRevisions(ctx, id); err != nil {
+		return err
+	}
+	purged, err := uc.repo.PurgeTask(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !purged {
+		return uc.notDeletedError(ctx, id)
+	}
+
+	uc.log.WithContext(ctx).Infof("PurgeTask: purged task %d with %d executions", id, deleted)
+	return nil
+}
+
+// RollbackTask 将任务定义回滚到 revision 版本的快照，version 为回滚前读取到的任务版本
+// 回滚作为一次新的更新记录修订，不会删除之后的修订
+func (uc *TaskUsecase) RollbackTask(ctx context.Context, id, revision, version int64) (*Task, error) {
+	uc.log.WithContext(ctx).Infof("RollbackTask: %d to version %d", id, revision)
+
+	target, err := uc.GetTaskRevision(ctx, id, revision)
+	if err != nil {
+		return nil, err
+	}
+	task := *target.Snapshot
+	task.ID = id
+	task.Version = version
+	return uc.updateTask(ctx, &task, TaskUpdateFields, pb.TaskRevisionAction_REVISION_ROLLED_BACK, revision)
+}
+
+// GetTaskRevision 获取任务指定版本的修订
+func (uc *TaskUsecase) GetTaskRevision(ctx context.Context, taskID, version int64) (*TaskRevision, error) {
+	// 修订不记录命名空间，通过任务检查是否在上下文限定的命名空间中
+	task, err := uc.LookupTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := uc.revisionRepo.GetRevision(ctx, taskID, version)
+	if err != nil {
+		return nil, err
+	}
+	if revision == nil {
+		return nil, ErrTaskRevisionNotFound
+	}
+	revision.Snapshot.Namespace = task.Namespace
+	return revision, nil
+}
+
+// ListTaskRevisions 任务修订列表查询，按版本倒序
+func (uc *TaskUsecase) ListTaskRevisions(ctx context.Context, filter *TaskRevisionListFilter) ([]*TaskRevision, int64, error) {
+	task, err := uc.LookupTask(ctx, filter.TaskID)
+	if err != nil {
+		return nil, 0, err
+	}
+	revisions, total, err := uc.revisionRepo.ListRevisions(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, revision := range revisions {
+		revision.Snapshot.Namespace = task.Namespace
+	}
+	return revisions, total, nil
 }
 
-// ListTasks 任务列表查询
-func (uc *TaskUsecase) ListTasks(ctx context.Context, filter *TaskListFilter) ([]*Task, int64, error) {
-	return uc.repo.ListTasks(ctx, filter)
+// recordRevision 记录任务修订，任务已保存，记录失败只记录日志
+func (uc *TaskUsecase) recordRevision(ctx context.Context, before, after *Task, action pb.TaskRevisionAction, sourceVersion int64) {
+	revision := newTaskRevision(ctx, before, after, action)
+	revision.SourceVersion = sourceVersion
+	if _, err := uc.revisionRepo.CreateRevision(ctx, revision); err != nil {
+		uc.log.WithContext(ctx).Errorf("record revision %d of task %d: %v", after.Version, after.ID, err)
+	}
+}
+
+// hasActiveExecution 判断任务是否有排队中或执行中的执行记录
+func (uc *TaskUsecase) hasActiveExecution(ctx context.Context, taskID int64) (bool, error) {
+	for _, status := range []pb.ExecutionStatus{pb.ExecutionStatus_EXECUTING, pb.ExecutionStatus_QUEUED} {
+		executions, _, err := uc.executionRepo.ListExecutions(ctx, &ExecutionListFilter{TaskID: taskID, Status: status, PageSize: 1, SkipTotal: true})
+		if err != nil {
+			return false, err
+		}
+		if len(executions) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getTask 获取任务，任务不存在或已删除时返回 ErrTaskNotFound
+func (uc *TaskUsecase) getTask(ctx context.Context, id int64) (*Task, error) {
+	task, err := uc.repo.GetTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if task == nil {
+		return nil, ErrTaskNotFound
+	}
+	return task, nil
+}
+
+// validateTaskFields 语义校验：调度配置能按任务类型解析、处理器已注册、负载是合法的 JSON
+// fields 不为空时只校验其中涉及的字段，已保存的任务定义不因处理器下线等原因阻止其他字段的更新；
+// 更新时处理器与 current 相同但未注册（如升级前创建的任务）只记录警告，不阻止更新
+func (uc *TaskUsecase) validateTaskFields(ctx context.Context, task, current *Task, fields []string) error {
+	all := len(fields) == 0
+	if all || containsField(fields, "type") || containsField(fields, "schedule") {
+		if _, err := ParseSchedule(task.Type, task.Schedule, time.Local); err != nil {
+			return errors.BadRequest(pb.ErrorReason_INVALID_SCHEDULE.String(), err.Error())
+		}
+	}
+	if all || containsField(fields, "handler") {
+		if _, ok := uc.handlers.Get(task.Handler); !ok {
+			if current == nil || current.Handler != task.Handler {
+				return errors.BadRequest(pb.ErrorReason_INVALID_TASK.String(),
+					fmt.Sprintf("handler %q is not registered, available handlers: %s", task.Handler, strings.Join(uc.handlers.Names(), ", ")))
+			}
+			uc.log.WithContext(ctx).Warnf("task %d keeps handler %q which is not registered, its executions fail until the handler is configured", task.ID, task.Handler)
+		}
+	}
+	if all || containsField(fields, "payload") {
+		if err := validatePayload(task.Payload); err != nil {
+			return errors.BadRequest(pb.ErrorReason_INVALID_PAYLOAD.String(), err.Error())
+		}
+	}
+	return nil
+}
+
+// checkTaskQuota 检查命名空间存在且未达到最大任务数
+func (uc *TaskUsecase) checkTaskQuota(ctx context.Context, name string) error {
+	namespace, err := uc.namespaceRepo.GetNamespace(ctx, name)
+	if err != nil {
+		return err
+	}
+	if namespace == nil {
+		return ErrNamespaceNotFound
+	}
+	if namespace.MaxTasks <= 0 {
+		return nil
+	}
+	count, err := uc.repo.CountTasks(NewNamespaceContext(ctx, name), name, false)
+	if err != nil {
+		return err
+	}
+	if count >= int64(namespace.MaxTasks) {
+		return namespaceQuotaExceeded(name, "max_tasks", namespace.MaxTasks)
+	}
+	return nil
+}
+
+// LookupTask 获取任务，包括已删除的任务，任务不存在时返回 ErrTaskNotFound
+func (uc *TaskUsecase) LookupTask(ctx context.Context, id int64) (*Task, error) {
+	task, err := uc.repo.GetTask(ctx, id)
+	if err != nil || task != nil {
+		return task, err
+	}
+	task, err = uc.repo.GetDeletedTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if task == nil {
+		return nil, ErrTaskNotFound
+	}
+	return task, nil
+}
+
+// notDeletedError 区分未删除的任务和不存在的任务
+func (uc *TaskUsecase) notDeletedError(ctx context.Context, id int64) error {
+	task, err := uc.repo.GetTask(ctx, id)
+	if err != nil {
+		return err
+	}
+	if task != nil {
+		return ErrTaskNotDeleted
+	}
+	return ErrTaskNotFound
+}
+
+// ListTasks 任务列表查询，orderBy 为空时按创建时间倒序
+// page 大于 0 时按页码分页；否则按 pageToken 游标分页，还有后续记录时返回下一页的 token
+func (uc *TaskUsecase) ListTasks(ctx context.Context, filter *TaskListFilter, pageToken, orderBy string) ([]*Task, int64, string, error) {
+	order, err := parseListOrder(orderBy, taskOrderFields)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	fingerprint := filter.fingerprint()
+	after, err := pageCursorFor(filter.Page, pageToken, order, fingerprint)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	query := *filter
+	query.OrderBy = order
+	query.After = after
+	if filter.Page > 0 {
+		tasks, total, err := uc.repo.ListTasks(ctx, &query)
+		return tasks, total, "", err
+	}
+
+	// 多取一条判断是否还有下一页
+	query.PageSize++
+	tasks, total, err := uc.repo.ListTasks(ctx, &query)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	var next string
+	if len(tasks) > int(filter.PageSize) {
+		tasks = tasks[:filter.PageSize]
+		last := tasks[len(tasks)-1]
+		next = encodePageToken(order, fingerprint, TaskSortValue(last, order.Field), last.ID)
+	}
+	return tasks, total, next, nil
 }
 
-// ExecuteTask 立即执行任务
-func (uc *TaskUsecase) ExecuteTask(ctx context.Context, taskID int64, payload string) (int64, error) {
+// ExecuteTask 立即执行任务，payload 和 priority 为空时使用任务的配置
+func (uc *TaskUsecase) ExecuteTask(ctx context.Context, taskID int64, payload string, priority *int32) (int64, error) {
 	uc.log.WithContext(ctx).Infof("ExecuteTask: %d", taskID)
 
 	// 获取任务
@@ -77,38 +497,204 @@ func (uc *TaskUsecase) ExecuteTask(ctx context.Context, taskID int64, payload st
 	if err != nil {
 		return 0, err
 	}
+	if task == nil {
+		return 0, ErrTaskNotFound
+	}
 
-	// TODO: 创建执行记录并提交到执行队列
-	// 这里先简单实现，后续会添加调度逻辑
+	if payload == "" {
+		payload = task.Payload
+	} else if err := validatePayload(payload); err != nil {
+		return 0, errors.BadRequest(pb.ErrorReason_INVALID_PAYLOAD.String(), err.Error())
+	}
+	executionPriority := task.Priority
+	if priority != nil {
+		if err := validatePriority(*priority); err != nil {
+			return 0, errors.BadRequest(pb.ErrorReason_INVALID_PRIORITY.String(), err.Error())
+		}
+		executionPriority = *priority
+	}
 
-	return task.ID, nil
+	// 创建排队中的执行记录，由执行器认领执行
+	execution, err := enqueueExecution(ctx, uc.executionRepo, uc.queue, &TaskExecution{
+		Namespace:   task.Namespace,
+		TaskID:      task.ID,
+		TaskName:    task.Name,
+		Payload:     payload,
+		Priority:    executionPriority,
+		TaskVersion: task.Version,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return execution.ID, nil
 }
 
-// PauseTask 暂停任务
-func (uc *TaskUsecase) PauseTask(ctx context.Context, id int64) (*Task, error) {
+// PauseTask 暂停等待中或运行中的任务，已暂停的任务保持不变；暂停期间排队中的执行记录暂缓执行
+func (uc *TaskUsecase) PauseTask(ctx context.Context, id int64, reason string) (*Task, error) {
 	uc.log.WithContext(ctx).Infof("PauseTask: %d", id)
 
-	if err := uc.repo.UpdateTaskStatus(ctx, id, pb.TaskStatus_PAUSED); err != nil {
+	return uc.transitionTask(ctx, id, TaskEventPause, reason)
+}
+
+// ResumeTask 恢复已暂停的任务，等待中的任务保持不变
+func (uc *TaskUsecase) ResumeTask(ctx context.Context, id int64, reason string) (*Task, error) {
+	uc.log.WithContext(ctx).Infof("ResumeTask: %d", id)
+
+	return uc.transitionTask(ctx, id, TaskEventResume, reason)
+}
+
+// CancelTask 取消任务并取消其排队中的执行记录，已取消的任务不能再恢复；执行中的记录不受影响
+func (uc *TaskUsecase) CancelTask(ctx context.Context, id int64, reason string) (*Task, error) {
+	uc.log.WithContext(ctx).Infof("CancelTask: %d", id)
+
+	task, err := uc.transitionTask(ctx, id, TaskEventCancel, reason)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.cancelQueuedExecutions(ctx, "CancelTask", id); err != nil {
 		return nil, err
 	}
+	return task, nil
+}
+
+// maxTransitionAttempts 状态被并发修改时重新校验的最大次数
+const maxTransitionAttempts = 3
+
+// transitionTask 按状态机处理事件并记录原因，任务已处于目标状态时原样返回
+// 状态以条件更新写入，期间被调度器或其他请求修改时按最新状态重新校验
+func (uc *TaskUsecase) transitionTask(ctx context.Context, id int64, event TaskEvent, reason string) (*Task, error) {
+	if reason == "" {
+		reason = fmt.Sprintf("%s requested", event)
+	}
+	if author := AuthorFromContext(ctx); author != "" {
+		reason = fmt.Sprintf("%s (by %s)", reason, author)
+	}
+
+	for attempt := 0; attempt < maxTransitionAttempts; attempt++ {
+		task, err := uc.getTask(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		to, err := NextTaskStatus(task.Status, event)
+		if err != nil {
+			return nil, err
+		}
+		if to == task.Status {
+			return task, nil
+		}
 
-	return uc.repo.GetTask(ctx, id)
+		ok, err := uc.repo.TransitionTaskStatus(ctx, id, task.Status, to, reason, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return uc.getTask(ctx, id)
+		}
+	}
+	return nil, errors.Conflict(pb.ErrorReason_INVALID_STATE_TRANSITION.String(),
+		fmt.Sprintf("task status changed concurrently, cannot %s task", event))
 }
 
-// ResumeTask 恢复任务
-func (uc *TaskUsecase) ResumeTask(ctx context.Context, id int64) (*Task, error) {
-	uc.log.WithContext(ctx).Infof("ResumeTask: %d", id)
+// PreviewSchedule 预览调度配置的后续触发时间
+// 与分发器一样按服务端时区计算，请求中的时区只用于展示触发时间
+func (uc *TaskUsecase) PreviewSchedule(ctx context.Context, req *SchedulePreviewRequest) (*SchedulePreview, error) {
+	count := req.Count
+	if count <= 0 {
+		count = defaultPreviewCount
+	}
+	if count > maxPreviewCount {
+		count = maxPreviewCount
+	}
+
+	preview := &SchedulePreview{Timezone: req.Timezone}
+
+	loc := time.Local
+	if req.Timezone != "" {
+		l, err := time.LoadLocation(req.Timezone)
+		if err != nil {
+			preview.Errors = append(preview.Errors, fmt.Sprintf("invalid timezone %q: %v", req.Timezone, err))
+		} else {
+			loc = l
+		}
+	}
+	preview.Timezone = loc.String()
 
-	if err := uc.repo.UpdateTaskStatus(ctx, id, pb.TaskStatus_PENDING); err != nil {
+	calendar, err := getCalendar(ctx, uc.calendarRepo, req.CalendarID)
+	if err != nil {
 		return nil, err
 	}
 
-	return uc.repo.GetTask(ctx, id)
+	schedule, err := ParseSchedule(req.Type, req.Schedule, time.Local)
+	if err != nil {
+		preview.Errors = append(preview.Errors, err.Error())
+	}
+	if len(preview.Errors) > 0 {
+		return preview, nil
+	}
+	schedule = WithCalendar(schedule, calendar)
+
+	preview.Valid = true
+	preview.Description = DescribeSchedule(req.Type, req.Schedule)
+	if calendar != nil {
+		preview.Description += fmt.Sprintf(", skipping days excluded by calendar %q", calendar.Name)
+	}
+	for _, t := range NextRunTimes(schedule, time.Now(), int(count)) {
+		preview.NextRunTimes = append(preview.NextRunTimes, t.In(loc))
+	}
+
+	return preview, nil
+}
+
+// getCalendar 获取任务引用的日历，id 为 0 时返回 nil
+func getCalendar(ctx context.Context, repo CalendarRepo, id int64) (*Calendar, error) {
+	if id <= 0 {
+		return nil, nil
+	}
+	calendar, err := repo.GetCalendar(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if calendar == nil {
+		return nil, ErrCalendarNotFound
+	}
+	return calendar, nil
 }
 
-// calculateNextRunTime 计算下次执行时间
-func calculateNextRunTime(taskType pb.TaskType, schedule string) time.Time {
-	// TODO: 实现真实的计算逻辑
-	// 这里先简单返回当前时间
-	return time.Now()
+// taskSchedule 构建任务的完整调度计划：调度配置、业务日历和执行约束
+// 固定频率的间隔任务对齐到 intervalAnchor，固定延迟的间隔任务以传入时间为基准
+func taskSchedule(task *Task, calendar *Calendar) (Schedule, error) {
+	var anchor time.Time
+	if !task.isFixedDelay() {
+		anchor = intervalAnchor(task)
+	}
+	return buildSchedule(task, calendar, anchor)
+}
+
+// buildSchedule 以 anchor 作为间隔任务的对齐基准构建调度计划
+func buildSchedule(task *Task, calendar *Calendar, anchor time.Time) (Schedule, error) {
+	schedule, err := ParseSchedule(task.Type, task.Schedule, time.Local)
+	if err != nil {
+		return nil, err
+	}
+	schedule = withIntervalAnchor(schedule, anchor)
+	return WithConstraints(WithCalendar(schedule, calendar), task, time.Local)
+}
+
+// firstRunTime 计算任务创建后的首次执行时间，间隔任务从 intervalAnchor 开始
+func firstRunTime(task *Task, calendar *Calendar, now time.Time) (time.Time, error) {
+	schedule, err := buildSchedule(task, calendar, intervalAnchor(task))
+	if err != nil {
+		return time.Time{}, err
+	}
+	return schedule.Next(now), nil
+}
+
+// calculateNextRunTime 计算 after 之后的下次执行时间，没有后续触发时返回零值
+func calculateNextRunTime(task *Task, calendar *Calendar, after time.Time) (time.Time, error) {
+	schedule, err := taskSchedule(task, calendar)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return schedule.Next(after), nil
 }
@@ -0,0 +1,40 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+)
+
+func TestPreviewScheduleUsesSchedulerTimezone(t *testing.T) {
+	uc, _, _ := newUpdateUsecase(nil)
+	preview, err := uc.PreviewSchedule(context.Background(), &SchedulePreviewRequest{
+		Type:     pb.TaskType_CRON,
+		Schedule: "0 9 * * *",
+		Timezone: "Asia/Tokyo",
+		Count:    3,
+	})
+	if err != nil {
+		t.Fatalf("PreviewSchedule: %v", err)
+	}
+	if !preview.Valid || preview.Timezone != "Asia/Tokyo" {
+		t.Fatalf("preview = %+v", preview)
+	}
+
+	// 触发时间与分发器一样按服务端时区计算，只按请求的时区展示
+	for _, at := range preview.NextRunTimes {
+		if at.Location().String() != "Asia/Tokyo" {
+			t.Errorf("%v is not shown in Asia/Tokyo", at)
+		}
+		if local := at.In(time.Local); local.Hour() != 9 || local.Minute() != 0 {
+			t.Errorf("%v does not fire at 09:00 in the scheduler time zone", at)
+		}
+	}
+
+	preview, err = uc.PreviewSchedule(context.Background(), &SchedulePreviewRequest{Type: pb.TaskType_CRON, Schedule: "0 9 * * *", Timezone: "Mars/Base"})
+	if err != nil || preview.Valid || len(preview.Errors) != 1 {
+		t.Errorf("invalid timezone preview = %+v, %v", preview, err)
+	}
+}
@@ -0,0 +1,337 @@
+package biz_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+	"heytom-scheduler/internal/data/memory"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// testUsecase 基于内存仓储的任务用例
+type testUsecase struct {
+	*biz.TaskUsecase
+	tasks      biz.TaskRepo
+	executions biz.ExecutionRepo
+	revisions  biz.TaskRevisionRepo
+	namespaces biz.NamespaceRepo
+	queue      *flakyQueue
+}
+
+func newTestUsecase() *testUsecase {
+	handlers := biz.NewHandlerRegistry()
+	handlers.Register("http", biz.HandlerFunc(func(context.Context, *biz.TaskExecution) (string, error) { return "", nil }))
+	uc := &testUsecase{
+		tasks:      memory.NewTaskRepo(),
+		executions: memory.NewExecutionRepo(),
+		revisions:  memory.NewTaskRevisionRepo(),
+		namespaces: memory.NewNamespaceRepo(),
+		queue:      &flakyQueue{},
+	}
+	uc.TaskUsecase = biz.NewTaskUsecase(uc.tasks, uc.executions, uc.revisions, nil, uc.namespaces, uc.queue, handlers, log.DefaultLogger)
+	return uc
+}
+
+// createTask 通过用例创建每 5 分钟执行一次的任务
+func (uc *testUsecase) createTask(t *testing.T, task *biz.Task) *biz.Task {
+	t.Helper()
+	if task.Type == pb.TaskType_TASK_TYPE_UNSPECIFIED {
+		task.Type, task.Schedule = pb.TaskType_INTERVAL, "5m"
+	}
+	if task.Handler == "" {
+		task.Handler = "http"
+	}
+	created, err := uc.CreateTask(context.Background(), task)
+	if err != nil {
+		t.Fatalf("CreateTask %q: %v", task.Name, err)
+	}
+	return created
+}
+
+// reasonOf 返回错误的 kratos 错误原因
+func reasonOf(err error) string {
+	if err == nil {
+		return ""
+	}
+	return errors.Reason(err)
+}
+
+func TestExecuteTaskPriority(t *testing.T) {
+	ctx := context.Background()
+	uc := newTestUsecase()
+	task := uc.createTask(t, &biz.Task{Name: "report", Priority: 3})
+
+	override := func(p int32) *int32 { return &p }
+	tests := []struct {
+		name     string
+		priority *int32
+		want     int32
+		reason   string
+	}{
+		{"task priority", nil, 3, ""},
+		{"override", override(9), 9, ""},
+		{"override lowest", override(0), 0, ""},
+		{"out of range", override(10), 0, pb.ErrorReason_INVALID_PRIORITY.String()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := uc.ExecuteTask(ctx, task.ID, "", tt.priority)
+			if got := reasonOf(err); got != tt.reason {
+				t.Fatalf("ExecuteTask error = %v, want reason %q", err, tt.reason)
+			}
+			if err != nil {
+				return
+			}
+			execution, err := uc.executions.GetExecution(ctx, id)
+			if err != nil {
+				t.Fatalf("GetExecution: %v", err)
+			}
+			if execution.Priority != tt.want || execution.Status != pb.ExecutionStatus_QUEUED {
+				t.Errorf("execution priority = %d, status = %s; want %d, QUEUED", execution.Priority, execution.Status, tt.want)
+			}
+		})
+	}
+	if len(uc.queue.pushed) != 3 {
+		t.Errorf("pushed %d executions, want 3", len(uc.queue.pushed))
+	}
+}
+
+func TestDeleteRestorePurgeTask(t *testing.T) {
+	ctx := context.Background()
+	uc := newTestUsecase()
+	task := uc.createTask(t, &biz.Task{Name: "report"})
+	other := uc.createTask(t, &biz.Task{Name: "cleanup"})
+
+	queued, err := uc.ExecuteTask(ctx, task.ID, "", nil)
+	if err != nil {
+		t.Fatalf("ExecuteTask: %v", err)
+	}
+	running, err := uc.executions.CreateExecution(ctx, &biz.TaskExecution{TaskID: task.ID, Status: pb.ExecutionStatus_EXECUTING})
+	if err != nil {
+		t.Fatalf("CreateExecution: %v", err)
+	}
+
+	// 删除任务取消排队中的执行记录并移出队列，执行中的记录不受影响
+	if err := uc.DeleteTask(ctx, task.ID); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+	if execution, _ := uc.executions.GetExecution(ctx, queued); execution.Status != pb.ExecutionStatus_EXECUTION_CANCELLED {
+		t.Errorf("queued execution status = %s, want EXECUTION_CANCELLED", execution.Status)
+	}
+	if execution, _ := uc.executions.GetExecution(ctx, running.ID); execution.Status != pb.ExecutionStatus_EXECUTING {
+		t.Errorf("running execution status = %s, want EXECUTING", execution.Status)
+	}
+	if len(uc.queue.acked) != 1 || uc.queue.acked[0] != queued {
+		t.Errorf("acked %v, want [%d]", uc.queue.acked, queued)
+	}
+
+	// 已删除的任务不能查看、执行或再次删除，只在 include_deleted 时列出
+	tests := []struct {
+		name   string
+		call   func() error
+		reason pb.ErrorReason
+	}{
+		{"get", func() error { _, err := uc.GetTask(ctx, task.ID); return err }, pb.ErrorReason_TASK_NOT_FOUND},
+		{"execute", func() error { _, err := uc.ExecuteTask(ctx, task.ID, "", nil); return err }, pb.ErrorReason_TASK_NOT_FOUND},
+		{"delete again", func() error { return uc.DeleteTask(ctx, task.ID) }, pb.ErrorReason_TASK_NOT_FOUND},
+		{"restore active", func() error { _, err := uc.RestoreTask(ctx, other.ID); return err }, pb.ErrorReason_TASK_NOT_DELETED},
+		{"purge active", func() error { return uc.PurgeTask(ctx, other.ID) }, pb.ErrorReason_TASK_NOT_DELETED},
+		{"purge missing", func() error { return uc.PurgeTask(ctx, 404) }, pb.ErrorReason_TASK_NOT_FOUND},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); reasonOf(err) != tt.reason.String() {
+				t.Errorf("error = %v, want reason %s", err, tt.reason)
+			}
+		})
+	}
+	if _, total, _, err := uc.ListTasks(ctx, &biz.TaskListFilter{PageSize: 10}, "", ""); err != nil || total != 1 {
+		t.Errorf("ListTasks total = %d, %v; want 1", total, err)
+	}
+	if _, total, _, err := uc.ListTasks(ctx, &biz.TaskListFilter{PageSize: 10, IncludeDeleted: true}, "", ""); err != nil || total != 2 {
+		t.Errorf("ListTasks with deleted total = %d, %v; want 2", total, err)
+	}
+
+	// 恢复后任务按删除前的定义继续调度
+	restored, err := uc.RestoreTask(ctx, task.ID)
+	if err != nil {
+		t.Fatalf("RestoreTask: %v", err)
+	}
+	if restored.Status != task.Status || restored.NextRunTime == nil || !restored.NextRunTime.Equal(*task.NextRunTime) {
+		t.Errorf("restored task = %s at %v, want %s at %v", restored.Status, restored.NextRunTime, task.Status, task.NextRunTime)
+	}
+	if _, err := uc.RestoreTask(ctx, task.ID); reasonOf(err) != pb.ErrorReason_TASK_NOT_DELETED.String() {
+		t.Errorf("RestoreTask of a restored task error = %v, want TASK_NOT_DELETED", err)
+	}
+
+	// 彻底删除任务及其执行记录和修订，之后不能恢复
+	if err := uc.DeleteTask(ctx, task.ID); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+	if err := uc.PurgeTask(ctx, task.ID); err != nil {
+		t.Fatalf("PurgeTask: %v", err)
+	}
+	if _, total, err := uc.executions.ListExecutions(ctx, &biz.ExecutionListFilter{TaskID: task.ID}); err != nil || total != 0 {
+		t.Errorf("executions after purge = %d, %v; want 0", total, err)
+	}
+	if _, total, err := uc.revisions.ListRevisions(ctx, &biz.TaskRevisionListFilter{TaskID: task.ID}); err != nil || total != 0 {
+		t.Errorf("revisions after purge = %d, %v; want 0", total, err)
+	}
+	if _, err := uc.RestoreTask(ctx, task.ID); reasonOf(err) != pb.ErrorReason_TASK_NOT_FOUND.String() {
+		t.Errorf("RestoreTask of a purged task error = %v, want TASK_NOT_FOUND", err)
+	}
+	if _, total, err := uc.revisions.ListRevisions(ctx, &biz.TaskRevisionListFilter{TaskID: other.ID}); err != nil || total != 1 {
+		t.Errorf("revisions of another task = %d, %v; want 1", total, err)
+	}
+}
+
+func TestTaskRevisionsAndRollback(t *testing.T) {
+	ctx := context.Background()
+	uc := newTestUsecase()
+	task, err := uc.CreateTask(biz.NewAuthorContext(ctx, "alice"), &biz.Task{Name: "report", Type: pb.TaskType_INTERVAL, Schedule: "5m", Handler: "http", Payload: `{"day":1}`})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+
+	updated, err := uc.UpdateTask(biz.NewAuthorContext(ctx, "bob"), &biz.Task{ID: task.ID, Version: task.Version, Schedule: "10m", Payload: `{"day":2}`}, []string{"schedule", "payload"})
+	if err != nil {
+		t.Fatalf("UpdateTask: %v", err)
+	}
+
+	// 执行记录关联执行时的任务版本
+	executionID, err := uc.ExecuteTask(ctx, task.ID, "", nil)
+	if err != nil {
+		t.Fatalf("ExecuteTask: %v", err)
+	}
+	if execution, _ := uc.executions.GetExecution(ctx, executionID); execution.TaskVersion != updated.Version {
+		t.Errorf("execution task version = %d, want %d", execution.TaskVersion, updated.Version)
+	}
+
+	// 回滚要求当前版本，目标修订必须存在
+	if _, err := uc.RollbackTask(ctx, task.ID, task.Version, task.Version); reasonOf(err) != pb.ErrorReason_TASK_VERSION_MISMATCH.String() {
+		t.Errorf("RollbackTask with a stale version error = %v, want TASK_VERSION_MISMATCH", err)
+	}
+	if _, err := uc.RollbackTask(ctx, task.ID, 99, updated.Version); reasonOf(err) != pb.ErrorReason_TASK_REVISION_NOT_FOUND.String() {
+		t.Errorf("RollbackTask to a missing revision error = %v, want TASK_REVISION_NOT_FOUND", err)
+	}
+
+	rolledBack, err := uc.RollbackTask(biz.NewAuthorContext(ctx, "carol"), task.ID, task.Version, updated.Version)
+	if err != nil {
+		t.Fatalf("RollbackTask: %v", err)
+	}
+	if rolledBack.Schedule != "5m" || rolledBack.Payload != `{"day":1}` || rolledBack.Version != updated.Version+1 {
+		t.Errorf("rolled back task = %q %s version %d, want 5m {\"day\":1} version %d", rolledBack.Schedule, rolledBack.Payload, rolledBack.Version, updated.Version+1)
+	}
+
+	// 回滚记录为一次新的修订，之前的修订保留
+	revisions, total, err := uc.ListTaskRevisions(ctx, &biz.TaskRevisionListFilter{TaskID: task.ID, PageSize: 10})
+	if err != nil || total != 3 {
+		t.Fatalf("ListTaskRevisions = %d, %v; want 3", total, err)
+	}
+	tests := []struct {
+		version int64
+		action  pb.TaskRevisionAction
+		author  string
+		source  int64
+		changes []string
+	}{
+		{rolledBack.Version, pb.TaskRevisionAction_REVISION_ROLLED_BACK, "carol", task.Version, []string{"schedule", "payload"}},
+		{updated.Version, pb.TaskRevisionAction_REVISION_UPDATED, "bob", 0, []string{"schedule", "payload"}},
+		{task.Version, pb.TaskRevisionAction_REVISION_CREATED, "alice", 0, nil},
+	}
+	for i, tt := range tests {
+		revision := revisions[i]
+		if revision.Version != tt.version || revision.Action != tt.action || revision.Author != tt.author || revision.SourceVersion != tt.source {
+			t.Errorf("revision %d = version %d %s by %q from %d, want version %d %s by %q from %d", i,
+				revision.Version, revision.Action, revision.Author, revision.SourceVersion, tt.version, tt.action, tt.author, tt.source)
+		}
+		if tt.changes == nil {
+			continue
+		}
+		var fields []string
+		for _, change := range revision.Changes {
+			fields = append(fields, change.Field)
+		}
+		if !slices.Equal(fields, tt.changes) {
+			t.Errorf("revision %d changed %v, want %v", revision.Version, fields, tt.changes)
+		}
+	}
+
+	revision, err := uc.GetTaskRevision(ctx, task.ID, updated.Version)
+	if err != nil {
+		t.Fatalf("GetTaskRevision: %v", err)
+	}
+	if revision.Snapshot.Schedule != "10m" || revision.Snapshot.Namespace != biz.DefaultNamespace {
+		t.Errorf("snapshot schedule = %q, namespace = %q; want 10m, %s", revision.Snapshot.Schedule, revision.Snapshot.Namespace, biz.DefaultNamespace)
+	}
+	if _, err := uc.GetTaskRevision(ctx, 404, 1); reasonOf(err) != pb.ErrorReason_TASK_NOT_FOUND.String() {
+		t.Errorf("GetTaskRevision of a missing task error = %v, want TASK_NOT_FOUND", err)
+	}
+}
+
+func TestNamespaceTaskQuotaAndIsolation(t *testing.T) {
+	ctx := context.Background()
+	uc := newTestUsecase()
+	if _, err := uc.namespaces.CreateNamespace(ctx, &biz.Namespace{Name: "team-a", MaxTasks: 1}); err != nil {
+		t.Fatalf("CreateNamespace: %v", err)
+	}
+	teamA := biz.NewNamespaceContext(ctx, "team-a")
+	newTask := func(name, namespace string) *biz.Task {
+		return &biz.Task{Name: name, Namespace: namespace, Type: pb.TaskType_INTERVAL, Schedule: "5m", Handler: "http"}
+	}
+
+	report, err := uc.CreateTask(teamA, newTask("report", ""))
+	if err != nil {
+		t.Fatalf("CreateTask in team-a: %v", err)
+	}
+	if report.Namespace != "team-a" {
+		t.Errorf("task namespace = %q, want the context namespace team-a", report.Namespace)
+	}
+	shared := uc.createTask(t, &biz.Task{Name: "report"})
+	if shared.Namespace != biz.DefaultNamespace {
+		t.Errorf("task namespace = %q, want %s", shared.Namespace, biz.DefaultNamespace)
+	}
+
+	tests := []struct {
+		name   string
+		call   func() error
+		reason pb.ErrorReason
+	}{
+		{"max tasks", func() error { _, err := uc.CreateTask(teamA, newTask("sync", "")); return err }, pb.ErrorReason_NAMESPACE_QUOTA_EXCEEDED},
+		{"other namespace in a scoped request", func() error { _, err := uc.CreateTask(teamA, newTask("sync", "team-b")); return err }, pb.ErrorReason_NAMESPACE_MISMATCH},
+		{"unknown namespace", func() error { _, err := uc.CreateTask(ctx, newTask("sync", "team-b")); return err }, pb.ErrorReason_NAMESPACE_NOT_FOUND},
+		{"get another namespace", func() error { _, err := uc.GetTask(teamA, shared.ID); return err }, pb.ErrorReason_TASK_NOT_FOUND},
+		{"execute another namespace", func() error { _, err := uc.ExecuteTask(teamA, shared.ID, "", nil); return err }, pb.ErrorReason_TASK_NOT_FOUND},
+		{"delete another namespace", func() error { return uc.DeleteTask(teamA, shared.ID) }, pb.ErrorReason_TASK_NOT_FOUND},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); reasonOf(err) != tt.reason.String() {
+				t.Errorf("error = %v, want reason %s", err, tt.reason)
+			}
+		})
+	}
+
+	if tasks, total, _, err := uc.ListTasks(teamA, &biz.TaskListFilter{PageSize: 10}, "", ""); err != nil || total != 1 || tasks[0].ID != report.ID {
+		t.Errorf("ListTasks in team-a = %d, %v; want only task %d", total, err, report.ID)
+	}
+	if _, total, _, err := uc.ListTasks(ctx, &biz.TaskListFilter{PageSize: 10}, "", ""); err != nil || total != 2 {
+		t.Errorf("ListTasks across namespaces = %d, %v; want 2", total, err)
+	}
+
+	// 已删除的任务不占用配额，恢复时重新检查配额
+	if err := uc.DeleteTask(teamA, report.ID); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+	if _, err := uc.CreateTask(teamA, newTask("sync", "")); err != nil {
+		t.Fatalf("CreateTask after delete: %v", err)
+	}
+	if _, err := uc.RestoreTask(teamA, report.ID); reasonOf(err) != pb.ErrorReason_NAMESPACE_QUOTA_EXCEEDED.String() {
+		t.Errorf("RestoreTask over the quota error = %v, want NAMESPACE_QUOTA_EXCEEDED", err)
+	}
+}
@@ -0,0 +1,136 @@
+package data
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// readArchive 读取 dir 下所有归档文件中的执行记录ID
+func readArchive(t *testing.T, dir string) []int64 {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join(dir, archiveFilePrefix+"*.jsonl.gz"))
+	if err != nil {
+		t.Fatalf("glob archive files: %v", err)
+	}
+	var ids []int64
+	for _, name := range files {
+		f, err := os.Open(name)
+		if err != nil {
+			t.Fatalf("open archive: %v", err)
+		}
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			t.Fatalf("read archive: %v", err)
+		}
+		scanner := bufio.NewScanner(zr)
+		for scanner.Scan() {
+			var row archivedExecution
+			if err := json.Unmarshal(scanner.Bytes(), &row); err != nil {
+				t.Fatalf("decode archived execution %q: %v", scanner.Text(), err)
+			}
+			ids = append(ids, row.ID)
+		}
+		if err := scanner.Err(); err != nil {
+			t.Fatalf("scan archive: %v", err)
+		}
+		zr.Close()
+		f.Close()
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+func TestRetentionJanitor(t *testing.T) {
+	ctx := context.Background()
+	logger := log.DefaultLogger
+	data := newTestData(t)
+	tasks, executions, locks := NewTaskRepo(data, logger), NewExecutionRepo(data, logger), NewLockRepo(data, logger)
+	dir := t.TempDir()
+	archiver, err := NewFileArchiver(dir, logger)
+	if err != nil {
+		t.Fatalf("NewFileArchiver: %v", err)
+	}
+	janitor := biz.NewRetentionJanitor(tasks, executions, locks, archiver, logger)
+
+	defaults := biz.RetentionPolicy{KeepDays: 1, FailedKeepDays: 7}
+	inherited, err := tasks.CreateTask(ctx, &biz.Task{Name: "report", Handler: "http"})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	keepLast, err := tasks.CreateTask(ctx, &biz.Task{Name: "sync", Handler: "http", Retention: biz.RetentionPolicy{KeepLast: 1}})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+
+	create := func(taskID int64, status pb.ExecutionStatus) int64 {
+		t.Helper()
+		execution, err := executions.CreateExecution(ctx, &biz.TaskExecution{TaskID: taskID, TaskName: "t", Status: status})
+		if err != nil {
+			t.Fatalf("CreateExecution: %v", err)
+		}
+		return execution.ID
+	}
+	var want []int64
+	// 继承全局策略：成功记录超过 1 天清理，失败记录保留 7 天，未结束的记录不清理
+	want = append(want, create(inherited.ID, pb.ExecutionStatus_SUCCESS), create(inherited.ID, pb.ExecutionStatus_EXECUTION_SKIPPED))
+	failed := create(inherited.ID, pb.ExecutionStatus_EXECUTION_FAILED)
+	running := create(inherited.ID, pb.ExecutionStatus_EXECUTING)
+	// 任务策略保留最近 1 条
+	want = append(want, create(keepLast.ID, pb.ExecutionStatus_SUCCESS), create(keepLast.ID, pb.ExecutionStatus_SUCCESS))
+	latest := create(keepLast.ID, pb.ExecutionStatus_SUCCESS)
+	// 已彻底删除的任务按全局策略清理
+	want = append(want, create(404, pb.ExecutionStatus_SUCCESS))
+
+	now := time.Now().AddDate(0, 0, 2)
+
+	// 其他节点持有清理锁时不清理
+	if ok, err := locks.AcquireLock(ctx, "__retention_janitor", "node-b", time.Minute); err != nil || !ok {
+		t.Fatalf("AcquireLock = %v, %v", ok, err)
+	}
+	if n, err := janitor.Prune(ctx, "node-a", defaults, now, 2); err != nil || n != 0 {
+		t.Fatalf("Prune while locked = %d, %v; want 0, nil", n, err)
+	}
+	if err := locks.ReleaseLock(ctx, "__retention_janitor", "node-b"); err != nil {
+		t.Fatalf("ReleaseLock: %v", err)
+	}
+
+	// 每批 2 条，需要多批才能清理完
+	n, err := janitor.Prune(ctx, "node-a", defaults, now, 2)
+	if err != nil || n != int64(len(want)) {
+		t.Fatalf("Prune = %d, %v; want %d, nil", n, err, len(want))
+	}
+	for _, id := range want {
+		if execution, err := executions.GetExecution(ctx, id); err != nil || execution != nil {
+			t.Errorf("execution %d = %v, %v; want pruned", id, execution, err)
+		}
+	}
+	for _, id := range []int64{failed, running, latest} {
+		if execution, err := executions.GetExecution(ctx, id); err != nil || execution == nil {
+			t.Errorf("execution %d = %v, %v; want kept", id, execution, err)
+		}
+	}
+	if got := readArchive(t, dir); !slices.Equal(got, want) {
+		t.Errorf("archived %v, want %v", got, want)
+	}
+
+	// 清理锁已释放，再次清理没有可清理的记录
+	if n, err := janitor.Prune(ctx, "node-b", defaults, now, 2); err != nil || n != 0 {
+		t.Errorf("second Prune = %d, %v; want 0, nil", n, err)
+	}
+	// 失败记录超过保留天数后清理
+	if n, err := janitor.Prune(ctx, "node-a", defaults, now.AddDate(0, 0, 7), 2); err != nil || n != 1 {
+		t.Errorf("Prune after the failure retention = %d, %v; want 1, nil", n, err)
+	}
+}
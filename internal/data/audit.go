@@ -0,0 +1,234 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+	"heytom-scheduler/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 审计事件写入位置
+const (
+	AuditSinkDatabase = "database"
+	AuditSinkFile     = "file"
+)
+
+type auditRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAuditRepo 创建审计事件仓储实例
+func NewAuditRepo(data *Data, logger log.Logger) biz.AuditRepo {
+	return &auditRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// WriteAuditEvent 写入审计事件
+func (r *auditRepo) WriteAuditEvent(ctx context.Context, event *biz.AuditEvent) error {
+	return r.data.db.WithContext(ctx).Create(&AuditEvent{
+		CreatedAt: event.Time,
+		Actor:     event.Actor,
+		Operation: event.Operation,
+		TargetID:  event.TargetID,
+		Targets:   strings.Join(event.Targets, ","),
+		Request:   event.Request,
+		Result:    event.Result.String(),
+		Code:      event.Code,
+		Reason:    event.Reason,
+		Message:   event.Message,
+		ClientIP:  event.ClientIP,
+		Transport: event.Transport,
+		Duration:  event.Duration,
+	}).Error
+}
+
+// ListAuditEvents 审计事件列表查询，按时间倒序
+func (r *auditRepo) ListAuditEvents(ctx context.Context, filter *biz.AuditEventListFilter) ([]*biz.AuditEvent, int64, error) {
+	query := r.data.db.WithContext(ctx).Model(&AuditEvent{})
+	if filter.Actor != "" {
+		query = query.Where("actor = ?", filter.Actor)
+	}
+	if filter.Operation != "" {
+		query = query.Where("operation = ?", filter.Operation)
+	}
+	if filter.TargetID > 0 {
+		query = query.Where("target_id = ?", filter.TargetID)
+	}
+	if filter.Result != pb.AuditResult_AUDIT_RESULT_UNSPECIFIED {
+		query = query.Where("result = ?", filter.Result.String())
+	}
+	if filter.StartTime != nil {
+		query = query.Where("created_at >= ?", *filter.StartTime)
+	}
+	if filter.EndTime != nil {
+		query = query.Where("created_at < ?", *filter.EndTime)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var events []AuditEvent
+	offset := pageOffset(filter.Page, filter.PageSize)
+	if err := query.Order("created_at DESC, id DESC").Offset(offset).Limit(int(filter.PageSize)).Find(&events).Error; err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]*biz.AuditEvent, 0, len(events))
+	for i := range events {
+		result = append(result, toBusinessAuditEvent(&events[i]))
+	}
+	return result, total, nil
+}
+
+// toBusinessAuditEvent 转换为业务模型
+func toBusinessAuditEvent(event *AuditEvent) *biz.AuditEvent {
+	var targets []string
+	if event.Targets != "" {
+		targets = strings.Split(event.Targets, ",")
+	}
+	return &biz.AuditEvent{
+		ID:        event.ID,
+		Time:      event.CreatedAt,
+		Actor:     event.Actor,
+		Operation: event.Operation,
+		TargetID:  event.TargetID,
+		Targets:   targets,
+		Request:   event.Request,
+		Result:    pb.AuditResult(pb.AuditResult_value[event.Result]),
+		Code:      event.Code,
+		Reason:    event.Reason,
+		Message:   event.Message,
+		ClientIP:  event.ClientIP,
+		Transport: event.Transport,
+		Duration:  event.Duration,
+	}
+}
+
+// fileAuditEvent 审计日志文件中的一行
+type fileAuditEvent struct {
+	Time      time.Time `json:"time"`
+	Actor     string    `json:"actor"`
+	Operation string    `json:"operation"`
+	TargetID  int64     `json:"target_id,omitempty"`
+	Targets   []string  `json:"targets,omitempty"`
+	Request   string    `json:"request,omitempty"`
+	Result    string    `json:"result"`
+	Code      int32     `json:"code,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	Message   string    `json:"message,omitempty"`
+	ClientIP  string    `json:"client_ip,omitempty"`
+	Transport string    `json:"transport"`
+	Duration  int32     `json:"duration"`
+}
+
+type fileAuditSink struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+// NewFileAuditSink 创建 JSON Lines 文件审计写入位置，每个事件追加写入一行
+func NewFileAuditSink(path string) (biz.AuditSink, func(), error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, nil, fmt.Errorf("create audit log dir: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open audit log: %w", err)
+	}
+	sink := &fileAuditSink{f: f}
+	return sink, func() { sink.close() }, nil
+}
+
+// WriteAuditEvent 追加写入一行审计事件
+func (s *fileAuditSink) WriteAuditEvent(ctx context.Context, event *biz.AuditEvent) error {
+	var line bytes.Buffer
+	enc := json.NewEncoder(&line)
+	enc.SetEscapeHTML(false)
+	err := enc.Encode(&fileAuditEvent{
+		Time:      event.Time,
+		Actor:     event.Actor,
+		Operation: event.Operation,
+		TargetID:  event.TargetID,
+		Targets:   event.Targets,
+		Request:   event.Request,
+		Result:    event.Result.String(),
+		Code:      event.Code,
+		Reason:    event.Reason,
+		Message:   event.Message,
+		ClientIP:  event.ClientIP,
+		Transport: event.Transport,
+		Duration:  event.Duration,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.f.Write(line.Bytes())
+	return err
+}
+
+func (s *fileAuditSink) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.f.Close()
+}
+
+// NewAuditSinks 按配置创建审计事件写入位置，未配置时写入数据库，关闭审计日志时返回空列表
+func NewAuditSinks(c *conf.Server, repo biz.AuditRepo, logger log.Logger) ([]biz.AuditSink, func(), error) {
+	cfg := c.GetAudit()
+	if cfg.GetDisabled() {
+		return nil, func() {}, nil
+	}
+	names := cfg.GetSinks()
+	if len(names) == 0 {
+		names = []string{AuditSinkDatabase}
+	}
+
+	var sinks []biz.AuditSink
+	var closers []func()
+	cleanup := func() {
+		for _, closeSink := range closers {
+			closeSink()
+		}
+	}
+	for _, name := range names {
+		switch name {
+		case AuditSinkDatabase:
+			sinks = append(sinks, repo)
+		case AuditSinkFile:
+			if cfg.GetFile() == "" {
+				cleanup()
+				return nil, nil, fmt.Errorf("audit sink %q requires server.audit.file", name)
+			}
+			sink, closeSink, err := NewFileAuditSink(cfg.GetFile())
+			if err != nil {
+				cleanup()
+				return nil, nil, err
+			}
+			sinks = append(sinks, sink)
+			closers = append(closers, closeSink)
+		default:
+			cleanup()
+			return nil, nil, fmt.Errorf("unknown audit sink %q", name)
+		}
+	}
+	return sinks, cleanup, nil
+}
@@ -0,0 +1,176 @@
+package data
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+	"heytom-scheduler/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestNewAuditSinks(t *testing.T) {
+	repo := NewAuditRepo(newTestData(t), log.DefaultLogger)
+	file := filepath.Join(t.TempDir(), "audit", "audit.jsonl")
+
+	tests := []struct {
+		name    string
+		audit   *conf.Server_Audit
+		want    int
+		wantErr bool
+	}{
+		{"database by default", nil, 1, false},
+		{"disabled", &conf.Server_Audit{Disabled: true, Sinks: []string{AuditSinkDatabase}}, 0, false},
+		{"database and file", &conf.Server_Audit{Sinks: []string{AuditSinkDatabase, AuditSinkFile}, File: file}, 2, false},
+		{"file without a path", &conf.Server_Audit{Sinks: []string{AuditSinkFile}}, 0, true},
+		{"unknown sink", &conf.Server_Audit{Sinks: []string{AuditSinkDatabase, "syslog"}}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sinks, cleanup, err := NewAuditSinks(&conf.Server{Audit: tt.audit}, repo, log.DefaultLogger)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewAuditSinks error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			defer cleanup()
+			if len(sinks) != tt.want {
+				t.Fatalf("got %d sinks, want %d", len(sinks), tt.want)
+			}
+			if tt.want > 0 && sinks[0] != repo {
+				t.Errorf("first sink is %T, want the audit repo", sinks[0])
+			}
+		})
+	}
+}
+
+func TestFileAuditSink(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	sink, cleanup, err := NewFileAuditSink(path)
+	if err != nil {
+		t.Fatalf("NewFileAuditSink: %v", err)
+	}
+
+	at := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	events := []*biz.AuditEvent{
+		{Time: at, Actor: "alice", Operation: "DeleteTask", TargetID: 7, Targets: []string{"id=7"}, Request: `{"note":"a<b"}`, Result: pb.AuditResult_AUDIT_SUCCESS, ClientIP: "10.0.0.1", Transport: "http", Duration: 12},
+		{Time: at, Actor: "bob", Operation: "ExecuteTask", Result: pb.AuditResult_AUDIT_FAILURE, Code: 404, Reason: "TASK_NOT_FOUND", Transport: "grpc"},
+	}
+	for _, event := range events {
+		if err := sink.WriteAuditEvent(ctx, event); err != nil {
+			t.Fatalf("WriteAuditEvent: %v", err)
+		}
+	}
+	cleanup()
+
+	// 重新打开时追加写入
+	sink, cleanup, err = NewFileAuditSink(path)
+	if err != nil {
+		t.Fatalf("reopen NewFileAuditSink: %v", err)
+	}
+	if err := sink.WriteAuditEvent(ctx, &biz.AuditEvent{Time: at, Actor: "carol", Operation: "PauseTask", Result: pb.AuditResult_AUDIT_SUCCESS}); err != nil {
+		t.Fatalf("WriteAuditEvent: %v", err)
+	}
+	cleanup()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open audit log: %v", err)
+	}
+	defer f.Close()
+	var lines []fileAuditEvent
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var line fileAuditEvent
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("decode %q: %v", scanner.Text(), err)
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want 3", len(lines))
+	}
+	first := lines[0]
+	if first.Actor != "alice" || first.Operation != "DeleteTask" || first.TargetID != 7 || first.Request != `{"note":"a<b"}` ||
+		first.Result != "AUDIT_SUCCESS" || first.ClientIP != "10.0.0.1" || !first.Time.Equal(at) {
+		t.Errorf("first line = %+v", first)
+	}
+	if second := lines[1]; second.Result != "AUDIT_FAILURE" || second.Code != 404 || second.Reason != "TASK_NOT_FOUND" {
+		t.Errorf("second line = %+v", second)
+	}
+	if lines[2].Actor != "carol" {
+		t.Errorf("appended line actor = %q, want carol", lines[2].Actor)
+	}
+}
+
+func TestAuditRepo(t *testing.T) {
+	ctx := context.Background()
+	repo := NewAuditRepo(newTestData(t), log.DefaultLogger)
+
+	base := time.Now().Truncate(time.Second)
+	events := []*biz.AuditEvent{
+		{Time: base, Actor: "alice", Operation: "CreateTask", TargetID: 1, Targets: []string{"id=1", "calendar_id=2"}, Result: pb.AuditResult_AUDIT_SUCCESS, Transport: "http"},
+		{Time: base.Add(time.Minute), Actor: "bob", Operation: "DeleteTask", TargetID: 1, Result: pb.AuditResult_AUDIT_FAILURE, Code: 403, Reason: "FORBIDDEN", Transport: "grpc"},
+		{Time: base.Add(2 * time.Minute), Actor: "alice", Operation: "DeleteTask", TargetID: 2, Result: pb.AuditResult_AUDIT_SUCCESS, Transport: "http"},
+	}
+	for _, event := range events {
+		if err := repo.WriteAuditEvent(ctx, event); err != nil {
+			t.Fatalf("WriteAuditEvent: %v", err)
+		}
+	}
+
+	start, end := base.Add(time.Minute), base.Add(2*time.Minute)
+	tests := []struct {
+		name   string
+		filter biz.AuditEventListFilter
+		want   []string // 按时间倒序的 操作者/接口
+	}{
+		{"all", biz.AuditEventListFilter{}, []string{"alice/DeleteTask", "bob/DeleteTask", "alice/CreateTask"}},
+		{"actor", biz.AuditEventListFilter{Actor: "alice"}, []string{"alice/DeleteTask", "alice/CreateTask"}},
+		{"operation", biz.AuditEventListFilter{Operation: "CreateTask"}, []string{"alice/CreateTask"}},
+		{"target", biz.AuditEventListFilter{TargetID: 1}, []string{"bob/DeleteTask", "alice/CreateTask"}},
+		{"result", biz.AuditEventListFilter{Result: pb.AuditResult_AUDIT_FAILURE}, []string{"bob/DeleteTask"}},
+		{"time range", biz.AuditEventListFilter{StartTime: &start, EndTime: &end}, []string{"bob/DeleteTask"}},
+		{"page", biz.AuditEventListFilter{Page: 2, PageSize: 2}, []string{"alice/CreateTask"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := tt.filter
+			if filter.PageSize == 0 {
+				filter.PageSize = 10
+			}
+			got, _, err := repo.ListAuditEvents(ctx, &filter)
+			if err != nil {
+				t.Fatalf("ListAuditEvents: %v", err)
+			}
+			var names []string
+			for _, event := range got {
+				names = append(names, event.Actor+"/"+event.Operation)
+			}
+			if !slices.Equal(names, tt.want) {
+				t.Errorf("got %v, want %v", names, tt.want)
+			}
+		})
+	}
+
+	got, total, err := repo.ListAuditEvents(ctx, &biz.AuditEventListFilter{Result: pb.AuditResult_AUDIT_FAILURE, PageSize: 10})
+	if err != nil || total != 1 {
+		t.Fatalf("ListAuditEvents total = %d, %v; want 1", total, err)
+	}
+	if event := got[0]; event.Code != 403 || event.Reason != "FORBIDDEN" || event.Transport != "grpc" || !event.Time.Equal(base.Add(time.Minute)) {
+		t.Errorf("stored event = %+v", event)
+	}
+	if got, _, _ := repo.ListAuditEvents(ctx, &biz.AuditEventListFilter{Operation: "CreateTask", PageSize: 10}); len(got[0].Targets) != 2 {
+		t.Errorf("targets = %v, want [id=1 calendar_id=2]", got[0].Targets)
+	}
+}
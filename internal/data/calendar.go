@@ -0,0 +1,153 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type calendarRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCalendarRepo 创建业务日历仓储实例
+func NewCalendarRepo(data *Data, logger log.Logger) biz.CalendarRepo {
+	return &calendarRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateCalendar 创建日历
+func (r *calendarRepo) CreateCalendar(ctx context.Context, calendar *biz.Calendar) (*biz.Calendar, error) {
+	dbCalendar := &Calendar{
+		Name:        calendar.Name,
+		Description: calendar.Description,
+		Timezone:    calendar.Timezone,
+		Rules:       toCalendarRules(calendar.Rules),
+	}
+
+	if err := r.data.db.WithContext(ctx).Create(dbCalendar).Error; err != nil {
+		return nil, err
+	}
+
+	return r.toBusinessCalendar(dbCalendar), nil
+}
+
+// GetCalendar 获取日历详情
+func (r *calendarRepo) GetCalendar(ctx context.Context, id int64) (*biz.Calendar, error) {
+	var calendar Calendar
+	if err := r.data.db.WithContext(ctx).First(&calendar, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toBusinessCalendar(&calendar), nil
+}
+
+// UpdateCalendar 更新日历
+func (r *calendarRepo) UpdateCalendar(ctx context.Context, calendar *biz.Calendar) (*biz.Calendar, error) {
+	updates := map[string]interface{}{
+		"name":        calendar.Name,
+		"description": calendar.Description,
+		"timezone":    calendar.Timezone,
+		"rules":       toCalendarRules(calendar.Rules),
+	}
+
+	if err := r.data.db.WithContext(ctx).Model(&Calendar{}).Where("id = ?", calendar.ID).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+
+	return r.GetCalendar(ctx, calendar.ID)
+}
+
+// DeleteCalendar 删除日历
+func (r *calendarRepo) DeleteCalendar(ctx context.Context, id int64) error {
+	return r.data.db.WithContext(ctx).Delete(&Calendar{}, id).Error
+}
+
+// ListCalendars 日历列表查询
+func (r *calendarRepo) ListCalendars(ctx context.Context, filter *biz.CalendarListFilter) ([]*biz.Calendar, int64, error) {
+	var calendars []Calendar
+	var total int64
+
+	query := r.data.db.WithContext(ctx).Model(&Calendar{})
+
+	// 关键词搜索
+	if filter.Keyword != "" {
+		query = query.Where(keywordCondition(filter.Keyword, "name", "description"))
+	}
+
+	// 查询总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询
+	offset := pageOffset(filter.Page, filter.PageSize)
+	if err := query.Offset(offset).Limit(int(filter.PageSize)).Order("id DESC").Find(&calendars).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 转换为业务模型
+	result := make([]*biz.Calendar, 0, len(calendars))
+	for _, calendar := range calendars {
+		result = append(result, r.toBusinessCalendar(&calendar))
+	}
+
+	return result, total, nil
+}
+
+// toBusinessCalendar 转换为业务模型
+func (r *calendarRepo) toBusinessCalendar(calendar *Calendar) *biz.Calendar {
+	rules := make([]*biz.CalendarRule, 0, len(calendar.Rules))
+	for _, rule := range calendar.Rules {
+		weekdays := make([]time.Weekday, 0, len(rule.Weekdays))
+		for _, wd := range rule.Weekdays {
+			weekdays = append(weekdays, time.Weekday(wd))
+		}
+		rules = append(rules, &biz.CalendarRule{
+			Action:      pb.CalendarRuleAction(pb.CalendarRuleAction_value[rule.Action]),
+			StartDate:   rule.StartDate,
+			EndDate:     rule.EndDate,
+			Weekdays:    weekdays,
+			Description: rule.Description,
+		})
+	}
+
+	return &biz.Calendar{
+		ID:          calendar.ID,
+		Name:        calendar.Name,
+		Description: calendar.Description,
+		Timezone:    calendar.Timezone,
+		Rules:       rules,
+		CreatedAt:   calendar.CreatedAt,
+		UpdatedAt:   calendar.UpdatedAt,
+	}
+}
+
+// toCalendarRules 转换为数据库存储的日历规则
+func toCalendarRules(rules []*biz.CalendarRule) CalendarRules {
+	result := make(CalendarRules, 0, len(rules))
+	for _, rule := range rules {
+		weekdays := make([]int, 0, len(rule.Weekdays))
+		for _, wd := range rule.Weekdays {
+			weekdays = append(weekdays, int(wd))
+		}
+		result = append(result, CalendarRule{
+			Action:      rule.Action.String(),
+			StartDate:   rule.StartDate,
+			EndDate:     rule.EndDate,
+			Weekdays:    weekdays,
+			Description: rule.Description,
+		})
+	}
+	return result
+}
@@ -0,0 +1,188 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// newExecutor 创建使用 data 的执行器，多个执行器共用同一数据库时相当于集群中的多个执行节点
+func newExecutor(data *Data, queue biz.ExecutionQueue) *biz.Executor {
+	logger := log.DefaultLogger
+	return biz.NewExecutor(NewTaskRepo(data, logger), NewExecutionRepo(data, logger), NewCalendarRepo(data, logger),
+		NewResourcePoolRepo(data, logger), NewNamespaceRepo(data, logger), NewSecretRepo(data, logger),
+		NewLockRepo(data, logger), queue, biz.NewHandlerRegistry(), nil, logger)
+}
+
+// enqueueExecutions 为任务创建 n 条排队中的执行记录
+func enqueueExecutions(t *testing.T, data *Data, queue biz.ExecutionQueue, task *biz.Task, n int) {
+	t.Helper()
+	ctx := context.Background()
+	repo := NewExecutionRepo(data, log.DefaultLogger)
+	for i := 0; i < n; i++ {
+		execution, err := repo.CreateExecution(ctx, &biz.TaskExecution{
+			Namespace: task.Namespace,
+			TaskID:    task.ID,
+			TaskName:  task.Name,
+			Status:    pb.ExecutionStatus_QUEUED,
+		})
+		if err != nil {
+			t.Fatalf("CreateExecution: %v", err)
+		}
+		if err := queue.Push(ctx, execution, 0); err != nil {
+			t.Fatalf("Push: %v", err)
+		}
+	}
+}
+
+func TestClaimAcrossNodes(t *testing.T) {
+	tests := []struct {
+		name        string
+		pool        *biz.ResourcePool
+		lockGroup   string
+		policy      pb.ConcurrencyPolicy
+		wantA       int
+		wantB       int
+		wantSkipped int64
+	}{
+		{
+			name:  "pool concurrency is shared by all nodes",
+			pool:  &biz.ResourcePool{Name: "api", Handler: "http", MaxConcurrency: 1},
+			wantA: 1,
+			wantB: 0,
+		},
+		{
+			name:  "pool with free slots",
+			pool:  &biz.ResourcePool{Name: "api", Handler: "http", MaxConcurrency: 2},
+			wantA: 1,
+			wantB: 1,
+		},
+		{
+			name:  "pool rate limit applies per node",
+			pool:  &biz.ResourcePool{Name: "api", Handler: "http", RateLimit: 0.001, Burst: 1},
+			wantA: 1,
+			wantB: 1,
+		},
+		{
+			name:      "lock group waits",
+			lockGroup: "maintenance",
+			policy:    pb.ConcurrencyPolicy_WAIT,
+			wantA:     1,
+			wantB:     0,
+		},
+		{
+			name:        "lock group skips",
+			lockGroup:   "maintenance",
+			policy:      pb.ConcurrencyPolicy_SKIP,
+			wantA:       1,
+			wantB:       0,
+			wantSkipped: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			data := newTestData(t)
+			queue := NewDBQueue(data, time.Minute, log.DefaultLogger)
+			if tt.pool != nil {
+				if _, err := NewResourcePoolRepo(data, log.DefaultLogger).CreateResourcePool(ctx, tt.pool); err != nil {
+					t.Fatalf("CreateResourcePool: %v", err)
+				}
+			}
+			// 两个不同的任务，互斥组对不同任务同样生效
+			for _, name := range []string{"report", "cleanup"} {
+				task, err := NewTaskRepo(data, log.DefaultLogger).CreateTask(ctx, &biz.Task{
+					Name: name, Handler: "http", Status: pb.TaskStatus_PENDING,
+					LockGroup: tt.lockGroup, ConcurrencyPolicy: tt.policy,
+				})
+				if err != nil {
+					t.Fatalf("CreateTask: %v", err)
+				}
+				enqueueExecutions(t, data, queue, task, 1)
+			}
+
+			// 每个节点各认领一次，第二个节点只能取到第一个节点之后剩下的记录
+			a, err := newExecutor(data, queue).Claim(ctx, "node-a", 1)
+			if err != nil {
+				t.Fatalf("Claim on node-a: %v", err)
+			}
+			b, err := newExecutor(data, queue).Claim(ctx, "node-b", 1)
+			if err != nil {
+				t.Fatalf("Claim on node-b: %v", err)
+			}
+			if len(a) != tt.wantA || len(b) != tt.wantB {
+				t.Fatalf("claimed %d on node-a and %d on node-b, want %d and %d", len(a), len(b), tt.wantA, tt.wantB)
+			}
+
+			_, skipped, err := NewExecutionRepo(data, log.DefaultLogger).ListExecutions(ctx, &biz.ExecutionListFilter{Status: pb.ExecutionStatus_EXECUTION_SKIPPED})
+			if err != nil {
+				t.Fatalf("ListExecutions: %v", err)
+			}
+			if skipped != tt.wantSkipped {
+				t.Errorf("%d executions skipped, want %d", skipped, tt.wantSkipped)
+			}
+		})
+	}
+}
+
+func TestClaimNamespaceQuotas(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace *biz.Namespace
+		running   int // 其他节点已在执行的 team-a 执行记录数
+		want      int // 本节点还能认领的 team-a 执行记录数
+	}{
+		{"no quota", &biz.Namespace{Name: "team-a"}, 0, 3},
+		{"max concurrent executions", &biz.Namespace{Name: "team-a", MaxConcurrentExecutions: 2}, 0, 2},
+		{"running on another node", &biz.Namespace{Name: "team-a", MaxConcurrentExecutions: 2}, 1, 1},
+		{"max executions per minute", &biz.Namespace{Name: "team-a", MaxExecutionsPerMinute: 1}, 0, 1},
+		{"started on another node", &biz.Namespace{Name: "team-a", MaxExecutionsPerMinute: 1}, 1, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			data := newTestData(t)
+			queue := NewDBQueue(data, time.Minute, log.DefaultLogger)
+			if _, err := NewNamespaceRepo(data, log.DefaultLogger).CreateNamespace(ctx, tt.namespace); err != nil {
+				t.Fatalf("CreateNamespace: %v", err)
+			}
+			tasks := NewTaskRepo(data, log.DefaultLogger)
+			limited, err := tasks.CreateTask(ctx, &biz.Task{Namespace: "team-a", Name: "report", Handler: "http", Status: pb.TaskStatus_PENDING})
+			if err != nil {
+				t.Fatalf("CreateTask: %v", err)
+			}
+			other, err := tasks.CreateTask(ctx, &biz.Task{Name: "report", Handler: "http", Status: pb.TaskStatus_PENDING})
+			if err != nil {
+				t.Fatalf("CreateTask: %v", err)
+			}
+			enqueueExecutions(t, data, queue, limited, 3)
+			enqueueExecutions(t, data, queue, other, 2)
+
+			executor := newExecutor(data, queue)
+			// team-a 的执行记录先入队，先被其他节点认领
+			if tt.running > 0 {
+				if claimed, err := executor.Claim(ctx, "node-b", tt.running); err != nil || len(claimed) != tt.running {
+					t.Fatalf("Claim on node-b = %d, %v", len(claimed), err)
+				}
+			}
+
+			// 配额在所有节点上合计，其他命名空间不受影响
+			claimed, err := executor.Claim(ctx, "node-a", 10)
+			if err != nil {
+				t.Fatalf("Claim: %v", err)
+			}
+			counts := make(map[string]int)
+			for _, execution := range claimed {
+				counts[execution.Namespace]++
+			}
+			if counts["team-a"] != tt.want || counts[biz.DefaultNamespace] != 2 {
+				t.Errorf("claimed %v, want %d in team-a and 2 in %s", counts, tt.want, biz.DefaultNamespace)
+			}
+		})
+	}
+}
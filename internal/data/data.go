@@ -1,20 +1,40 @@
 package data
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
+	"heytom-scheduler/internal/biz"
 	"heytom-scheduler/internal/conf"
+	"heytom-scheduler/internal/data/migrate"
 
+	"github.com/glebarez/sqlite"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
+	"github.com/redis/go-redis/v9"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// DriverMySQL MySQL 数据库，未配置驱动时的默认值
+	DriverMySQL = "mysql"
+	// DriverSQLite SQLite 数据库，适用于单节点部署、开发和测试
+	DriverSQLite = "sqlite"
+	// DriverPostgres PostgreSQL 数据库
+	DriverPostgres = "postgres"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewTaskRepo, NewExecutionRepo)
+var ProviderSet = wire.NewSet(NewData, NewTaskRepo, NewExecutionRepo, NewTaskRevisionRepo, NewCalendarRepo, NewResourcePoolRepo, NewLockRepo, NewExecutionQueue, NewExecutionArchiver, NewAuditRepo, NewAuditSinks, NewNamespaceRepo, NewSecretRepo, NewKeyring)
 
 // Data .
 type Data struct {
-	db *gorm.DB
+	db  *gorm.DB
+	rdb *redis.Client
 }
 
 // NewData .
@@ -22,15 +42,21 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 	log := log.NewHelper(logger)
 	
 	// 初始化数据库连接
-	db, err := gorm.Open(mysql.Open(c.Database.Source), &gorm.Config{})
+	db, err := NewDB(c)
 	if err != nil {
 		log.Errorf("failed to connect database: %v", err)
 		return nil, nil, err
 	}
-	
-	// 自动迁移表结构
-	if err := db.AutoMigrate(&Task{}, &TaskExecution{}); err != nil {
+	closeDB := func() {
+		if sqlDB, _ := db.DB(); sqlDB != nil {
+			sqlDB.Close()
+		}
+	}
+
+	// 检查表结构版本，拒绝在未迁移或更新版本的表结构上运行
+	if err := checkSchema(db, c.GetDatabase().GetAutoMigrate(), logger); err != nil {
 		log.Errorf("failed to migrate database: %v", err)
+		closeDB()
 		return nil, nil, err
 	}
 	
@@ -38,13 +64,124 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 		db: db,
 	}
 	
+	// 使用 Redis 执行队列时初始化 Redis 连接
+	if c.GetQueue().GetDriver() == QueueDriverRedis {
+		data.rdb = redis.NewClient(&redis.Options{
+			Network:      c.GetRedis().GetNetwork(),
+			Addr:         c.GetRedis().GetAddr(),
+			Password:     c.GetRedis().GetPassword(),
+			DB:           int(c.GetRedis().GetDb()),
+			ReadTimeout:  c.GetRedis().GetReadTimeout().AsDuration(),
+			WriteTimeout: c.GetRedis().GetWriteTimeout().AsDuration(),
+		})
+	}
+	
 	cleanup := func() {
 		log.Info("closing the data resources")
-		sqlDB, _ := db.DB()
-		if sqlDB != nil {
-			sqlDB.Close()
+		closeDB()
+		if data.rdb != nil {
+			data.rdb.Close()
 		}
 	}
 	
 	return data, cleanup, nil
 }
+
+// NewDB 按配置的驱动打开数据库连接，不检查表结构版本
+func NewDB(c *conf.Data) (*gorm.DB, error) {
+	dialector, err := newDialector(c.GetDatabase().GetDriver(), c.GetDatabase().GetSource())
+	if err != nil {
+		return nil, err
+	}
+	db, err := gorm.Open(dialector, &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+	if dialector.Name() == DriverSQLite {
+		// SQLite 同一时间只允许一个写连接，共用单个连接避免 database is locked
+		sqlDB, err := db.DB()
+		if err != nil {
+			return nil, err
+		}
+		sqlDB.SetMaxOpenConns(1)
+	}
+	return db, nil
+}
+
+// checkSchema 校验表结构已迁移到当前版本，autoMigrate 为 true 时先执行未执行的迁移
+func checkSchema(db *gorm.DB, autoMigrate bool, logger log.Logger) error {
+	ctx := context.Background()
+	m, err := migrate.New(db, logger)
+	if err != nil {
+		return err
+	}
+	if autoMigrate {
+		if _, err := m.Up(ctx); err != nil {
+			return err
+		}
+	}
+	return m.Verify(ctx)
+}
+
+// newDialector 按驱动名称创建数据库方言
+func newDialector(driver, source string) (gorm.Dialector, error) {
+	switch driver {
+	case "", DriverMySQL:
+		return mysql.Open(source), nil
+	case DriverSQLite, "sqlite3":
+		return sqlite.Open(source), nil
+	case DriverPostgres, "postgresql":
+		return postgres.Open(source), nil
+	default:
+		return nil, fmt.Errorf("unknown database driver %q", driver)
+	}
+}
+
+// likeEscaper 转义 LIKE 模式中的通配符，转义字符为 !
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+// keywordCondition 构造不区分大小写的关键词搜索条件，任一列包含关键词即匹配
+// 使用 LOWER 和显式 ESCAPE 以便在 MySQL、SQLite 和 PostgreSQL 中得到一致的结果
+func keywordCondition(keyword string, columns ...string) clause.Expression {
+	pattern := "%" + likeEscaper.Replace(strings.ToLower(keyword)) + "%"
+	exprs := make([]clause.Expression, 0, len(columns))
+	for _, column := range columns {
+		exprs = append(exprs, clause.Expr{SQL: "LOWER(?) LIKE ? ESCAPE '!'", Vars: []interface{}{clause.Column{Name: column}, pattern}})
+	}
+	return clause.Or(exprs...)
+}
+
+// pageOffset 页码对应的偏移量，页码小于 1 时从头开始
+func pageOffset(page, pageSize int32) int {
+	if page <= 1 {
+		return 0
+	}
+	return int((page - 1) * pageSize)
+}
+
+// seekPage 按排序表达式和 ID 排序，after 不为空时只查询排在游标之后的记录（键集分页），sortBy 为 nil 时只按 ID 排序
+func seekPage(query *gorm.DB, sortBy interface{}, order biz.ListOrder, after *biz.PageCursor) *gorm.DB {
+	dir, cmp := "DESC", "<"
+	if order.Asc {
+		dir, cmp = "ASC", ">"
+	}
+	id := clause.Column{Name: "id"}
+
+	if sortBy == nil {
+		if after != nil {
+			query = query.Where(clause.Expr{SQL: "? " + cmp + " ?", Vars: []interface{}{id, after.ID}})
+		}
+		return query.Order(clause.OrderBy{Expression: clause.Expr{SQL: "? " + dir, Vars: []interface{}{id}}})
+	}
+
+	if after != nil {
+		query = query.Where(clause.Expr{
+			SQL:  fmt.Sprintf("(? %s ? OR (? = ? AND ? %s ?))", cmp, cmp),
+			Vars: []interface{}{sortBy, after.Value, sortBy, after.Value, id, after.ID},
+		})
+	}
+	return query.Order(clause.OrderBy{Expression: clause.Expr{
+		SQL:  fmt.Sprintf("? %s, ? %s", dir, dir),
+		Vars: []interface{}{sortBy, id},
+	}})
+}
@@ -0,0 +1,86 @@
+package data
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"heytom-scheduler/internal/biz"
+	"heytom-scheduler/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+)
+
+// newTestData 在临时目录中创建已执行全部迁移的 SQLite 数据库
+func newTestData(t *testing.T) *Data {
+	t.Helper()
+	data, cleanup, err := NewData(&conf.Data{
+		Database: &conf.Data_Database{
+			Driver:      DriverSQLite,
+			Source:      filepath.Join(t.TempDir(), "scheduler.db"),
+			AutoMigrate: true,
+		},
+	}, log.DefaultLogger)
+	if err != nil {
+		t.Fatalf("NewData: %v", err)
+	}
+	t.Cleanup(cleanup)
+	return data
+}
+
+func TestPageOffset(t *testing.T) {
+	tests := []struct {
+		page, pageSize int32
+		want           int
+	}{
+		{page: -1, pageSize: 10, want: 0},
+		{page: 0, pageSize: 10, want: 0},
+		{page: 1, pageSize: 10, want: 0},
+		{page: 3, pageSize: 10, want: 20},
+	}
+	for _, tt := range tests {
+		if got := pageOffset(tt.page, tt.pageSize); got != tt.want {
+			t.Errorf("pageOffset(%d, %d) = %d, want %d", tt.page, tt.pageSize, got, tt.want)
+		}
+	}
+}
+
+func TestSeekPage(t *testing.T) {
+	db := newTestData(t).db
+	if err := db.Exec("CREATE TABLE seek_rows (id INTEGER PRIMARY KEY, name TEXT)").Error; err != nil {
+		t.Fatal(err)
+	}
+	// b 有两条记录，按 ID 同向排序
+	if err := db.Exec("INSERT INTO seek_rows (id, name) VALUES (1, 'b'), (2, 'a'), (3, 'c'), (4, 'b')").Error; err != nil {
+		t.Fatal(err)
+	}
+	name := clause.Column{Name: "name"}
+
+	tests := []struct {
+		name   string
+		sortBy interface{}
+		order  biz.ListOrder
+		after  *biz.PageCursor
+		want   []int64
+	}{
+		{"id desc", nil, biz.ListOrder{}, nil, []int64{4, 3, 2, 1}},
+		{"id asc after", nil, biz.ListOrder{Asc: true}, &biz.PageCursor{ID: 2}, []int64{3, 4}},
+		{"asc", name, biz.ListOrder{Field: biz.OrderByName, Asc: true}, nil, []int64{2, 1, 4, 3}},
+		{"desc", name, biz.ListOrder{Field: biz.OrderByName}, nil, []int64{3, 4, 1, 2}},
+		{"asc after tie", name, biz.ListOrder{Field: biz.OrderByName, Asc: true}, &biz.PageCursor{Value: "b", ID: 1}, []int64{4, 3}},
+		{"desc after tie", name, biz.ListOrder{Field: biz.OrderByName}, &biz.PageCursor{Value: "b", ID: 4}, []int64{1, 2}},
+		{"after last", name, biz.ListOrder{Field: biz.OrderByName, Asc: true}, &biz.PageCursor{Value: "c", ID: 3}, []int64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []int64{}
+			if err := seekPage(db.Table("seek_rows"), tt.sortBy, tt.order, tt.after).Pluck("id", &got).Error; err != nil {
+				t.Fatalf("query: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
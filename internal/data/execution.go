@@ -2,12 +2,15 @@ package data
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	pb "heytom-scheduler/api/scheduler/v1"
 	"heytom-scheduler/internal/biz"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type executionRepo struct {
@@ -26,17 +29,21 @@ func NewExecutionRepo(data *Data, logger log.Logger) biz.ExecutionRepo {
 // CreateExecution 创建执行记录
 func (r *executionRepo) CreateExecution(ctx context.Context, execution *biz.TaskExecution) (*biz.TaskExecution, error) {
 	dbExecution := &TaskExecution{
-		TaskID:     execution.TaskID,
-		TaskName:   execution.TaskName,
-		Status:     ExecutionStatus(execution.Status),
-		NodeID:     execution.NodeID,
-		StartTime:  execution.StartTime,
-		EndTime:    execution.EndTime,
-		Duration:   execution.Duration,
-		Result:     execution.Result,
-		Error:      execution.Error,
-		RetryCount: execution.RetryCount,
-		Payload:    execution.Payload,
+		Namespace:   namespaceFor(ctx, execution.Namespace),
+		TaskID:      execution.TaskID,
+		TaskName:    execution.TaskName,
+		Status:      ExecutionStatus(execution.Status),
+		NodeID:      execution.NodeID,
+		StartTime:   execution.StartTime,
+		EndTime:     execution.EndTime,
+		HeartbeatAt: execution.HeartbeatAt,
+		Duration:    execution.Duration,
+		Result:      execution.Result,
+		Error:       execution.Error,
+		RetryCount:  execution.RetryCount,
+		Payload:     execution.Payload,
+		Priority:    execution.Priority,
+		TaskVersion: execution.TaskVersion,
 	}
 
 	if err := r.data.db.WithContext(ctx).Create(dbExecution).Error; err != nil {
@@ -49,7 +56,7 @@ func (r *executionRepo) CreateExecution(ctx context.Context, execution *biz.Task
 // GetExecution 获取执行记录详情
 func (r *executionRepo) GetExecution(ctx context.Context, id int64) (*biz.TaskExecution, error) {
 	var execution TaskExecution
-	if err := r.data.db.WithContext(ctx).First(&execution, id).Error; err != nil {
+	if err := withNamespace(ctx, r.data.db).First(&execution, id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, nil
 		}
@@ -70,7 +77,7 @@ func (r *executionRepo) UpdateExecution(ctx context.Context, execution *biz.Task
 		RetryCount: execution.RetryCount,
 	}
 
-	if err := r.data.db.WithContext(ctx).Model(&TaskExecution{}).Where("id = ?", execution.ID).Updates(dbExecution).Error; err != nil {
+	if err := withNamespace(ctx, r.data.db).Model(&TaskExecution{}).Where("id = ?", execution.ID).Updates(dbExecution).Error; err != nil {
 		return nil, err
 	}
 
@@ -82,7 +89,12 @@ func (r *executionRepo) ListExecutions(ctx context.Context, filter *biz.Executio
 	var executions []TaskExecution
 	var total int64
 
-	query := r.data.db.WithContext(ctx).Model(&TaskExecution{})
+	query := withNamespace(ctx, r.data.db).Model(&TaskExecution{})
+
+	// 命名空间筛选
+	if filter.Namespace != "" {
+		query = query.Where("namespace = ?", filter.Namespace)
+	}
 
 	// 任务ID筛选
 	if filter.TaskID > 0 {
@@ -91,17 +103,27 @@ func (r *executionRepo) ListExecutions(ctx context.Context, filter *biz.Executio
 
 	// 状态筛选
 	if filter.Status != pb.ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED {
-		query = query.Where("status = ?", filter.Status)
+		query = query.Where("status = ?", ExecutionStatus(filter.Status))
 	}
 
 	// 查询总数
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	if !filter.SkipTotal {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
 	}
 
-	// 分页查询
-	offset := (filter.Page - 1) * filter.PageSize
-	if err := query.Offset(int(offset)).Limit(int(filter.PageSize)).Order("id DESC").Find(&executions).Error; err != nil {
+	// 排序和分页，有游标时从游标之后查询，否则按页码偏移
+	var sortBy interface{}
+	switch filter.OrderBy.Field {
+	case biz.OrderByCreatedAt, biz.OrderByDuration:
+		sortBy = clause.Column{Name: filter.OrderBy.Field}
+	}
+	query = seekPage(query, sortBy, filter.OrderBy, filter.After)
+	if filter.After == nil {
+		query = query.Offset(pageOffset(filter.Page, filter.PageSize))
+	}
+	if err := query.Limit(int(filter.PageSize)).Find(&executions).Error; err != nil {
 		return nil, 0, err
 	}
 
@@ -116,24 +138,305 @@ func (r *executionRepo) ListExecutions(ctx context.Context, filter *biz.Executio
 
 // UpdateExecutionStatus 更新执行状态
 func (r *executionRepo) UpdateExecutionStatus(ctx context.Context, id int64, status pb.ExecutionStatus) error {
-	return r.data.db.WithContext(ctx).Model(&TaskExecution{}).Where("id = ?", id).Update("status", ExecutionStatus(status)).Error
+	return withNamespace(ctx, r.data.db).Model(&TaskExecution{}).Where("id = ?", id).Update("status", ExecutionStatus(status)).Error
+}
+
+// ClaimExecution 将仍在排队中的执行记录更新为 execution.Status，条件更新保证同一条记录只会被一个节点认领
+func (r *executionRepo) ClaimExecution(ctx context.Context, execution *biz.TaskExecution) (bool, error) {
+	updates := map[string]interface{}{
+		"status":       ExecutionStatus(execution.Status),
+		"node_id":      execution.NodeID,
+		"start_time":   execution.StartTime,
+		"end_time":     execution.EndTime,
+		"heartbeat_at": execution.HeartbeatAt,
+		"wait_time":    execution.WaitTime,
+		"error":        execution.Error,
+	}
+	if execution.TaskVersion > 0 {
+		updates["task_version"] = execution.TaskVersion
+	}
+	res := withNamespace(ctx, r.data.db).Model(&TaskExecution{}).
+		Where("id = ? AND status = ?", execution.ID, ExecutionStatus(pb.ExecutionStatus_QUEUED)).
+		Updates(updates)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// HeartbeatExecutions 更新仍在执行中的记录的心跳时间，返回不再执行中的执行记录ID
+func (r *executionRepo) HeartbeatExecutions(ctx context.Context, ids []int64, at time.Time) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	db := withNamespace(ctx, r.data.db)
+	executing := ExecutionStatus(pb.ExecutionStatus_EXECUTING)
+	err := db.Model(&TaskExecution{}).
+		Where("id IN ? AND status = ?", ids, executing).
+		Update("heartbeat_at", at).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var alive []int64
+	if err := db.Model(&TaskExecution{}).Where("id IN ? AND status = ?", ids, executing).Pluck("id", &alive).Error; err != nil {
+		return nil, err
+	}
+	running := make(map[int64]bool, len(alive))
+	for _, id := range alive {
+		running[id] = true
+	}
+	var stopped []int64
+	for _, id := range ids {
+		if !running[id] {
+			stopped = append(stopped, id)
+		}
+	}
+	return stopped, nil
+}
+
+// ListExpiredExecutions 查询心跳超时的执行中记录，没有心跳时按开始时间判断
+func (r *executionRepo) ListExpiredExecutions(ctx context.Context, before time.Time, limit int) ([]*biz.TaskExecution, error) {
+	var executions []TaskExecution
+	err := withNamespace(ctx, r.data.db).
+		Where("status = ?", ExecutionStatus(pb.ExecutionStatus_EXECUTING)).
+		Where("heartbeat_at < ? OR (heartbeat_at IS NULL AND start_time < ?)", before, before).
+		Order("id").
+		Limit(limit).
+		Find(&executions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*biz.TaskExecution, 0, len(executions))
+	for _, execution := range executions {
+		result = append(result, r.toBusinessExecution(&execution))
+	}
+	return result, nil
+}
+
+// FinishExecution 记录执行结果，仅当执行记录仍处于执行中时生效，返回是否更新成功
+func (r *executionRepo) FinishExecution(ctx context.Context, execution *biz.TaskExecution) (bool, error) {
+	res := withNamespace(ctx, r.data.db).Model(&TaskExecution{}).
+		Where("id = ? AND status = ?", execution.ID, ExecutionStatus(pb.ExecutionStatus_EXECUTING)).
+		Updates(map[string]interface{}{
+			"status":   ExecutionStatus(execution.Status),
+			"end_time": execution.EndTime,
+			"duration": execution.Duration,
+			"result":   execution.Result,
+			"error":    execution.Error,
+		})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// GetQueueStats 获取执行队列统计
+func (r *executionRepo) GetQueueStats(ctx context.Context) (*biz.QueueStats, error) {
+	var rows []struct {
+		Priority int32
+		Queued   int64
+	}
+	err := withNamespace(ctx, r.data.db).Model(&TaskExecution{}).
+		Select("priority, COUNT(*) AS queued").
+		Where("status = ?", ExecutionStatus(pb.ExecutionStatus_QUEUED)).
+		Group("priority").
+		Order("priority DESC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &biz.QueueStats{Priorities: make([]*biz.PriorityQueueStats, 0, len(rows))}
+	for _, row := range rows {
+		// 聚合函数的结果在部分驱动中以字符串返回，最早入队时间直接读取列值
+		var oldest []time.Time
+		if err := withNamespace(ctx, r.data.db).Model(&TaskExecution{}).
+			Where("status = ? AND priority = ?", ExecutionStatus(pb.ExecutionStatus_QUEUED), row.Priority).
+			Order("created_at").
+			Limit(1).
+			Pluck("created_at", &oldest).Error; err != nil {
+			return nil, err
+		}
+		ps := &biz.PriorityQueueStats{
+			Priority: row.Priority,
+			Queued:   row.Queued,
+		}
+		if len(oldest) > 0 {
+			ps.OldestQueuedAt = &oldest[0]
+		}
+		stats.Priorities = append(stats.Priorities, ps)
+		stats.TotalQueued += row.Queued
+	}
+
+	if err := withNamespace(ctx, r.data.db).Model(&TaskExecution{}).
+		Where("status = ?", ExecutionStatus(pb.ExecutionStatus_EXECUTING)).
+		Count(&stats.Executing).Error; err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// GetNamespaceUsage 统计命名空间中执行中的记录数和 since 之后开始执行的记录数
+func (r *executionRepo) GetNamespaceUsage(ctx context.Context, namespace string, since time.Time) (*biz.NamespaceUsage, error) {
+	db := withNamespace(ctx, r.data.db)
+	usage := &biz.NamespaceUsage{}
+	if err := db.Model(&TaskExecution{}).
+		Where("namespace = ? AND status = ?", namespace, ExecutionStatus(pb.ExecutionStatus_EXECUTING)).
+		Count(&usage.Running).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Model(&TaskExecution{}).
+		Where("namespace = ? AND start_time >= ?", namespace, since).
+		Count(&usage.StartedSince).Error; err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+// ListExecutionTaskIDs 返回存在执行记录的任务ID，按 ID 升序
+func (r *executionRepo) ListExecutionTaskIDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	if err := withNamespace(ctx, r.data.db).Model(&TaskExecution{}).Distinct("task_id").Order("task_id").Pluck("task_id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ListPrunableExecutions 查询符合清理条件的已结束执行记录，按 ID 升序，最多返回 limit 条
+func (r *executionRepo) ListPrunableExecutions(ctx context.Context, filter *biz.ExecutionPruneFilter, limit int) ([]*biz.TaskExecution, error) {
+	db := withNamespace(ctx, r.data.db)
+	query := db.Where("task_id = ?", filter.TaskID).
+		Where(
+			db.Where("status IN ? AND created_at < ?", finishedStatuses(false), filter.CreatedBefore).
+				Or("status IN ? AND created_at < ?", finishedStatuses(true), filter.FailedCreatedBefore),
+		)
+
+	// 保留最近的 KeepLast 条执行记录：只清理 ID 小于第 KeepLast 新的执行记录的记录
+	if filter.KeepLast > 0 {
+		var boundary []int64
+		if err := db.Model(&TaskExecution{}).
+			Where("task_id = ?", filter.TaskID).
+			Order("id DESC").
+			Offset(int(filter.KeepLast)-1).
+			Limit(1).
+			Pluck("id", &boundary).Error; err != nil {
+			return nil, err
+		}
+		if len(boundary) == 0 {
+			return nil, nil
+		}
+		query = query.Where("id < ?", boundary[0])
+	}
+
+	var executions []TaskExecution
+	if err := query.Order("id ASC").Limit(limit).Find(&executions).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*biz.TaskExecution, 0, len(executions))
+	for i := range executions {
+		result = append(result, r.toBusinessExecution(&executions[i]))
+	}
+	return result, nil
+}
+
+// DeleteExecutions 删除执行记录，返回删除的记录数
+func (r *executionRepo) DeleteExecutions(ctx context.Context, ids []int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	res := withNamespace(ctx, r.data.db).Where("id IN ?", ids).Delete(&TaskExecution{})
+	return res.RowsAffected, res.Error
+}
+
+// DeleteTaskExecutions 删除任务的所有执行记录
+func (r *executionRepo) DeleteTaskExecutions(ctx context.Context, taskID int64) (int64, error) {
+	res := withNamespace(ctx, r.data.db).Where("task_id = ?", taskID).Delete(&TaskExecution{})
+	return res.RowsAffected, res.Error
+}
+
+// CancelExecution 将仍在排队中或执行中的执行记录更新为已取消，条件更新避免覆盖已结束的执行结果
+func (r *executionRepo) CancelExecution(ctx context.Context, id int64, endTime time.Time) (bool, error) {
+	result := withNamespace(ctx, r.data.db).Model(&TaskExecution{}).
+		Where("id = ? AND status IN ?", id, []ExecutionStatus{
+			ExecutionStatus(pb.ExecutionStatus_QUEUED),
+			ExecutionStatus(pb.ExecutionStatus_EXECUTING),
+		}).
+		Updates(map[string]interface{}{
+			"status":   ExecutionStatus(pb.ExecutionStatus_EXECUTION_CANCELLED),
+			"end_time": endTime,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// CancelQueuedExecutions 将任务仍在排队中的执行记录更新为已取消
+// 逐条按状态条件更新，与执行器的认领互斥，返回的只包含实际取消的执行记录
+func (r *executionRepo) CancelQueuedExecutions(ctx context.Context, taskID int64, endTime time.Time) ([]int64, error) {
+	db := withNamespace(ctx, r.data.db)
+	queued := ExecutionStatus(pb.ExecutionStatus_QUEUED)
+
+	var ids []int64
+	if err := db.Model(&TaskExecution{}).Where("task_id = ? AND status = ?", taskID, queued).Order("id").Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+
+	cancelled := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		result := db.Model(&TaskExecution{}).
+			Where("id = ? AND status = ?", id, queued).
+			Updates(map[string]interface{}{
+				"status":   ExecutionStatus(pb.ExecutionStatus_EXECUTION_CANCELLED),
+				"end_time": endTime,
+			})
+		if result.Error != nil {
+			return cancelled, result.Error
+		}
+		if result.RowsAffected == 1 {
+			cancelled = append(cancelled, id)
+		}
+	}
+	return cancelled, nil
+}
+
+// finishedStatuses 返回已结束的执行状态，failed 为 true 时返回失败和超时状态，否则返回其余的已结束状态
+func finishedStatuses(failed bool) []ExecutionStatus {
+	var statuses []ExecutionStatus
+	for value := range pb.ExecutionStatus_name {
+		status := pb.ExecutionStatus(value)
+		if biz.IsFinishedExecutionStatus(status) && biz.IsFailedExecutionStatus(status) == failed {
+			statuses = append(statuses, ExecutionStatus(status))
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i] < statuses[j] })
+	return statuses
 }
 
 // toBusinessExecution 转换为业务模型
 func (r *executionRepo) toBusinessExecution(execution *TaskExecution) *biz.TaskExecution {
 	return &biz.TaskExecution{
-		ID:         execution.ID,
-		TaskID:     execution.TaskID,
-		TaskName:   execution.TaskName,
-		Status:     pb.ExecutionStatus(execution.Status),
-		NodeID:     execution.NodeID,
-		StartTime:  execution.StartTime,
-		EndTime:    execution.EndTime,
-		Duration:   execution.Duration,
-		Result:     execution.Result,
-		Error:      execution.Error,
-		RetryCount: execution.RetryCount,
-		Payload:    execution.Payload,
-		CreatedAt:  execution.CreatedAt,
+		ID:          execution.ID,
+		Namespace:   execution.Namespace,
+		TaskID:      execution.TaskID,
+		TaskName:    execution.TaskName,
+		Status:      pb.ExecutionStatus(execution.Status),
+		NodeID:      execution.NodeID,
+		StartTime:   execution.StartTime,
+		EndTime:     execution.EndTime,
+		HeartbeatAt: execution.HeartbeatAt,
+		Duration:    execution.Duration,
+		Result:      execution.Result,
+		Error:       execution.Error,
+		RetryCount:  execution.RetryCount,
+		Payload:     execution.Payload,
+		Priority:    execution.Priority,
+		WaitTime:    execution.WaitTime,
+		TaskVersion: execution.TaskVersion,
+		CreatedAt:   execution.CreatedAt,
 	}
 }
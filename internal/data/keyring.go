@@ -0,0 +1,53 @@
+package data
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"heytom-scheduler/internal/biz"
+	"heytom-scheduler/internal/conf"
+)
+
+// keyFile 主密钥文件格式
+//
+//	{"primary": "2026-10", "keys": {"2026-04": "<base64>", "2026-10": "<base64>"}}
+type keyFile struct {
+	Primary string            `json:"primary"`
+	Keys    map[string]string `json:"keys"`
+}
+
+// NewKeyring 从 data.secrets.key_file 加载主密钥，未配置时返回未启用的密钥集合
+func NewKeyring(c *conf.Data) (*biz.Keyring, error) {
+	path := c.GetSecrets().GetKeyFile()
+	if path == "" {
+		return biz.NewKeyring(nil, "")
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read secrets key file: %w", err)
+	}
+	var file keyFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("parse secrets key file %s: %w", path, err)
+	}
+	if len(file.Keys) == 0 {
+		return nil, fmt.Errorf("secrets key file %s defines no keys", path)
+	}
+
+	keys := make(map[string][]byte, len(file.Keys))
+	for id, encoded := range file.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("decode master key %q: %w", id, err)
+		}
+		keys[id] = key
+	}
+	keyring, err := biz.NewKeyring(keys, file.Primary)
+	if err != nil {
+		return nil, fmt.Errorf("secrets key file %s: %w", path, err)
+	}
+	return keyring, nil
+}
@@ -0,0 +1,63 @@
+package data
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"heytom-scheduler/internal/conf"
+)
+
+// keyFileConf 将 content 写入临时密钥文件并返回引用它的配置
+func keyFileConf(t *testing.T, content string) *conf.Data {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "keys.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return &conf.Data{Secrets: &conf.Data_Secrets{KeyFile: path}}
+}
+
+func TestNewKeyring(t *testing.T) {
+	key1 := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("1", 32)))
+	key2 := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("2", 32)))
+
+	keyring, err := NewKeyring(&conf.Data{})
+	if err != nil || keyring.Enabled() {
+		t.Fatalf("without key file = %v, %v, want disabled keyring", keyring, err)
+	}
+
+	keyring, err = NewKeyring(keyFileConf(t, `{"primary": "k2", "keys": {"k1": "`+key1+`", "k2": "`+key2+`"}}`))
+	if err != nil {
+		t.Fatalf("NewKeyring: %v", err)
+	}
+	if !keyring.Enabled() || keyring.PrimaryKeyID() != "k2" {
+		t.Errorf("keyring enabled = %v, primary = %q", keyring.Enabled(), keyring.PrimaryKeyID())
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"not json", `primary: k1`, "parse secrets key file"},
+		{"no keys", `{"primary": "k1", "keys": {}}`, "defines no keys"},
+		{"bad base64", `{"primary": "k1", "keys": {"k1": "***"}}`, "decode master key"},
+		{"short key", `{"primary": "k1", "keys": {"k1": "` + base64.StdEncoding.EncodeToString([]byte("short")) + `"}}`, "must be 32 bytes"},
+		{"unknown primary", `{"primary": "k3", "keys": {"k1": "` + key1 + `"}}`, "is not defined"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewKeyring(keyFileConf(t, tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := NewKeyring(&conf.Data{Secrets: &conf.Data_Secrets{KeyFile: filepath.Join(t.TempDir(), "missing.json")}}); err == nil {
+		t.Errorf("NewKeyring accepted a missing key file")
+	}
+}
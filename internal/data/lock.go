@@ -0,0 +1,105 @@
+package data
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"heytom-scheduler/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+)
+
+type lockRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewLockRepo 创建集群互斥锁仓储实例
+func NewLockRepo(data *Data, logger log.Logger) biz.LockRepo {
+	return &lockRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// AcquireLock 尝试获取互斥组的锁，锁不存在或已过期时获取成功
+func (r *lockRepo) AcquireLock(ctx context.Context, group, owner string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+
+	// 接管已过期的锁
+	res := r.data.db.WithContext(ctx).Model(&TaskLock{}).
+		Where("lock_group = ? AND expires_at < ?", group, now).
+		Updates(map[string]interface{}{
+			"owner":       owner,
+			"expires_at":  expiresAt,
+			"acquired_at": now,
+		})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if res.RowsAffected == 1 {
+		return true, nil
+	}
+
+	// 锁不存在时插入，主键冲突说明锁已被占用
+	res = r.data.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&TaskLock{
+		LockGroup:  group,
+		Owner:      owner,
+		AcquiredAt: now,
+		ExpiresAt:  expiresAt,
+	})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// AcquireSlot 尝试获取 prefix 后接 0 到 size-1 编号的一组锁中的任意一个，返回获取到的锁名称
+func (r *lockRepo) AcquireSlot(ctx context.Context, prefix string, size int, owner string, ttl time.Duration) (string, bool, error) {
+	var held []string
+	err := r.data.db.WithContext(ctx).Model(&TaskLock{}).
+		Where("lock_group LIKE ? ESCAPE '!' AND expires_at >= ?", likeEscaper.Replace(prefix)+"%", time.Now()).
+		Pluck("lock_group", &held).Error
+	if err != nil {
+		return "", false, err
+	}
+	// 容量缩小后编号超出范围的锁仍计入占用数
+	if len(held) >= size {
+		return "", false, nil
+	}
+
+	taken := make(map[string]bool, len(held))
+	for _, group := range held {
+		taken[group] = true
+	}
+	for i := 0; i < size; i++ {
+		group := prefix + strconv.Itoa(i)
+		if taken[group] {
+			continue
+		}
+		// 查询后其他节点可能已获取该编号，获取失败时继续尝试下一个
+		acquired, err := r.AcquireLock(ctx, group, owner, ttl)
+		if err != nil {
+			return "", false, err
+		}
+		if acquired {
+			return group, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// ReleaseLock 释放由 owner 持有的互斥组锁
+func (r *lockRepo) ReleaseLock(ctx context.Context, group, owner string) error {
+	return r.data.db.WithContext(ctx).
+		Where("lock_group = ? AND owner = ?", group, owner).
+		Delete(&TaskLock{}).Error
+}
+
+// ReleaseOwnerLocks 释放 owner 持有的全部锁
+func (r *lockRepo) ReleaseOwnerLocks(ctx context.Context, owner string) error {
+	return r.data.db.WithContext(ctx).Where("owner = ?", owner).Delete(&TaskLock{}).Error
+}
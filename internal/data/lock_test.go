@@ -0,0 +1,100 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestLockRepoAcquireLock(t *testing.T) {
+	ctx := context.Background()
+	r := NewLockRepo(newTestData(t), log.DefaultLogger)
+
+	acquire := func(group, owner string, ttl time.Duration, want bool) {
+		t.Helper()
+		got, err := r.AcquireLock(ctx, group, owner, ttl)
+		if err != nil {
+			t.Fatalf("AcquireLock(%q, %q): %v", group, owner, err)
+		}
+		if got != want {
+			t.Fatalf("AcquireLock(%q, %q) = %v, want %v", group, owner, got, want)
+		}
+	}
+
+	acquire("reports", "a/1", time.Minute, true)
+	acquire("reports", "b/2", time.Minute, false)
+	acquire("exports", "b/2", time.Minute, true)
+
+	// 只有持有者能释放锁
+	if err := r.ReleaseLock(ctx, "reports", "b/2"); err != nil {
+		t.Fatalf("ReleaseLock: %v", err)
+	}
+	acquire("reports", "b/2", time.Minute, false)
+	if err := r.ReleaseLock(ctx, "reports", "a/1"); err != nil {
+		t.Fatalf("ReleaseLock: %v", err)
+	}
+	acquire("reports", "b/2", time.Minute, true)
+
+	// 过期的锁可被接管
+	acquire("stale", "a/1", -time.Second, true)
+	acquire("stale", "b/2", time.Minute, true)
+	acquire("stale", "a/1", time.Minute, false)
+}
+
+func TestLockRepoAcquireSlot(t *testing.T) {
+	ctx := context.Background()
+	r := NewLockRepo(newTestData(t), log.DefaultLogger)
+
+	acquire := func(owner string, size int, ttl time.Duration, want bool) string {
+		t.Helper()
+		slot, ok, err := r.AcquireSlot(ctx, "__pool/1/", size, owner, ttl)
+		if err != nil {
+			t.Fatalf("AcquireSlot(%q): %v", owner, err)
+		}
+		if ok != want {
+			t.Fatalf("AcquireSlot(%q) = %v, want %v", owner, ok, want)
+		}
+		return slot
+	}
+
+	// 其他前缀和仅因 LIKE 通配符匹配的锁不占用槽位
+	for _, group := range []string{"__pool/12/0", "xxpool/1/0"} {
+		if ok, err := r.AcquireLock(ctx, group, "other", time.Minute); err != nil || !ok {
+			t.Fatalf("AcquireLock(%q) = %v, %v", group, ok, err)
+		}
+	}
+
+	first := acquire("a/1", 2, time.Minute, true)
+	second := acquire("b/2", 2, time.Minute, true)
+	if first == second {
+		t.Fatalf("both owners got slot %q", first)
+	}
+	acquire("c/3", 2, time.Minute, false)
+
+	// 释放后槽位可被其他持有者获取
+	if err := r.ReleaseLock(ctx, first, "a/1"); err != nil {
+		t.Fatalf("ReleaseLock: %v", err)
+	}
+	if got := acquire("c/3", 2, time.Minute, true); got != first {
+		t.Fatalf("slot = %q, want released slot %q", got, first)
+	}
+
+	// 容量缩小后已占用的槽位仍计入
+	acquire("d/4", 1, time.Minute, false)
+
+	// 释放持有者的全部锁
+	if err := r.ReleaseOwnerLocks(ctx, "b/2"); err != nil {
+		t.Fatalf("ReleaseOwnerLocks: %v", err)
+	}
+	acquire("d/4", 2, time.Minute, true)
+	acquire("e/5", 2, time.Minute, false)
+
+	// 过期的槽位不计入占用，可被接管
+	if err := r.ReleaseOwnerLocks(ctx, "d/4"); err != nil {
+		t.Fatalf("ReleaseOwnerLocks: %v", err)
+	}
+	acquire("e/5", 2, -time.Second, true)
+	acquire("f/6", 2, time.Minute, true)
+}
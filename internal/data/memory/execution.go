@@ -0,0 +1,395 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+)
+
+type executionRepo struct {
+	mu         sync.RWMutex
+	nextID     int64
+	executions map[int64]*biz.TaskExecution
+}
+
+// NewExecutionRepo 创建内存执行记录仓储实例
+func NewExecutionRepo() biz.ExecutionRepo {
+	return &executionRepo{executions: make(map[int64]*biz.TaskExecution)}
+}
+
+// CreateExecution 创建执行记录
+func (r *executionRepo) CreateExecution(ctx context.Context, execution *biz.TaskExecution) (*biz.TaskExecution, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := copyExecution(execution)
+	r.nextID++
+	stored.ID = r.nextID
+	stored.Namespace = namespaceFor(ctx, stored.Namespace)
+	stored.WaitTime = 0
+	stored.CreatedAt = time.Now()
+	r.executions[stored.ID] = stored
+
+	return copyExecution(stored), nil
+}
+
+// GetExecution 获取执行记录详情，执行记录不存在时返回 nil
+func (r *executionRepo) GetExecution(ctx context.Context, id int64) (*biz.TaskExecution, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	execution, ok := r.executions[id]
+	if !ok || !visible(ctx, execution.Namespace) {
+		return nil, nil
+	}
+	return copyExecution(execution), nil
+}
+
+// UpdateExecution 更新执行记录，与数据库实现一致只更新非零值的状态、结束时间、耗时、结果、错误和重试次数
+func (r *executionRepo) UpdateExecution(ctx context.Context, execution *biz.TaskExecution) (*biz.TaskExecution, error) {
+	r.mu.Lock()
+	if stored, ok := r.executions[execution.ID]; ok && visible(ctx, stored.Namespace) {
+		if execution.Status != pb.ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED {
+			stored.Status = execution.Status
+		}
+		if execution.EndTime != nil {
+			stored.EndTime = copyTime(execution.EndTime)
+		}
+		if execution.Duration != 0 {
+			stored.Duration = execution.Duration
+		}
+		if execution.Result != "" {
+			stored.Result = execution.Result
+		}
+		if execution.Error != "" {
+			stored.Error = execution.Error
+		}
+		if execution.RetryCount != 0 {
+			stored.RetryCount = execution.RetryCount
+		}
+	}
+	r.mu.Unlock()
+
+	return r.GetExecution(ctx, execution.ID)
+}
+
+// ListExecutions 执行记录列表查询，按 filter.OrderBy 排序后按页码或游标分页
+func (r *executionRepo) ListExecutions(ctx context.Context, filter *biz.ExecutionListFilter) ([]*biz.TaskExecution, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matched []*biz.TaskExecution
+	for _, execution := range r.executions {
+		if !visible(ctx, execution.Namespace) {
+			continue
+		}
+		if filter.Namespace != "" && execution.Namespace != filter.Namespace {
+			continue
+		}
+		if filter.TaskID > 0 && execution.TaskID != filter.TaskID {
+			continue
+		}
+		if filter.Status != pb.ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED && execution.Status != filter.Status {
+			continue
+		}
+		matched = append(matched, execution)
+	}
+	var total int64
+	if !filter.SkipTotal {
+		total = int64(len(matched))
+	}
+
+	order := filter.OrderBy
+	indexes := seekPage(len(matched), order, filter.After, func(i int) (interface{}, int64) {
+		return biz.ExecutionSortValue(matched[i], order.Field), matched[i].ID
+	})
+	page := filter.Page
+	if filter.After != nil {
+		page = 1
+	}
+	start, end := paginate(len(indexes), page, filter.PageSize)
+	result := make([]*biz.TaskExecution, 0, end-start)
+	for _, i := range indexes[start:end] {
+		result = append(result, copyExecution(matched[i]))
+	}
+	return result, total, nil
+}
+
+// UpdateExecutionStatus 更新执行状态
+func (r *executionRepo) UpdateExecutionStatus(ctx context.Context, id int64, status pb.ExecutionStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if execution, ok := r.executions[id]; ok && visible(ctx, execution.Namespace) {
+		execution.Status = status
+	}
+	return nil
+}
+
+// ClaimExecution 将仍在排队中的执行记录更新为 execution.Status，非零的任务版本同时写入，返回是否认领成功
+func (r *executionRepo) ClaimExecution(ctx context.Context, execution *biz.TaskExecution) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.executions[execution.ID]
+	if !ok || !visible(ctx, stored.Namespace) || stored.Status != pb.ExecutionStatus_QUEUED {
+		return false, nil
+	}
+	stored.Status = execution.Status
+	stored.NodeID = execution.NodeID
+	stored.StartTime = copyTime(execution.StartTime)
+	stored.EndTime = copyTime(execution.EndTime)
+	stored.HeartbeatAt = copyTime(execution.HeartbeatAt)
+	stored.WaitTime = execution.WaitTime
+	stored.Error = execution.Error
+	if execution.TaskVersion > 0 {
+		stored.TaskVersion = execution.TaskVersion
+	}
+	return true, nil
+}
+
+// HeartbeatExecutions 更新仍在执行中的记录的心跳时间，返回不再执行中的执行记录ID
+func (r *executionRepo) HeartbeatExecutions(ctx context.Context, ids []int64, at time.Time) ([]int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var stopped []int64
+	for _, id := range ids {
+		execution, ok := r.executions[id]
+		if !ok || !visible(ctx, execution.Namespace) || execution.Status != pb.ExecutionStatus_EXECUTING {
+			stopped = append(stopped, id)
+			continue
+		}
+		execution.HeartbeatAt = copyTime(&at)
+	}
+	return stopped, nil
+}
+
+// ListExpiredExecutions 查询心跳超时的执行中记录，没有心跳时按开始时间判断，按 ID 升序
+func (r *executionRepo) ListExpiredExecutions(ctx context.Context, before time.Time, limit int) ([]*biz.TaskExecution, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var expired []*biz.TaskExecution
+	for _, execution := range r.executions {
+		if !visible(ctx, execution.Namespace) || execution.Status != pb.ExecutionStatus_EXECUTING {
+			continue
+		}
+		last := execution.HeartbeatAt
+		if last == nil {
+			last = execution.StartTime
+		}
+		if last != nil && last.Before(before) {
+			expired = append(expired, copyExecution(execution))
+		}
+	}
+	sort.Slice(expired, func(i, j int) bool { return expired[i].ID < expired[j].ID })
+	if limit > 0 && len(expired) > limit {
+		expired = expired[:limit]
+	}
+	return expired, nil
+}
+
+// FinishExecution 记录执行结果，仅当执行记录仍处于执行中时生效，返回是否更新成功
+func (r *executionRepo) FinishExecution(ctx context.Context, execution *biz.TaskExecution) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.executions[execution.ID]
+	if !ok || !visible(ctx, stored.Namespace) || stored.Status != pb.ExecutionStatus_EXECUTING {
+		return false, nil
+	}
+	stored.Status = execution.Status
+	stored.EndTime = copyTime(execution.EndTime)
+	stored.Duration = execution.Duration
+	stored.Result = execution.Result
+	stored.Error = execution.Error
+	return true, nil
+}
+
+// GetQueueStats 获取执行队列统计，按优先级倒序
+func (r *executionRepo) GetQueueStats(ctx context.Context) (*biz.QueueStats, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stats := &biz.QueueStats{Priorities: []*biz.PriorityQueueStats{}}
+	byPriority := make(map[int32]*biz.PriorityQueueStats)
+	for _, execution := range r.executions {
+		if !visible(ctx, execution.Namespace) {
+			continue
+		}
+		switch execution.Status {
+		case pb.ExecutionStatus_EXECUTING:
+			stats.Executing++
+		case pb.ExecutionStatus_QUEUED:
+			ps, ok := byPriority[execution.Priority]
+			if !ok {
+				ps = &biz.PriorityQueueStats{Priority: execution.Priority}
+				byPriority[execution.Priority] = ps
+				stats.Priorities = append(stats.Priorities, ps)
+			}
+			ps.Queued++
+			if ps.OldestQueuedAt == nil || execution.CreatedAt.Before(*ps.OldestQueuedAt) {
+				ps.OldestQueuedAt = copyTime(&execution.CreatedAt)
+			}
+			stats.TotalQueued++
+		}
+	}
+	sort.Slice(stats.Priorities, func(i, j int) bool {
+		return stats.Priorities[i].Priority > stats.Priorities[j].Priority
+	})
+	return stats, nil
+}
+
+// GetNamespaceUsage 统计命名空间中执行中的记录数和 since 之后开始执行的记录数
+func (r *executionRepo) GetNamespaceUsage(ctx context.Context, namespace string, since time.Time) (*biz.NamespaceUsage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	usage := &biz.NamespaceUsage{}
+	for _, execution := range r.executions {
+		if execution.Namespace != namespace || !visible(ctx, execution.Namespace) {
+			continue
+		}
+		if execution.Status == pb.ExecutionStatus_EXECUTING {
+			usage.Running++
+		}
+		if execution.StartTime != nil && !execution.StartTime.Before(since) {
+			usage.StartedSince++
+		}
+	}
+	return usage, nil
+}
+
+// ListExecutionTaskIDs 返回存在执行记录的任务ID，按 ID 升序
+func (r *executionRepo) ListExecutionTaskIDs(ctx context.Context) ([]int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	seen := make(map[int64]bool)
+	ids := []int64{}
+	for _, execution := range r.executions {
+		if visible(ctx, execution.Namespace) && !seen[execution.TaskID] {
+			seen[execution.TaskID] = true
+			ids = append(ids, execution.TaskID)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+// ListPrunableExecutions 查询符合清理条件的已结束执行记录，按 ID 升序，最多返回 limit 条
+func (r *executionRepo) ListPrunableExecutions(ctx context.Context, filter *biz.ExecutionPruneFilter, limit int) ([]*biz.TaskExecution, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var all []*biz.TaskExecution
+	for _, execution := range r.executions {
+		if execution.TaskID == filter.TaskID && visible(ctx, execution.Namespace) {
+			all = append(all, execution)
+		}
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].ID < all[j].ID })
+	// 保留最近的 KeepLast 条执行记录
+	if filter.KeepLast > 0 {
+		if len(all) < int(filter.KeepLast) {
+			return nil, nil
+		}
+		all = all[:len(all)-int(filter.KeepLast)]
+	}
+
+	result := []*biz.TaskExecution{}
+	for _, execution := range all {
+		if len(result) == limit {
+			break
+		}
+		if !biz.IsFinishedExecutionStatus(execution.Status) {
+			continue
+		}
+		before := filter.CreatedBefore
+		if biz.IsFailedExecutionStatus(execution.Status) {
+			before = filter.FailedCreatedBefore
+		}
+		if execution.CreatedAt.Before(before) {
+			result = append(result, copyExecution(execution))
+		}
+	}
+	return result, nil
+}
+
+// DeleteExecutions 删除执行记录，返回删除的记录数
+func (r *executionRepo) DeleteExecutions(ctx context.Context, ids []int64) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int64
+	for _, id := range ids {
+		if execution, ok := r.executions[id]; ok && visible(ctx, execution.Namespace) {
+			delete(r.executions, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// DeleteTaskExecutions 删除任务的所有执行记录
+func (r *executionRepo) DeleteTaskExecutions(ctx context.Context, taskID int64) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int64
+	for id, execution := range r.executions {
+		if execution.TaskID == taskID && visible(ctx, execution.Namespace) {
+			delete(r.executions, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// CancelExecution 将仍在排队中或执行中的执行记录更新为已取消，返回是否取消成功
+func (r *executionRepo) CancelExecution(ctx context.Context, id int64, endTime time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	execution, ok := r.executions[id]
+	if !ok || !visible(ctx, execution.Namespace) {
+		return false, nil
+	}
+	if execution.Status != pb.ExecutionStatus_QUEUED && execution.Status != pb.ExecutionStatus_EXECUTING {
+		return false, nil
+	}
+	execution.Status = pb.ExecutionStatus_EXECUTION_CANCELLED
+	execution.EndTime = copyTime(&endTime)
+	return true, nil
+}
+
+// CancelQueuedExecutions 将任务仍在排队中的执行记录更新为已取消，返回按 ID 升序的被取消执行记录ID
+func (r *executionRepo) CancelQueuedExecutions(ctx context.Context, taskID int64, endTime time.Time) ([]int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cancelled := []int64{}
+	for _, execution := range r.executions {
+		if execution.TaskID == taskID && visible(ctx, execution.Namespace) && execution.Status == pb.ExecutionStatus_QUEUED {
+			execution.Status = pb.ExecutionStatus_EXECUTION_CANCELLED
+			execution.EndTime = copyTime(&endTime)
+			cancelled = append(cancelled, execution.ID)
+		}
+	}
+	sort.Slice(cancelled, func(i, j int) bool { return cancelled[i] < cancelled[j] })
+	return cancelled, nil
+}
+
+// copyExecution 复制执行记录，避免调用方修改仓储中保存的数据
+func copyExecution(execution *biz.TaskExecution) *biz.TaskExecution {
+	c := *execution
+	c.StartTime = copyTime(execution.StartTime)
+	c.EndTime = copyTime(execution.EndTime)
+	c.HeartbeatAt = copyTime(execution.HeartbeatAt)
+	return &c
+}
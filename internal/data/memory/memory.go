@@ -0,0 +1,117 @@
+// Package memory 提供基于内存的仓储实现，用于测试和无需数据库的嵌入式运行
+// 过滤、分页和计数语义与 data 包中的数据库实现保持一致
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"heytom-scheduler/internal/biz"
+)
+
+// paginate 按 page/pageSize 计算分页区间，语义与数据库实现的 Offset/Limit 一致：
+// page 小于 1 时从头开始，pageSize 为 0 时不返回记录，pageSize 为负数时不限制数量
+func paginate(n int, page, pageSize int32) (int, int) {
+	start := int((page - 1) * pageSize)
+	if start < 0 {
+		start = 0
+	}
+	if start > n {
+		start = n
+	}
+	end := n
+	if pageSize >= 0 && start+int(pageSize) < n {
+		end = start + int(pageSize)
+	}
+	return start, end
+}
+
+// orderedBefore 按 order 排序时值为 a、ID 为 aID 的记录是否排在 b 之前，值相同时按 ID 同向排序
+func orderedBefore(order biz.ListOrder, a interface{}, aID int64, b interface{}, bID int64) bool {
+	c := compareSortValues(a, b)
+	if c == 0 {
+		c = compareSortValues(aID, bID)
+	}
+	if order.Asc {
+		return c < 0
+	}
+	return c > 0
+}
+
+// compareSortValues 比较排序字段的值，与数据库按时间、整数和二进制字符串的比较一致
+func compareSortValues(a, b interface{}) int {
+	switch x := a.(type) {
+	case time.Time:
+		y := b.(time.Time)
+		switch {
+		case x.Before(y):
+			return -1
+		case x.After(y):
+			return 1
+		}
+	case int64:
+		y := b.(int64)
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	case string:
+		return strings.Compare(x, b.(string))
+	}
+	return 0
+}
+
+// seekPage 按 order 排序，after 不为空时只保留排在游标之后的记录，返回保留记录的下标；sortValue 返回记录的排序字段值和 ID
+func seekPage(n int, order biz.ListOrder, after *biz.PageCursor, sortValue func(i int) (interface{}, int64)) []int {
+	indexes := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		if after != nil {
+			value, id := sortValue(i)
+			if !orderedBefore(order, after.Value, after.ID, value, id) {
+				continue
+			}
+		}
+		indexes = append(indexes, i)
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		a, aID := sortValue(indexes[i])
+		b, bID := sortValue(indexes[j])
+		return orderedBefore(order, a, aID, b, bID)
+	})
+	return indexes
+}
+
+// containsFold 不区分大小写的子串匹配，与数据库默认排序规则下的 LIKE '%keyword%' 一致
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// copyTime 复制时间指针
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	return &c
+}
+
+// namespaceFor 新记录所属的命名空间，上下文限定命名空间时总是写入该命名空间
+func namespaceFor(ctx context.Context, namespace string) string {
+	if scoped, ok := biz.NamespaceFromContext(ctx); ok {
+		return scoped
+	}
+	if namespace == "" {
+		return biz.DefaultNamespace
+	}
+	return namespace
+}
+
+// visible 判断记录是否在上下文限定的命名空间中，未限定时所有记录可见
+func visible(ctx context.Context, namespace string) bool {
+	scoped, ok := biz.NamespaceFromContext(ctx)
+	return !ok || scoped == namespace
+}
@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"reflect"
+	"testing"
+
+	"heytom-scheduler/internal/biz"
+)
+
+func TestPaginate(t *testing.T) {
+	tests := []struct {
+		n              int
+		page, pageSize int32
+		wantStart      int
+		wantEnd        int
+	}{
+		{n: 10, page: 0, pageSize: 3, wantStart: 0, wantEnd: 3},
+		{n: 10, page: 1, pageSize: 3, wantStart: 0, wantEnd: 3},
+		{n: 10, page: 4, pageSize: 3, wantStart: 9, wantEnd: 10},
+		{n: 10, page: 5, pageSize: 3, wantStart: 10, wantEnd: 10},
+		{n: 10, page: 1, pageSize: 0, wantStart: 0, wantEnd: 0},
+		{n: 10, page: 1, pageSize: -1, wantStart: 0, wantEnd: 10},
+	}
+	for _, tt := range tests {
+		start, end := paginate(tt.n, tt.page, tt.pageSize)
+		if start != tt.wantStart || end != tt.wantEnd {
+			t.Errorf("paginate(%d, %d, %d) = %d, %d, want %d, %d", tt.n, tt.page, tt.pageSize, start, end, tt.wantStart, tt.wantEnd)
+		}
+	}
+}
+
+func TestSeekPage(t *testing.T) {
+	// 下标对应的名称和 ID，b 有两条记录，按 ID 同向排序
+	names := []string{"b", "a", "c", "b"}
+	ids := []int64{1, 2, 3, 4}
+	sortValue := func(i int) (interface{}, int64) { return names[i], ids[i] }
+
+	tests := []struct {
+		name  string
+		order biz.ListOrder
+		after *biz.PageCursor
+		want  []int64
+	}{
+		{"asc", biz.ListOrder{Field: biz.OrderByName, Asc: true}, nil, []int64{2, 1, 4, 3}},
+		{"desc", biz.ListOrder{Field: biz.OrderByName}, nil, []int64{3, 4, 1, 2}},
+		{"asc after tie", biz.ListOrder{Field: biz.OrderByName, Asc: true}, &biz.PageCursor{Value: "b", ID: 1}, []int64{4, 3}},
+		{"desc after tie", biz.ListOrder{Field: biz.OrderByName}, &biz.PageCursor{Value: "b", ID: 4}, []int64{1, 2}},
+		{"after last", biz.ListOrder{Field: biz.OrderByName, Asc: true}, &biz.PageCursor{Value: "c", ID: 3}, []int64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []int64{}
+			for _, i := range seekPage(len(names), tt.order, tt.after, sortValue) {
+				got = append(got, ids[i])
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
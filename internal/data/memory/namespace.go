@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"heytom-scheduler/internal/biz"
+)
+
+type namespaceRepo struct {
+	mu         sync.RWMutex
+	nextID     int64
+	namespaces map[string]*biz.Namespace
+}
+
+// NewNamespaceRepo 创建内存命名空间仓储实例，与数据库迁移一致预置默认命名空间
+func NewNamespaceRepo() biz.NamespaceRepo {
+	now := time.Now()
+	return &namespaceRepo{
+		nextID: 1,
+		namespaces: map[string]*biz.Namespace{
+			biz.DefaultNamespace: {ID: 1, Name: biz.DefaultNamespace, CreatedAt: now, UpdatedAt: now},
+		},
+	}
+}
+
+// CreateNamespace 创建命名空间
+func (r *namespaceRepo) CreateNamespace(ctx context.Context, namespace *biz.Namespace) (*biz.Namespace, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	stored := *namespace
+	r.nextID++
+	stored.ID = r.nextID
+	stored.CreatedAt = now
+	stored.UpdatedAt = now
+	r.namespaces[stored.Name] = &stored
+
+	c := stored
+	return &c, nil
+}
+
+// GetNamespace 按名称获取命名空间，不存在时返回 nil
+func (r *namespaceRepo) GetNamespace(ctx context.Context, name string) (*biz.Namespace, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	namespace, ok := r.namespaces[name]
+	if !ok {
+		return nil, nil
+	}
+	c := *namespace
+	return &c, nil
+}
+
+// UpdateNamespace 更新命名空间的描述和配额，不存在时返回 nil
+func (r *namespaceRepo) UpdateNamespace(ctx context.Context, namespace *biz.Namespace) (*biz.Namespace, error) {
+	r.mu.Lock()
+	if stored, ok := r.namespaces[namespace.Name]; ok {
+		stored.Description = namespace.Description
+		stored.MaxTasks = namespace.MaxTasks
+		stored.MaxConcurrentExecutions = namespace.MaxConcurrentExecutions
+		stored.MaxExecutionsPerMinute = namespace.MaxExecutionsPerMinute
+		stored.UpdatedAt = time.Now()
+	}
+	r.mu.Unlock()
+
+	return r.GetNamespace(ctx, namespace.Name)
+}
+
+// DeleteNamespace 删除命名空间
+func (r *namespaceRepo) DeleteNamespace(ctx context.Context, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.namespaces, name)
+	return nil
+}
+
+// ListNamespaces 命名空间列表查询，按名称排序分页
+func (r *namespaceRepo) ListNamespaces(ctx context.Context, filter *biz.NamespaceListFilter) ([]*biz.Namespace, int64, error) {
+	all, _ := r.ListAllNamespaces(ctx)
+
+	var matched []*biz.Namespace
+	for _, namespace := range all {
+		if filter.Keyword != "" && !containsFold(namespace.Name, filter.Keyword) && !containsFold(namespace.Description, filter.Keyword) {
+			continue
+		}
+		matched = append(matched, namespace)
+	}
+
+	start, end := paginate(len(matched), filter.Page, filter.PageSize)
+	return matched[start:end], int64(len(matched)), nil
+}
+
+// ListAllNamespaces 查询全部命名空间，按名称排序
+func (r *namespaceRepo) ListAllNamespaces(ctx context.Context) ([]*biz.Namespace, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*biz.Namespace, 0, len(r.namespaces))
+	for _, namespace := range r.namespaces {
+		c := *namespace
+		result = append(result, &c)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
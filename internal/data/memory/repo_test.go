@@ -0,0 +1,37 @@
+package memory
+
+import (
+	"testing"
+
+	"heytom-scheduler/internal/biz"
+	"heytom-scheduler/internal/biz/repotest"
+)
+
+// TestRepos 运行内存仓储的一致性测试
+func TestRepos(t *testing.T) {
+	t.Run("Task", func(t *testing.T) {
+		repotest.RunTaskRepo(t, func(t *testing.T) biz.TaskRepo {
+			return NewTaskRepo()
+		})
+	})
+	t.Run("Execution", func(t *testing.T) {
+		repotest.RunExecutionRepo(t, func(t *testing.T) biz.ExecutionRepo {
+			return NewExecutionRepo()
+		})
+	})
+	t.Run("TaskRevision", func(t *testing.T) {
+		repotest.RunTaskRevisionRepo(t, func(t *testing.T) biz.TaskRevisionRepo {
+			return NewTaskRevisionRepo()
+		})
+	})
+	t.Run("Namespace", func(t *testing.T) {
+		repotest.RunNamespaceRepo(t, func(t *testing.T) biz.NamespaceRepo {
+			return NewNamespaceRepo()
+		})
+	})
+	t.Run("Secret", func(t *testing.T) {
+		repotest.RunSecretRepo(t, func(t *testing.T) biz.SecretRepo {
+			return NewSecretRepo()
+		})
+	})
+}
@@ -0,0 +1,109 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"heytom-scheduler/internal/biz"
+)
+
+type revisionKey struct {
+	taskID  int64
+	version int64
+}
+
+type taskRevisionRepo struct {
+	mu        sync.RWMutex
+	nextID    int64
+	revisions map[revisionKey]*biz.TaskRevision
+}
+
+// NewTaskRevisionRepo 创建内存任务修订仓储实例
+func NewTaskRevisionRepo() biz.TaskRevisionRepo {
+	return &taskRevisionRepo{
+		revisions: make(map[revisionKey]*biz.TaskRevision),
+	}
+}
+
+// CreateRevision 记录任务修订，与数据库的唯一索引一致，同一任务的版本重复时返回错误
+func (r *taskRevisionRepo) CreateRevision(ctx context.Context, revision *biz.TaskRevision) (*biz.TaskRevision, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := revisionKey{taskID: revision.TaskID, version: revision.Version}
+	if _, ok := r.revisions[key]; ok {
+		return nil, fmt.Errorf("revision %d of task %d already exists", revision.Version, revision.TaskID)
+	}
+	stored := copyRevision(revision)
+	r.nextID++
+	stored.ID = r.nextID
+	stored.CreatedAt = time.Now()
+	r.revisions[key] = stored
+
+	return copyRevision(stored), nil
+}
+
+// GetRevision 获取任务指定版本的修订，不存在时返回 nil
+func (r *taskRevisionRepo) GetRevision(ctx context.Context, taskID, version int64) (*biz.TaskRevision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	revision, ok := r.revisions[revisionKey{taskID: taskID, version: version}]
+	if !ok {
+		return nil, nil
+	}
+	return copyRevision(revision), nil
+}
+
+// ListRevisions 任务修订列表查询，按版本倒序
+func (r *taskRevisionRepo) ListRevisions(ctx context.Context, filter *biz.TaskRevisionListFilter) ([]*biz.TaskRevision, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matched []*biz.TaskRevision
+	for _, revision := range r.revisions {
+		if revision.TaskID == filter.TaskID {
+			matched = append(matched, revision)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].Version > matched[j].Version
+	})
+
+	start, end := paginate(len(matched), filter.Page, filter.PageSize)
+	result := make([]*biz.TaskRevision, 0, end-start)
+	for _, revision := range matched[start:end] {
+		result = append(result, copyRevision(revision))
+	}
+	return result, int64(len(matched)), nil
+}
+
+// DeleteTaskRevisions 删除任务的全部修订
+func (r *taskRevisionRepo) DeleteTaskRevisions(ctx context.Context, taskID int64) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int64
+	for key := range r.revisions {
+		if key.taskID == taskID {
+			delete(r.revisions, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// copyRevision 复制任务修订
+func copyRevision(revision *biz.TaskRevision) *biz.TaskRevision {
+	c := *revision
+	if revision.Snapshot != nil {
+		c.Snapshot = copyTask(revision.Snapshot)
+		c.Snapshot.InitialDelay = c.Snapshot.InitialDelay.Truncate(time.Millisecond)
+		c.Snapshot.Jitter = c.Snapshot.Jitter.Truncate(time.Millisecond)
+	}
+	c.Changes = append([]biz.TaskFieldChange{}, revision.Changes...)
+	return &c
+}
@@ -0,0 +1,146 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"heytom-scheduler/internal/biz"
+)
+
+type secretKey struct {
+	namespace string
+	name      string
+}
+
+type secretRepo struct {
+	mu      sync.RWMutex
+	nextID  int64
+	secrets map[secretKey]*biz.Secret
+}
+
+// NewSecretRepo 创建内存密钥仓储实例
+func NewSecretRepo() biz.SecretRepo {
+	return &secretRepo{secrets: make(map[secretKey]*biz.Secret)}
+}
+
+// CreateSecret 创建密钥
+func (r *secretRepo) CreateSecret(ctx context.Context, secret *biz.Secret) (*biz.Secret, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	stored := copySecret(secret)
+	r.nextID++
+	stored.ID = r.nextID
+	stored.Namespace = namespaceFor(ctx, secret.Namespace)
+	stored.CreatedAt = now
+	stored.UpdatedAt = now
+	r.secrets[secretKey{stored.Namespace, stored.Name}] = stored
+
+	return copySecret(stored), nil
+}
+
+// GetSecret 按命名空间和名称获取密钥，不存在时返回 nil
+func (r *secretRepo) GetSecret(ctx context.Context, namespace, name string) (*biz.Secret, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	secret, ok := r.secrets[secretKey{namespace, name}]
+	if !ok || !visible(ctx, secret.Namespace) {
+		return nil, nil
+	}
+	return copySecret(secret), nil
+}
+
+// UpdateSecret 更新密钥的描述、版本和密文，不存在时返回 nil
+func (r *secretRepo) UpdateSecret(ctx context.Context, secret *biz.Secret) (*biz.Secret, error) {
+	r.mu.Lock()
+	if stored, ok := r.secrets[secretKey{secret.Namespace, secret.Name}]; ok && visible(ctx, stored.Namespace) {
+		updated := copySecret(secret)
+		stored.Description = updated.Description
+		stored.Version = updated.Version
+		stored.Value = updated.Value
+		stored.UpdatedAt = time.Now()
+	}
+	r.mu.Unlock()
+
+	return r.GetSecret(ctx, secret.Namespace, secret.Name)
+}
+
+// DeleteSecret 删除密钥
+func (r *secretRepo) DeleteSecret(ctx context.Context, namespace, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := secretKey{namespace, name}
+	if secret, ok := r.secrets[key]; ok && visible(ctx, secret.Namespace) {
+		delete(r.secrets, key)
+	}
+	return nil
+}
+
+// ListSecrets 密钥列表查询，按命名空间和名称排序分页
+func (r *secretRepo) ListSecrets(ctx context.Context, filter *biz.SecretListFilter) ([]*biz.Secret, int64, error) {
+	matched := r.filter(func(secret *biz.Secret) bool {
+		if !visible(ctx, secret.Namespace) {
+			return false
+		}
+		if filter.Namespace != "" && secret.Namespace != filter.Namespace {
+			return false
+		}
+		return filter.Keyword == "" || containsFold(secret.Name, filter.Keyword) || containsFold(secret.Description, filter.Keyword)
+	})
+	sort.Slice(matched, func(i, j int) bool {
+		if matched[i].Namespace != matched[j].Namespace {
+			return matched[i].Namespace < matched[j].Namespace
+		}
+		return matched[i].Name < matched[j].Name
+	})
+
+	start, end := paginate(len(matched), filter.Page, filter.PageSize)
+	return matched[start:end], int64(len(matched)), nil
+}
+
+// ListSecretsToRewrap 获取数据密钥不是由主密钥 primaryKeyID 加密的密钥，按ID排序
+func (r *secretRepo) ListSecretsToRewrap(ctx context.Context, primaryKeyID string, limit int) ([]*biz.Secret, error) {
+	matched := r.filter(func(secret *biz.Secret) bool {
+		return visible(ctx, secret.Namespace) && secret.Value.KeyID != primaryKeyID
+	})
+	sort.Slice(matched, func(i, j int) bool { return matched[i].ID < matched[j].ID })
+	if len(matched) > limit {
+		matched = matched[:limit]
+	}
+	return matched, nil
+}
+
+// CountSecrets 统计命名空间中的密钥数
+func (r *secretRepo) CountSecrets(ctx context.Context, namespace string) (int64, error) {
+	matched := r.filter(func(secret *biz.Secret) bool {
+		return visible(ctx, secret.Namespace) && secret.Namespace == namespace
+	})
+	return int64(len(matched)), nil
+}
+
+// filter 返回满足条件的密钥副本
+func (r *secretRepo) filter(match func(*biz.Secret) bool) []*biz.Secret {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*biz.Secret
+	for _, secret := range r.secrets {
+		if match(secret) {
+			result = append(result, copySecret(secret))
+		}
+	}
+	return result
+}
+
+// copySecret 深拷贝密钥，密文不与调用方共享
+func copySecret(secret *biz.Secret) *biz.Secret {
+	c := *secret
+	c.Value.EncryptedKey = append([]byte(nil), secret.Value.EncryptedKey...)
+	c.Value.Ciphertext = append([]byte(nil), secret.Value.Ciphertext...)
+	return &c
+}
@@ -0,0 +1,514 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+)
+
+// defaultTimeout 任务超时时间的默认值，与 tasks.timeout 列的默认值一致
+const defaultTimeout = 300
+
+type taskRepo struct {
+	mu      sync.RWMutex
+	nextID  int64
+	tasks   map[int64]*biz.Task
+	deleted map[int64]*biz.Task // 已软删除的任务，与数据库实现一致不参与查询和更新
+}
+
+// NewTaskRepo 创建内存任务仓储实例
+func NewTaskRepo() biz.TaskRepo {
+	return &taskRepo{
+		tasks:   make(map[int64]*biz.Task),
+		deleted: make(map[int64]*biz.Task),
+	}
+}
+
+// CreateTask 创建任务
+func (r *taskRepo) CreateTask(ctx context.Context, task *biz.Task) (*biz.Task, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	stored := copyTask(task)
+	r.nextID++
+	stored.ID = r.nextID
+	stored.Namespace = namespaceFor(ctx, stored.Namespace)
+	if stored.Status == pb.TaskStatus_TASK_STATUS_UNSPECIFIED {
+		stored.Status = pb.TaskStatus_PENDING
+	}
+	if stored.Timeout == 0 {
+		stored.Timeout = defaultTimeout
+	}
+	// 数据库按毫秒保存延迟
+	stored.InitialDelay = stored.InitialDelay.Truncate(time.Millisecond)
+	stored.Jitter = stored.Jitter.Truncate(time.Millisecond)
+	if stored.CreatedAt.IsZero() {
+		stored.CreatedAt = now
+	}
+	stored.UpdatedAt = now
+	stored.RunCount, stored.ExecutionCount, stored.SuccessCount, stored.FailedCount = 0, 0, 0, 0
+	stored.Version = 1
+	r.tasks[stored.ID] = stored
+
+	return copyTask(stored), nil
+}
+
+// GetTask 获取任务详情，任务不存在或已删除时返回 nil
+func (r *taskRepo) GetTask(ctx context.Context, id int64) (*biz.Task, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	task, ok := r.tasks[id]
+	if !ok || !visible(ctx, task.Namespace) {
+		return nil, nil
+	}
+	return copyTask(task), nil
+}
+
+// GetDeletedTask 获取已删除的任务，任务不存在或未删除时返回 nil
+func (r *taskRepo) GetDeletedTask(ctx context.Context, id int64) (*biz.Task, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	task, ok := r.deleted[id]
+	if !ok || !visible(ctx, task.Namespace) {
+		return nil, nil
+	}
+	return copyTask(task), nil
+}
+
+// UpdateTask 更新任务定义并递增版本号，与数据库实现一致：fields 为空时只更新非零值字段，类型、状态和处理器只能通过 fields 修改
+func (r *taskRepo) UpdateTask(ctx context.Context, task *biz.Task, fields []string) (*biz.Task, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.tasks[task.ID]
+	if !ok || !visible(ctx, stored.Namespace) || (task.Version > 0 && stored.Version != task.Version) {
+		return nil, nil
+	}
+	if len(fields) > 0 {
+		for _, field := range fields {
+			setTaskField(stored, task, field)
+		}
+	} else {
+		updateNonZeroFields(stored, task)
+	}
+	stored.Version++
+	stored.UpdatedAt = time.Now()
+
+	return copyTask(stored), nil
+}
+
+// updateNonZeroFields 将 task 中的非零值字段写入 stored
+func updateNonZeroFields(stored, task *biz.Task) {
+	if task.Name != "" {
+		stored.Name = task.Name
+	}
+	if task.Description != "" {
+		stored.Description = task.Description
+	}
+	if task.Schedule != "" {
+		stored.Schedule = task.Schedule
+	}
+	if task.Payload != "" {
+		stored.Payload = task.Payload
+	}
+	if task.Timeout != 0 {
+		stored.Timeout = task.Timeout
+	}
+	if task.Metadata != nil {
+		stored.Metadata = copyMetadata(task.Metadata)
+	}
+	if task.CalendarID != 0 {
+		stored.CalendarID = task.CalendarID
+	}
+	if task.StartTime != nil {
+		stored.StartTime = copyTime(task.StartTime)
+	}
+	if task.EndTime != nil {
+		stored.EndTime = copyTime(task.EndTime)
+	}
+	if task.ActiveWindows != nil {
+		stored.ActiveWindows = append([]biz.TimeWindow{}, task.ActiveWindows...)
+	}
+	if task.MaxRuns != 0 {
+		stored.MaxRuns = task.MaxRuns
+	}
+	if task.IntervalMode != pb.IntervalMode_INTERVAL_MODE_UNSPECIFIED {
+		stored.IntervalMode = task.IntervalMode
+	}
+	if task.InitialDelay.Milliseconds() != 0 {
+		stored.InitialDelay = task.InitialDelay.Truncate(time.Millisecond)
+	}
+	if task.Jitter.Milliseconds() != 0 {
+		stored.Jitter = task.Jitter.Truncate(time.Millisecond)
+	}
+	if task.Priority != 0 {
+		stored.Priority = task.Priority
+	}
+	if task.LockGroup != "" {
+		stored.LockGroup = task.LockGroup
+	}
+	if task.ConcurrencyPolicy != pb.ConcurrencyPolicy_CONCURRENCY_POLICY_UNSPECIFIED {
+		stored.ConcurrencyPolicy = task.ConcurrencyPolicy
+	}
+	stored.Retention = task.Retention.Merge(stored.Retention)
+}
+
+// setTaskField 将 task 的 field 字段写入 stored，零值用于清空字段
+func setTaskField(stored, task *biz.Task, field string) {
+	switch field {
+	case "name":
+		stored.Name = task.Name
+	case "description":
+		stored.Description = task.Description
+	case "type":
+		stored.Type = task.Type
+	case "schedule":
+		stored.Schedule = task.Schedule
+	case "handler":
+		stored.Handler = task.Handler
+	case "payload":
+		stored.Payload = task.Payload
+	case "timeout":
+		stored.Timeout = task.Timeout
+	case "metadata":
+		stored.Metadata = copyMetadata(task.Metadata)
+	case "calendar_id":
+		stored.CalendarID = task.CalendarID
+	case "start_time":
+		stored.StartTime = copyTime(task.StartTime)
+	case "end_time":
+		stored.EndTime = copyTime(task.EndTime)
+	case "active_windows":
+		stored.ActiveWindows = append([]biz.TimeWindow{}, task.ActiveWindows...)
+	case "max_runs":
+		stored.MaxRuns = task.MaxRuns
+	case "interval_mode":
+		stored.IntervalMode = task.IntervalMode
+	case "initial_delay":
+		stored.InitialDelay = task.InitialDelay.Truncate(time.Millisecond)
+	case "jitter":
+		stored.Jitter = task.Jitter.Truncate(time.Millisecond)
+	case "priority":
+		stored.Priority = task.Priority
+	case "lock_group":
+		stored.LockGroup = task.LockGroup
+	case "concurrency_policy":
+		stored.ConcurrencyPolicy = task.ConcurrencyPolicy
+	case "retention":
+		stored.Retention = task.Retention
+	case "status":
+		stored.Status = task.Status
+		stored.StatusReason = task.StatusReason
+		stored.StatusChangedAt = copyTime(task.StatusChangedAt)
+	case "next_run_time":
+		stored.NextRunTime = copyTime(task.NextRunTime)
+	}
+}
+
+// DeleteTask 软删除任务
+func (r *taskRepo) DeleteTask(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	task, ok := r.tasks[id]
+	if !ok || !visible(ctx, task.Namespace) {
+		return nil
+	}
+	now := time.Now()
+	task.DeletedAt = &now
+	r.deleted[id] = task
+	delete(r.tasks, id)
+	return nil
+}
+
+// RestoreTask 恢复已删除的任务
+func (r *taskRepo) RestoreTask(ctx context.Context, id int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	task, ok := r.deleted[id]
+	if !ok || !visible(ctx, task.Namespace) {
+		return false, nil
+	}
+	task.DeletedAt = nil
+	task.UpdatedAt = time.Now()
+	r.tasks[id] = task
+	delete(r.deleted, id)
+	return true, nil
+}
+
+// PurgeTask 彻底删除已删除的任务
+func (r *taskRepo) PurgeTask(ctx context.Context, id int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if task, ok := r.deleted[id]; !ok || !visible(ctx, task.Namespace) {
+		return false, nil
+	}
+	delete(r.deleted, id)
+	return true, nil
+}
+
+// ListTasks 任务列表查询，按 filter.OrderBy 排序后按页码或游标分页
+func (r *taskRepo) ListTasks(ctx context.Context, filter *biz.TaskListFilter) ([]*biz.Task, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	candidates := make([]*biz.Task, 0, len(r.tasks)+len(r.deleted))
+	for _, task := range r.tasks {
+		candidates = append(candidates, task)
+	}
+	if filter.IncludeDeleted {
+		for _, task := range r.deleted {
+			candidates = append(candidates, task)
+		}
+	}
+
+	var matched []*biz.Task
+	for _, task := range candidates {
+		if !visible(ctx, task.Namespace) {
+			continue
+		}
+		if filter.Namespace != "" && task.Namespace != filter.Namespace {
+			continue
+		}
+		if filter.Status != pb.TaskStatus_TASK_STATUS_UNSPECIFIED && task.Status != filter.Status {
+			continue
+		}
+		if filter.Type != pb.TaskType_TASK_TYPE_UNSPECIFIED && task.Type != filter.Type {
+			continue
+		}
+		if filter.CalendarID > 0 && task.CalendarID != filter.CalendarID {
+			continue
+		}
+		if filter.Keyword != "" && !containsFold(task.Name, filter.Keyword) && !containsFold(task.Description, filter.Keyword) {
+			continue
+		}
+		if filter.Scopes != nil && !inAnyScope(task, filter.Scopes) {
+			continue
+		}
+		matched = append(matched, task)
+	}
+	var total int64
+	if !filter.SkipTotal {
+		total = int64(len(matched))
+	}
+
+	order := filter.OrderBy
+	indexes := seekPage(len(matched), order, filter.After, func(i int) (interface{}, int64) {
+		return biz.TaskSortValue(matched[i], order.Field), matched[i].ID
+	})
+	page := filter.Page
+	if filter.After != nil {
+		page = 1
+	}
+	start, end := paginate(len(indexes), page, filter.PageSize)
+	result := make([]*biz.Task, 0, end-start)
+	for _, i := range indexes[start:end] {
+		result = append(result, copyTask(matched[i]))
+	}
+	return result, total, nil
+}
+
+// CountTasks 统计命名空间中的任务数
+func (r *taskRepo) CountTasks(ctx context.Context, namespace string, includeDeleted bool) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, task := range r.tasks {
+		if task.Namespace == namespace && visible(ctx, task.Namespace) {
+			count++
+		}
+	}
+	if includeDeleted {
+		for _, task := range r.deleted {
+			if task.Namespace == namespace && visible(ctx, task.Namespace) {
+				count++
+			}
+		}
+	}
+	return count, nil
+}
+
+// TransitionTaskStatus 在任务状态仍为 from 时更新为 to，并记录状态变化的原因和时间，返回是否更新成功
+func (r *taskRepo) TransitionTaskStatus(ctx context.Context, id int64, from, to pb.TaskStatus, reason string, at time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	task, ok := r.tasks[id]
+	if !ok || !visible(ctx, task.Namespace) || task.Status != from {
+		return false, nil
+	}
+	task.Status = to
+	setStatusReason(task, reason, at)
+	task.UpdatedAt = time.Now()
+	return true, nil
+}
+
+// UpdateTaskNextRunTime 更新任务下次执行时间
+func (r *taskRepo) UpdateTaskNextRunTime(ctx context.Context, id int64, nextRunTime time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if task, ok := r.tasks[id]; ok && visible(ctx, task.Namespace) {
+		task.NextRunTime = &nextRunTime
+		task.UpdatedAt = time.Now()
+	}
+	return nil
+}
+
+// IncrementExecutionCount 增加执行次数
+func (r *taskRepo) IncrementExecutionCount(ctx context.Context, id int64, success bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if task, ok := r.tasks[id]; ok && visible(ctx, task.Namespace) {
+		task.ExecutionCount++
+		if success {
+			task.SuccessCount++
+		} else {
+			task.FailedCount++
+		}
+		task.UpdatedAt = time.Now()
+	}
+	return nil
+}
+
+// ListDueTasks 查询下次执行时间不晚于 now 的待调度任务，按下次执行时间升序
+func (r *taskRepo) ListDueTasks(ctx context.Context, now time.Time, limit int) ([]*biz.Task, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var due []*biz.Task
+	for _, task := range r.tasks {
+		if visible(ctx, task.Namespace) && task.Status == pb.TaskStatus_PENDING && task.NextRunTime != nil && !task.NextRunTime.After(now) {
+			due = append(due, task)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if !due[i].NextRunTime.Equal(*due[j].NextRunTime) {
+			return due[i].NextRunTime.Before(*due[j].NextRunTime)
+		}
+		return due[i].ID < due[j].ID
+	})
+	if limit >= 0 && len(due) > limit {
+		due = due[:limit]
+	}
+
+	result := make([]*biz.Task, 0, len(due))
+	for _, task := range due {
+		result = append(result, copyTask(task))
+	}
+	return result, nil
+}
+
+// AdvanceTask 在任务仍处于待调度且下次执行时间仍为 current 时推进到 next 并更新状态，返回是否抢占成功
+func (r *taskRepo) AdvanceTask(ctx context.Context, id int64, current time.Time, next *time.Time, status pb.TaskStatus, reason string, run bool) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	task, ok := r.tasks[id]
+	if !ok || !visible(ctx, task.Namespace) || task.Status != pb.TaskStatus_PENDING || task.NextRunTime == nil || !task.NextRunTime.Equal(current) {
+		return false, nil
+	}
+	task.NextRunTime = copyTime(next)
+	task.Status = status
+	if run {
+		task.RunCount++
+	}
+	if reason != "" {
+		setStatusReason(task, reason, time.Now())
+	}
+	task.UpdatedAt = time.Now()
+	return true, nil
+}
+
+// RevertAdvance 在任务仍为 status 且下次执行时间仍为 next 时恢复为待调度，下次执行时间恢复为 current，返回是否撤销成功
+func (r *taskRepo) RevertAdvance(ctx context.Context, id int64, current time.Time, next *time.Time, status pb.TaskStatus, reason string, run bool) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	task, ok := r.tasks[id]
+	if !ok || !visible(ctx, task.Namespace) || task.Status != status || (task.NextRunTime == nil) != (next == nil) ||
+		(next != nil && !task.NextRunTime.Equal(*next)) {
+		return false, nil
+	}
+	task.NextRunTime = copyTime(&current)
+	task.Status = pb.TaskStatus_PENDING
+	if run {
+		task.RunCount--
+	}
+	if reason != "" {
+		setStatusReason(task, reason, time.Now())
+	}
+	task.UpdatedAt = time.Now()
+	return true, nil
+}
+
+// RescheduleTask 在任务处于待调度且没有下次执行时间时设置下次执行时间并更新状态，返回是否更新成功
+func (r *taskRepo) RescheduleTask(ctx context.Context, id int64, next *time.Time, status pb.TaskStatus, reason string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	task, ok := r.tasks[id]
+	if !ok || !visible(ctx, task.Namespace) || task.Status != pb.TaskStatus_PENDING || task.NextRunTime != nil {
+		return false, nil
+	}
+	task.NextRunTime = copyTime(next)
+	task.Status = status
+	if reason != "" {
+		setStatusReason(task, reason, time.Now())
+	}
+	task.UpdatedAt = time.Now()
+	return true, nil
+}
+
+// setStatusReason 记录状态变化的原因和时间，时间与数据库一致精确到毫秒
+func setStatusReason(task *biz.Task, reason string, at time.Time) {
+	at = at.Truncate(time.Millisecond)
+	task.StatusReason = reason
+	task.StatusChangedAt = &at
+}
+
+// inAnyScope 判断任务是否至少在一个访问范围内
+func inAnyScope(task *biz.Task, scopes []biz.AccessScope) bool {
+	for _, scope := range scopes {
+		if scope.Matches(task) {
+			return true
+		}
+	}
+	return false
+}
+
+// copyTask 深拷贝任务，避免调用方修改仓储中保存的数据
+func copyTask(task *biz.Task) *biz.Task {
+	c := *task
+	c.Metadata = copyMetadata(task.Metadata)
+	c.ActiveWindows = append([]biz.TimeWindow{}, task.ActiveWindows...)
+	c.StartTime = copyTime(task.StartTime)
+	c.EndTime = copyTime(task.EndTime)
+	c.NextRunTime = copyTime(task.NextRunTime)
+	c.DeletedAt = copyTime(task.DeletedAt)
+	c.StatusChangedAt = copyTime(task.StatusChangedAt)
+	return &c
+}
+
+// copyMetadata 复制元数据
+func copyMetadata(metadata map[string]string) map[string]string {
+	if metadata == nil {
+		return nil
+	}
+	c := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		c[k] = v
+	}
+	return c
+}
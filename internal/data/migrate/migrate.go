@@ -0,0 +1,491 @@
+// Package migrate 管理数据库表结构的版本化迁移
+// 迁移脚本按数据库方言嵌入在 migrations/<dialect>/ 目录下，文件名格式为 <version>_<name>.up.sql 和 <version>_<name>.down.sql，
+// 已执行的迁移记录在 schema_migrations 表中，并保存 up 脚本的校验和用于检测已执行的脚本被修改
+// PostgreSQL 和 SQLite 的迁移在事务中执行；MySQL 的 DDL 会隐式提交，迁移逐条执行并记录进度，失败后从失败的语句继续
+package migrate
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+//go:embed migrations
+var migrationsFS embed.FS
+
+const (
+	// lockName MySQL 迁移锁的锁名后缀
+	lockName = ".schema_migrations"
+	// lockID PostgreSQL 迁移锁的咨询锁 ID
+	lockID int64 = 0x6865_7974_6f6d
+)
+
+var (
+	// ErrNotMigrated 数据库存在未执行的迁移
+	ErrNotMigrated = errors.New("database schema is not migrated")
+	// ErrNewerSchema 数据库已执行当前程序不认识的更高版本迁移
+	ErrNewerSchema = errors.New("database schema is newer than this binary")
+	// ErrChecksumMismatch 已执行的迁移脚本与当前程序内嵌的脚本不一致
+	ErrChecksumMismatch = errors.New("migration checksum mismatch")
+)
+
+// Migration 一个版本的迁移脚本
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+// SchemaMigration 迁移执行记录模型
+type SchemaMigration struct {
+	Version   int64     `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"type:varchar(255);not null"`
+	Checksum  string    `gorm:"type:varchar(64);not null"`
+	Progress  int       `gorm:"not null;default:0"` // 未执行完的迁移已执行的语句数，0 表示迁移已完成
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+// TableName 指定表名
+func (SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// Status 迁移状态
+type Status struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt *time.Time
+	// Missing 为 true 表示数据库中已执行但当前程序中不存在的迁移
+	Missing bool
+	// Modified 为 true 表示已执行的脚本校验和与当前程序内嵌的脚本不一致
+	Modified bool
+	// Progress 未执行完的迁移已执行的语句数，此时 Applied 为 false
+	Progress int
+}
+
+// Migrator 数据库迁移器
+type Migrator struct {
+	db         *gorm.DB
+	migrations []*Migration
+	// atomicDDL DDL 是否可以在事务中回滚，MySQL 的 DDL 会隐式提交
+	atomicDDL bool
+	log       *log.Helper
+}
+
+// New 按数据库方言加载内嵌的迁移脚本并创建迁移器
+func New(db *gorm.DB, logger log.Logger) (*Migrator, error) {
+	dialect := db.Dialector.Name()
+	sub, err := fs.Sub(migrationsFS, path.Join("migrations", dialect))
+	if err != nil {
+		return nil, err
+	}
+	migrations, err := Load(sub)
+	if err != nil {
+		return nil, fmt.Errorf("load %s migrations: %w", dialect, err)
+	}
+	if len(migrations) == 0 {
+		return nil, fmt.Errorf("no migrations for database driver %q", dialect)
+	}
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+		atomicDDL:  dialect != "mysql",
+		log:        log.NewHelper(logger),
+	}, nil
+}
+
+// Load 从 fsys 根目录加载迁移脚本，按版本升序返回，每个版本必须同时有 up 和 down 脚本
+func Load(fsys fs.FS) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".sql") {
+			continue
+		}
+		base := strings.TrimSuffix(entry.Name(), ".sql")
+		direction := path.Ext(base)
+		base = strings.TrimSuffix(base, direction)
+		prefix, name, ok := strings.Cut(base, "_")
+		if !ok || (direction != ".up" && direction != ".down") {
+			return nil, fmt.Errorf("invalid migration file name %q", entry.Name())
+		}
+		version, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version in %q", entry.Name())
+		}
+
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		} else if m.Name != name {
+			return nil, fmt.Errorf("migration %d has conflicting names %q and %q", version, m.Name, name)
+		}
+		if direction == ".up" {
+			m.Up = string(content)
+			sum := sha256.Sum256(content)
+			m.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s must have both up and down scripts", m.Version, m.Name)
+		}
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Latest 返回当前程序内嵌的最新迁移版本
+func (m *Migrator) Latest() int64 {
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Status 返回所有迁移的状态，按版本升序
+func (m *Migrator) Status(ctx context.Context) ([]*Status, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []*Status
+	for _, migration := range m.migrations {
+		s := &Status{Version: migration.Version, Name: migration.Name}
+		if record, ok := applied[migration.Version]; ok {
+			appliedAt := record.AppliedAt
+			s.Applied = record.Progress == 0
+			s.AppliedAt = &appliedAt
+			s.Modified = record.Checksum != migration.Checksum
+			s.Progress = record.Progress
+			delete(applied, migration.Version)
+		}
+		statuses = append(statuses, s)
+	}
+	for _, record := range applied {
+		appliedAt := record.AppliedAt
+		statuses = append(statuses, &Status{
+			Version:   record.Version,
+			Name:      record.Name,
+			Applied:   true,
+			AppliedAt: &appliedAt,
+			Missing:   true,
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Version < statuses[j].Version
+	})
+	return statuses, nil
+}
+
+// Verify 检查数据库表结构与当前程序一致：所有迁移均已执行、没有未知的更高版本且校验和一致
+func (m *Migrator) Verify(ctx context.Context) error {
+	if err := m.verifyApplied(ctx); err != nil {
+		return err
+	}
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	var pending []string
+	for _, s := range statuses {
+		if s.Progress > 0 {
+			pending = append(pending, fmt.Sprintf("%d (partially applied)", s.Version))
+		} else if !s.Applied {
+			pending = append(pending, strconv.FormatInt(s.Version, 10))
+		}
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: pending migrations %s, run the migrate command", ErrNotMigrated, strings.Join(pending, ", "))
+	}
+	return nil
+}
+
+// Up 按版本升序执行所有未执行的迁移，返回执行的迁移数
+// 执行期间持有迁移锁，多个节点同时执行时只有一个节点执行迁移，其余节点等待后看到已执行的迁移
+func (m *Migrator) Up(ctx context.Context) (count int, err error) {
+	err = m.withLock(ctx, func(locked *Migrator) error {
+		count, err = locked.up(ctx)
+		return err
+	})
+	return count, err
+}
+
+// up 在持有迁移锁的连接上执行所有未执行的迁移，部分执行的迁移从未执行的语句继续
+func (m *Migrator) up(ctx context.Context) (int, error) {
+	if err := m.verifyApplied(ctx); err != nil {
+		return 0, err
+	}
+	if err := m.ensureTable(ctx); err != nil {
+		return 0, err
+	}
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, migration := range m.migrations {
+		done := 0
+		if record, ok := applied[migration.Version]; ok {
+			if record.Progress == 0 {
+				continue
+			}
+			done = record.Progress
+		}
+		if m.atomicDDL {
+			err = m.applyInTransaction(ctx, migration)
+		} else {
+			err = m.applyByStatement(ctx, migration, done)
+		}
+		if err != nil {
+			return count, fmt.Errorf("apply migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+		m.log.WithContext(ctx).Infof("applied migration %d_%s", migration.Version, migration.Name)
+		count++
+	}
+	return count, nil
+}
+
+// applyInTransaction 在一个事务中执行迁移并记录，失败时整体回滚
+func (m *Migrator) applyInTransaction(ctx context.Context, migration *Migration) error {
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := execScript(tx, migration.Up); err != nil {
+			return err
+		}
+		return tx.Create(&SchemaMigration{
+			Version:   migration.Version,
+			Name:      migration.Name,
+			Checksum:  migration.Checksum,
+			AppliedAt: time.Now(),
+		}).Error
+	})
+}
+
+// applyByStatement 从第 done 条语句开始逐条执行迁移，每条语句执行后记录进度
+// 用于 DDL 无法回滚的数据库：失败时已执行的语句保留，修复问题后重新执行迁移从失败的语句继续
+func (m *Migrator) applyByStatement(ctx context.Context, migration *Migration, done int) error {
+	db := m.db.WithContext(ctx)
+	stmts := splitStatements(migration.Up)
+	if done > 0 {
+		m.log.WithContext(ctx).Infof("resuming migration %d_%s at statement %d of %d", migration.Version, migration.Name, done+1, len(stmts))
+	}
+
+	record := &SchemaMigration{
+		Version:  migration.Version,
+		Name:     migration.Name,
+		Checksum: migration.Checksum,
+	}
+	for i := done; i < len(stmts); i++ {
+		if err := db.Exec(stmts[i]).Error; err != nil {
+			return fmt.Errorf("statement %d of %d failed, the %d statement(s) before it stay applied and the next run resumes from it: %w", i+1, len(stmts), i, err)
+		}
+		if i+1 < len(stmts) {
+			record.Progress = i + 1
+			record.AppliedAt = time.Now()
+			if err := db.Save(record).Error; err != nil {
+				return fmt.Errorf("record progress after statement %d of %d: %w", i+1, len(stmts), err)
+			}
+		}
+	}
+	record.Progress = 0
+	record.AppliedAt = time.Now()
+	return db.Save(record).Error
+}
+
+// Down 按版本降序回滚最近执行的 steps 个迁移，返回回滚的迁移数
+func (m *Migrator) Down(ctx context.Context, steps int) (count int, err error) {
+	err = m.withLock(ctx, func(locked *Migrator) error {
+		count, err = locked.down(ctx, steps)
+		return err
+	})
+	return count, err
+}
+
+// down 在持有迁移锁的连接上回滚最近执行的 steps 个迁移
+func (m *Migrator) down(ctx context.Context, steps int) (int, error) {
+	if err := m.verifyApplied(ctx); err != nil {
+		return 0, err
+	}
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, record := range applied {
+		if record.Progress > 0 {
+			return 0, fmt.Errorf("migration %d_%s is partially applied, run the up command to finish it before reverting", record.Version, record.Name)
+		}
+	}
+
+	count := 0
+	for i := len(m.migrations) - 1; i >= 0 && count < steps; i-- {
+		migration := m.migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+		err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := execScript(tx, migration.Down); err != nil {
+				return err
+			}
+			return tx.Delete(&SchemaMigration{}, migration.Version).Error
+		})
+		if err != nil {
+			return count, fmt.Errorf("revert migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+		m.log.WithContext(ctx).Infof("reverted migration %d_%s", migration.Version, migration.Name)
+		count++
+	}
+	return count, nil
+}
+
+// withLock 在同一个数据库连接上持有迁移锁并执行 fn，fn 收到的迁移器只使用该连接
+// MySQL 和 PostgreSQL 使用会话级的咨询锁；SQLite 只使用一个连接，写事务本身是串行的
+func (m *Migrator) withLock(ctx context.Context, fn func(locked *Migrator) error) error {
+	return m.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		var unlock func(db *gorm.DB) error
+		switch conn.Dialector.Name() {
+		case "mysql":
+			// GET_LOCK 的锁名在整个 MySQL 实例内共享，带上库名避免不同库的迁移互相等待
+			var acquired sql.NullInt64
+			if err := conn.Raw("SELECT GET_LOCK(CONCAT(DATABASE(), ?), ?)", lockName, -1).Scan(&acquired).Error; err != nil {
+				return fmt.Errorf("acquire migration lock: %w", err)
+			}
+			if acquired.Int64 != 1 {
+				return errors.New("acquire migration lock: GET_LOCK failed")
+			}
+			unlock = func(db *gorm.DB) error {
+				return db.Exec("SELECT RELEASE_LOCK(CONCAT(DATABASE(), ?))", lockName).Error
+			}
+		case "postgres":
+			if err := conn.Exec("SELECT pg_advisory_lock(?)", lockID).Error; err != nil {
+				return fmt.Errorf("acquire migration lock: %w", err)
+			}
+			unlock = func(db *gorm.DB) error {
+				return db.Exec("SELECT pg_advisory_unlock(?)", lockID).Error
+			}
+		}
+
+		err := fn(&Migrator{db: conn, migrations: m.migrations, atomicDDL: m.atomicDDL, log: m.log})
+		if unlock != nil {
+			// ctx 已取消时仍需释放锁，否则连接放回连接池后会一直持有锁
+			if unlockErr := unlock(conn.WithContext(context.WithoutCancel(ctx))); unlockErr != nil {
+				m.log.WithContext(ctx).Errorf("release migration lock: %v", unlockErr)
+			}
+		}
+		return err
+	})
+}
+
+// verifyApplied 检查已执行的迁移都能在当前程序中找到且校验和一致
+func (m *Migrator) verifyApplied(ctx context.Context) error {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	for _, s := range statuses {
+		if s.Missing {
+			if s.Version > m.Latest() {
+				return fmt.Errorf("%w: applied migration %d_%s, latest known %d", ErrNewerSchema, s.Version, s.Name, m.Latest())
+			}
+			return fmt.Errorf("applied migration %d_%s is unknown to this binary", s.Version, s.Name)
+		}
+		if s.Modified {
+			return fmt.Errorf("%w: migration %d_%s was modified after it was applied", ErrChecksumMismatch, s.Version, s.Name)
+		}
+	}
+	return nil
+}
+
+// ensureTable schema_migrations 表不存在时创建，旧版本创建的表缺少 progress 列时补充
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	db := m.db.WithContext(ctx)
+	if !db.Migrator().HasTable(&SchemaMigration{}) {
+		return db.Migrator().CreateTable(&SchemaMigration{})
+	}
+	if !db.Migrator().HasColumn(&SchemaMigration{}, "Progress") {
+		return db.Migrator().AddColumn(&SchemaMigration{}, "Progress")
+	}
+	return nil
+}
+
+// applied 返回已执行的迁移记录，schema_migrations 表不存在时视为没有执行过任何迁移
+func (m *Migrator) applied(ctx context.Context) (map[int64]*SchemaMigration, error) {
+	db := m.db.WithContext(ctx)
+	if !db.Migrator().HasTable(&SchemaMigration{}) {
+		return map[int64]*SchemaMigration{}, nil
+	}
+
+	var records []*SchemaMigration
+	if err := db.Order("version").Find(&records).Error; err != nil {
+		return nil, err
+	}
+	applied := make(map[int64]*SchemaMigration, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+	return applied, nil
+}
+
+// execScript 逐条执行脚本中的 SQL 语句，语句以行尾的分号结束，以 -- 开头的行为注释
+func execScript(tx *gorm.DB, script string) error {
+	for _, stmt := range splitStatements(script) {
+		if err := tx.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitStatements 将脚本拆分为单条 SQL 语句
+func splitStatements(script string) []string {
+	var (
+		stmts   []string
+		current strings.Builder
+	)
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		current.WriteString(line)
+		current.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			stmts = append(stmts, strings.TrimSuffix(strings.TrimSpace(current.String()), ";"))
+			current.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(current.String()); rest != "" {
+		stmts = append(stmts, rest)
+	}
+	return stmts
+}
@@ -0,0 +1,150 @@
+package migrate
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/glebarez/sqlite"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// newTestMigrator 在临时 SQLite 数据库上创建使用 scripts 的迁移器，atomicDDL 为 false 时按 MySQL 的方式逐条执行
+func newTestMigrator(t *testing.T, atomicDDL bool, scripts fstest.MapFS) *Migrator {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "migrate.db")), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	migrations, err := Load(scripts)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	return &Migrator{db: db, migrations: migrations, atomicDDL: atomicDDL, log: log.NewHelper(log.DefaultLogger)}
+}
+
+// script 由多行 SQL 组成的迁移脚本
+func script(stmts ...string) *fstest.MapFile {
+	return &fstest.MapFile{Data: []byte(strings.Join(stmts, "\n") + "\n")}
+}
+
+func TestUp(t *testing.T) {
+	for _, atomicDDL := range []bool{true, false} {
+		name := "atomic"
+		if !atomicDDL {
+			name = "by statement"
+		}
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			m := newTestMigrator(t, atomicDDL, fstest.MapFS{
+				"0001_a.up.sql":   script("CREATE TABLE a (id INTEGER);", "CREATE TABLE b (id INTEGER);"),
+				"0001_a.down.sql": script("DROP TABLE b;", "DROP TABLE a;"),
+				"0002_c.up.sql":   script("CREATE TABLE c (id INTEGER);"),
+				"0002_c.down.sql": script("DROP TABLE c;"),
+			})
+
+			if n, err := m.Up(ctx); err != nil || n != 2 {
+				t.Fatalf("Up = %d, %v, want 2", n, err)
+			}
+			if err := m.Verify(ctx); err != nil {
+				t.Fatalf("Verify: %v", err)
+			}
+			if n, err := m.Up(ctx); err != nil || n != 0 {
+				t.Fatalf("second Up = %d, %v, want 0", n, err)
+			}
+			if n, err := m.Down(ctx, 2); err != nil || n != 2 {
+				t.Fatalf("Down = %d, %v, want 2", n, err)
+			}
+			if m.db.Migrator().HasTable("a") {
+				t.Fatalf("table a still exists after Down")
+			}
+		})
+	}
+}
+
+func TestUpFailure(t *testing.T) {
+	scripts := fstest.MapFS{
+		"0001_a.up.sql":   script("CREATE TABLE a (id INTEGER);", "CREATE TABLE b (id INTEGER);", "CREATE TABLE a (id INTEGER);"),
+		"0001_a.down.sql": script("DROP TABLE b;", "DROP TABLE a;"),
+	}
+
+	t.Run("atomic", func(t *testing.T) {
+		ctx := context.Background()
+		m := newTestMigrator(t, true, scripts)
+		if _, err := m.Up(ctx); err == nil {
+			t.Fatalf("Up succeeded, want error")
+		}
+		// 事务回滚后没有执行任何语句
+		if m.db.Migrator().HasTable("a") || m.db.Migrator().HasTable("b") {
+			t.Fatalf("failed migration left tables behind")
+		}
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			t.Fatalf("Status: %v", err)
+		}
+		if statuses[0].Applied || statuses[0].Progress != 0 {
+			t.Fatalf("status = %+v, want pending", statuses[0])
+		}
+	})
+
+	t.Run("by statement", func(t *testing.T) {
+		ctx := context.Background()
+		m := newTestMigrator(t, false, scripts)
+		_, err := m.Up(ctx)
+		if err == nil || !strings.Contains(err.Error(), "statement 3 of 3") {
+			t.Fatalf("Up err = %v, want failure at statement 3", err)
+		}
+
+		// 已执行的语句保留并记录进度
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			t.Fatalf("Status: %v", err)
+		}
+		if statuses[0].Applied || statuses[0].Progress != 2 {
+			t.Fatalf("status = %+v, want 2 statements applied", statuses[0])
+		}
+		if err := m.Verify(ctx); err == nil || !strings.Contains(err.Error(), "partially applied") {
+			t.Fatalf("Verify err = %v, want partially applied", err)
+		}
+		if _, err := m.Down(ctx, 1); err == nil || !strings.Contains(err.Error(), "partially applied") {
+			t.Fatalf("Down err = %v, want partially applied", err)
+		}
+
+		// 修复脚本后从失败的语句继续，不重复执行已执行的语句
+		m.db.Exec("DROP TABLE a")
+		if n, err := m.Up(ctx); err != nil || n != 1 {
+			t.Fatalf("resumed Up = %d, %v, want 1", n, err)
+		}
+		if err := m.Verify(ctx); err != nil {
+			t.Fatalf("Verify: %v", err)
+		}
+	})
+}
+
+func TestEnsureTableAddsProgress(t *testing.T) {
+	ctx := context.Background()
+	m := newTestMigrator(t, true, fstest.MapFS{
+		"0001_a.up.sql":   script("CREATE TABLE a (id INTEGER);"),
+		"0001_a.down.sql": script("DROP TABLE a;"),
+	})
+	// 旧版本创建的 schema_migrations 表没有 progress 列
+	if err := m.db.Exec("CREATE TABLE schema_migrations (version INTEGER PRIMARY KEY, name TEXT NOT NULL, checksum TEXT NOT NULL, applied_at DATETIME NOT NULL)").Error; err != nil {
+		t.Fatal(err)
+	}
+	if n, err := m.Up(ctx); err != nil || n != 1 {
+		t.Fatalf("Up = %d, %v, want 1", n, err)
+	}
+	if !m.db.Migrator().HasColumn(&SchemaMigration{}, "Progress") {
+		t.Fatalf("progress column was not added")
+	}
+}
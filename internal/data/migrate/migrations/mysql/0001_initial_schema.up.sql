@@ -0,0 +1,142 @@
+-- 初始表结构
+-- tasks 和 task_executions 与引入迁移之前的 init_db.sql 完全一致，已存在的表（旧版 init_db.sql 或 AutoMigrate 创建）保持不变，
+-- 之后新增的列和索引通过 ALTER TABLE 添加，使已有数据库和新数据库得到相同的表结构
+
+-- ============================================
+-- 任务表
+-- ============================================
+CREATE TABLE IF NOT EXISTS `tasks` (
+  `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '任务ID',
+  `name` VARCHAR(255) NOT NULL COMMENT '任务名称',
+  `description` TEXT COMMENT '任务描述',
+  `type` VARCHAR(20) NOT NULL COMMENT '任务类型: IMMEDIATE(立即执行), SCHEDULED(指定时间), CRON(Cron表达式), INTERVAL(固定间隔)',
+  `status` VARCHAR(20) NOT NULL DEFAULT 'PENDING' COMMENT '任务状态: PENDING(等待中), RUNNING(运行中), PAUSED(已暂停), COMPLETED(已完成), FAILED(失败), CANCELLED(已取消)',
+  `schedule` VARCHAR(255) DEFAULT NULL COMMENT '调度配置: Cron表达式、时间戳或间隔秒数',
+  `handler` VARCHAR(255) NOT NULL COMMENT '处理器名称',
+  `payload` TEXT COMMENT '任务负载(JSON格式)',
+  `timeout` INT(11) DEFAULT 300 COMMENT '超时时间(秒)',
+  `metadata` JSON COMMENT '元数据',
+  `next_run_time` DATETIME DEFAULT NULL COMMENT '下次执行时间',
+  `execution_count` BIGINT(20) DEFAULT 0 COMMENT '执行次数',
+  `success_count` BIGINT(20) DEFAULT 0 COMMENT '成功次数',
+  `failed_count` BIGINT(20) DEFAULT 0 COMMENT '失败次数',
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_name` (`name`),
+  KEY `idx_type` (`type`),
+  KEY `idx_status` (`status`),
+  KEY `idx_next_run_time` (`next_run_time`),
+  KEY `idx_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='任务表';
+
+ALTER TABLE `tasks`
+  MODIFY COLUMN `schedule` VARCHAR(255) DEFAULT NULL COMMENT '调度配置: Cron表达式、时间戳或间隔(Go时长格式或秒数)',
+  MODIFY COLUMN `next_run_time` DATETIME(3) DEFAULT NULL COMMENT '下次执行时间',
+  MODIFY COLUMN `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间',
+  MODIFY COLUMN `updated_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '更新时间',
+  ADD COLUMN `calendar_id` BIGINT(20) DEFAULT 0 COMMENT '业务日历ID',
+  ADD COLUMN `start_time` DATETIME(3) DEFAULT NULL COMMENT '生效开始时间',
+  ADD COLUMN `end_time` DATETIME(3) DEFAULT NULL COMMENT '生效结束时间',
+  ADD COLUMN `active_windows` JSON COMMENT '每日执行时间窗口',
+  ADD COLUMN `max_runs` BIGINT(20) DEFAULT 0 COMMENT '最大执行次数(0表示不限制)',
+  ADD COLUMN `interval_mode` VARCHAR(32) COMMENT '间隔模式: FIXED_RATE, FIXED_DELAY',
+  ADD COLUMN `initial_delay` BIGINT(20) DEFAULT 0 COMMENT '首次执行延迟(毫秒)',
+  ADD COLUMN `jitter` BIGINT(20) DEFAULT 0 COMMENT '随机延迟上限(毫秒)',
+  ADD COLUMN `priority` INT(11) DEFAULT 0 COMMENT '优先级(0-9，数值越大越优先)',
+  ADD COLUMN `lock_group` VARCHAR(255) DEFAULT NULL COMMENT '互斥组(同组任务在集群内不会同时执行)',
+  ADD COLUMN `concurrency_policy` VARCHAR(32) COMMENT '互斥组被占用时的并发策略: WAIT(等待), SKIP(跳过)',
+  ADD KEY `idx_calendar_id` (`calendar_id`),
+  ADD KEY `idx_lock_group` (`lock_group`);
+
+-- ============================================
+-- 任务执行记录表
+-- ============================================
+CREATE TABLE IF NOT EXISTS `task_executions` (
+  `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '执行记录ID',
+  `task_id` BIGINT(20) NOT NULL COMMENT '任务ID',
+  `task_name` VARCHAR(255) NOT NULL COMMENT '任务名称',
+  `status` VARCHAR(20) NOT NULL COMMENT '执行状态: QUEUED(队列中), EXECUTING(执行中), SUCCESS(成功), EXECUTION_FAILED(失败), TIMEOUT(超时), EXECUTION_CANCELLED(已取消)',
+  `node_id` VARCHAR(100) DEFAULT NULL COMMENT '执行节点ID',
+  `start_time` DATETIME DEFAULT NULL COMMENT '开始时间',
+  `end_time` DATETIME DEFAULT NULL COMMENT '结束时间',
+  `duration` INT(11) DEFAULT NULL COMMENT '执行耗时(毫秒)',
+  `result` TEXT COMMENT '执行结果',
+  `error` TEXT COMMENT '错误信息',
+  `retry_count` INT(11) DEFAULT 0 COMMENT '重试次数',
+  `payload` TEXT COMMENT '执行负载(JSON格式)',
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_task_id` (`task_id`),
+  KEY `idx_status` (`status`),
+  KEY `idx_node_id` (`node_id`),
+  KEY `idx_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='任务执行记录表';
+
+ALTER TABLE `task_executions`
+  MODIFY COLUMN `status` VARCHAR(20) NOT NULL COMMENT '执行状态: QUEUED(队列中), EXECUTING(执行中), SUCCESS(成功), EXECUTION_FAILED(失败), TIMEOUT(超时), EXECUTION_CANCELLED(已取消), EXECUTION_SKIPPED(已跳过)',
+  MODIFY COLUMN `start_time` DATETIME(3) DEFAULT NULL COMMENT '开始时间',
+  MODIFY COLUMN `end_time` DATETIME(3) DEFAULT NULL COMMENT '结束时间',
+  MODIFY COLUMN `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间',
+  ADD COLUMN `priority` INT(11) DEFAULT 0 COMMENT '优先级(0-9)',
+  ADD COLUMN `wait_time` INT(11) DEFAULT 0 COMMENT '开始执行前的排队等待耗时(毫秒)';
+
+-- ============================================
+-- 业务日历表
+-- ============================================
+CREATE TABLE `calendars` (
+  `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '日历ID',
+  `name` VARCHAR(255) NOT NULL COMMENT '日历名称',
+  `description` TEXT COMMENT '日历描述',
+  `timezone` VARCHAR(64) DEFAULT NULL COMMENT 'IANA时区',
+  `rules` JSON COMMENT '日历规则: 包含/排除的日期、日期区间和按周规则',
+  `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间',
+  `updated_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_name` (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='业务日历表';
+
+-- ============================================
+-- 资源池表
+-- ============================================
+CREATE TABLE `resource_pools` (
+  `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '资源池ID',
+  `name` VARCHAR(255) NOT NULL COMMENT '资源池名称',
+  `description` TEXT COMMENT '资源池描述',
+  `handler` VARCHAR(255) DEFAULT NULL COMMENT '匹配的处理器名称',
+  `label_key` VARCHAR(255) DEFAULT NULL COMMENT '匹配的元数据标签键',
+  `label_value` VARCHAR(255) DEFAULT NULL COMMENT '匹配的元数据标签值(为空匹配任意值)',
+  `max_concurrency` INT(11) DEFAULT 0 COMMENT '每个节点的最大并发执行数(0表示不限制)',
+  `rate_limit` DOUBLE DEFAULT 0 COMMENT '每个节点每秒允许开始的执行数(0表示不限制)',
+  `burst` INT(11) DEFAULT 0 COMMENT '令牌桶容量',
+  `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间',
+  `updated_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_name` (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='资源池表';
+
+-- ============================================
+-- 互斥组锁表
+-- ============================================
+CREATE TABLE `task_locks` (
+  `lock_group` VARCHAR(255) NOT NULL COMMENT '互斥组',
+  `owner` VARCHAR(255) NOT NULL COMMENT '持有者: 执行节点/执行记录ID',
+  `acquired_at` DATETIME(3) NOT NULL COMMENT '获取时间',
+  `expires_at` DATETIME(3) NOT NULL COMMENT '过期时间',
+  PRIMARY KEY (`lock_group`),
+  KEY `idx_expires_at` (`expires_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='互斥组锁表';
+
+-- ============================================
+-- 执行队列表（data.queue.driver 为 database 时使用）
+-- ============================================
+CREATE TABLE `execution_queue` (
+  `execution_id` BIGINT(20) NOT NULL COMMENT '执行记录ID',
+  `priority` INT(11) DEFAULT 0 COMMENT '优先级(0-9)',
+  `queue_score` BIGINT(20) NOT NULL COMMENT '出队顺序(入队毫秒时间戳减去优先级折算的时长，值越小越先执行)',
+  `available_at` BIGINT(20) NOT NULL COMMENT '可出队的毫秒时间戳',
+  `reserved_until` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '出队后的可见性超时毫秒时间戳(0表示未出队)',
+  `consumer` VARCHAR(255) DEFAULT NULL COMMENT '出队的执行节点',
+  PRIMARY KEY (`execution_id`),
+  KEY `idx_queue_score` (`queue_score`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='执行队列表';
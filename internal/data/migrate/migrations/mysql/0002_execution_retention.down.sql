@@ -0,0 +1,4 @@
+ALTER TABLE `tasks`
+  DROP COLUMN `retention_keep_last`,
+  DROP COLUMN `retention_keep_days`,
+  DROP COLUMN `retention_failed_keep_days`;
@@ -0,0 +1,5 @@
+-- 任务级执行记录保留策略
+ALTER TABLE `tasks`
+  ADD COLUMN `retention_keep_last` INT(11) DEFAULT 0 COMMENT '保留最近的执行记录数(0表示使用全局配置)' AFTER `concurrency_policy`,
+  ADD COLUMN `retention_keep_days` INT(11) DEFAULT 0 COMMENT '执行记录保留天数(0表示使用全局配置)' AFTER `retention_keep_last`,
+  ADD COLUMN `retention_failed_keep_days` INT(11) DEFAULT 0 COMMENT '失败和超时的执行记录保留天数(0表示使用全局配置)' AFTER `retention_keep_days`;
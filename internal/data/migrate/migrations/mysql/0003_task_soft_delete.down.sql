@@ -0,0 +1,3 @@
+ALTER TABLE `tasks`
+  DROP KEY `idx_deleted_at`,
+  DROP COLUMN `deleted_at`;
@@ -0,0 +1,4 @@
+-- 任务软删除
+ALTER TABLE `tasks`
+  ADD COLUMN `deleted_at` DATETIME(3) DEFAULT NULL COMMENT '删除时间(非空表示已删除)' AFTER `updated_at`,
+  ADD KEY `idx_deleted_at` (`deleted_at`);
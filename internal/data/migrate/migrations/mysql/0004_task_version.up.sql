@@ -0,0 +1,3 @@
+-- 任务乐观锁版本号
+ALTER TABLE `tasks`
+  ADD COLUMN `version` BIGINT(20) NOT NULL DEFAULT 1 COMMENT '版本号(每次更新任务定义时递增)' AFTER `retention_failed_keep_days`;
@@ -0,0 +1,18 @@
+-- 任务修订表：每次创建、更新或回滚任务定义时记录一条
+CREATE TABLE IF NOT EXISTS `task_revisions` (
+  `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '修订ID',
+  `task_id` BIGINT(20) NOT NULL COMMENT '任务ID',
+  `version` BIGINT(20) NOT NULL COMMENT '任务版本',
+  `action` VARCHAR(32) NOT NULL COMMENT '修订动作: REVISION_CREATED(创建), REVISION_UPDATED(更新), REVISION_ROLLED_BACK(回滚)',
+  `author` VARCHAR(255) DEFAULT NULL COMMENT '操作者',
+  `snapshot` JSON COMMENT '任务定义快照',
+  `changes` JSON COMMENT '相对上一版本变化的字段',
+  `source_version` BIGINT(20) DEFAULT 0 COMMENT '回滚时的目标版本',
+  `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_task_version` (`task_id`, `version`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='任务修订表';
+
+-- 执行记录关联的任务版本
+ALTER TABLE `task_executions`
+  ADD COLUMN `task_version` BIGINT(20) DEFAULT 0 COMMENT '执行时的任务版本(对应任务修订)' AFTER `wait_time`;
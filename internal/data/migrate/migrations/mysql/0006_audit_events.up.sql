@@ -0,0 +1,22 @@
+-- 审计事件表：记录修改类接口的调用
+CREATE TABLE IF NOT EXISTS `audit_events` (
+  `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '审计事件ID',
+  `created_at` DATETIME(3) NOT NULL COMMENT '调用时间',
+  `actor` VARCHAR(255) DEFAULT NULL COMMENT '操作者',
+  `operation` VARCHAR(100) NOT NULL COMMENT '接口名称',
+  `target_id` BIGINT(20) DEFAULT 0 COMMENT '操作对象ID',
+  `targets` VARCHAR(255) DEFAULT NULL COMMENT '请求中的所有ID字段(逗号分隔的 field=value)',
+  `request` TEXT COMMENT '请求摘要(JSON)',
+  `result` VARCHAR(20) NOT NULL COMMENT '调用结果: AUDIT_SUCCESS(成功), AUDIT_FAILURE(失败)',
+  `code` INT(11) DEFAULT 0 COMMENT '失败时的 HTTP 状态码',
+  `reason` VARCHAR(100) DEFAULT NULL COMMENT '失败时的错误原因',
+  `message` TEXT COMMENT '失败时的错误信息',
+  `client_ip` VARCHAR(64) DEFAULT NULL COMMENT '客户端IP',
+  `transport` VARCHAR(10) DEFAULT NULL COMMENT '传输协议: http, grpc',
+  `duration` INT(11) DEFAULT 0 COMMENT '耗时(毫秒)',
+  PRIMARY KEY (`id`),
+  KEY `idx_created_at` (`created_at`),
+  KEY `idx_actor` (`actor`),
+  KEY `idx_operation` (`operation`),
+  KEY `idx_target_id` (`target_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='审计事件表';
@@ -0,0 +1,7 @@
+ALTER TABLE `task_executions`
+  DROP KEY `idx_namespace`,
+  DROP COLUMN `namespace`;
+ALTER TABLE `tasks`
+  DROP KEY `idx_namespace`,
+  DROP COLUMN `namespace`;
+DROP TABLE IF EXISTS `namespaces`;
@@ -0,0 +1,24 @@
+-- 命名空间表：隔离不同团队的任务和执行记录
+CREATE TABLE IF NOT EXISTS `namespaces` (
+  `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '命名空间ID',
+  `name` VARCHAR(63) NOT NULL COMMENT '命名空间名称',
+  `description` TEXT COMMENT '命名空间描述',
+  `max_tasks` INT(11) DEFAULT 0 COMMENT '最大任务数(0表示不限制)',
+  `max_concurrent_executions` INT(11) DEFAULT 0 COMMENT '最大同时执行数(0表示不限制)',
+  `max_executions_per_minute` INT(11) DEFAULT 0 COMMENT '每分钟最多开始执行的次数(0表示不限制)',
+  `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间',
+  `updated_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_name` (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='命名空间表';
+
+INSERT INTO `namespaces` (`name`, `description`) VALUES ('default', 'Default namespace');
+
+-- 已有的任务和执行记录属于默认命名空间
+ALTER TABLE `tasks`
+  ADD COLUMN `namespace` VARCHAR(63) NOT NULL DEFAULT 'default' COMMENT '所属命名空间' AFTER `id`,
+  ADD KEY `idx_namespace` (`namespace`);
+
+ALTER TABLE `task_executions`
+  ADD COLUMN `namespace` VARCHAR(63) NOT NULL DEFAULT 'default' COMMENT '任务所属的命名空间' AFTER `task_id`,
+  ADD KEY `idx_namespace` (`namespace`);
@@ -0,0 +1,16 @@
+-- 密钥表：任务负载通过 {{ secret "name" }} 引用，值以信封加密的密文保存
+CREATE TABLE IF NOT EXISTS `secrets` (
+  `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '密钥ID',
+  `namespace` VARCHAR(63) NOT NULL COMMENT '所属命名空间',
+  `name` VARCHAR(128) NOT NULL COMMENT '密钥名称',
+  `description` TEXT COMMENT '密钥描述',
+  `version` INT(11) NOT NULL DEFAULT 1 COMMENT '值的版本',
+  `key_id` VARCHAR(64) NOT NULL COMMENT '加密数据密钥的主密钥ID',
+  `encrypted_key` VARBINARY(512) NOT NULL COMMENT '加密后的数据密钥',
+  `ciphertext` BLOB NOT NULL COMMENT '加密后的值',
+  `created_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间',
+  `updated_at` DATETIME(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_namespace_name` (`namespace`, `name`),
+  KEY `idx_key_id` (`key_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='密钥表';
@@ -0,0 +1,3 @@
+ALTER TABLE `tasks`
+  DROP COLUMN `status_changed_at`,
+  DROP COLUMN `status_reason`;
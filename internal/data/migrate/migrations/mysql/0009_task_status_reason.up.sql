@@ -0,0 +1,4 @@
+-- 任务状态变化的原因和时间
+ALTER TABLE `tasks`
+  ADD COLUMN `status_reason` VARCHAR(255) DEFAULT NULL COMMENT '最近一次状态变化的原因' AFTER `status`,
+  ADD COLUMN `status_changed_at` DATETIME(3) DEFAULT NULL COMMENT '最近一次状态变化的时间' AFTER `status_reason`;
@@ -0,0 +1,3 @@
+ALTER TABLE `task_executions`
+  DROP KEY `idx_task_id_created_at`,
+  DROP KEY `idx_duration`;
@@ -0,0 +1,4 @@
+-- 执行记录按任务和创建时间、按耗时的游标分页
+ALTER TABLE `task_executions`
+  ADD KEY `idx_task_id_created_at` (`task_id`, `created_at`, `id`),
+  ADD KEY `idx_duration` (`duration`, `id`);
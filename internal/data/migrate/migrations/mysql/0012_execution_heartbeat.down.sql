@@ -0,0 +1 @@
+ALTER TABLE `task_executions` DROP COLUMN `heartbeat_at`;
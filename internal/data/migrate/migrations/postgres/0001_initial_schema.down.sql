@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS "execution_queue";
+DROP TABLE IF EXISTS "task_locks";
+DROP TABLE IF EXISTS "resource_pools";
+DROP TABLE IF EXISTS "calendars";
+DROP TABLE IF EXISTS "task_executions";
+DROP TABLE IF EXISTS "tasks";
@@ -0,0 +1,5 @@
+-- 任务级执行记录保留策略
+ALTER TABLE "tasks"
+  ADD COLUMN "retention_keep_last" INT DEFAULT 0,
+  ADD COLUMN "retention_keep_days" INT DEFAULT 0,
+  ADD COLUMN "retention_failed_keep_days" INT DEFAULT 0;
@@ -0,0 +1,2 @@
+-- 任务乐观锁版本号
+ALTER TABLE "tasks" ADD COLUMN "version" BIGINT NOT NULL DEFAULT 1;
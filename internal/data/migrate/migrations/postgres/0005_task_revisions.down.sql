@@ -0,0 +1,2 @@
+ALTER TABLE "task_executions" DROP COLUMN "task_version";
+DROP TABLE IF EXISTS "task_revisions";
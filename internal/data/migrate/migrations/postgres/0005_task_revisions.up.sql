@@ -0,0 +1,16 @@
+-- 任务修订表：每次创建、更新或回滚任务定义时记录一条
+CREATE TABLE IF NOT EXISTS "task_revisions" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "task_id" BIGINT NOT NULL,
+  "version" BIGINT NOT NULL,
+  "action" VARCHAR(32) NOT NULL,
+  "author" VARCHAR(255),
+  "snapshot" JSONB,
+  "changes" JSONB,
+  "source_version" BIGINT DEFAULT 0,
+  "created_at" TIMESTAMPTZ NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_task_revisions_task_version" ON "task_revisions" ("task_id", "version");
+
+-- 执行记录关联的任务版本
+ALTER TABLE "task_executions" ADD COLUMN "task_version" BIGINT DEFAULT 0;
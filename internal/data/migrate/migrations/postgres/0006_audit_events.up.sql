@@ -0,0 +1,21 @@
+-- 审计事件表：记录修改类接口的调用
+CREATE TABLE IF NOT EXISTS "audit_events" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "created_at" TIMESTAMPTZ NOT NULL,
+  "actor" VARCHAR(255),
+  "operation" VARCHAR(100) NOT NULL,
+  "target_id" BIGINT DEFAULT 0,
+  "targets" VARCHAR(255),
+  "request" TEXT,
+  "result" VARCHAR(20) NOT NULL,
+  "code" INT DEFAULT 0,
+  "reason" VARCHAR(100),
+  "message" TEXT,
+  "client_ip" VARCHAR(64),
+  "transport" VARCHAR(10),
+  "duration" INT DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS "idx_audit_events_created_at" ON "audit_events" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_audit_events_actor" ON "audit_events" ("actor");
+CREATE INDEX IF NOT EXISTS "idx_audit_events_operation" ON "audit_events" ("operation");
+CREATE INDEX IF NOT EXISTS "idx_audit_events_target_id" ON "audit_events" ("target_id");
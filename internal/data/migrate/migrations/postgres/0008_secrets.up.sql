@@ -0,0 +1,15 @@
+-- 密钥表：任务负载通过 {{ secret "name" }} 引用，值以信封加密的密文保存
+CREATE TABLE IF NOT EXISTS "secrets" (
+  "id" BIGSERIAL PRIMARY KEY,
+  "namespace" VARCHAR(63) NOT NULL,
+  "name" VARCHAR(128) NOT NULL,
+  "description" TEXT,
+  "version" INT NOT NULL DEFAULT 1,
+  "key_id" VARCHAR(64) NOT NULL,
+  "encrypted_key" BYTEA NOT NULL,
+  "ciphertext" BYTEA NOT NULL,
+  "created_at" TIMESTAMPTZ NOT NULL,
+  "updated_at" TIMESTAMPTZ NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_secrets_namespace_name" ON "secrets" ("namespace", "name");
+CREATE INDEX IF NOT EXISTS "idx_secrets_key_id" ON "secrets" ("key_id");
@@ -0,0 +1,2 @@
+ALTER TABLE "tasks" DROP COLUMN "status_changed_at";
+ALTER TABLE "tasks" DROP COLUMN "status_reason";
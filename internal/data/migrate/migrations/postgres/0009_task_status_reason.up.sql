@@ -0,0 +1,3 @@
+-- 任务状态变化的原因和时间
+ALTER TABLE "tasks" ADD COLUMN "status_reason" VARCHAR(255);
+ALTER TABLE "tasks" ADD COLUMN "status_changed_at" TIMESTAMPTZ;
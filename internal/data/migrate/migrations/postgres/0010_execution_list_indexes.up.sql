@@ -0,0 +1,3 @@
+-- 执行记录按任务和创建时间、按耗时的游标分页
+CREATE INDEX IF NOT EXISTS "idx_task_executions_task_id_created_at" ON "task_executions" ("task_id", "created_at", "id");
+CREATE INDEX IF NOT EXISTS "idx_task_executions_duration" ON "task_executions" ("duration", "id");
@@ -0,0 +1,111 @@
+-- 初始表结构
+-- 引入迁移之前的版本不支持 SQLite，不沿用已存在的同名表：表已存在时迁移直接失败，避免跳过建表后缺少列
+
+-- 任务表
+CREATE TABLE `tasks` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `name` VARCHAR(255) NOT NULL,
+  `description` TEXT,
+  `type` VARCHAR(20) NOT NULL,
+  `status` VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+  `schedule` VARCHAR(255),
+  `handler` VARCHAR(255) NOT NULL,
+  `payload` TEXT,
+  `timeout` INTEGER DEFAULT 300,
+  `metadata` TEXT,
+  `calendar_id` BIGINT DEFAULT 0,
+  `start_time` DATETIME,
+  `end_time` DATETIME,
+  `active_windows` TEXT,
+  `max_runs` BIGINT DEFAULT 0,
+  `interval_mode` VARCHAR(32),
+  `initial_delay` BIGINT DEFAULT 0,
+  `jitter` BIGINT DEFAULT 0,
+  `priority` INTEGER DEFAULT 0,
+  `lock_group` VARCHAR(255),
+  `concurrency_policy` VARCHAR(32),
+  `next_run_time` DATETIME,
+  `execution_count` BIGINT DEFAULT 0,
+  `success_count` BIGINT DEFAULT 0,
+  `failed_count` BIGINT DEFAULT 0,
+  `created_at` DATETIME NOT NULL,
+  `updated_at` DATETIME NOT NULL
+);
+CREATE INDEX `idx_tasks_name` ON `tasks` (`name`);
+CREATE INDEX `idx_tasks_type` ON `tasks` (`type`);
+CREATE INDEX `idx_tasks_status` ON `tasks` (`status`);
+CREATE INDEX `idx_tasks_next_run_time` ON `tasks` (`next_run_time`);
+CREATE INDEX `idx_tasks_calendar_id` ON `tasks` (`calendar_id`);
+CREATE INDEX `idx_tasks_lock_group` ON `tasks` (`lock_group`);
+CREATE INDEX `idx_tasks_created_at` ON `tasks` (`created_at`);
+
+-- 任务执行记录表
+CREATE TABLE `task_executions` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `task_id` BIGINT NOT NULL,
+  `task_name` VARCHAR(255) NOT NULL,
+  `status` VARCHAR(20) NOT NULL,
+  `node_id` VARCHAR(100),
+  `start_time` DATETIME,
+  `end_time` DATETIME,
+  `duration` INTEGER,
+  `result` TEXT,
+  `error` TEXT,
+  `retry_count` INTEGER DEFAULT 0,
+  `payload` TEXT,
+  `priority` INTEGER DEFAULT 0,
+  `wait_time` INTEGER DEFAULT 0,
+  `created_at` DATETIME NOT NULL
+);
+CREATE INDEX `idx_task_executions_task_id` ON `task_executions` (`task_id`);
+CREATE INDEX `idx_task_executions_status` ON `task_executions` (`status`);
+CREATE INDEX `idx_task_executions_node_id` ON `task_executions` (`node_id`);
+CREATE INDEX `idx_task_executions_created_at` ON `task_executions` (`created_at`);
+
+-- 业务日历表
+CREATE TABLE `calendars` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `name` VARCHAR(255) NOT NULL,
+  `description` TEXT,
+  `timezone` VARCHAR(64),
+  `rules` TEXT,
+  `created_at` DATETIME NOT NULL,
+  `updated_at` DATETIME NOT NULL
+);
+CREATE UNIQUE INDEX `idx_calendars_name` ON `calendars` (`name`);
+
+-- 资源池表
+CREATE TABLE `resource_pools` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `name` VARCHAR(255) NOT NULL,
+  `description` TEXT,
+  `handler` VARCHAR(255),
+  `label_key` VARCHAR(255),
+  `label_value` VARCHAR(255),
+  `max_concurrency` INTEGER DEFAULT 0,
+  `rate_limit` DOUBLE PRECISION DEFAULT 0,
+  `burst` INTEGER DEFAULT 0,
+  `created_at` DATETIME NOT NULL,
+  `updated_at` DATETIME NOT NULL
+);
+CREATE UNIQUE INDEX `idx_resource_pools_name` ON `resource_pools` (`name`);
+
+-- 互斥组锁表
+CREATE TABLE `task_locks` (
+  `lock_group` VARCHAR(255) NOT NULL PRIMARY KEY,
+  `owner` VARCHAR(255) NOT NULL,
+  `acquired_at` DATETIME NOT NULL,
+  `expires_at` DATETIME NOT NULL
+);
+CREATE INDEX `idx_task_locks_expires_at` ON `task_locks` (`expires_at`);
+
+-- 执行队列表（data.queue.driver 为 database 时使用）
+CREATE TABLE `execution_queue` (
+  `execution_id` BIGINT NOT NULL PRIMARY KEY,
+  `priority` INTEGER DEFAULT 0,
+  `queue_score` BIGINT NOT NULL,
+  `available_at` BIGINT NOT NULL,
+  `reserved_until` BIGINT NOT NULL DEFAULT 0,
+  `consumer` VARCHAR(255)
+);
+CREATE INDEX `idx_execution_queue_queue_score` ON `execution_queue` (`queue_score`);
@@ -0,0 +1,3 @@
+ALTER TABLE `tasks` DROP COLUMN `retention_keep_last`;
+ALTER TABLE `tasks` DROP COLUMN `retention_keep_days`;
+ALTER TABLE `tasks` DROP COLUMN `retention_failed_keep_days`;
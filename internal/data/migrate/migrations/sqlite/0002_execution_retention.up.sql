@@ -0,0 +1,4 @@
+-- 任务级执行记录保留策略
+ALTER TABLE `tasks` ADD COLUMN `retention_keep_last` INTEGER DEFAULT 0;
+ALTER TABLE `tasks` ADD COLUMN `retention_keep_days` INTEGER DEFAULT 0;
+ALTER TABLE `tasks` ADD COLUMN `retention_failed_keep_days` INTEGER DEFAULT 0;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS `idx_task_executions_namespace`;
+ALTER TABLE `task_executions` DROP COLUMN `namespace`;
+DROP INDEX IF EXISTS `idx_tasks_namespace`;
+ALTER TABLE `tasks` DROP COLUMN `namespace`;
+DROP TABLE IF EXISTS `namespaces`;
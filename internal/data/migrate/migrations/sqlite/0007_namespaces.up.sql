@@ -0,0 +1,21 @@
+-- 命名空间表：隔离不同团队的任务和执行记录
+CREATE TABLE IF NOT EXISTS `namespaces` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `name` VARCHAR(63) NOT NULL,
+  `description` TEXT,
+  `max_tasks` INTEGER DEFAULT 0,
+  `max_concurrent_executions` INTEGER DEFAULT 0,
+  `max_executions_per_minute` INTEGER DEFAULT 0,
+  `created_at` DATETIME NOT NULL,
+  `updated_at` DATETIME NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_namespaces_name` ON `namespaces` (`name`);
+
+INSERT INTO `namespaces` (`name`, `description`, `created_at`, `updated_at`) VALUES ('default', 'Default namespace', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+
+-- 已有的任务和执行记录属于默认命名空间
+ALTER TABLE `tasks` ADD COLUMN `namespace` VARCHAR(63) NOT NULL DEFAULT 'default';
+CREATE INDEX IF NOT EXISTS `idx_tasks_namespace` ON `tasks` (`namespace`);
+
+ALTER TABLE `task_executions` ADD COLUMN `namespace` VARCHAR(63) NOT NULL DEFAULT 'default';
+CREATE INDEX IF NOT EXISTS `idx_task_executions_namespace` ON `task_executions` (`namespace`);
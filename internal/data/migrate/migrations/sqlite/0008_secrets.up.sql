@@ -0,0 +1,15 @@
+-- 密钥表：任务负载通过 {{ secret "name" }} 引用，值以信封加密的密文保存
+CREATE TABLE IF NOT EXISTS `secrets` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `namespace` VARCHAR(63) NOT NULL,
+  `name` VARCHAR(128) NOT NULL,
+  `description` TEXT,
+  `version` INTEGER NOT NULL DEFAULT 1,
+  `key_id` VARCHAR(64) NOT NULL,
+  `encrypted_key` BLOB NOT NULL,
+  `ciphertext` BLOB NOT NULL,
+  `created_at` DATETIME NOT NULL,
+  `updated_at` DATETIME NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_secrets_namespace_name` ON `secrets` (`namespace`, `name`);
+CREATE INDEX IF NOT EXISTS `idx_secrets_key_id` ON `secrets` (`key_id`);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS `idx_task_executions_task_id_created_at`;
+DROP INDEX IF EXISTS `idx_task_executions_duration`;
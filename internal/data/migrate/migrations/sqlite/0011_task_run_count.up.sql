@@ -0,0 +1,5 @@
+-- 任务的调度次数，用于判断是否达到最大执行次数
+ALTER TABLE `tasks` ADD COLUMN `run_count` BIGINT NOT NULL DEFAULT 0;
+
+-- 已有任务以现存的执行记录数作为初始值
+UPDATE `tasks` SET `run_count` = (SELECT COUNT(*) FROM `task_executions` WHERE `task_executions`.`task_id` = `tasks`.`id`);
@@ -0,0 +1,2 @@
+-- 执行节点的心跳，超过租约时间未更新的执行中记录由其他节点回收
+ALTER TABLE `task_executions` ADD COLUMN `heartbeat_at` DATETIME;
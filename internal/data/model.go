@@ -7,8 +7,35 @@ import (
 	"time"
 
 	pb "heytom-scheduler/api/scheduler/v1"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
 )
 
+// scanText 读取文本列的值，MySQL 驱动返回 []byte，SQLite 和 PostgreSQL 驱动可能返回 string
+func scanText(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case []byte:
+		return string(v), nil
+	case string:
+		return v, nil
+	default:
+		return "", fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+// jsonDataType 返回 JSON 字段在当前数据库中的列类型
+func jsonDataType(db *gorm.DB) string {
+	switch db.Dialector.Name() {
+	case DriverPostgres:
+		return "JSONB"
+	case DriverSQLite:
+		return "TEXT"
+	default:
+		return "JSON"
+	}
+}
+
 // Metadata 元数据类型（JSON存储）
 type Metadata map[string]string
 
@@ -18,11 +45,16 @@ func (m *Metadata) Scan(value interface{}) error {
 		*m = make(Metadata)
 		return nil
 	}
-	bytes, ok := value.([]byte)
-	if !ok {
-		return nil
+	text, err := scanText(value)
+	if err != nil {
+		return fmt.Errorf("failed to scan Metadata: %w", err)
 	}
-	return json.Unmarshal(bytes, m)
+	return json.Unmarshal([]byte(text), m)
+}
+
+// GormDBDataType 按数据库返回列类型
+func (Metadata) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return jsonDataType(db)
 }
 
 // Value 实现 driver.Valuer 接口
@@ -33,6 +65,164 @@ func (m Metadata) Value() (driver.Value, error) {
 	return json.Marshal(m)
 }
 
+// TimeWindow 每日时间窗口（JSON存储）
+type TimeWindow struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// TimeWindows 每日时间窗口列表（JSON存储）
+type TimeWindows []TimeWindow
+
+// Scan 实现 sql.Scanner 接口
+func (w *TimeWindows) Scan(value interface{}) error {
+	if value == nil {
+		*w = nil
+		return nil
+	}
+	text, err := scanText(value)
+	if err != nil {
+		return fmt.Errorf("failed to scan TimeWindows: %w", err)
+	}
+	return json.Unmarshal([]byte(text), w)
+}
+
+// GormDBDataType 按数据库返回列类型
+func (TimeWindows) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return jsonDataType(db)
+}
+
+// Value 实现 driver.Valuer 接口
+func (w TimeWindows) Value() (driver.Value, error) {
+	if w == nil {
+		return "[]", nil
+	}
+	return json.Marshal(w)
+}
+
+// CalendarRule 日历规则（JSON存储）
+type CalendarRule struct {
+	Action      string `json:"action"`
+	StartDate   string `json:"start_date,omitempty"`
+	EndDate     string `json:"end_date,omitempty"`
+	Weekdays    []int  `json:"weekdays,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// CalendarRules 日历规则列表（JSON存储）
+type CalendarRules []CalendarRule
+
+// Scan 实现 sql.Scanner 接口
+func (r *CalendarRules) Scan(value interface{}) error {
+	if value == nil {
+		*r = nil
+		return nil
+	}
+	text, err := scanText(value)
+	if err != nil {
+		return fmt.Errorf("failed to scan CalendarRules: %w", err)
+	}
+	return json.Unmarshal([]byte(text), r)
+}
+
+// GormDBDataType 按数据库返回列类型
+func (CalendarRules) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return jsonDataType(db)
+}
+
+// Value 实现 driver.Valuer 接口
+func (r CalendarRules) Value() (driver.Value, error) {
+	if r == nil {
+		return "[]", nil
+	}
+	return json.Marshal(r)
+}
+
+// TaskSnapshot 任务定义快照（JSON存储），枚举按名称保存，时长按毫秒保存
+type TaskSnapshot struct {
+	Name              string            `json:"name"`
+	Description       string            `json:"description,omitempty"`
+	Type              string            `json:"type"`
+	Schedule          string            `json:"schedule,omitempty"`
+	Handler           string            `json:"handler"`
+	Payload           string            `json:"payload,omitempty"`
+	Timeout           int32             `json:"timeout,omitempty"`
+	Metadata          map[string]string `json:"metadata,omitempty"`
+	CalendarID        int64             `json:"calendar_id,omitempty"`
+	StartTime         *time.Time        `json:"start_time,omitempty"`
+	EndTime           *time.Time        `json:"end_time,omitempty"`
+	ActiveWindows     []TimeWindow      `json:"active_windows,omitempty"`
+	MaxRuns           int64             `json:"max_runs,omitempty"`
+	IntervalMode      string            `json:"interval_mode,omitempty"`
+	InitialDelay      int64             `json:"initial_delay,omitempty"`
+	Jitter            int64             `json:"jitter,omitempty"`
+	Priority          int32             `json:"priority,omitempty"`
+	LockGroup         string            `json:"lock_group,omitempty"`
+	ConcurrencyPolicy string            `json:"concurrency_policy,omitempty"`
+	KeepLast          int32             `json:"retention_keep_last,omitempty"`
+	KeepDays          int32             `json:"retention_keep_days,omitempty"`
+	FailedKeepDays    int32             `json:"retention_failed_keep_days,omitempty"`
+}
+
+// Scan 实现 sql.Scanner 接口
+func (s *TaskSnapshot) Scan(value interface{}) error {
+	if value == nil {
+		*s = TaskSnapshot{}
+		return nil
+	}
+	text, err := scanText(value)
+	if err != nil {
+		return fmt.Errorf("failed to scan TaskSnapshot: %w", err)
+	}
+	return json.Unmarshal([]byte(text), s)
+}
+
+// GormDBDataType 按数据库返回列类型
+func (TaskSnapshot) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return jsonDataType(db)
+}
+
+// Value 实现 driver.Valuer 接口
+func (s TaskSnapshot) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
+
+// TaskFieldChange 任务定义的字段变化（JSON存储）
+type TaskFieldChange struct {
+	Field    string `json:"field"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
+
+// TaskFieldChanges 任务定义的字段变化列表（JSON存储）
+type TaskFieldChanges []TaskFieldChange
+
+// Scan 实现 sql.Scanner 接口
+func (c *TaskFieldChanges) Scan(value interface{}) error {
+	if value == nil {
+		*c = nil
+		return nil
+	}
+	text, err := scanText(value)
+	if err != nil {
+		return fmt.Errorf("failed to scan TaskFieldChanges: %w", err)
+	}
+	return json.Unmarshal([]byte(text), c)
+}
+
+// GormDBDataType 按数据库返回列类型
+func (TaskFieldChanges) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return jsonDataType(db)
+}
+
+// Value 实现 driver.Valuer 接口
+func (c TaskFieldChanges) Value() (driver.Value, error) {
+	if c == nil {
+		return "[]", nil
+	}
+	return json.Marshal(c)
+}
+
 // TaskType 任务类型（数据库存储为字符串）
 type TaskType pb.TaskType
 
@@ -42,11 +232,11 @@ func (t *TaskType) Scan(value interface{}) error {
 		*t = TaskType(pb.TaskType_TASK_TYPE_UNSPECIFIED)
 		return nil
 	}
-	str, ok := value.([]byte)
-	if !ok {
-		return fmt.Errorf("failed to scan TaskType")
+	str, err := scanText(value)
+	if err != nil {
+		return fmt.Errorf("failed to scan TaskType: %w", err)
 	}
-	*t = TaskType(parseTaskTypeFromString(string(str)))
+	*t = TaskType(parseTaskTypeFromString(str))
 	return nil
 }
 
@@ -55,6 +245,50 @@ func (t TaskType) Value() (driver.Value, error) {
 	return pb.TaskType(t).String(), nil
 }
 
+// IntervalMode 间隔模式（数据库存储为字符串）
+type IntervalMode pb.IntervalMode
+
+// Scan 实现 sql.Scanner 接口
+func (m *IntervalMode) Scan(value interface{}) error {
+	if value == nil {
+		*m = IntervalMode(pb.IntervalMode_INTERVAL_MODE_UNSPECIFIED)
+		return nil
+	}
+	str, err := scanText(value)
+	if err != nil {
+		return fmt.Errorf("failed to scan IntervalMode: %w", err)
+	}
+	*m = IntervalMode(pb.IntervalMode_value[str])
+	return nil
+}
+
+// Value 实现 driver.Valuer 接口
+func (m IntervalMode) Value() (driver.Value, error) {
+	return pb.IntervalMode(m).String(), nil
+}
+
+// ConcurrencyPolicy 并发策略（数据库存储为字符串）
+type ConcurrencyPolicy pb.ConcurrencyPolicy
+
+// Scan 实现 sql.Scanner 接口
+func (p *ConcurrencyPolicy) Scan(value interface{}) error {
+	if value == nil {
+		*p = ConcurrencyPolicy(pb.ConcurrencyPolicy_CONCURRENCY_POLICY_UNSPECIFIED)
+		return nil
+	}
+	str, err := scanText(value)
+	if err != nil {
+		return fmt.Errorf("failed to scan ConcurrencyPolicy: %w", err)
+	}
+	*p = ConcurrencyPolicy(pb.ConcurrencyPolicy_value[str])
+	return nil
+}
+
+// Value 实现 driver.Valuer 接口
+func (p ConcurrencyPolicy) Value() (driver.Value, error) {
+	return pb.ConcurrencyPolicy(p).String(), nil
+}
+
 // TaskStatus 任务状态（数据库存储为字符串）
 type TaskStatus pb.TaskStatus
 
@@ -64,11 +298,11 @@ func (s *TaskStatus) Scan(value interface{}) error {
 		*s = TaskStatus(pb.TaskStatus_TASK_STATUS_UNSPECIFIED)
 		return nil
 	}
-	str, ok := value.([]byte)
-	if !ok {
-		return fmt.Errorf("failed to scan TaskStatus")
+	str, err := scanText(value)
+	if err != nil {
+		return fmt.Errorf("failed to scan TaskStatus: %w", err)
 	}
-	*s = TaskStatus(parseTaskStatusFromString(string(str)))
+	*s = TaskStatus(parseTaskStatusFromString(str))
 	return nil
 }
 
@@ -86,11 +320,11 @@ func (s *ExecutionStatus) Scan(value interface{}) error {
 		*s = ExecutionStatus(pb.ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED)
 		return nil
 	}
-	str, ok := value.([]byte)
-	if !ok {
-		return fmt.Errorf("failed to scan ExecutionStatus")
+	str, err := scanText(value)
+	if err != nil {
+		return fmt.Errorf("failed to scan ExecutionStatus: %w", err)
 	}
-	*s = ExecutionStatus(parseExecutionStatusFromString(string(str)))
+	*s = ExecutionStatus(parseExecutionStatusFromString(str))
 	return nil
 }
 
@@ -150,6 +384,8 @@ func parseExecutionStatusFromString(s string) pb.ExecutionStatus {
 		return pb.ExecutionStatus_TIMEOUT
 	case "EXECUTION_CANCELLED":
 		return pb.ExecutionStatus_EXECUTION_CANCELLED
+	case "EXECUTION_SKIPPED":
+		return pb.ExecutionStatus_EXECUTION_SKIPPED
 	default:
 		return pb.ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED
 	}
@@ -157,22 +393,42 @@ func parseExecutionStatusFromString(s string) pb.ExecutionStatus {
 
 // Task 任务模型
 type Task struct {
-	ID             int64      `gorm:"primaryKey;autoIncrement"`
-	Name           string     `gorm:"type:varchar(255);not null;index"`
-	Description    string     `gorm:"type:text"`
-	Type           TaskType   `gorm:"type:varchar(20);not null;index"`
-	Status         TaskStatus `gorm:"type:varchar(20);not null;index;default:'PENDING'"`
-	Schedule       string     `gorm:"type:varchar(255)"` // Cron表达式、时间戳或间隔秒数
-	Handler        string     `gorm:"type:varchar(255);not null"`
-	Payload        string     `gorm:"type:text"` // JSON格式
-	Timeout        int32      `gorm:"type:int;default:300"`
-	Metadata       Metadata   `gorm:"type:json"`
-	NextRunTime    *time.Time `gorm:"type:datetime;index"`
-	ExecutionCount int64      `gorm:"type:bigint;default:0"`
-	SuccessCount   int64      `gorm:"type:bigint;default:0"`
-	FailedCount    int64      `gorm:"type:bigint;default:0"`
-	CreatedAt      time.Time  `gorm:"type:datetime;not null;autoCreateTime"`
-	UpdatedAt      time.Time  `gorm:"type:datetime;not null;autoUpdateTime"`
+	ID                int64      `gorm:"primaryKey;autoIncrement"`
+	Namespace         string     `gorm:"type:varchar(63);not null;default:'default';index"` // 所属命名空间
+	Name              string     `gorm:"type:varchar(255);not null;index"`
+	Description       string     `gorm:"type:text"`
+	Type              TaskType   `gorm:"type:varchar(20);not null;index"`
+	Status            TaskStatus `gorm:"type:varchar(20);not null;index;default:'PENDING'"`
+	StatusReason      string     `gorm:"type:varchar(255)"` // 最近一次状态变化的原因
+	StatusChangedAt   *time.Time // 最近一次状态变化的时间
+	Schedule          string     `gorm:"type:varchar(255)"` // Cron表达式、时间戳或间隔（Go时长格式或秒数）
+	Handler           string     `gorm:"type:varchar(255);not null"`
+	Payload           string     `gorm:"type:text"` // JSON格式
+	Timeout           int32      `gorm:"type:int;default:300"`
+	Metadata          Metadata
+	CalendarID        int64             `gorm:"type:bigint;default:0;index"` // 业务日历ID
+	StartTime         *time.Time        // 生效开始时间
+	EndTime           *time.Time        // 生效结束时间
+	ActiveWindows     TimeWindows       // 每日执行时间窗口
+	MaxRuns           int64             `gorm:"type:bigint;default:0"`                                // 最大执行次数，0 表示不限制
+	RunCount          int64             `gorm:"type:bigint;not null;default:0"`                       // 调度次数，达到最大执行次数后任务完成
+	IntervalMode      IntervalMode      `gorm:"type:varchar(32)"`                                     // 间隔模式
+	InitialDelay      int64             `gorm:"type:bigint;default:0"`                                // 首次执行延迟（毫秒）
+	Jitter            int64             `gorm:"type:bigint;default:0"`                                // 随机延迟上限（毫秒）
+	Priority          int32             `gorm:"type:int;default:0"`                                   // 优先级 0-9
+	LockGroup         string            `gorm:"type:varchar(255);index"`                              // 互斥组
+	ConcurrencyPolicy ConcurrencyPolicy `gorm:"type:varchar(32)"`                                     // 互斥组被占用时的并发策略
+	KeepLast          int32             `gorm:"column:retention_keep_last;type:int;default:0"`        // 保留最近的执行记录数
+	KeepDays          int32             `gorm:"column:retention_keep_days;type:int;default:0"`        // 执行记录保留天数
+	FailedKeepDays    int32             `gorm:"column:retention_failed_keep_days;type:int;default:0"` // 失败和超时的执行记录保留天数
+	Version           int64             `gorm:"type:bigint;not null;default:1"`                       // 版本号，每次更新任务定义时递增
+	NextRunTime       *time.Time        `gorm:"index"`
+	ExecutionCount    int64             `gorm:"type:bigint;default:0"`
+	SuccessCount      int64             `gorm:"type:bigint;default:0"`
+	FailedCount       int64             `gorm:"type:bigint;default:0"`
+	CreatedAt         time.Time         `gorm:"not null;autoCreateTime"`
+	UpdatedAt         time.Time         `gorm:"not null;autoUpdateTime"`
+	DeletedAt         gorm.DeletedAt    `gorm:"index"` // 软删除时间，查询默认排除已删除的任务
 }
 
 // TableName 指定表名
@@ -182,22 +438,168 @@ func (Task) TableName() string {
 
 // TaskExecution 任务执行记录模型
 type TaskExecution struct {
-	ID         int64           `gorm:"primaryKey;autoIncrement"`
-	TaskID     int64           `gorm:"type:bigint;not null;index"`
-	TaskName   string          `gorm:"type:varchar(255);not null"`
-	Status     ExecutionStatus `gorm:"type:varchar(20);not null;index"`
-	NodeID     string          `gorm:"type:varchar(100);index"` // 执行节点ID
-	StartTime  *time.Time      `gorm:"type:datetime"`
-	EndTime    *time.Time      `gorm:"type:datetime"`
-	Duration   int32           `gorm:"type:int"` // 执行耗时（毫秒）
-	Result     string          `gorm:"type:text"`
-	Error      string          `gorm:"type:text"`
-	RetryCount int32           `gorm:"type:int;default:0"`
-	Payload    string          `gorm:"type:text"` // JSON格式
-	CreatedAt  time.Time       `gorm:"type:datetime;not null;autoCreateTime"`
+	ID          int64           `gorm:"primaryKey;autoIncrement"`
+	TaskID      int64           `gorm:"type:bigint;not null;index"`
+	Namespace   string          `gorm:"type:varchar(63);not null;default:'default';index"` // 任务所属的命名空间
+	TaskName    string          `gorm:"type:varchar(255);not null"`
+	Status      ExecutionStatus `gorm:"type:varchar(20);not null;index"`
+	NodeID      string          `gorm:"type:varchar(100);index"` // 执行节点ID
+	StartTime   *time.Time
+	EndTime     *time.Time
+	HeartbeatAt *time.Time // 执行节点最近一次心跳的时间
+	Duration    int32      `gorm:"type:int"` // 执行耗时（毫秒）
+	Result      string     `gorm:"type:text"`
+	Error       string     `gorm:"type:text"`
+	RetryCount  int32      `gorm:"type:int;default:0"`
+	Payload     string     `gorm:"type:text"` // JSON格式
+	Priority    int32      `gorm:"type:int;default:0"`
+	WaitTime    int32      `gorm:"type:int;default:0"`    // 排队等待耗时（毫秒）
+	TaskVersion int64      `gorm:"type:bigint;default:0"` // 执行时的任务版本，对应任务修订
+	CreatedAt   time.Time  `gorm:"not null;autoCreateTime"`
 }
 
 // TableName 指定表名
 func (TaskExecution) TableName() string {
 	return "task_executions"
 }
+
+// TaskRevision 任务修订模型，记录后不再修改
+type TaskRevision struct {
+	ID            int64            `gorm:"primaryKey;autoIncrement"`
+	TaskID        int64            `gorm:"type:bigint;not null;uniqueIndex:idx_task_revisions_task_version,priority:1"`
+	Version       int64            `gorm:"type:bigint;not null;uniqueIndex:idx_task_revisions_task_version,priority:2"`
+	Action        string           `gorm:"type:varchar(32);not null"` // 修订动作
+	Author        string           `gorm:"type:varchar(255)"`         // 操作者
+	Snapshot      TaskSnapshot     // 任务定义快照
+	Changes       TaskFieldChanges // 相对上一版本变化的字段
+	SourceVersion int64            `gorm:"type:bigint;default:0"` // 回滚时的目标版本
+	CreatedAt     time.Time        `gorm:"not null;autoCreateTime"`
+}
+
+// TableName 指定表名
+func (TaskRevision) TableName() string {
+	return "task_revisions"
+}
+
+// AuditEvent 审计事件模型
+type AuditEvent struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement"`
+	CreatedAt time.Time `gorm:"not null;index"`                   // 调用时间
+	Actor     string    `gorm:"type:varchar(255);index"`          // 操作者
+	Operation string    `gorm:"type:varchar(100);not null;index"` // 接口名称
+	TargetID  int64     `gorm:"type:bigint;default:0;index"`      // 操作对象ID
+	Targets   string    `gorm:"type:varchar(255)"`                // 请求中的所有ID字段，逗号分隔
+	Request   string    `gorm:"type:text"`                        // 请求摘要
+	Result    string    `gorm:"type:varchar(20);not null"`        // 调用结果
+	Code      int32     `gorm:"type:int;default:0"`               // 失败时的 HTTP 状态码
+	Reason    string    `gorm:"type:varchar(100)"`                // 失败时的错误原因
+	Message   string    `gorm:"type:text"`                        // 失败时的错误信息
+	ClientIP  string    `gorm:"column:client_ip;type:varchar(64)"`
+	Transport string    `gorm:"type:varchar(10)"`
+	Duration  int32     `gorm:"type:int;default:0"` // 耗时（毫秒）
+}
+
+// TableName 指定表名
+func (AuditEvent) TableName() string {
+	return "audit_events"
+}
+
+// Namespace 命名空间模型
+type Namespace struct {
+	ID                      int64     `gorm:"primaryKey;autoIncrement"`
+	Name                    string    `gorm:"type:varchar(63);not null;uniqueIndex"`
+	Description             string    `gorm:"type:text"`
+	MaxTasks                int32     `gorm:"type:int;default:0"` // 最大任务数
+	MaxConcurrentExecutions int32     `gorm:"type:int;default:0"` // 最大同时执行数
+	MaxExecutionsPerMinute  int32     `gorm:"type:int;default:0"` // 每分钟最多开始执行的次数
+	CreatedAt               time.Time `gorm:"not null;autoCreateTime"`
+	UpdatedAt               time.Time `gorm:"not null;autoUpdateTime"`
+}
+
+// TableName 指定表名
+func (Namespace) TableName() string {
+	return "namespaces"
+}
+
+// Secret 密钥模型，值以信封加密的密文保存
+type Secret struct {
+	ID           int64     `gorm:"primaryKey;autoIncrement"`
+	Namespace    string    `gorm:"type:varchar(63);not null;uniqueIndex:idx_secrets_namespace_name"`
+	Name         string    `gorm:"type:varchar(128);not null;uniqueIndex:idx_secrets_namespace_name"`
+	Description  string    `gorm:"type:text"`
+	Version      int32     `gorm:"type:int;not null;default:1"`     // 值的版本
+	KeyID        string    `gorm:"type:varchar(64);not null;index"` // 加密数据密钥的主密钥ID
+	EncryptedKey []byte    `gorm:"not null"`                        // 加密后的数据密钥
+	Ciphertext   []byte    `gorm:"not null"`                        // 加密后的值
+	CreatedAt    time.Time `gorm:"not null;autoCreateTime"`
+	UpdatedAt    time.Time `gorm:"not null;autoUpdateTime"`
+}
+
+// TableName 指定表名
+func (Secret) TableName() string {
+	return "secrets"
+}
+
+// ResourcePool 资源池模型
+type ResourcePool struct {
+	ID             int64     `gorm:"primaryKey;autoIncrement"`
+	Name           string    `gorm:"type:varchar(255);not null;uniqueIndex"`
+	Description    string    `gorm:"type:text"`
+	Handler        string    `gorm:"type:varchar(255)"`               // 匹配的处理器名称
+	LabelKey       string    `gorm:"type:varchar(255)"`               // 匹配的元数据标签键
+	LabelValue     string    `gorm:"type:varchar(255)"`               // 匹配的元数据标签值，为空匹配任意值
+	MaxConcurrency int32     `gorm:"type:int;default:0"`              // 最大并发数
+	RateLimit      float64   `gorm:"type:double precision;default:0"` // 每秒执行数
+	Burst          int32     `gorm:"type:int;default:0"`              // 令牌桶容量
+	CreatedAt      time.Time `gorm:"not null;autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"not null;autoUpdateTime"`
+}
+
+// TableName 指定表名
+func (ResourcePool) TableName() string {
+	return "resource_pools"
+}
+
+// TaskLock 互斥组锁模型
+type TaskLock struct {
+	LockGroup  string    `gorm:"type:varchar(255);primaryKey"`
+	Owner      string    `gorm:"type:varchar(255);not null"` // 持有者：执行节点/执行记录ID
+	AcquiredAt time.Time `gorm:"not null"`
+	ExpiresAt  time.Time `gorm:"not null;index"` // 过期时间，持有节点异常退出时锁在过期后可被接管
+}
+
+// TableName 指定表名
+func (TaskLock) TableName() string {
+	return "task_locks"
+}
+
+// QueuedExecution 执行队列模型，默认的数据库表执行队列
+type QueuedExecution struct {
+	ExecutionID   int64  `gorm:"primaryKey;autoIncrement:false"`
+	Priority      int32  `gorm:"type:int;default:0"`
+	QueueScore    int64  `gorm:"type:bigint;not null;index"` // 出队顺序，值越小越先执行
+	AvailableAt   int64  `gorm:"type:bigint;not null"`       // 可出队的毫秒时间戳
+	ReservedUntil int64  `gorm:"type:bigint;not null"`       // 出队后的可见性超时毫秒时间戳，0 表示未出队
+	Consumer      string `gorm:"type:varchar(255)"`          // 出队的执行节点
+}
+
+// TableName 指定表名
+func (QueuedExecution) TableName() string {
+	return "execution_queue"
+}
+
+// Calendar 业务日历模型
+type Calendar struct {
+	ID          int64  `gorm:"primaryKey;autoIncrement"`
+	Name        string `gorm:"type:varchar(255);not null;uniqueIndex"`
+	Description string `gorm:"type:text"`
+	Timezone    string `gorm:"type:varchar(64)"`
+	Rules       CalendarRules
+	CreatedAt   time.Time `gorm:"not null;autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"not null;autoUpdateTime"`
+}
+
+// TableName 指定表名
+func (Calendar) TableName() string {
+	return "calendars"
+}
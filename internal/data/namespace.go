@@ -0,0 +1,154 @@
+package data
+
+import (
+	"context"
+
+	"heytom-scheduler/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type namespaceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewNamespaceRepo 创建命名空间仓储实例
+func NewNamespaceRepo(data *Data, logger log.Logger) biz.NamespaceRepo {
+	return &namespaceRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateNamespace 创建命名空间
+func (r *namespaceRepo) CreateNamespace(ctx context.Context, namespace *biz.Namespace) (*biz.Namespace, error) {
+	dbNamespace := &Namespace{
+		Name:                    namespace.Name,
+		Description:             namespace.Description,
+		MaxTasks:                namespace.MaxTasks,
+		MaxConcurrentExecutions: namespace.MaxConcurrentExecutions,
+		MaxExecutionsPerMinute:  namespace.MaxExecutionsPerMinute,
+	}
+
+	if err := r.data.db.WithContext(ctx).Create(dbNamespace).Error; err != nil {
+		return nil, err
+	}
+
+	return r.toBusinessNamespace(dbNamespace), nil
+}
+
+// GetNamespace 按名称获取命名空间
+func (r *namespaceRepo) GetNamespace(ctx context.Context, name string) (*biz.Namespace, error) {
+	var namespace Namespace
+	if err := r.data.db.WithContext(ctx).Where("name = ?", name).First(&namespace).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toBusinessNamespace(&namespace), nil
+}
+
+// UpdateNamespace 更新命名空间的描述和配额
+func (r *namespaceRepo) UpdateNamespace(ctx context.Context, namespace *biz.Namespace) (*biz.Namespace, error) {
+	updates := map[string]interface{}{
+		"description":               namespace.Description,
+		"max_tasks":                 namespace.MaxTasks,
+		"max_concurrent_executions": namespace.MaxConcurrentExecutions,
+		"max_executions_per_minute": namespace.MaxExecutionsPerMinute,
+	}
+
+	if err := r.data.db.WithContext(ctx).Model(&Namespace{}).Where("name = ?", namespace.Name).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+
+	return r.GetNamespace(ctx, namespace.Name)
+}
+
+// DeleteNamespace 删除命名空间
+func (r *namespaceRepo) DeleteNamespace(ctx context.Context, name string) error {
+	return r.data.db.WithContext(ctx).Where("name = ?", name).Delete(&Namespace{}).Error
+}
+
+// ListNamespaces 命名空间列表查询
+func (r *namespaceRepo) ListNamespaces(ctx context.Context, filter *biz.NamespaceListFilter) ([]*biz.Namespace, int64, error) {
+	var namespaces []Namespace
+	var total int64
+
+	query := r.data.db.WithContext(ctx).Model(&Namespace{})
+
+	// 关键词搜索
+	if filter.Keyword != "" {
+		query = query.Where(keywordCondition(filter.Keyword, "name", "description"))
+	}
+
+	// 查询总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询
+	offset := pageOffset(filter.Page, filter.PageSize)
+	if err := query.Offset(offset).Limit(int(filter.PageSize)).Order("name ASC").Find(&namespaces).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 转换为业务模型
+	result := make([]*biz.Namespace, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		result = append(result, r.toBusinessNamespace(&namespace))
+	}
+
+	return result, total, nil
+}
+
+// ListAllNamespaces 查询全部命名空间
+func (r *namespaceRepo) ListAllNamespaces(ctx context.Context) ([]*biz.Namespace, error) {
+	var namespaces []Namespace
+	if err := r.data.db.WithContext(ctx).Order("name ASC").Find(&namespaces).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*biz.Namespace, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		result = append(result, r.toBusinessNamespace(&namespace))
+	}
+	return result, nil
+}
+
+// toBusinessNamespace 转换为业务模型
+func (r *namespaceRepo) toBusinessNamespace(namespace *Namespace) *biz.Namespace {
+	return &biz.Namespace{
+		ID:                      namespace.ID,
+		Name:                    namespace.Name,
+		Description:             namespace.Description,
+		MaxTasks:                namespace.MaxTasks,
+		MaxConcurrentExecutions: namespace.MaxConcurrentExecutions,
+		MaxExecutionsPerMinute:  namespace.MaxExecutionsPerMinute,
+		CreatedAt:               namespace.CreatedAt,
+		UpdatedAt:               namespace.UpdatedAt,
+	}
+}
+
+// withNamespace 返回绑定上下文的查询，上下文限定命名空间时只读写该命名空间中的记录
+func withNamespace(ctx context.Context, db *gorm.DB) *gorm.DB {
+	db = db.WithContext(ctx)
+	if namespace, ok := biz.NamespaceFromContext(ctx); ok {
+		// 新建会话，条件在同一会话的多次查询和事务中保留
+		db = db.Where("namespace = ?", namespace).Session(&gorm.Session{})
+	}
+	return db
+}
+
+// namespaceFor 新记录所属的命名空间，上下文限定命名空间时总是写入该命名空间
+func namespaceFor(ctx context.Context, namespace string) string {
+	if scoped, ok := biz.NamespaceFromContext(ctx); ok {
+		return scoped
+	}
+	if namespace == "" {
+		return biz.DefaultNamespace
+	}
+	return namespace
+}
@@ -0,0 +1,143 @@
+package data
+
+import (
+	"context"
+
+	"heytom-scheduler/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type resourcePoolRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewResourcePoolRepo 创建资源池仓储实例
+func NewResourcePoolRepo(data *Data, logger log.Logger) biz.ResourcePoolRepo {
+	return &resourcePoolRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateResourcePool 创建资源池
+func (r *resourcePoolRepo) CreateResourcePool(ctx context.Context, pool *biz.ResourcePool) (*biz.ResourcePool, error) {
+	dbPool := &ResourcePool{
+		Name:           pool.Name,
+		Description:    pool.Description,
+		Handler:        pool.Handler,
+		LabelKey:       pool.LabelKey,
+		LabelValue:     pool.LabelValue,
+		MaxConcurrency: pool.MaxConcurrency,
+		RateLimit:      pool.RateLimit,
+		Burst:          pool.Burst,
+	}
+
+	if err := r.data.db.WithContext(ctx).Create(dbPool).Error; err != nil {
+		return nil, err
+	}
+
+	return r.toBusinessResourcePool(dbPool), nil
+}
+
+// GetResourcePool 获取资源池详情
+func (r *resourcePoolRepo) GetResourcePool(ctx context.Context, id int64) (*biz.ResourcePool, error) {
+	var pool ResourcePool
+	if err := r.data.db.WithContext(ctx).First(&pool, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toBusinessResourcePool(&pool), nil
+}
+
+// UpdateResourcePool 更新资源池
+func (r *resourcePoolRepo) UpdateResourcePool(ctx context.Context, pool *biz.ResourcePool) (*biz.ResourcePool, error) {
+	updates := map[string]interface{}{
+		"name":            pool.Name,
+		"description":     pool.Description,
+		"handler":         pool.Handler,
+		"label_key":       pool.LabelKey,
+		"label_value":     pool.LabelValue,
+		"max_concurrency": pool.MaxConcurrency,
+		"rate_limit":      pool.RateLimit,
+		"burst":           pool.Burst,
+	}
+
+	if err := r.data.db.WithContext(ctx).Model(&ResourcePool{}).Where("id = ?", pool.ID).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+
+	return r.GetResourcePool(ctx, pool.ID)
+}
+
+// DeleteResourcePool 删除资源池
+func (r *resourcePoolRepo) DeleteResourcePool(ctx context.Context, id int64) error {
+	return r.data.db.WithContext(ctx).Delete(&ResourcePool{}, id).Error
+}
+
+// ListResourcePools 资源池列表查询
+func (r *resourcePoolRepo) ListResourcePools(ctx context.Context, filter *biz.ResourcePoolListFilter) ([]*biz.ResourcePool, int64, error) {
+	var pools []ResourcePool
+	var total int64
+
+	query := r.data.db.WithContext(ctx).Model(&ResourcePool{})
+
+	// 关键词搜索
+	if filter.Keyword != "" {
+		query = query.Where(keywordCondition(filter.Keyword, "name", "description"))
+	}
+
+	// 查询总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询
+	offset := pageOffset(filter.Page, filter.PageSize)
+	if err := query.Offset(offset).Limit(int(filter.PageSize)).Order("id DESC").Find(&pools).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 转换为业务模型
+	result := make([]*biz.ResourcePool, 0, len(pools))
+	for _, pool := range pools {
+		result = append(result, r.toBusinessResourcePool(&pool))
+	}
+
+	return result, total, nil
+}
+
+// ListAllResourcePools 查询全部资源池
+func (r *resourcePoolRepo) ListAllResourcePools(ctx context.Context) ([]*biz.ResourcePool, error) {
+	var pools []ResourcePool
+	if err := r.data.db.WithContext(ctx).Order("id ASC").Find(&pools).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*biz.ResourcePool, 0, len(pools))
+	for _, pool := range pools {
+		result = append(result, r.toBusinessResourcePool(&pool))
+	}
+	return result, nil
+}
+
+// toBusinessResourcePool 转换为业务模型
+func (r *resourcePoolRepo) toBusinessResourcePool(pool *ResourcePool) *biz.ResourcePool {
+	return &biz.ResourcePool{
+		ID:             pool.ID,
+		Name:           pool.Name,
+		Description:    pool.Description,
+		Handler:        pool.Handler,
+		LabelKey:       pool.LabelKey,
+		LabelValue:     pool.LabelValue,
+		MaxConcurrency: pool.MaxConcurrency,
+		RateLimit:      pool.RateLimit,
+		Burst:          pool.Burst,
+		CreatedAt:      pool.CreatedAt,
+		UpdatedAt:      pool.UpdatedAt,
+	}
+}
@@ -0,0 +1,128 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"heytom-scheduler/internal/biz"
+	"heytom-scheduler/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// QueueDriverDatabase 数据库表执行队列，与业务数据共用同一个数据库
+	QueueDriverDatabase = "database"
+	// QueueDriverMySQL 数据库表执行队列，QueueDriverDatabase 的旧名称
+	QueueDriverMySQL = "mysql"
+	// QueueDriverRedis Redis Stream 执行队列
+	QueueDriverRedis = "redis"
+
+	// defaultVisibilityTimeout 出队后未确认的执行记录重新可出队的默认超时时间
+	defaultVisibilityTimeout = 30 * time.Second
+)
+
+// NewExecutionQueue 按配置创建执行队列，默认使用数据库表队列
+func NewExecutionQueue(c *conf.Data, data *Data, logger log.Logger) (biz.ExecutionQueue, error) {
+	visibility := defaultVisibilityTimeout
+	if c.GetQueue().GetVisibilityTimeout() != nil {
+		visibility = c.GetQueue().GetVisibilityTimeout().AsDuration()
+	}
+
+	switch driver := c.GetQueue().GetDriver(); driver {
+	case "", QueueDriverDatabase, QueueDriverMySQL:
+		return NewDBQueue(data, visibility, logger), nil
+	case QueueDriverRedis:
+		return NewRedisQueue(context.Background(), data.rdb, c.GetQueue().GetKeyPrefix(), visibility, logger)
+	default:
+		return nil, fmt.Errorf("unknown queue driver %q", driver)
+	}
+}
+
+type dbQueue struct {
+	data       *Data
+	visibility time.Duration
+	log        *log.Helper
+}
+
+// NewDBQueue 创建数据库表执行队列，出队通过条件更新保留记录，多节点共享同一张队列表
+func NewDBQueue(data *Data, visibility time.Duration, logger log.Logger) biz.ExecutionQueue {
+	return &dbQueue{
+		data:       data,
+		visibility: visibility,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// Push 将执行记录加入队列，重复加入时忽略
+func (q *dbQueue) Push(ctx context.Context, execution *biz.TaskExecution, delay time.Duration) error {
+	now := time.Now()
+	return q.data.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&QueuedExecution{
+		ExecutionID: execution.ID,
+		Priority:    execution.Priority,
+		QueueScore:  biz.QueueScore(execution.Priority, enqueuedAt(execution, now)),
+		AvailableAt: now.Add(delay).UnixMilli(),
+	}).Error
+}
+
+// Pop 按出队顺序保留最多 limit 条可出队的执行记录
+func (q *dbQueue) Pop(ctx context.Context, consumer string, limit int) ([]int64, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	now := time.Now().UnixMilli()
+	var candidates []int64
+	err := q.data.db.WithContext(ctx).Model(&QueuedExecution{}).
+		Where("available_at <= ? AND reserved_until <= ?", now, now).
+		Order("queue_score ASC, execution_id ASC").
+		Limit(limit).
+		Pluck("execution_id", &candidates).Error
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(candidates))
+	for _, id := range candidates {
+		// 条件更新保证同一条记录只会被一个节点取出
+		res := q.data.db.WithContext(ctx).Model(&QueuedExecution{}).
+			Where("execution_id = ? AND available_at <= ? AND reserved_until <= ?", id, now, now).
+			Updates(map[string]interface{}{
+				"reserved_until": now + q.visibility.Milliseconds(),
+				"consumer":       consumer,
+			})
+		if res.Error != nil {
+			return ids, res.Error
+		}
+		if res.RowsAffected == 1 {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// Ack 从队列中删除执行记录
+func (q *dbQueue) Ack(ctx context.Context, id int64) error {
+	return q.data.db.WithContext(ctx).Where("execution_id = ?", id).Delete(&QueuedExecution{}).Error
+}
+
+// Nack 取消执行记录的出队保留，delay 后可再次出队，出队顺序保持不变
+func (q *dbQueue) Nack(ctx context.Context, id int64, delay time.Duration) error {
+	return q.data.db.WithContext(ctx).Model(&QueuedExecution{}).
+		Where("execution_id = ?", id).
+		Updates(map[string]interface{}{
+			"available_at":   time.Now().Add(delay).UnixMilli(),
+			"reserved_until": 0,
+			"consumer":       "",
+		}).Error
+}
+
+// enqueuedAt 返回执行记录的入队时间，用于计算出队顺序
+func enqueuedAt(execution *biz.TaskExecution, now time.Time) time.Time {
+	if execution.CreatedAt.IsZero() {
+		return now
+	}
+	return execution.CreatedAt
+}
@@ -0,0 +1,32 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"heytom-scheduler/internal/biz"
+	"heytom-scheduler/internal/biz/queuetest"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestDBQueue(t *testing.T) {
+	queuetest.Run(t, func(t *testing.T, visibility time.Duration) biz.ExecutionQueue {
+		return NewDBQueue(newTestData(t), visibility, log.DefaultLogger)
+	})
+}
+
+func TestRedisQueue(t *testing.T) {
+	queuetest.Run(t, func(t *testing.T, visibility time.Duration) biz.ExecutionQueue {
+		rdb := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+		t.Cleanup(func() { rdb.Close() })
+		q, err := NewRedisQueue(context.Background(), rdb, "", visibility, log.DefaultLogger)
+		if err != nil {
+			t.Fatalf("NewRedisQueue: %v", err)
+		}
+		return q
+	})
+}
@@ -0,0 +1,350 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"heytom-scheduler/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// defaultQueueKeyPrefix Redis 执行队列的默认键前缀
+	defaultQueueKeyPrefix = "heytom-scheduler:queue"
+	// queueGroup Redis Stream 消费组名称
+	queueGroup = "executors"
+	// promoteBatchSize 每次出队时最多转移的到期延迟记录数
+	promoteBatchSize = 100
+)
+
+// promoteScript 将到期的延迟记录原子地转移到对应优先级的 Stream
+// KEYS[1] 为延迟集合，KEYS[2..] 依次为各优先级的 Stream；ARGV 为当前毫秒时间戳和转移上限
+var promoteScript = redis.NewScript(`
+local items = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+for _, item in ipairs(items) do
+	local id, priority, enqueued = string.match(item, '^(%d+):(%d+):(%d+)$')
+	redis.call('ZREM', KEYS[1], item)
+	if id then
+		redis.call('XADD', KEYS[tonumber(priority) + 2], '*', 'id', id, 'enqueued', enqueued)
+	end
+end
+return #items
+`)
+
+type redisQueue struct {
+	rdb        *redis.Client
+	prefix     string
+	visibility time.Duration
+	log        *log.Helper
+}
+
+// queueEntry Redis 队列中的一条执行记录
+type queueEntry struct {
+	id       int64
+	priority int32
+	enqueued int64  // 入队毫秒时间戳
+	entryID  string // Stream 消息ID
+}
+
+// score 计算出队顺序
+func (e *queueEntry) score() int64 {
+	return biz.QueueScore(e.priority, time.UnixMilli(e.enqueued))
+}
+
+// member 延迟集合中的成员，格式为 id:priority:enqueued
+func (e *queueEntry) member() string {
+	return fmt.Sprintf("%d:%d:%d", e.id, e.priority, e.enqueued)
+}
+
+// NewRedisQueue 创建 Redis 执行队列
+// 每个优先级使用一个 Stream，通过消费组分发给各节点；延迟记录保存在有序集合中，到期后转入 Stream
+func NewRedisQueue(ctx context.Context, rdb *redis.Client, prefix string, visibility time.Duration, logger log.Logger) (biz.ExecutionQueue, error) {
+	if rdb == nil {
+		return nil, fmt.Errorf("redis queue requires data.redis to be configured")
+	}
+	if prefix == "" {
+		prefix = defaultQueueKeyPrefix
+	}
+	q := &redisQueue{
+		rdb:        rdb,
+		prefix:     prefix,
+		visibility: visibility,
+		log:        log.NewHelper(logger),
+	}
+	for p := int32(biz.MinPriority); p <= biz.MaxPriority; p++ {
+		err := rdb.XGroupCreateMkStream(ctx, q.streamKey(p), queueGroup, "0").Err()
+		if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+// streamKey 优先级对应的 Stream 键
+func (q *redisQueue) streamKey(priority int32) string {
+	return fmt.Sprintf("%s:p%d", q.prefix, priority)
+}
+
+// delayedKey 延迟记录有序集合的键，分数为可出队的毫秒时间戳
+func (q *redisQueue) delayedKey() string {
+	return q.prefix + ":delayed"
+}
+
+// inflightKey 已出队未确认记录的哈希键，保存执行记录ID到 enqueued:消息ID:priority 的映射
+func (q *redisQueue) inflightKey() string {
+	return q.prefix + ":inflight"
+}
+
+// Push 将执行记录加入对应优先级的 Stream，delay 大于 0 时先加入延迟集合
+func (q *redisQueue) Push(ctx context.Context, execution *biz.TaskExecution, delay time.Duration) error {
+	if execution.Priority < biz.MinPriority || execution.Priority > biz.MaxPriority {
+		return fmt.Errorf("invalid priority %d of execution %d", execution.Priority, execution.ID)
+	}
+	now := time.Now()
+	entry := &queueEntry{
+		id:       execution.ID,
+		priority: execution.Priority,
+		enqueued: enqueuedAt(execution, now).UnixMilli(),
+	}
+	if delay > 0 {
+		return q.rdb.ZAdd(ctx, q.delayedKey(), redis.Z{
+			Score:  float64(now.Add(delay).UnixMilli()),
+			Member: entry.member(),
+		}).Err()
+	}
+	return q.add(ctx, q.rdb, entry)
+}
+
+// add 将记录追加到对应优先级的 Stream
+func (q *redisQueue) add(ctx context.Context, c redis.Cmdable, entry *queueEntry) error {
+	return c.XAdd(ctx, &redis.XAddArgs{
+		Stream: q.streamKey(entry.priority),
+		Values: map[string]interface{}{"id": entry.id, "enqueued": entry.enqueued},
+	}).Err()
+}
+
+// Pop 转移到期的延迟记录，接管超过可见性超时的未确认记录，再按出队顺序从各优先级 Stream 读取新记录
+func (q *redisQueue) Pop(ctx context.Context, consumer string, limit int) ([]int64, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	keys := []string{q.delayedKey()}
+	for p := int32(biz.MinPriority); p <= biz.MaxPriority; p++ {
+		keys = append(keys, q.streamKey(p))
+	}
+	if err := promoteScript.Run(ctx, q.rdb, keys, time.Now().UnixMilli(), promoteBatchSize).Err(); err != nil {
+		return nil, err
+	}
+
+	entries, err := q.reclaim(ctx, consumer, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) < limit {
+		fresh, err := q.read(ctx, consumer, limit-len(entries))
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, fresh...)
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].score() != entries[j].score() {
+			return entries[i].score() < entries[j].score()
+		}
+		return entries[i].id < entries[j].id
+	})
+
+	inflight := make(map[string]interface{}, len(entries))
+	ids := make([]int64, 0, len(entries))
+	for _, entry := range entries {
+		inflight[strconv.FormatInt(entry.id, 10)] = fmt.Sprintf("%d:%s:%d", entry.enqueued, entry.entryID, entry.priority)
+		ids = append(ids, entry.id)
+	}
+	if err := q.rdb.HSet(ctx, q.inflightKey(), inflight).Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// reclaim 接管各 Stream 中超过可见性超时仍未确认的记录
+func (q *redisQueue) reclaim(ctx context.Context, consumer string, limit int) ([]*queueEntry, error) {
+	var entries []*queueEntry
+	for p := int32(biz.MaxPriority); p >= biz.MinPriority && len(entries) < limit; p-- {
+		messages, _, err := q.rdb.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   q.streamKey(p),
+			Group:    queueGroup,
+			MinIdle:  q.visibility,
+			Start:    "0-0",
+			Count:    int64(limit - len(entries)),
+			Consumer: consumer,
+		}).Result()
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, q.toEntries(p, messages)...)
+	}
+	return entries, nil
+}
+
+// read 预览各 Stream 中尚未分发的记录，按出队顺序决定每个 Stream 读取的数量后通过消费组读取
+// 同一 Stream 内的记录按入队顺序排列，因此按出队顺序选出的记录是每个 Stream 的前缀
+func (q *redisQueue) read(ctx context.Context, consumer string, limit int) ([]*queueEntry, error) {
+	groups := make(map[string]string)
+	for p := int32(biz.MinPriority); p <= biz.MaxPriority; p++ {
+		infos, err := q.rdb.XInfoGroups(ctx, q.streamKey(p)).Result()
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range infos {
+			if info.Name == queueGroup {
+				groups[q.streamKey(p)] = info.LastDeliveredID
+			}
+		}
+	}
+
+	var pending []*queueEntry
+	for p := int32(biz.MinPriority); p <= biz.MaxPriority; p++ {
+		key := q.streamKey(p)
+		last := groups[key]
+		messages, err := q.rdb.XRangeN(ctx, key, last, "+", int64(limit+1)).Result()
+		if err != nil {
+			return nil, err
+		}
+		if len(messages) > 0 && messages[0].ID == last {
+			messages = messages[1:]
+		}
+		pending = append(pending, q.toEntries(p, messages)...)
+	}
+	sort.SliceStable(pending, func(i, j int) bool {
+		return pending[i].score() < pending[j].score()
+	})
+	if len(pending) > limit {
+		pending = pending[:limit]
+	}
+
+	counts := make(map[int32]int64)
+	for _, entry := range pending {
+		counts[entry.priority]++
+	}
+
+	var entries []*queueEntry
+	for p := int32(biz.MaxPriority); p >= biz.MinPriority; p-- {
+		if counts[p] == 0 {
+			continue
+		}
+		streams, err := q.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    queueGroup,
+			Consumer: consumer,
+			Streams:  []string{q.streamKey(p), ">"},
+			Count:    counts[p],
+			Block:    -1,
+		}).Result()
+		if err == redis.Nil {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, stream := range streams {
+			entries = append(entries, q.toEntries(p, stream.Messages)...)
+		}
+	}
+	return entries, nil
+}
+
+// toEntries 解析 Stream 消息
+func (q *redisQueue) toEntries(priority int32, messages []redis.XMessage) []*queueEntry {
+	entries := make([]*queueEntry, 0, len(messages))
+	for _, message := range messages {
+		id, err := strconv.ParseInt(fmt.Sprint(message.Values["id"]), 10, 64)
+		if err != nil {
+			q.log.Warnf("skip malformed queue message %s: %v", message.ID, err)
+			continue
+		}
+		enqueued, _ := strconv.ParseInt(fmt.Sprint(message.Values["enqueued"]), 10, 64)
+		entries = append(entries, &queueEntry{
+			id:       id,
+			priority: priority,
+			enqueued: enqueued,
+			entryID:  message.ID,
+		})
+	}
+	return entries
+}
+
+// inflight 查询已出队未确认的记录，不存在时返回 nil
+func (q *redisQueue) inflight(ctx context.Context, id int64) (*queueEntry, error) {
+	value, err := q.rdb.HGet(ctx, q.inflightKey(), strconv.FormatInt(id, 10)).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.SplitN(value, ":", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("malformed inflight entry %q of execution %d", value, id)
+	}
+	enqueued, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	priority, err := strconv.ParseInt(parts[2], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	return &queueEntry{id: id, priority: int32(priority), enqueued: enqueued, entryID: parts[1]}, nil
+}
+
+// Ack 确认并删除 Stream 消息
+func (q *redisQueue) Ack(ctx context.Context, id int64) error {
+	entry, err := q.inflight(ctx, id)
+	if err != nil || entry == nil {
+		return err
+	}
+	_, err = q.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		q.remove(ctx, pipe, entry)
+		return nil
+	})
+	return err
+}
+
+// Nack 确认并删除 Stream 消息后将记录加入延迟集合，到期后重新追加到 Stream 末尾
+func (q *redisQueue) Nack(ctx context.Context, id int64, delay time.Duration) error {
+	entry, err := q.inflight(ctx, id)
+	if err != nil || entry == nil {
+		return err
+	}
+	_, err = q.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		q.remove(ctx, pipe, entry)
+		if delay > 0 {
+			pipe.ZAdd(ctx, q.delayedKey(), redis.Z{
+				Score:  float64(time.Now().Add(delay).UnixMilli()),
+				Member: entry.member(),
+			})
+			return nil
+		}
+		return q.add(ctx, pipe, entry)
+	})
+	return err
+}
+
+// remove 确认并删除已出队的 Stream 消息
+func (q *redisQueue) remove(ctx context.Context, pipe redis.Pipeliner, entry *queueEntry) {
+	key := q.streamKey(entry.priority)
+	pipe.XAck(ctx, key, queueGroup, entry.entryID)
+	pipe.XDel(ctx, key, entry.entryID)
+	pipe.HDel(ctx, q.inflightKey(), strconv.FormatInt(entry.id, 10))
+}
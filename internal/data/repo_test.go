@@ -0,0 +1,39 @@
+package data
+
+import (
+	"testing"
+
+	"heytom-scheduler/internal/biz"
+	"heytom-scheduler/internal/biz/repotest"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TestRepos 在 SQLite 上运行仓储的一致性测试，每个用例使用独立的数据库
+func TestRepos(t *testing.T) {
+	t.Run("Task", func(t *testing.T) {
+		repotest.RunTaskRepo(t, func(t *testing.T) biz.TaskRepo {
+			return NewTaskRepo(newTestData(t), log.DefaultLogger)
+		})
+	})
+	t.Run("Execution", func(t *testing.T) {
+		repotest.RunExecutionRepo(t, func(t *testing.T) biz.ExecutionRepo {
+			return NewExecutionRepo(newTestData(t), log.DefaultLogger)
+		})
+	})
+	t.Run("TaskRevision", func(t *testing.T) {
+		repotest.RunTaskRevisionRepo(t, func(t *testing.T) biz.TaskRevisionRepo {
+			return NewTaskRevisionRepo(newTestData(t), log.DefaultLogger)
+		})
+	})
+	t.Run("Namespace", func(t *testing.T) {
+		repotest.RunNamespaceRepo(t, func(t *testing.T) biz.NamespaceRepo {
+			return NewNamespaceRepo(newTestData(t), log.DefaultLogger)
+		})
+	})
+	t.Run("Secret", func(t *testing.T) {
+		repotest.RunSecretRepo(t, func(t *testing.T) biz.SecretRepo {
+			return NewSecretRepo(newTestData(t), log.DefaultLogger)
+		})
+	})
+}
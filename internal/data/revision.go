@@ -0,0 +1,180 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type taskRevisionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTaskRevisionRepo 创建任务修订仓储实例
+func NewTaskRevisionRepo(data *Data, logger log.Logger) biz.TaskRevisionRepo {
+	return &taskRevisionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateRevision 记录任务修订
+func (r *taskRevisionRepo) CreateRevision(ctx context.Context, revision *biz.TaskRevision) (*biz.TaskRevision, error) {
+	dbRevision := &TaskRevision{
+		TaskID:        revision.TaskID,
+		Version:       revision.Version,
+		Action:        revision.Action.String(),
+		Author:        revision.Author,
+		Snapshot:      toTaskSnapshot(revision.Snapshot),
+		Changes:       toTaskFieldChanges(revision.Changes),
+		SourceVersion: revision.SourceVersion,
+	}
+
+	if err := r.data.db.WithContext(ctx).Create(dbRevision).Error; err != nil {
+		return nil, err
+	}
+
+	return toBusinessRevision(dbRevision), nil
+}
+
+// GetRevision 获取任务指定版本的修订
+func (r *taskRevisionRepo) GetRevision(ctx context.Context, taskID, version int64) (*biz.TaskRevision, error) {
+	var revision TaskRevision
+	if err := r.data.db.WithContext(ctx).Where("task_id = ? AND version = ?", taskID, version).First(&revision).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toBusinessRevision(&revision), nil
+}
+
+// ListRevisions 任务修订列表查询，按版本倒序
+func (r *taskRevisionRepo) ListRevisions(ctx context.Context, filter *biz.TaskRevisionListFilter) ([]*biz.TaskRevision, int64, error) {
+	query := r.data.db.WithContext(ctx).Model(&TaskRevision{}).Where("task_id = ?", filter.TaskID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var revisions []TaskRevision
+	offset := pageOffset(filter.Page, filter.PageSize)
+	if err := query.Order("version DESC").Offset(offset).Limit(int(filter.PageSize)).Find(&revisions).Error; err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]*biz.TaskRevision, 0, len(revisions))
+	for i := range revisions {
+		result = append(result, toBusinessRevision(&revisions[i]))
+	}
+	return result, total, nil
+}
+
+// DeleteTaskRevisions 删除任务的全部修订
+func (r *taskRevisionRepo) DeleteTaskRevisions(ctx context.Context, taskID int64) (int64, error) {
+	res := r.data.db.WithContext(ctx).Where("task_id = ?", taskID).Delete(&TaskRevision{})
+	return res.RowsAffected, res.Error
+}
+
+// toTaskSnapshot 转换为任务定义快照
+func toTaskSnapshot(task *biz.Task) TaskSnapshot {
+	if task == nil {
+		return TaskSnapshot{}
+	}
+	var windows []TimeWindow
+	for _, w := range task.ActiveWindows {
+		windows = append(windows, TimeWindow{Start: w.Start, End: w.End})
+	}
+	return TaskSnapshot{
+		Name:              task.Name,
+		Description:       task.Description,
+		Type:              task.Type.String(),
+		Schedule:          task.Schedule,
+		Handler:           task.Handler,
+		Payload:           task.Payload,
+		Timeout:           task.Timeout,
+		Metadata:          task.Metadata,
+		CalendarID:        task.CalendarID,
+		StartTime:         task.StartTime,
+		EndTime:           task.EndTime,
+		ActiveWindows:     windows,
+		MaxRuns:           task.MaxRuns,
+		IntervalMode:      task.IntervalMode.String(),
+		InitialDelay:      task.InitialDelay.Milliseconds(),
+		Jitter:            task.Jitter.Milliseconds(),
+		Priority:          task.Priority,
+		LockGroup:         task.LockGroup,
+		ConcurrencyPolicy: task.ConcurrencyPolicy.String(),
+		KeepLast:          task.Retention.KeepLast,
+		KeepDays:          task.Retention.KeepDays,
+		FailedKeepDays:    task.Retention.FailedKeepDays,
+	}
+}
+
+// toTaskFieldChanges 转换字段变化列表
+func toTaskFieldChanges(changes []biz.TaskFieldChange) TaskFieldChanges {
+	result := make(TaskFieldChanges, 0, len(changes))
+	for _, c := range changes {
+		result = append(result, TaskFieldChange{Field: c.Field, OldValue: c.OldValue, NewValue: c.NewValue})
+	}
+	return result
+}
+
+// toBusinessRevision 转换为业务模型
+func toBusinessRevision(revision *TaskRevision) *biz.TaskRevision {
+	s := revision.Snapshot
+	var windows []biz.TimeWindow
+	for _, w := range s.ActiveWindows {
+		windows = append(windows, biz.TimeWindow{Start: w.Start, End: w.End})
+	}
+	changes := make([]biz.TaskFieldChange, 0, len(revision.Changes))
+	for _, c := range revision.Changes {
+		changes = append(changes, biz.TaskFieldChange{Field: c.Field, OldValue: c.OldValue, NewValue: c.NewValue})
+	}
+
+	return &biz.TaskRevision{
+		ID:      revision.ID,
+		TaskID:  revision.TaskID,
+		Version: revision.Version,
+		Action:  pb.TaskRevisionAction(pb.TaskRevisionAction_value[revision.Action]),
+		Author:  revision.Author,
+		Snapshot: &biz.Task{
+			ID:                revision.TaskID,
+			Version:           revision.Version,
+			Name:              s.Name,
+			Description:       s.Description,
+			Type:              pb.TaskType(pb.TaskType_value[s.Type]),
+			Schedule:          s.Schedule,
+			Handler:           s.Handler,
+			Payload:           s.Payload,
+			Timeout:           s.Timeout,
+			Metadata:          s.Metadata,
+			CalendarID:        s.CalendarID,
+			StartTime:         s.StartTime,
+			EndTime:           s.EndTime,
+			ActiveWindows:     windows,
+			MaxRuns:           s.MaxRuns,
+			IntervalMode:      pb.IntervalMode(pb.IntervalMode_value[s.IntervalMode]),
+			InitialDelay:      time.Duration(s.InitialDelay) * time.Millisecond,
+			Jitter:            time.Duration(s.Jitter) * time.Millisecond,
+			Priority:          s.Priority,
+			LockGroup:         s.LockGroup,
+			ConcurrencyPolicy: pb.ConcurrencyPolicy(pb.ConcurrencyPolicy_value[s.ConcurrencyPolicy]),
+			Retention: biz.RetentionPolicy{
+				KeepLast:       s.KeepLast,
+				KeepDays:       s.KeepDays,
+				FailedKeepDays: s.FailedKeepDays,
+			},
+		},
+		Changes:       changes,
+		SourceVersion: revision.SourceVersion,
+		CreatedAt:     revision.CreatedAt,
+	}
+}
@@ -0,0 +1,153 @@
+package data
+
+import (
+	"context"
+
+	"heytom-scheduler/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type secretRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSecretRepo 创建密钥仓储实例
+func NewSecretRepo(data *Data, logger log.Logger) biz.SecretRepo {
+	return &secretRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateSecret 创建密钥
+func (r *secretRepo) CreateSecret(ctx context.Context, secret *biz.Secret) (*biz.Secret, error) {
+	dbSecret := &Secret{
+		Namespace:    namespaceFor(ctx, secret.Namespace),
+		Name:         secret.Name,
+		Description:  secret.Description,
+		Version:      secret.Version,
+		KeyID:        secret.Value.KeyID,
+		EncryptedKey: secret.Value.EncryptedKey,
+		Ciphertext:   secret.Value.Ciphertext,
+	}
+
+	if err := r.data.db.WithContext(ctx).Create(dbSecret).Error; err != nil {
+		return nil, err
+	}
+
+	return r.toBusinessSecret(dbSecret), nil
+}
+
+// GetSecret 按命名空间和名称获取密钥
+func (r *secretRepo) GetSecret(ctx context.Context, namespace, name string) (*biz.Secret, error) {
+	var secret Secret
+	if err := withNamespace(ctx, r.data.db).Where("namespace = ? AND name = ?", namespace, name).First(&secret).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toBusinessSecret(&secret), nil
+}
+
+// UpdateSecret 更新密钥的描述、版本和密文
+func (r *secretRepo) UpdateSecret(ctx context.Context, secret *biz.Secret) (*biz.Secret, error) {
+	updates := map[string]interface{}{
+		"description":   secret.Description,
+		"version":       secret.Version,
+		"key_id":        secret.Value.KeyID,
+		"encrypted_key": secret.Value.EncryptedKey,
+		"ciphertext":    secret.Value.Ciphertext,
+	}
+
+	if err := withNamespace(ctx, r.data.db).Model(&Secret{}).
+		Where("namespace = ? AND name = ?", secret.Namespace, secret.Name).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+
+	return r.GetSecret(ctx, secret.Namespace, secret.Name)
+}
+
+// DeleteSecret 删除密钥
+func (r *secretRepo) DeleteSecret(ctx context.Context, namespace, name string) error {
+	return withNamespace(ctx, r.data.db).Where("namespace = ? AND name = ?", namespace, name).Delete(&Secret{}).Error
+}
+
+// ListSecrets 密钥列表查询
+func (r *secretRepo) ListSecrets(ctx context.Context, filter *biz.SecretListFilter) ([]*biz.Secret, int64, error) {
+	var secrets []Secret
+	var total int64
+
+	query := withNamespace(ctx, r.data.db).Model(&Secret{})
+
+	// 命名空间过滤
+	if filter.Namespace != "" {
+		query = query.Where("namespace = ?", filter.Namespace)
+	}
+
+	// 关键词搜索
+	if filter.Keyword != "" {
+		query = query.Where(keywordCondition(filter.Keyword, "name", "description"))
+	}
+
+	// 查询总数
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 分页查询
+	offset := pageOffset(filter.Page, filter.PageSize)
+	if err := query.Offset(offset).Limit(int(filter.PageSize)).Order("namespace ASC, name ASC").Find(&secrets).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 转换为业务模型
+	result := make([]*biz.Secret, 0, len(secrets))
+	for _, secret := range secrets {
+		result = append(result, r.toBusinessSecret(&secret))
+	}
+
+	return result, total, nil
+}
+
+// ListSecretsToRewrap 获取数据密钥不是由主密钥 primaryKeyID 加密的密钥
+func (r *secretRepo) ListSecretsToRewrap(ctx context.Context, primaryKeyID string, limit int) ([]*biz.Secret, error) {
+	var secrets []Secret
+	if err := withNamespace(ctx, r.data.db).Where("key_id <> ?", primaryKeyID).Order("id ASC").Limit(limit).Find(&secrets).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]*biz.Secret, 0, len(secrets))
+	for _, secret := range secrets {
+		result = append(result, r.toBusinessSecret(&secret))
+	}
+	return result, nil
+}
+
+// CountSecrets 统计命名空间中的密钥数
+func (r *secretRepo) CountSecrets(ctx context.Context, namespace string) (int64, error) {
+	var count int64
+	err := withNamespace(ctx, r.data.db).Model(&Secret{}).Where("namespace = ?", namespace).Count(&count).Error
+	return count, err
+}
+
+// toBusinessSecret 转换为业务模型
+func (r *secretRepo) toBusinessSecret(secret *Secret) *biz.Secret {
+	return &biz.Secret{
+		ID:          secret.ID,
+		Namespace:   secret.Namespace,
+		Name:        secret.Name,
+		Description: secret.Description,
+		Version:     secret.Version,
+		Value: biz.EncryptedValue{
+			KeyID:        secret.KeyID,
+			EncryptedKey: secret.EncryptedKey,
+			Ciphertext:   secret.Ciphertext,
+		},
+		CreatedAt: secret.CreatedAt,
+		UpdatedAt: secret.UpdatedAt,
+	}
+}
@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	pb "heytom-scheduler/api/scheduler/v1"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type taskRepo struct {
@@ -27,16 +29,39 @@ func NewTaskRepo(data *Data, logger log.Logger) biz.TaskRepo {
 // CreateTask 创建任务
 func (r *taskRepo) CreateTask(ctx context.Context, task *biz.Task) (*biz.Task, error) {
 	dbTask := &Task{
-		Name:        task.Name,
-		Description: task.Description,
-		Type:        TaskType(task.Type),
-		Status:      TaskStatus(task.Status),
-		Schedule:    task.Schedule,
-		Handler:     task.Handler,
-		Payload:     task.Payload,
-		Timeout:     task.Timeout,
-		Metadata:    task.Metadata,
-		NextRunTime: task.NextRunTime,
+		Namespace:         namespaceFor(ctx, task.Namespace),
+		Name:              task.Name,
+		Description:       task.Description,
+		Type:              TaskType(task.Type),
+		Status:            TaskStatus(task.Status),
+		StatusReason:      task.StatusReason,
+		StatusChangedAt:   task.StatusChangedAt,
+		Schedule:          task.Schedule,
+		Handler:           task.Handler,
+		Payload:           task.Payload,
+		Timeout:           task.Timeout,
+		Metadata:          task.Metadata,
+		CalendarID:        task.CalendarID,
+		StartTime:         task.StartTime,
+		EndTime:           task.EndTime,
+		ActiveWindows:     toTimeWindows(task.ActiveWindows),
+		MaxRuns:           task.MaxRuns,
+		IntervalMode:      IntervalMode(task.IntervalMode),
+		InitialDelay:      task.InitialDelay.Milliseconds(),
+		Jitter:            task.Jitter.Milliseconds(),
+		Priority:          task.Priority,
+		LockGroup:         task.LockGroup,
+		ConcurrencyPolicy: ConcurrencyPolicy(task.ConcurrencyPolicy),
+		KeepLast:          task.Retention.KeepLast,
+		KeepDays:          task.Retention.KeepDays,
+		FailedKeepDays:    task.Retention.FailedKeepDays,
+		NextRunTime:       task.NextRunTime,
+		Version:           1,
+		CreatedAt:         task.CreatedAt,
+	}
+	// 显式写入默认状态，避免依赖列默认值时部分数据库需要回读字符串列到枚举字段
+	if dbTask.Status == TaskStatus(pb.TaskStatus_TASK_STATUS_UNSPECIFIED) {
+		dbTask.Status = TaskStatus(pb.TaskStatus_PENDING)
 	}
 
 	if err := r.data.db.WithContext(ctx).Create(dbTask).Error; err != nil {
@@ -49,7 +74,7 @@ func (r *taskRepo) CreateTask(ctx context.Context, task *biz.Task) (*biz.Task, e
 // GetTask 获取任务详情
 func (r *taskRepo) GetTask(ctx context.Context, id int64) (*biz.Task, error) {
 	var task Task
-	if err := r.data.db.WithContext(ctx).First(&task, id).Error; err != nil {
+	if err := withNamespace(ctx, r.data.db).First(&task, id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, nil
 		}
@@ -58,28 +83,135 @@ func (r *taskRepo) GetTask(ctx context.Context, id int64) (*biz.Task, error) {
 	return r.toBusinessTask(&task), nil
 }
 
-// UpdateTask 更新任务
-func (r *taskRepo) UpdateTask(ctx context.Context, task *biz.Task) (*biz.Task, error) {
+// GetDeletedTask 获取已删除的任务
+func (r *taskRepo) GetDeletedTask(ctx context.Context, id int64) (*biz.Task, error) {
+	var task Task
+	if err := withNamespace(ctx, r.data.db).Unscoped().Where("deleted_at IS NOT NULL").First(&task, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toBusinessTask(&task), nil
+}
+
+// UpdateTask 更新任务定义并递增版本号
+func (r *taskRepo) UpdateTask(ctx context.Context, task *biz.Task, fields []string) (*biz.Task, error) {
 	dbTask := &Task{
-		ID:          task.ID,
-		Name:        task.Name,
-		Description: task.Description,
-		Schedule:    task.Schedule,
-		Payload:     task.Payload,
-		Timeout:     task.Timeout,
-		Metadata:    task.Metadata,
+		ID:                task.ID,
+		Name:              task.Name,
+		Description:       task.Description,
+		Schedule:          task.Schedule,
+		Payload:           task.Payload,
+		Timeout:           task.Timeout,
+		Metadata:          task.Metadata,
+		CalendarID:        task.CalendarID,
+		StartTime:         task.StartTime,
+		EndTime:           task.EndTime,
+		MaxRuns:           task.MaxRuns,
+		IntervalMode:      IntervalMode(task.IntervalMode),
+		InitialDelay:      task.InitialDelay.Milliseconds(),
+		Jitter:            task.Jitter.Milliseconds(),
+		Priority:          task.Priority,
+		LockGroup:         task.LockGroup,
+		ConcurrencyPolicy: ConcurrencyPolicy(task.ConcurrencyPolicy),
+		KeepLast:          task.Retention.KeepLast,
+		KeepDays:          task.Retention.KeepDays,
+		FailedKeepDays:    task.Retention.FailedKeepDays,
+	}
+	if task.ActiveWindows != nil || len(fields) > 0 {
+		dbTask.ActiveWindows = toTimeWindows(task.ActiveWindows)
+	}
+	if len(fields) > 0 {
+		// 类型、处理器、状态和下次执行时间只能通过字段掩码更新
+		dbTask.Type = TaskType(task.Type)
+		dbTask.Handler = task.Handler
+		dbTask.Status = TaskStatus(task.Status)
+		dbTask.StatusReason = task.StatusReason
+		dbTask.StatusChangedAt = task.StatusChangedAt
+		dbTask.NextRunTime = task.NextRunTime
+	}
+
+	var columns []string
+	for _, field := range fields {
+		columns = append(columns, taskFieldColumns[field]...)
 	}
 
-	if err := r.data.db.WithContext(ctx).Model(&Task{}).Where("id = ?", task.ID).Updates(dbTask).Error; err != nil {
+	updated := false
+	err := withNamespace(ctx, r.data.db).Transaction(func(tx *gorm.DB) error {
+		// 先按版本号条件递增版本，与并发的更新互斥
+		bump := tx.Model(&Task{}).Where("id = ?", task.ID)
+		if task.Version > 0 {
+			bump = bump.Where("version = ?", task.Version)
+		}
+		result := bump.Update("version", gorm.Expr("version + 1"))
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		updated = true
+
+		query := tx.Model(&Task{}).Where("id = ?", task.ID)
+		if len(columns) > 0 {
+			query = query.Select(columns)
+		}
+		return query.Updates(dbTask).Error
+	})
+	if err != nil || !updated {
 		return nil, err
 	}
 
 	return r.GetTask(ctx, task.ID)
 }
 
-// DeleteTask 删除任务
+// taskFieldColumns 字段掩码中的字段对应的列
+var taskFieldColumns = map[string][]string{
+	"name":               {"name"},
+	"description":        {"description"},
+	"type":               {"type"},
+	"schedule":           {"schedule"},
+	"handler":            {"handler"},
+	"payload":            {"payload"},
+	"timeout":            {"timeout"},
+	"metadata":           {"metadata"},
+	"calendar_id":        {"calendar_id"},
+	"start_time":         {"start_time"},
+	"end_time":           {"end_time"},
+	"active_windows":     {"active_windows"},
+	"max_runs":           {"max_runs"},
+	"interval_mode":      {"interval_mode"},
+	"initial_delay":      {"initial_delay"},
+	"jitter":             {"jitter"},
+	"priority":           {"priority"},
+	"lock_group":         {"lock_group"},
+	"concurrency_policy": {"concurrency_policy"},
+	"retention":          {"retention_keep_last", "retention_keep_days", "retention_failed_keep_days"},
+	"status":             {"status", "status_reason", "status_changed_at"},
+	"next_run_time":      {"next_run_time"},
+}
+
+// DeleteTask 软删除任务
 func (r *taskRepo) DeleteTask(ctx context.Context, id int64) error {
-	return r.data.db.WithContext(ctx).Delete(&Task{}, id).Error
+	return withNamespace(ctx, r.data.db).Delete(&Task{}, id).Error
+}
+
+// RestoreTask 恢复已删除的任务
+func (r *taskRepo) RestoreTask(ctx context.Context, id int64) (bool, error) {
+	result := withNamespace(ctx, r.data.db).Unscoped().Model(&Task{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", gorm.Expr("NULL"))
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// PurgeTask 彻底删除已删除的任务
+func (r *taskRepo) PurgeTask(ctx context.Context, id int64) (bool, error) {
+	result := withNamespace(ctx, r.data.db).Unscoped().Where("deleted_at IS NOT NULL").Delete(&Task{}, id)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
 }
 
 // ListTasks 任务列表查询
@@ -87,31 +219,56 @@ func (r *taskRepo) ListTasks(ctx context.Context, filter *biz.TaskListFilter) ([
 	var tasks []Task
 	var total int64
 
-	query := r.data.db.WithContext(ctx).Model(&Task{})
+	query := withNamespace(ctx, r.data.db).Model(&Task{})
+
+	// 默认排除已删除的任务
+	if filter.IncludeDeleted {
+		query = query.Unscoped()
+	}
+
+	// 命名空间筛选
+	if filter.Namespace != "" {
+		query = query.Where("namespace = ?", filter.Namespace)
+	}
 
 	// 状态筛选
 	if filter.Status != pb.TaskStatus_TASK_STATUS_UNSPECIFIED {
-		query = query.Where("status = ?", filter.Status)
+		query = query.Where("status = ?", TaskStatus(filter.Status))
 	}
 
 	// 类型筛选
 	if filter.Type != pb.TaskType_TASK_TYPE_UNSPECIFIED {
-		query = query.Where("type = ?", filter.Type)
+		query = query.Where("type = ?", TaskType(filter.Type))
+	}
+
+	// 业务日历筛选
+	if filter.CalendarID > 0 {
+		query = query.Where("calendar_id = ?", filter.CalendarID)
 	}
 
 	// 关键词搜索
 	if filter.Keyword != "" {
-		query = query.Where("name LIKE ? OR description LIKE ?", "%"+filter.Keyword+"%", "%"+filter.Keyword+"%")
+		query = query.Where(keywordCondition(filter.Keyword, "name", "description"))
+	}
+
+	// 访问范围筛选
+	if filter.Scopes != nil {
+		query = query.Where(scopeCondition(query, filter.Scopes))
 	}
 
 	// 查询总数
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	if !filter.SkipTotal {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
 	}
 
-	// 分页查询
-	offset := (filter.Page - 1) * filter.PageSize
-	if err := query.Offset(int(offset)).Limit(int(filter.PageSize)).Order("id DESC").Find(&tasks).Error; err != nil {
+	// 排序和分页，有游标时从游标之后查询，否则按页码偏移
+	query = seekPage(query, taskSortExpr(filter.OrderBy.Field), filter.OrderBy, filter.After)
+	if filter.After == nil {
+		query = query.Offset(pageOffset(filter.Page, filter.PageSize))
+	}
+	if err := query.Limit(int(filter.PageSize)).Find(&tasks).Error; err != nil {
 		return nil, 0, err
 	}
 
@@ -124,14 +281,48 @@ func (r *taskRepo) ListTasks(ctx context.Context, filter *biz.TaskListFilter) ([
 	return result, total, nil
 }
 
-// UpdateTaskStatus 更新任务状态
-func (r *taskRepo) UpdateTaskStatus(ctx context.Context, id int64, status pb.TaskStatus) error {
-	return r.data.db.WithContext(ctx).Model(&Task{}).Where("id = ?", id).Update("status", TaskStatus(status)).Error
+// taskSortExpr 任务排序字段对应的表达式，没有下次执行时间的任务按 biz.UnscheduledSortTime 排序
+func taskSortExpr(field string) interface{} {
+	switch field {
+	case biz.OrderByCreatedAt, biz.OrderByName:
+		return clause.Column{Name: field}
+	case biz.OrderByNextRunTime:
+		return clause.Expr{SQL: "COALESCE(?, ?)", Vars: []interface{}{clause.Column{Name: "next_run_time"}, biz.UnscheduledSortTime}}
+	}
+	return nil
+}
+
+// CountTasks 统计命名空间中的任务数
+func (r *taskRepo) CountTasks(ctx context.Context, namespace string, includeDeleted bool) (int64, error) {
+	query := withNamespace(ctx, r.data.db).Model(&Task{}).Where("namespace = ?", namespace)
+	if includeDeleted {
+		query = query.Unscoped()
+	}
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// TransitionTaskStatus 在任务状态仍为 from 时更新为 to，并记录状态变化的原因和时间，返回是否更新成功
+func (r *taskRepo) TransitionTaskStatus(ctx context.Context, id int64, from, to pb.TaskStatus, reason string, at time.Time) (bool, error) {
+	result := withNamespace(ctx, r.data.db).Model(&Task{}).
+		Where("id = ? AND status = ?", id, TaskStatus(from)).
+		Updates(map[string]interface{}{
+			"status":            TaskStatus(to),
+			"status_reason":     reason,
+			"status_changed_at": at,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
 }
 
 // UpdateTaskNextRunTime 更新任务下次执行时间
 func (r *taskRepo) UpdateTaskNextRunTime(ctx context.Context, id int64, nextRunTime time.Time) error {
-	return r.data.db.WithContext(ctx).Model(&Task{}).Where("id = ?", id).Update("next_run_time", nextRunTime).Error
+	return withNamespace(ctx, r.data.db).Model(&Task{}).Where("id = ?", id).Update("next_run_time", nextRunTime).Error
 }
 
 // IncrementExecutionCount 增加执行次数
@@ -144,27 +335,216 @@ func (r *taskRepo) IncrementExecutionCount(ctx context.Context, id int64, succes
 	} else {
 		updates["failed_count"] = gorm.Expr("failed_count + ?", 1)
 	}
-	return r.data.db.WithContext(ctx).Model(&Task{}).Where("id = ?", id).Updates(updates).Error
+	return withNamespace(ctx, r.data.db).Model(&Task{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// ListDueTasks 查询下次执行时间不晚于 now 的待调度任务
+func (r *taskRepo) ListDueTasks(ctx context.Context, now time.Time, limit int) ([]*biz.Task, error) {
+	var tasks []Task
+	err := withNamespace(ctx, r.data.db).
+		Where("status = ? AND next_run_time IS NOT NULL AND next_run_time <= ?", TaskStatus(pb.TaskStatus_PENDING), now).
+		Order("next_run_time ASC").
+		Limit(limit).
+		Find(&tasks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*biz.Task, 0, len(tasks))
+	for _, task := range tasks {
+		result = append(result, r.toBusinessTask(&task))
+	}
+	return result, nil
+}
+
+// AdvanceTask 在任务仍处于待调度且下次执行时间仍为 current 时推进到 next 并更新状态，返回是否抢占成功
+func (r *taskRepo) AdvanceTask(ctx context.Context, id int64, current time.Time, next *time.Time, status pb.TaskStatus, reason string, run bool) (bool, error) {
+	updates := map[string]interface{}{
+		"next_run_time": gorm.Expr("NULL"),
+		"status":        TaskStatus(status),
+	}
+	if next != nil {
+		updates["next_run_time"] = *next
+	}
+	if run {
+		updates["run_count"] = gorm.Expr("run_count + ?", 1)
+	}
+	if reason != "" {
+		updates["status_reason"] = reason
+		updates["status_changed_at"] = time.Now()
+	}
+
+	result := withNamespace(ctx, r.data.db).Model(&Task{}).
+		Where("id = ? AND status = ? AND next_run_time = ?", id, TaskStatus(pb.TaskStatus_PENDING), current).
+		Updates(updates)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// RevertAdvance 在任务仍为 status 且下次执行时间仍为 next 时恢复为待调度，下次执行时间恢复为 current，返回是否撤销成功
+func (r *taskRepo) RevertAdvance(ctx context.Context, id int64, current time.Time, next *time.Time, status pb.TaskStatus, reason string, run bool) (bool, error) {
+	updates := map[string]interface{}{
+		"next_run_time": current,
+		"status":        TaskStatus(pb.TaskStatus_PENDING),
+	}
+	if run {
+		updates["run_count"] = gorm.Expr("run_count - ?", 1)
+	}
+	if reason != "" {
+		updates["status_reason"] = reason
+		updates["status_changed_at"] = time.Now()
+	}
+
+	query := withNamespace(ctx, r.data.db).Model(&Task{}).Where("id = ? AND status = ?", id, TaskStatus(status))
+	if next != nil {
+		query = query.Where("next_run_time = ?", *next)
+	} else {
+		query = query.Where("next_run_time IS NULL")
+	}
+	result := query.Updates(updates)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// RescheduleTask 在任务处于待调度且没有下次执行时间时设置下次执行时间并更新状态，返回是否更新成功
+func (r *taskRepo) RescheduleTask(ctx context.Context, id int64, next *time.Time, status pb.TaskStatus, reason string) (bool, error) {
+	updates := map[string]interface{}{
+		"next_run_time": gorm.Expr("NULL"),
+		"status":        TaskStatus(status),
+	}
+	if next != nil {
+		updates["next_run_time"] = *next
+	}
+	if reason != "" {
+		updates["status_reason"] = reason
+		updates["status_changed_at"] = time.Now()
+	}
+
+	result := withNamespace(ctx, r.data.db).Model(&Task{}).
+		Where("id = ? AND status = ? AND next_run_time IS NULL", id, TaskStatus(pb.TaskStatus_PENDING)).
+		Updates(updates)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
 }
 
 // toBusinessTask 转换为业务模型
 func (r *taskRepo) toBusinessTask(task *Task) *biz.Task {
+	windows := make([]biz.TimeWindow, 0, len(task.ActiveWindows))
+	for _, w := range task.ActiveWindows {
+		windows = append(windows, biz.TimeWindow{Start: w.Start, End: w.End})
+	}
+
 	return &biz.Task{
-		ID:             task.ID,
-		Name:           task.Name,
-		Description:    task.Description,
-		Type:           pb.TaskType(task.Type),
-		Status:         pb.TaskStatus(task.Status),
-		Schedule:       task.Schedule,
-		Handler:        task.Handler,
-		Payload:        task.Payload,
-		Timeout:        task.Timeout,
-		Metadata:       task.Metadata,
+		ID:                task.ID,
+		Namespace:         task.Namespace,
+		Name:              task.Name,
+		Description:       task.Description,
+		Type:              pb.TaskType(task.Type),
+		Status:            pb.TaskStatus(task.Status),
+		StatusReason:      task.StatusReason,
+		StatusChangedAt:   task.StatusChangedAt,
+		Schedule:          task.Schedule,
+		Handler:           task.Handler,
+		Payload:           task.Payload,
+		Timeout:           task.Timeout,
+		Metadata:          task.Metadata,
+		CalendarID:        task.CalendarID,
+		StartTime:         task.StartTime,
+		EndTime:           task.EndTime,
+		ActiveWindows:     windows,
+		MaxRuns:           task.MaxRuns,
+		IntervalMode:      pb.IntervalMode(task.IntervalMode),
+		InitialDelay:      time.Duration(task.InitialDelay) * time.Millisecond,
+		Jitter:            time.Duration(task.Jitter) * time.Millisecond,
+		Priority:          task.Priority,
+		LockGroup:         task.LockGroup,
+		ConcurrencyPolicy: pb.ConcurrencyPolicy(task.ConcurrencyPolicy),
+		Retention: biz.RetentionPolicy{
+			KeepLast:       task.KeepLast,
+			KeepDays:       task.KeepDays,
+			FailedKeepDays: task.FailedKeepDays,
+		},
 		NextRunTime:    task.NextRunTime,
+		RunCount:       task.RunCount,
 		ExecutionCount: task.ExecutionCount,
 		SuccessCount:   task.SuccessCount,
 		FailedCount:    task.FailedCount,
 		CreatedAt:      task.CreatedAt,
 		UpdatedAt:      task.UpdatedAt,
+		DeletedAt:      deletedAt(task.DeletedAt),
+		Version:        task.Version,
+	}
+}
+
+// deletedAt 转换软删除时间，未删除时返回 nil
+func deletedAt(t gorm.DeletedAt) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// toTimeWindows 转换为数据库存储的时间窗口
+func toTimeWindows(windows []biz.TimeWindow) TimeWindows {
+	result := make(TimeWindows, 0, len(windows))
+	for _, w := range windows {
+		result = append(result, TimeWindow{Start: w.Start, End: w.End})
+	}
+	return result
+}
+
+// scopeCondition 任务至少在一个访问范围内，即属于范围的命名空间且元数据包含范围的全部标签
+func scopeCondition(db *gorm.DB, scopes []biz.AccessScope) clause.Expression {
+	if len(scopes) == 0 {
+		return clause.Expr{SQL: "1 = 0"}
+	}
+	exprs := make([]clause.Expression, 0, len(scopes))
+	for _, scope := range scopes {
+		if scope.Unrestricted() {
+			return clause.Expr{SQL: "1 = 1"}
+		}
+		keys := make([]string, 0, len(scope.Labels))
+		for key := range scope.Labels {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		conds := make([]clause.Expression, 0, len(keys)+1)
+		if len(scope.Namespaces) > 0 {
+			conds = append(conds, clause.IN{Column: clause.Column{Name: "namespace"}, Values: toValues(scope.Namespaces)})
+		}
+		for _, key := range keys {
+			conds = append(conds, metadataEquals(db, key, scope.Labels[key]))
+		}
+		exprs = append(exprs, clause.And(conds...))
+	}
+	return clause.Or(exprs...)
+}
+
+// toValues 转换为 IN 条件的值列表
+func toValues(values []string) []interface{} {
+	result := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		result = append(result, v)
+	}
+	return result
+}
+
+// metadataEquals 元数据标签等于指定值，key 不能包含双引号和反斜杠
+func metadataEquals(db *gorm.DB, key, value string) clause.Expression {
+	column := clause.Column{Name: "metadata"}
+	path := `$."` + key + `"`
+	switch db.Dialector.Name() {
+	case DriverPostgres:
+		return clause.Expr{SQL: "? ->> ? = ?", Vars: []interface{}{column, key, value}}
+	case DriverSQLite:
+		return clause.Expr{SQL: "json_extract(?, ?) = ?", Vars: []interface{}{column, path, value}}
+	default:
+		return clause.Expr{SQL: "JSON_UNQUOTE(JSON_EXTRACT(?, ?)) = ?", Vars: []interface{}{column, path, value}}
 	}
 }
@@ -0,0 +1,183 @@
+package server
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// maxAuditRequestSize 审计事件中请求摘要的最大字节数
+const maxAuditRequestSize = 1024
+
+// readOnlyPrefixes 只读接口的名称前缀，只读接口不记录审计事件
+var readOnlyPrefixes = []string{"Get", "List", "Preview", "WhoAmI"}
+
+// redactedFields 请求摘要中需要脱敏的字段
+var redactedFields = map[protoreflect.Name]bool{"payload": true, "value": true}
+
+// auditActorKey 审计事件操作者的上下文键
+// 审计在认证之前执行以记录认证失败的请求，操作者由之后的 author 中间件回填
+type auditActorKey struct{}
+
+// setAuditActor 回填审计事件的操作者，请求未经过审计中间件时忽略
+func setAuditActor(ctx context.Context, actor string) {
+	if p, ok := ctx.Value(auditActorKey{}).(*string); ok {
+		*p = actor
+	}
+}
+
+// audit 记录修改类接口的审计事件：操作者、接口、操作对象、请求摘要、结果和客户端IP
+// 只读接口只记录认证或鉴权失败的调用
+func audit(uc *biz.AuditUsecase) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok || !uc.Enabled() {
+				return handler(ctx, req)
+			}
+			operation := tr.Operation()
+			if i := strings.LastIndex(operation, "/"); i >= 0 {
+				operation = operation[i+1:]
+			}
+
+			start := time.Now()
+			var actor string
+			reply, err := handler(context.WithValue(ctx, auditActorKey{}, &actor), req)
+			if isReadOnly(operation) && !isDenied(err) {
+				return reply, err
+			}
+
+			event := &biz.AuditEvent{
+				Time:      start,
+				Actor:     actor,
+				Operation: operation,
+				Request:   requestSummary(req),
+				Result:    pb.AuditResult_AUDIT_SUCCESS,
+				ClientIP:  clientIP(ctx, tr),
+				Transport: string(tr.Kind()),
+				Duration:  int32(time.Since(start) / time.Millisecond),
+			}
+			event.TargetID, event.Targets = auditTargets(req)
+			if event.TargetID == 0 && err == nil {
+				// 创建类接口的操作对象为新对象
+				event.TargetID, _ = auditTargets(reply)
+			}
+			if err != nil {
+				e := errors.FromError(err)
+				event.Result = pb.AuditResult_AUDIT_FAILURE
+				event.Code = e.Code
+				event.Reason = e.Reason
+				event.Message = e.Message
+			}
+			// 请求被取消时仍然记录
+			uc.Record(context.WithoutCancel(ctx), event)
+			return reply, err
+		}
+	}
+}
+
+// isDenied 判断错误是否为认证失败或权限不足
+func isDenied(err error) bool {
+	return err != nil && (errors.IsUnauthorized(err) || errors.IsForbidden(err))
+}
+
+// isReadOnly 判断接口是否为只读接口
+func isReadOnly(operation string) bool {
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// auditTargets 返回消息中的ID字段（id 和 *_id），主操作对象依次取 id、task_id 或第一个ID字段
+func auditTargets(msg interface{}) (int64, []string) {
+	m, ok := msg.(proto.Message)
+	if !ok || m == nil {
+		return 0, nil
+	}
+	var (
+		targets []string
+		ids     = make(map[string]int64)
+		first   int64
+	)
+	m.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		name := string(fd.Name())
+		if fd.Kind() != protoreflect.Int64Kind || fd.IsList() || (name != "id" && !strings.HasSuffix(name, "_id")) {
+			return true
+		}
+		id := v.Int()
+		targets = append(targets, name+"="+strconv.FormatInt(id, 10))
+		ids[name] = id
+		if first == 0 {
+			first = id
+		}
+		return true
+	})
+	if id, ok := ids["id"]; ok {
+		return id, targets
+	}
+	if id, ok := ids["task_id"]; ok {
+		return id, targets
+	}
+	return first, targets
+}
+
+// requestSummary 返回请求的 JSON 摘要，负载等敏感字段替换为长度，超长时截断
+func requestSummary(req interface{}) string {
+	m, ok := req.(proto.Message)
+	if !ok || m == nil {
+		return ""
+	}
+	m = proto.Clone(m)
+	r := m.ProtoReflect()
+	r.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if redactedFields[fd.Name()] && fd.Kind() == protoreflect.StringKind {
+			r.Set(fd, protoreflect.ValueOfString("<redacted "+strconv.Itoa(len(v.String()))+" bytes>"))
+		}
+		return true
+	})
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return ""
+	}
+	summary := string(data)
+	if len(summary) > maxAuditRequestSize {
+		summary = summary[:maxAuditRequestSize]
+		for !utf8.ValidString(summary) {
+			summary = summary[:len(summary)-1]
+		}
+		summary += "..."
+	}
+	return summary
+}
+
+// clientIP 返回客户端IP，HTTP 请求取连接的对端地址，不信任可被伪造的 X-Forwarded-For
+func clientIP(ctx context.Context, tr transport.Transporter) string {
+	var addr string
+	if ht, ok := tr.(http.Transporter); ok {
+		addr = ht.Request().RemoteAddr
+	} else if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
@@ -0,0 +1,372 @@
+package server
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+	"heytom-scheduler/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// 携带凭证的请求头
+const (
+	apiKeyHeader        = "X-API-Key"
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// jwksReloadInterval 出现未知 kid 时重新读取 JWKS 文件的最小间隔
+const jwksReloadInterval = time.Minute
+
+// ErrUnauthenticated 未携带凭证或凭证无效
+var ErrUnauthenticated = errors.Unauthorized(pb.ErrorReason_UNAUTHENTICATED.String(), "missing or invalid credentials")
+
+// Authenticator 认证器，按配置校验 API Key、JWT 和 gRPC 客户端证书
+type Authenticator struct {
+	apiKeys []apiKey
+	jwt     *jwtVerifier
+	tls     *tls.Config // 配置客户端证书认证时 gRPC 服务使用的 TLS 配置
+}
+
+type apiKey struct {
+	name string
+	hash [sha256.Size]byte
+}
+
+// NewAuthenticator 按配置创建认证器，未配置任何认证方式时不校验凭证
+func NewAuthenticator(c *conf.Server) (*Authenticator, error) {
+	cfg := c.GetAuth()
+	a := &Authenticator{}
+	for _, k := range cfg.GetApiKeys() {
+		if k.GetName() == "" || k.GetKey() == "" {
+			return nil, fmt.Errorf("server.auth.api_keys: name and key are required")
+		}
+		a.apiKeys = append(a.apiKeys, apiKey{name: k.GetName(), hash: sha256.Sum256([]byte(k.GetKey()))})
+	}
+	if j := cfg.GetJwt(); j.GetSecret() != "" || j.GetJwksFile() != "" {
+		v, err := newJWTVerifier(j)
+		if err != nil {
+			return nil, err
+		}
+		a.jwt = v
+	}
+	if m := cfg.GetMtls(); m != nil {
+		tlsConf, err := newClientAuthTLSConfig(m, len(a.apiKeys) == 0 && a.jwt == nil)
+		if err != nil {
+			return nil, err
+		}
+		a.tls = tlsConf
+	}
+	return a, nil
+}
+
+// Enabled 是否配置了认证方式
+func (a *Authenticator) Enabled() bool {
+	return len(a.apiKeys) > 0 || a.jwt != nil || a.tls != nil
+}
+
+// TLSConfig 返回 gRPC 服务的 TLS 配置，未配置客户端证书认证时返回 nil
+func (a *Authenticator) TLSConfig() *tls.Config {
+	return a.tls
+}
+
+// Authenticate 校验请求携带的凭证，依次检查 API Key、Bearer Token 和客户端证书；
+// 携带了凭证但校验失败时直接拒绝，不再尝试其他方式
+func (a *Authenticator) Authenticate(ctx context.Context, tr transport.Transporter) (*biz.Principal, error) {
+	if key := tr.RequestHeader().Get(apiKeyHeader); key != "" && len(a.apiKeys) > 0 {
+		return a.authenticateAPIKey(key)
+	}
+	if auth := tr.RequestHeader().Get(authorizationHeader); strings.HasPrefix(auth, bearerPrefix) && a.jwt != nil {
+		name, err := a.jwt.verify(strings.TrimPrefix(auth, bearerPrefix))
+		if err != nil {
+			return nil, ErrUnauthenticated.WithCause(err)
+		}
+		return &biz.Principal{Name: name, Method: biz.AuthMethodJWT}, nil
+	}
+	if a.tls != nil && tr.Kind() == transport.KindGRPC {
+		if name := peerCommonName(ctx); name != "" {
+			return &biz.Principal{Name: name, Method: biz.AuthMethodMTLS}, nil
+		}
+	}
+	return nil, ErrUnauthenticated
+}
+
+// authenticateAPIKey 以常量时间比较 API Key 的摘要
+func (a *Authenticator) authenticateAPIKey(key string) (*biz.Principal, error) {
+	hash := sha256.Sum256([]byte(key))
+	var name string
+	for _, k := range a.apiKeys {
+		if subtle.ConstantTimeCompare(hash[:], k.hash[:]) == 1 && name == "" {
+			name = k.name
+		}
+	}
+	if name == "" {
+		return nil, ErrUnauthenticated
+	}
+	return &biz.Principal{Name: name, Method: biz.AuthMethodAPIKey}, nil
+}
+
+// peerCommonName 返回 gRPC 对端已验证客户端证书的 Common Name
+func peerCommonName(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return info.State.VerifiedChains[0][0].Subject.CommonName
+}
+
+// authenticate 校验凭证并将已认证的调用方放入上下文，未配置认证方式时不校验
+func authenticate(a *Authenticator) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if !a.Enabled() {
+				return handler(ctx, req)
+			}
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, ErrUnauthenticated
+			}
+			principal, err := a.Authenticate(ctx, tr)
+			if err != nil {
+				return nil, err
+			}
+			return handler(biz.NewPrincipalContext(ctx, principal), req)
+		}
+	}
+}
+
+// newClientAuthTLSConfig 创建校验客户端证书的 TLS 配置，required 为 true 时要求所有连接携带客户端证书
+func newClientAuthTLSConfig(c *conf.Server_Auth_MTLS, required bool) (*tls.Config, error) {
+	if c.GetCaFile() == "" || c.GetCertFile() == "" || c.GetKeyFile() == "" {
+		return nil, fmt.Errorf("server.auth.mtls: ca_file, cert_file and key_file are required")
+	}
+	cert, err := tls.LoadX509KeyPair(c.GetCertFile(), c.GetKeyFile())
+	if err != nil {
+		return nil, fmt.Errorf("load server certificate: %w", err)
+	}
+	ca, err := os.ReadFile(c.GetCaFile())
+	if err != nil {
+		return nil, fmt.Errorf("read client CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no certificates found in %s", c.GetCaFile())
+	}
+	clientAuth := tls.VerifyClientCertIfGiven
+	if required {
+		clientAuth = tls.RequireAndVerifyClientCert
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   clientAuth,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// jwtVerifier 校验 HS 共享密钥或 RS 公钥签名的 JWT
+type jwtVerifier struct {
+	secret    []byte
+	jwksFile  string
+	issuer    string
+	audience  string
+	nameClaim string
+	methods   []string
+	// allowMissingExp 接受没有 exp 声明的令牌
+	allowMissingExp bool
+
+	mu       sync.Mutex
+	keys     map[string]*rsa.PublicKey
+	loadedAt time.Time
+}
+
+// newJWTVerifier 创建 JWT 校验器，配置了 JWKS 文件时立即读取一次
+func newJWTVerifier(c *conf.Server_Auth_JWT) (*jwtVerifier, error) {
+	v := &jwtVerifier{
+		secret:    []byte(c.GetSecret()),
+		jwksFile:  c.GetJwksFile(),
+		issuer:    c.GetIssuer(),
+		audience:  c.GetAudience(),
+		nameClaim: c.GetNameClaim(),
+
+		allowMissingExp: c.GetAllowMissingExp(),
+	}
+	if v.nameClaim == "" {
+		v.nameClaim = "sub"
+	}
+	if len(v.secret) > 0 {
+		v.methods = append(v.methods, "HS256", "HS384", "HS512")
+	}
+	if v.jwksFile != "" {
+		if err := v.loadKeys(); err != nil {
+			return nil, err
+		}
+		v.methods = append(v.methods, "RS256", "RS384", "RS512")
+	}
+	return v, nil
+}
+
+// verify 校验签名、有效期、签发者和受众，返回主体名称；未配置 allow_missing_exp 时要求令牌携带 exp
+func (v *jwtVerifier) verify(token string) (string, error) {
+	claims := jwt.MapClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods(v.methods))
+	if _, err := parser.ParseWithClaims(token, claims, v.keyFunc); err != nil {
+		return "", err
+	}
+	if _, ok := claims["exp"]; !ok && !v.allowMissingExp {
+		return "", fmt.Errorf("claim \"exp\" is missing")
+	}
+	if v.issuer != "" && !claims.VerifyIssuer(v.issuer, true) {
+		return "", fmt.Errorf("unexpected issuer")
+	}
+	if v.audience != "" && !claims.VerifyAudience(v.audience, true) {
+		return "", fmt.Errorf("unexpected audience")
+	}
+	name, _ := claims[v.nameClaim].(string)
+	if name == "" {
+		return "", fmt.Errorf("claim %q is missing", v.nameClaim)
+	}
+	return name, nil
+}
+
+// keyFunc 按签名算法返回校验密钥，RS 算法按 kid 查找公钥
+func (v *jwtVerifier) keyFunc(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		return v.secret, nil
+	case *jwt.SigningMethodRSA:
+		kid, _ := token.Header["kid"].(string)
+		return v.publicKey(kid)
+	default:
+		return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+	}
+}
+
+// publicKey 返回 kid 对应的公钥，未找到时按最小间隔重新读取 JWKS 文件，以支持密钥轮换
+func (v *jwtVerifier) publicKey(kid string) (*rsa.PublicKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if key, ok := v.keys[kid]; ok {
+		return key, nil
+	}
+	if time.Since(v.loadedAt) >= jwksReloadInterval {
+		if err := v.loadKeysLocked(); err != nil {
+			return nil, err
+		}
+		if key, ok := v.keys[kid]; ok {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+func (v *jwtVerifier) loadKeys() error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.loadKeysLocked()
+}
+
+// jwks JWKS 文件格式，只使用 RSA 公钥
+type jwks struct {
+	Keys []struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	} `json:"keys"`
+}
+
+// loadKeysLocked 读取 JWKS 文件，调用方需持有锁
+func (v *jwtVerifier) loadKeysLocked() error {
+	v.loadedAt = time.Now()
+	data, err := os.ReadFile(v.jwksFile)
+	if err != nil {
+		return fmt.Errorf("read jwks: %w", err)
+	}
+	var set jwks
+	if err := json.Unmarshal(data, &set); err != nil {
+		return fmt.Errorf("parse jwks: %w", err)
+	}
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return fmt.Errorf("parse jwks key %q: %w", k.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return fmt.Errorf("parse jwks key %q: %w", k.Kid, err)
+		}
+		keys[k.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	}
+	v.keys = keys
+	return nil
+}
+
+// NewRoleBindings 按配置创建角色绑定，配置了绑定时必须同时配置认证方式
+func NewRoleBindings(c *conf.Server) ([]biz.RoleBinding, error) {
+	cfg := c.GetAuth()
+	if len(cfg.GetBindings()) == 0 {
+		return nil, nil
+	}
+	if len(cfg.GetApiKeys()) == 0 && cfg.GetJwt().GetSecret() == "" && cfg.GetJwt().GetJwksFile() == "" && cfg.GetMtls() == nil {
+		return nil, fmt.Errorf("server.auth.bindings requires an authentication method")
+	}
+	bindings := make([]biz.RoleBinding, 0, len(cfg.GetBindings()))
+	for i, b := range cfg.GetBindings() {
+		if b.GetPrincipal() == "" {
+			return nil, fmt.Errorf("server.auth.bindings[%d]: principal is required", i)
+		}
+		if err := biz.ValidateBindingPrincipal(b.GetPrincipal()); err != nil {
+			return nil, fmt.Errorf("server.auth.bindings[%d]: %w", i, err)
+		}
+		role, err := biz.ParseRole(b.GetRole())
+		if err != nil {
+			return nil, fmt.Errorf("server.auth.bindings[%d]: %w", i, err)
+		}
+		for key := range b.GetLabels() {
+			if key == "" || strings.ContainsAny(key, `"\`) {
+				return nil, fmt.Errorf("server.auth.bindings[%d]: invalid label key %q", i, key)
+			}
+		}
+		for _, name := range b.GetNamespaces() {
+			if err := (&biz.Namespace{Name: name}).Validate(); err != nil {
+				return nil, fmt.Errorf("server.auth.bindings[%d]: invalid namespace %q: %w", i, name, err)
+			}
+		}
+		bindings = append(bindings, biz.RoleBinding{
+			Principal: b.GetPrincipal(),
+			Role:      role,
+			Scope:     biz.AccessScope{Namespaces: b.GetNamespaces(), Labels: b.GetLabels()},
+		})
+	}
+	return bindings, nil
+}
@@ -0,0 +1,389 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"heytom-scheduler/internal/biz"
+	"heytom-scheduler/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// testHeader 请求头
+type testHeader map[string]string
+
+func (h testHeader) Get(key string) string      { return h[key] }
+func (h testHeader) Set(key, value string)      { h[key] = value }
+func (h testHeader) Add(key, value string)      { h[key] = value }
+func (h testHeader) Values(key string) []string { return []string{h[key]} }
+func (h testHeader) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// testTransport 测试用的服务端传输信息
+type testTransport struct {
+	kind      transport.Kind
+	operation string
+	header    testHeader
+}
+
+func (t *testTransport) Kind() transport.Kind            { return t.kind }
+func (t *testTransport) Endpoint() string                { return "" }
+func (t *testTransport) Operation() string               { return t.operation }
+func (t *testTransport) RequestHeader() transport.Header { return t.header }
+func (t *testTransport) ReplyHeader() transport.Header   { return testHeader{} }
+
+// newTransport 创建携带请求头的传输信息
+func newTransport(kind transport.Kind, header testHeader) *testTransport {
+	if header == nil {
+		header = testHeader{}
+	}
+	return &testTransport{kind: kind, operation: "/api.scheduler.v1.Scheduler/UpdateTask", header: header}
+}
+
+// signToken 使用 key 按 method 签发携带 claims 的令牌
+func signToken(t *testing.T, method jwt.SigningMethod, key interface{}, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return signed
+}
+
+// validClaims 一小时后过期的声明
+func validClaims(sub string) jwt.MapClaims {
+	return jwt.MapClaims{"sub": sub, "exp": time.Now().Add(time.Hour).Unix()}
+}
+
+// writeJWKS 将公钥写入 JWKS 文件
+func writeJWKS(t *testing.T, path string, keys map[string]*rsa.PrivateKey) {
+	t.Helper()
+	var set jwks
+	for kid, key := range keys {
+		set.Keys = append(set.Keys, struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		}{
+			Kty: "RSA",
+			Kid: kid,
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// mustRSAKey 生成测试用的 RSA 私钥
+func mustRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// mustAuthenticator 按认证配置创建认证器
+func mustAuthenticator(t *testing.T, auth *conf.Server_Auth) *Authenticator {
+	t.Helper()
+	a, err := NewAuthenticator(&conf.Server{Auth: auth})
+	if err != nil {
+		t.Fatalf("NewAuthenticator: %v", err)
+	}
+	return a
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	a := mustAuthenticator(t, &conf.Server_Auth{ApiKeys: []*conf.Server_Auth_APIKey{
+		{Name: "ops", Key: "ops-key"},
+		{Name: "ci", Key: "ci-key"},
+	}})
+
+	tests := []struct {
+		name    string
+		header  testHeader
+		want    string
+		wantErr bool
+	}{
+		{name: "valid", header: testHeader{apiKeyHeader: "ci-key"}, want: "ci"},
+		{name: "invalid", header: testHeader{apiKeyHeader: "wrong"}, wantErr: true},
+		{name: "missing", wantErr: true},
+		{name: "bearer without jwt config", header: testHeader{authorizationHeader: "Bearer ops-key"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := a.Authenticate(context.Background(), newTransport(transport.KindHTTP, tt.header))
+			if tt.wantErr {
+				if !errors.IsUnauthorized(err) {
+					t.Fatalf("err = %v, want unauthenticated", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate: %v", err)
+			}
+			if principal.Name != tt.want || principal.Method != biz.AuthMethodAPIKey {
+				t.Errorf("principal = %+v, want api key %q", principal, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthenticateJWT(t *testing.T) {
+	secret := []byte("jwt-secret")
+	rsaKey := mustRSAKey(t)
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, jwksFile, map[string]*rsa.PrivateKey{"k1": rsaKey})
+
+	tests := []struct {
+		name    string
+		config  *conf.Server_Auth_JWT
+		token   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "hs256",
+			config: &conf.Server_Auth_JWT{Secret: string(secret)},
+			token:  signToken(t, jwt.SigningMethodHS256, secret, "", validClaims("alice")),
+			want:   "alice",
+		},
+		{
+			name:   "rs256",
+			config: &conf.Server_Auth_JWT{JwksFile: jwksFile},
+			token:  signToken(t, jwt.SigningMethodRS256, rsaKey, "k1", validClaims("bot")),
+			want:   "bot",
+		},
+		{
+			name:   "name claim",
+			config: &conf.Server_Auth_JWT{Secret: string(secret), NameClaim: "email"},
+			token:  signToken(t, jwt.SigningMethodHS256, secret, "", jwt.MapClaims{"email": "a@example.com", "exp": time.Now().Add(time.Hour).Unix()}),
+			want:   "a@example.com",
+		},
+		{
+			name:    "expired",
+			config:  &conf.Server_Auth_JWT{Secret: string(secret)},
+			token:   signToken(t, jwt.SigningMethodHS256, secret, "", jwt.MapClaims{"sub": "alice", "exp": time.Now().Add(-time.Minute).Unix()}),
+			wantErr: true,
+		},
+		{
+			name:    "bad signature",
+			config:  &conf.Server_Auth_JWT{Secret: string(secret)},
+			token:   signToken(t, jwt.SigningMethodHS256, []byte("other-secret"), "", validClaims("alice")),
+			wantErr: true,
+		},
+		{
+			name:    "rs256 without jwks",
+			config:  &conf.Server_Auth_JWT{Secret: string(secret)},
+			token:   signToken(t, jwt.SigningMethodRS256, rsaKey, "k1", validClaims("alice")),
+			wantErr: true,
+		},
+		{
+			// 用公钥作为 HMAC 密钥伪造的令牌
+			name:    "hs256 with jwks only",
+			config:  &conf.Server_Auth_JWT{JwksFile: jwksFile},
+			token:   signToken(t, jwt.SigningMethodHS256, x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey), "k1", validClaims("alice")),
+			wantErr: true,
+		},
+		{
+			name:    "none",
+			config:  &conf.Server_Auth_JWT{Secret: string(secret)},
+			token:   signToken(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "", validClaims("alice")),
+			wantErr: true,
+		},
+		{
+			name:    "missing exp",
+			config:  &conf.Server_Auth_JWT{Secret: string(secret)},
+			token:   signToken(t, jwt.SigningMethodHS256, secret, "", jwt.MapClaims{"sub": "alice"}),
+			wantErr: true,
+		},
+		{
+			name:   "missing exp allowed",
+			config: &conf.Server_Auth_JWT{Secret: string(secret), AllowMissingExp: true},
+			token:  signToken(t, jwt.SigningMethodHS256, secret, "", jwt.MapClaims{"sub": "alice"}),
+			want:   "alice",
+		},
+		{
+			name:    "wrong issuer",
+			config:  &conf.Server_Auth_JWT{Secret: string(secret), Issuer: "https://sso.example.com"},
+			token:   signToken(t, jwt.SigningMethodHS256, secret, "", jwt.MapClaims{"sub": "alice", "iss": "https://evil.example.com", "exp": time.Now().Add(time.Hour).Unix()}),
+			wantErr: true,
+		},
+		{
+			name:    "wrong audience",
+			config:  &conf.Server_Auth_JWT{Secret: string(secret), Audience: "heytom-scheduler"},
+			token:   signToken(t, jwt.SigningMethodHS256, secret, "", jwt.MapClaims{"sub": "alice", "aud": "other", "exp": time.Now().Add(time.Hour).Unix()}),
+			wantErr: true,
+		},
+		{
+			name:    "missing subject",
+			config:  &conf.Server_Auth_JWT{Secret: string(secret)},
+			token:   signToken(t, jwt.SigningMethodHS256, secret, "", jwt.MapClaims{"exp": time.Now().Add(time.Hour).Unix()}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := mustAuthenticator(t, &conf.Server_Auth{Jwt: tt.config})
+			header := testHeader{authorizationHeader: bearerPrefix + tt.token}
+			principal, err := a.Authenticate(context.Background(), newTransport(transport.KindHTTP, header))
+			if tt.wantErr {
+				if !errors.IsUnauthorized(err) {
+					t.Fatalf("err = %v, want unauthenticated", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate: %v", err)
+			}
+			if principal.Name != tt.want || principal.Method != biz.AuthMethodJWT {
+				t.Errorf("principal = %+v, want jwt %q", principal, tt.want)
+			}
+		})
+	}
+}
+
+func TestJWKSRotation(t *testing.T) {
+	oldKey, newKey := mustRSAKey(t), mustRSAKey(t)
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, jwksFile, map[string]*rsa.PrivateKey{"old": oldKey})
+	a := mustAuthenticator(t, &conf.Server_Auth{Jwt: &conf.Server_Auth_JWT{JwksFile: jwksFile}})
+
+	verify := func(key *rsa.PrivateKey, kid string) error {
+		_, err := a.jwt.verify(signToken(t, jwt.SigningMethodRS256, key, kid, validClaims("bot")))
+		return err
+	}
+	if err := verify(oldKey, "old"); err != nil {
+		t.Fatalf("verify with old key: %v", err)
+	}
+
+	// 轮换后在重新读取间隔内不会重新读取文件
+	writeJWKS(t, jwksFile, map[string]*rsa.PrivateKey{"new": newKey})
+	if err := verify(newKey, "new"); err == nil {
+		t.Fatalf("new key accepted before the reload interval")
+	}
+
+	// 超过间隔后出现未知 kid 时重新读取，移除的密钥不再可用
+	a.jwt.loadedAt = time.Now().Add(-jwksReloadInterval)
+	if err := verify(newKey, "new"); err != nil {
+		t.Fatalf("verify with new key: %v", err)
+	}
+	if err := verify(oldKey, "old"); err == nil {
+		t.Fatalf("removed key still accepted")
+	}
+	// 用其他私钥签名但声称已知 kid 的令牌
+	if err := verify(oldKey, "new"); err == nil {
+		t.Fatalf("token signed by another key accepted")
+	}
+}
+
+// tlsPeerContext 返回携带已验证客户端证书的 gRPC 对端上下文
+func tlsPeerContext(commonName string) context.Context {
+	state := tls.ConnectionState{}
+	if commonName != "" {
+		cert := &x509.Certificate{Subject: pkix.Name{CommonName: commonName}}
+		state.VerifiedChains = [][]*x509.Certificate{{cert}}
+	}
+	return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+}
+
+func TestAuthenticateMTLS(t *testing.T) {
+	// 只配置客户端证书认证
+	a := &Authenticator{tls: &tls.Config{}}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		kind    transport.Kind
+		want    string
+		wantErr bool
+	}{
+		{name: "verified certificate", ctx: tlsPeerContext("worker-1"), kind: transport.KindGRPC, want: "worker-1"},
+		{name: "no certificate", ctx: tlsPeerContext(""), kind: transport.KindGRPC, wantErr: true},
+		{name: "no peer", ctx: context.Background(), kind: transport.KindGRPC, wantErr: true},
+		// HTTP 不支持客户端证书认证，只配置了 mTLS 时 HTTP 请求一律拒绝
+		{name: "http", ctx: tlsPeerContext("worker-1"), kind: transport.KindHTTP, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := a.Authenticate(tt.ctx, newTransport(tt.kind, nil))
+			if tt.wantErr {
+				if !errors.IsUnauthorized(err) {
+					t.Fatalf("err = %v, want unauthenticated", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate: %v", err)
+			}
+			if principal.Name != tt.want || principal.Method != biz.AuthMethodMTLS {
+				t.Errorf("principal = %+v, want mtls %q", principal, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	tests := []struct {
+		name        string
+		auth        *conf.Server_Auth
+		wantEnabled bool
+		wantErr     bool
+	}{
+		{name: "none"},
+		{name: "api key", auth: &conf.Server_Auth{ApiKeys: []*conf.Server_Auth_APIKey{{Name: "ops", Key: "k"}}}, wantEnabled: true},
+		{name: "api key without name", auth: &conf.Server_Auth{ApiKeys: []*conf.Server_Auth_APIKey{{Key: "k"}}}, wantErr: true},
+		{name: "missing jwks file", auth: &conf.Server_Auth{Jwt: &conf.Server_Auth_JWT{JwksFile: filepath.Join(t.TempDir(), "missing.json")}}, wantErr: true},
+		{name: "incomplete mtls", auth: &conf.Server_Auth{Mtls: &conf.Server_Auth_MTLS{CaFile: "ca.crt"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := NewAuthenticator(&conf.Server{Auth: tt.auth})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("NewAuthenticator succeeded, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewAuthenticator: %v", err)
+			}
+			if a.Enabled() != tt.wantEnabled {
+				t.Errorf("Enabled = %v, want %v", a.Enabled(), tt.wantEnabled)
+			}
+		})
+	}
+}
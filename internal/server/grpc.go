@@ -2,19 +2,27 @@ package server
 
 import (
 	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
 	"heytom-scheduler/internal/conf"
 	"heytom-scheduler/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, schedulerSvc *service.SchedulerService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, schedulerSvc *service.SchedulerService, auditUc *biz.AuditUsecase, authn *Authenticator, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			errorReason(logger),
+			audit(auditUc),
+			authenticate(authn),
+			author(authn),
+			namespace(),
+			validate.Validator(),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -26,6 +34,9 @@ func NewGRPCServer(c *conf.Server, schedulerSvc *service.SchedulerService, logge
 	if c.Grpc.Timeout != nil {
 		opts = append(opts, grpc.Timeout(c.Grpc.Timeout.AsDuration()))
 	}
+	if tlsConf := authn.TLSConfig(); tlsConf != nil {
+		opts = append(opts, grpc.TLSConfig(tlsConf))
+	}
 	srv := grpc.NewServer(opts...)
 	pb.RegisterSchedulerServer(srv, schedulerSvc)
 	return srv
@@ -2,19 +2,27 @@ package server
 
 import (
 	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
 	"heytom-scheduler/internal/conf"
 	"heytom-scheduler/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, schedulerSvc *service.SchedulerService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, schedulerSvc *service.SchedulerService, auditUc *biz.AuditUsecase, authn *Authenticator, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			errorReason(logger),
+			audit(auditUc),
+			authenticate(authn),
+			author(authn),
+			namespace(),
+			validate.Validator(),
 		),
 	}
 	if c.Http.Network != "" {
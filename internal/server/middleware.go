@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+	stderrors "errors"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// authorHeader 携带操作者的请求头，记录为任务修订的作者
+const authorHeader = "X-Operator"
+
+// author 确定操作者并放入上下文，同时作为审计事件的操作者
+// 启用认证时操作者只取已认证的调用方，忽略可被伪造的请求头；未启用认证时从请求头读取
+func author(a *Authenticator) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if a.Enabled() {
+				if principal := biz.PrincipalFromContext(ctx); principal != nil {
+					ctx = biz.NewAuthorContext(ctx, principal.Name)
+				}
+			} else if tr, ok := transport.FromServerContext(ctx); ok {
+				if name := tr.RequestHeader().Get(authorHeader); name != "" {
+					ctx = biz.NewAuthorContext(ctx, name)
+				}
+			}
+			setAuditActor(ctx, biz.AuthorFromContext(ctx))
+			return handler(ctx, req)
+		}
+	}
+}
+
+// namespaceHeader 限定请求命名空间的请求头，设置后只能读写该命名空间中的任务和执行记录
+const namespaceHeader = "X-Namespace"
+
+// namespace 从请求头读取命名空间并放入上下文
+func namespace() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				if name := tr.RequestHeader().Get(namespaceHeader); name != "" {
+					ctx = biz.NewNamespaceContext(ctx, name)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// errorReason 将未携带错误原因的错误转换为 kratos 错误，内部错误的详细信息只记录在日志中
+func errorReason(logger log.Logger) middleware.Middleware {
+	helper := log.NewHelper(logger)
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			reply, err := handler(ctx, req)
+			if err == nil {
+				return reply, nil
+			}
+			var se *errors.Error
+			switch {
+			case stderrors.As(err, &se):
+				return reply, err
+			case stderrors.Is(err, context.DeadlineExceeded):
+				return nil, errors.GatewayTimeout(pb.ErrorReason_DEADLINE_EXCEEDED.String(), "request deadline exceeded")
+			case stderrors.Is(err, context.Canceled):
+				return nil, errors.ClientClosed(pb.ErrorReason_CLIENT_CLOSED.String(), "request cancelled by the client")
+			}
+			operation := ""
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				operation = tr.Operation()
+			}
+			helper.WithContext(ctx).Errorf("%s: %v", operation, err)
+			return nil, errors.InternalServer(pb.ErrorReason_INTERNAL.String(), "internal error")
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package server
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"testing"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+	"heytom-scheduler/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/grpc/codes"
+)
+
+// recordingSink 保存写入的审计事件
+type recordingSink struct {
+	events []*biz.AuditEvent
+}
+
+func (s *recordingSink) WriteAuditEvent(ctx context.Context, event *biz.AuditEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestMiddlewareChain(t *testing.T) {
+	withKey := mustAuthenticator(t, &conf.Server_Auth{ApiKeys: []*conf.Server_Auth_APIKey{{Name: "ops", Key: "ops-key"}}})
+	noAuth := mustAuthenticator(t, nil)
+
+	tests := []struct {
+		name       string
+		authn      *Authenticator
+		operation  string
+		header     testHeader
+		wantErr    bool
+		wantAuthor string
+		wantEvent  bool
+		wantActor  string
+		wantResult pb.AuditResult
+	}{
+		{
+			name:       "author from principal ignores header",
+			authn:      withKey,
+			operation:  "UpdateTask",
+			header:     testHeader{apiKeyHeader: "ops-key", authorHeader: "mallory"},
+			wantAuthor: "ops",
+			wantEvent:  true,
+			wantActor:  "ops",
+			wantResult: pb.AuditResult_AUDIT_SUCCESS,
+		},
+		{
+			name:       "denied write is audited",
+			authn:      withKey,
+			operation:  "DeleteTask",
+			header:     testHeader{apiKeyHeader: "wrong", authorHeader: "mallory"},
+			wantErr:    true,
+			wantEvent:  true,
+			wantResult: pb.AuditResult_AUDIT_FAILURE,
+		},
+		{
+			name:       "denied read is audited",
+			authn:      withKey,
+			operation:  "ListTasks",
+			wantErr:    true,
+			wantEvent:  true,
+			wantResult: pb.AuditResult_AUDIT_FAILURE,
+		},
+		{
+			name:       "successful read is not audited",
+			authn:      withKey,
+			operation:  "GetTask",
+			header:     testHeader{apiKeyHeader: "ops-key"},
+			wantAuthor: "ops",
+		},
+		{
+			name:       "author from header without auth",
+			authn:      noAuth,
+			operation:  "UpdateTask",
+			header:     testHeader{authorHeader: "alice"},
+			wantAuthor: "alice",
+			wantEvent:  true,
+			wantActor:  "alice",
+			wantResult: pb.AuditResult_AUDIT_SUCCESS,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := &recordingSink{}
+			uc := biz.NewAuditUsecase(nil, []biz.AuditSink{sink}, log.DefaultLogger)
+
+			var gotAuthor string
+			handler := middleware.Chain(audit(uc), authenticate(tt.authn), author(tt.authn), namespace())(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					gotAuthor = biz.AuthorFromContext(ctx)
+					return &pb.TaskReply{}, nil
+				})
+
+			tr := newTransport(transport.KindHTTP, tt.header)
+			tr.operation = "/api.scheduler.v1.Scheduler/" + tt.operation
+			_, err := handler(transport.NewServerContext(context.Background(), tr), &pb.UpdateTaskRequest{Id: 5})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			if gotAuthor != tt.wantAuthor {
+				t.Errorf("author = %q, want %q", gotAuthor, tt.wantAuthor)
+			}
+
+			if !tt.wantEvent {
+				if len(sink.events) != 0 {
+					t.Fatalf("recorded %d audit events, want none", len(sink.events))
+				}
+				return
+			}
+			if len(sink.events) != 1 {
+				t.Fatalf("recorded %d audit events, want 1", len(sink.events))
+			}
+			event := sink.events[0]
+			if event.Actor != tt.wantActor || event.Result != tt.wantResult || event.Operation != tt.operation || event.TargetID != 5 {
+				t.Errorf("event = %+v, want actor %q, result %v, operation %s, target 5", event, tt.wantActor, tt.wantResult, tt.operation)
+			}
+		})
+	}
+}
+
+func TestErrorReason(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		code     int
+		grpcCode codes.Code
+		reason   string
+		message  string
+	}{
+		{"not found", biz.ErrTaskNotFound, 404, codes.NotFound, pb.ErrorReason_TASK_NOT_FOUND.String(), "task not found"},
+		{"wrapped kratos error", fmt.Errorf("restore: %w", biz.ErrTaskNotDeleted), 409, codes.Aborted, pb.ErrorReason_TASK_NOT_DELETED.String(), "task is not deleted"},
+		{"version mismatch", biz.ErrTaskVersionMismatch(4), 409, codes.Aborted, pb.ErrorReason_TASK_VERSION_MISMATCH.String(), "task was modified by another request, reload it and retry"},
+		{"quota", errors.New(429, pb.ErrorReason_NAMESPACE_QUOTA_EXCEEDED.String(), "quota"), 429, codes.ResourceExhausted, pb.ErrorReason_NAMESPACE_QUOTA_EXCEEDED.String(), "quota"},
+		{"deadline", fmt.Errorf("query: %w", context.DeadlineExceeded), 504, codes.DeadlineExceeded, pb.ErrorReason_DEADLINE_EXCEEDED.String(), "request deadline exceeded"},
+		{"cancelled", context.Canceled, 499, codes.Canceled, pb.ErrorReason_CLIENT_CLOSED.String(), "request cancelled by the client"},
+		// 内部错误的详细信息不返回给调用方
+		{"internal", stderrors.New("dial tcp 10.0.0.5:3306: connection refused"), 500, codes.Internal, pb.ErrorReason_INTERNAL.String(), "internal error"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := transport.NewServerContext(context.Background(), newTransport(transport.KindGRPC, nil))
+			handler := errorReason(log.DefaultLogger)(func(context.Context, interface{}) (interface{}, error) {
+				return nil, tt.err
+			})
+			_, err := handler(ctx, nil)
+			se := errors.FromError(err)
+			if int(se.Code) != tt.code || se.Reason != tt.reason || se.Message != tt.message {
+				t.Errorf("error = %d %s %q, want %d %s %q", se.Code, se.Reason, se.Message, tt.code, tt.reason, tt.message)
+			}
+			if got := se.GRPCStatus().Code(); got != tt.grpcCode {
+				t.Errorf("gRPC code = %s, want %s", got, tt.grpcCode)
+			}
+		})
+	}
+
+	reply, err := errorReason(log.DefaultLogger)(func(context.Context, interface{}) (interface{}, error) {
+		return "ok", nil
+	})(context.Background(), nil)
+	if reply != "ok" || err != nil {
+		t.Errorf("successful call = %v, %v; want ok, nil", reply, err)
+	}
+}
@@ -0,0 +1,282 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"heytom-scheduler/internal/biz"
+	"heytom-scheduler/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+const (
+	defaultDispatchInterval  = time.Second
+	defaultDispatchBatchSize = 100
+	defaultWorkers           = 10
+	defaultPollInterval      = time.Second
+	defaultRetentionInterval = time.Hour
+	defaultRetentionBatch    = 500
+	defaultHeartbeatInterval = 5 * time.Second
+	defaultExecutionLease    = time.Minute
+	reclaimBatchSize         = 100
+)
+
+var _ transport.Server = (*WorkerServer)(nil)
+
+// WorkerServer 调度工作节点：周期性分发到期任务，并认领执行排队中的执行记录
+type WorkerServer struct {
+	nodeID            string
+	dispatchInterval  time.Duration
+	dispatchBatchSize int
+	workers           int
+	pollInterval      time.Duration
+	retentionInterval time.Duration
+	retentionBatch    int
+	retention         biz.RetentionPolicy
+	heartbeatInterval time.Duration
+	executionLease    time.Duration
+
+	dispatcher *biz.Dispatcher
+	executor   *biz.Executor
+	janitor    *biz.RetentionJanitor
+	log        *log.Helper
+
+	slots   chan struct{}
+	running sync.WaitGroup
+	stop    chan struct{}
+	drained chan struct{} // 停止后正在执行的任务都已结束
+	once    sync.Once
+}
+
+// NewWorkerServer new a scheduler worker server.
+func NewWorkerServer(c *conf.Scheduler, dispatcher *biz.Dispatcher, executor *biz.Executor, janitor *biz.RetentionJanitor, logger log.Logger) *WorkerServer {
+	s := &WorkerServer{
+		nodeID:            c.GetNodeId(),
+		dispatchInterval:  defaultDispatchInterval,
+		dispatchBatchSize: defaultDispatchBatchSize,
+		workers:           defaultWorkers,
+		pollInterval:      defaultPollInterval,
+		retentionInterval: defaultRetentionInterval,
+		retentionBatch:    defaultRetentionBatch,
+		heartbeatInterval: defaultHeartbeatInterval,
+		executionLease:    defaultExecutionLease,
+		dispatcher:        dispatcher,
+		executor:          executor,
+		janitor:           janitor,
+		log:               log.NewHelper(logger),
+		stop:              make(chan struct{}),
+		drained:           make(chan struct{}),
+	}
+	if s.nodeID == "" {
+		s.nodeID, _ = os.Hostname()
+	}
+	if c.GetDispatchInterval() != nil {
+		s.dispatchInterval = c.GetDispatchInterval().AsDuration()
+	}
+	if c.GetDispatchBatchSize() > 0 {
+		s.dispatchBatchSize = int(c.GetDispatchBatchSize())
+	}
+	if c.GetWorkers() > 0 {
+		s.workers = int(c.GetWorkers())
+	}
+	if c.GetPollInterval() != nil {
+		s.pollInterval = c.GetPollInterval().AsDuration()
+	}
+	if r := c.GetRetention(); r != nil {
+		if r.GetInterval() != nil {
+			s.retentionInterval = r.GetInterval().AsDuration()
+		}
+		if r.GetBatchSize() > 0 {
+			s.retentionBatch = int(r.GetBatchSize())
+		}
+		s.retention = biz.RetentionPolicy{
+			KeepLast:       r.GetKeepLast(),
+			KeepDays:       r.GetKeepDays(),
+			FailedKeepDays: r.GetFailedKeepDays(),
+		}
+	}
+	if c.GetHeartbeatInterval() != nil {
+		s.heartbeatInterval = c.GetHeartbeatInterval().AsDuration()
+	}
+	if c.GetExecutionLease() != nil {
+		s.executionLease = c.GetExecutionLease().AsDuration()
+	}
+	if s.executionLease <= 2*s.heartbeatInterval {
+		s.log.Warnf("[worker] execution_lease %s should be several times heartbeat_interval %s, executions may be reclaimed while running", s.executionLease, s.heartbeatInterval)
+	}
+	s.slots = make(chan struct{}, s.workers)
+	return s
+}
+
+// Start 启动分发、执行、心跳与执行记录清理循环，阻塞直到 Stop 被调用且正在执行的任务都已结束
+func (s *WorkerServer) Start(ctx context.Context) error {
+	s.log.Infof("[worker] node %s started with %d workers", s.nodeID, s.workers)
+
+	var loops sync.WaitGroup
+	loops.Add(4)
+	go func() {
+		defer loops.Done()
+		s.loop(s.stop, s.dispatchInterval, s.dispatch)
+	}()
+	go func() {
+		defer loops.Done()
+		s.loop(s.stop, s.pollInterval, s.poll)
+	}()
+	go func() {
+		defer loops.Done()
+		s.loop(s.stop, s.retentionInterval, s.prune)
+	}()
+	go func() {
+		defer loops.Done()
+		// 停止后继续发送心跳，避免仍在执行的记录被其他节点回收
+		s.loop(s.drained, s.heartbeatInterval, s.heartbeat)
+	}()
+	loops.Wait()
+	return nil
+}
+
+// Stop 停止认领新的执行记录，并等待正在执行的任务结束
+func (s *WorkerServer) Stop(ctx context.Context) error {
+	s.once.Do(func() {
+		close(s.stop)
+		go func() {
+			s.running.Wait()
+			close(s.drained)
+		}()
+	})
+
+	select {
+	case <-s.drained:
+		s.log.Info("[worker] stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// loop 按固定间隔执行 fn，直到 done 被关闭
+func (s *WorkerServer) loop(done <-chan struct{}, interval time.Duration, fn func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			fn(context.Background())
+		}
+	}
+}
+
+// dispatch 分发到期任务
+func (s *WorkerServer) dispatch(ctx context.Context) {
+	if _, err := s.dispatcher.Dispatch(ctx, time.Now(), s.dispatchBatchSize); err != nil {
+		s.log.Errorf("[worker] dispatch: %v", err)
+	}
+}
+
+// poll 按空闲工作槽数量认领执行记录并异步执行
+func (s *WorkerServer) poll(ctx context.Context) {
+	free := cap(s.slots) - len(s.slots)
+	if free <= 0 {
+		return
+	}
+
+	executions, err := s.executor.Claim(ctx, s.nodeID, free)
+	if err != nil {
+		s.log.Errorf("[worker] claim executions: %v", err)
+	}
+	for _, execution := range executions {
+		s.slots <- struct{}{}
+		s.running.Add(1)
+		go func(execution *biz.TaskExecution) {
+			defer func() {
+				<-s.slots
+				s.running.Done()
+			}()
+			// 执行不随 Stop 取消，由 Stop 等待其完成
+			s.executor.Execute(context.Background(), execution)
+		}(execution)
+	}
+}
+
+// heartbeat 更新本节点执行中记录的心跳，并回收其他节点上心跳超时的执行中记录
+func (s *WorkerServer) heartbeat(ctx context.Context) {
+	now := time.Now()
+	if err := s.executor.Heartbeat(ctx, now); err != nil {
+		s.log.Errorf("[worker] heartbeat executions: %v", err)
+	}
+	if _, err := s.executor.ReclaimExpired(ctx, now, s.executionLease, reclaimBatchSize); err != nil {
+		s.log.Errorf("[worker] reclaim expired executions: %v", err)
+	}
+}
+
+// prune 按保留策略清理执行记录，服务停止时中断清理
+func (s *WorkerServer) prune(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-s.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	if _, err := s.janitor.Prune(ctx, s.nodeID, s.retention, time.Now(), s.retentionBatch); err != nil && ctx.Err() == nil {
+		s.log.Errorf("[worker] prune executions: %v", err)
+	}
+}
+
+// NewHandlerRegistry 按配置创建处理器注册表
+// 配置了 http_handler.allowed_hosts 时注册 http 处理器，handlers 中的每一项以其名称注册
+func NewHandlerRegistry(c *conf.Scheduler, logger log.Logger) (*biz.HandlerRegistry, error) {
+	registry := biz.NewHandlerRegistry()
+	helper := log.NewHelper(logger)
+	if cfg := c.GetHttpHandler(); len(cfg.GetAllowedHosts()) > 0 {
+		handler, err := newHTTPHandler(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("scheduler.http_handler: %w", err)
+		}
+		registry.Register("http", handler)
+	} else {
+		helper.Warn("[worker] scheduler.http_handler.allowed_hosts is empty, the http handler is not registered")
+	}
+
+	for i, h := range c.GetHandlers() {
+		if h.GetName() == "" {
+			return nil, fmt.Errorf("scheduler.handlers[%d]: name is required", i)
+		}
+		if h.GetName() == "http" {
+			return nil, fmt.Errorf("scheduler.handlers[%d]: name http is reserved, configure scheduler.http_handler instead", i)
+		}
+		if _, ok := registry.Get(h.GetName()); ok {
+			return nil, fmt.Errorf("scheduler.handlers[%d]: duplicate handler %q", i, h.GetName())
+		}
+		if len(h.GetHttp().GetAllowedHosts()) == 0 {
+			return nil, fmt.Errorf("scheduler.handlers[%d]: http.allowed_hosts is required", i)
+		}
+		handler, err := newHTTPHandler(h.GetHttp())
+		if err != nil {
+			return nil, fmt.Errorf("scheduler.handlers[%d]: %w", i, err)
+		}
+		registry.Register(h.GetName(), handler)
+	}
+
+	helper.Infof("[worker] registered handlers: %v", registry.Names())
+	return registry, nil
+}
+
+// newHTTPHandler 按配置创建 http 处理器
+func newHTTPHandler(c *conf.Scheduler_HTTPHandler) (biz.Handler, error) {
+	opts := biz.HTTPHandlerOptions{AllowedHosts: c.GetAllowedHosts()}
+	if c.GetTimeout() != nil {
+		opts.Timeout = c.GetTimeout().AsDuration()
+	}
+	return biz.NewHTTPHandler(opts)
+}
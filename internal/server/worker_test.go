@@ -0,0 +1,90 @@
+package server
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"heytom-scheduler/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestNewHandlerRegistry(t *testing.T) {
+	hosts := []string{"api.example.com"}
+	tests := []struct {
+		name      string
+		config    *conf.Scheduler
+		wantNames []string
+		wantErr   string
+	}{
+		{name: "none", config: &conf.Scheduler{}, wantNames: []string{}},
+		{
+			name:      "http",
+			config:    &conf.Scheduler{HttpHandler: &conf.Scheduler_HTTPHandler{AllowedHosts: hosts}},
+			wantNames: []string{"http"},
+		},
+		{
+			name: "named handlers",
+			config: &conf.Scheduler{
+				HttpHandler: &conf.Scheduler_HTTPHandler{AllowedHosts: hosts},
+				Handlers: []*conf.Scheduler_Handler{
+					{Name: "billing", Http: &conf.Scheduler_HTTPHandler{AllowedHosts: []string{"billing.example.com"}}},
+				},
+			},
+			wantNames: []string{"billing", "http"},
+		},
+		{
+			name: "named handler without http",
+			config: &conf.Scheduler{Handlers: []*conf.Scheduler_Handler{
+				{Name: "billing", Http: &conf.Scheduler_HTTPHandler{AllowedHosts: hosts}},
+			}},
+			wantNames: []string{"billing"},
+		},
+		{
+			name:    "invalid http host",
+			config:  &conf.Scheduler{HttpHandler: &conf.Scheduler_HTTPHandler{AllowedHosts: []string{"http://api.example.com"}}},
+			wantErr: "scheduler.http_handler",
+		},
+		{
+			name:    "missing name",
+			config:  &conf.Scheduler{Handlers: []*conf.Scheduler_Handler{{Http: &conf.Scheduler_HTTPHandler{AllowedHosts: hosts}}}},
+			wantErr: "name is required",
+		},
+		{
+			name:    "reserved name",
+			config:  &conf.Scheduler{Handlers: []*conf.Scheduler_Handler{{Name: "http", Http: &conf.Scheduler_HTTPHandler{AllowedHosts: hosts}}}},
+			wantErr: "reserved",
+		},
+		{
+			name: "duplicate name",
+			config: &conf.Scheduler{Handlers: []*conf.Scheduler_Handler{
+				{Name: "billing", Http: &conf.Scheduler_HTTPHandler{AllowedHosts: hosts}},
+				{Name: "billing", Http: &conf.Scheduler_HTTPHandler{AllowedHosts: hosts}},
+			}},
+			wantErr: "duplicate handler",
+		},
+		{
+			name:    "missing allowed hosts",
+			config:  &conf.Scheduler{Handlers: []*conf.Scheduler_Handler{{Name: "billing", Http: &conf.Scheduler_HTTPHandler{}}}},
+			wantErr: "allowed_hosts is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry, err := NewHandlerRegistry(tt.config, log.DefaultLogger)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewHandlerRegistry: %v", err)
+			}
+			if got := registry.Names(); !reflect.DeepEqual(got, tt.wantNames) {
+				t.Errorf("names = %v, want %v", got, tt.wantNames)
+			}
+		})
+	}
+}
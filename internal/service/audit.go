@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListAuditEvents 审计事件列表查询
+func (s *SchedulerService) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsReply, error) {
+	if err := s.authz.Check(ctx, biz.PermAuditRead); err != nil {
+		return nil, err
+	}
+
+	events, total, err := s.auditUc.ListAuditEvents(ctx, &biz.AuditEventListFilter{
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+		Actor:     req.Actor,
+		Operation: req.Operation,
+		TargetID:  req.TargetId,
+		Result:    req.Result,
+		StartTime: toTimePtr(req.StartTime),
+		EndTime:   toTimePtr(req.EndTime),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	eventReplies := make([]*pb.AuditEventReply, 0, len(events))
+	for _, event := range events {
+		eventReplies = append(eventReplies, toAuditEventReply(event))
+	}
+
+	return &pb.ListAuditEventsReply{
+		Events:   eventReplies,
+		Total:    total,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}, nil
+}
+
+// toAuditEventReply 转换为 AuditEventReply
+func toAuditEventReply(event *biz.AuditEvent) *pb.AuditEventReply {
+	return &pb.AuditEventReply{
+		Id:        event.ID,
+		Time:      timestamppb.New(event.Time),
+		Actor:     event.Actor,
+		Operation: event.Operation,
+		TargetId:  event.TargetID,
+		Targets:   event.Targets,
+		Request:   event.Request,
+		Result:    event.Result,
+		Code:      event.Code,
+		Reason:    event.Reason,
+		Message:   event.Message,
+		ClientIp:  event.ClientIP,
+		Transport: event.Transport,
+		Duration:  event.Duration,
+	}
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+)
+
+// authorizeTask 校验调用方在任务上的权限，只有范围受限的调用方需要加载任务（包括已删除的任务）
+func (s *SchedulerService) authorizeTask(ctx context.Context, perm biz.Permission, taskID int64) error {
+	if s.authz.Unrestricted(ctx, perm) {
+		return nil
+	}
+	task, err := s.taskUc.LookupTask(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	return s.authz.CheckTask(ctx, perm, task)
+}
+
+// authorizeExecution 校验调用方在执行记录所属任务上的权限
+func (s *SchedulerService) authorizeExecution(ctx context.Context, perm biz.Permission, executionID int64) error {
+	if s.authz.Unrestricted(ctx, perm) {
+		return nil
+	}
+	execution, err := s.executionUc.GetExecution(ctx, executionID)
+	if err != nil {
+		return err
+	}
+	return s.authorizeTask(ctx, perm, execution.TaskID)
+}
+
+// WhoAmI 返回当前调用方、角色授权和不限范围的有效权限
+func (s *SchedulerService) WhoAmI(ctx context.Context, req *pb.WhoAmIRequest) (*pb.WhoAmIReply, error) {
+	reply := &pb.WhoAmIReply{AuthorizationEnabled: s.authz.Enabled()}
+	principal := biz.PrincipalFromContext(ctx)
+	if principal != nil {
+		reply.Name = principal.Name
+		reply.AuthMethod = principal.Method
+	}
+	for _, b := range s.authz.Bindings(principal) {
+		reply.Grants = append(reply.Grants, &pb.RoleGrant{
+			Role:        string(b.Role),
+			Labels:      b.Scope.Labels,
+			Namespaces:  b.Scope.Namespaces,
+			Permissions: toPermissionNames(b.Permissions()),
+		})
+	}
+	reply.Permissions = toPermissionNames(s.authz.EffectivePermissions(principal))
+	return reply, nil
+}
+
+// toPermissionNames 转换权限名称列表
+func toPermissionNames(perms []biz.Permission) []string {
+	names := make([]string, 0, len(perms))
+	for _, p := range perms {
+		names = append(names, string(p))
+	}
+	return names
+}
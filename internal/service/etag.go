@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// taskETag 返回任务版本对应的 ETag
+func taskETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// setTaskETag 在响应头中返回任务版本对应的 ETag，用于后续更新时通过 If-Match 携带
+func setTaskETag(ctx context.Context, task *biz.Task) {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		tr.ReplyHeader().Set("ETag", taskETag(task.Version))
+	}
+}
+
+// requestVersion 返回更新请求携带的任务版本，请求体中的 version 和 If-Match 头都设置时必须一致
+func requestVersion(ctx context.Context, version int64) (int64, error) {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return version, nil
+	}
+	ifMatch := strings.TrimSpace(tr.RequestHeader().Get("If-Match"))
+	if ifMatch == "" {
+		return version, nil
+	}
+
+	tag := strings.TrimPrefix(ifMatch, "W/")
+	unquoted, err := strconv.Unquote(tag)
+	if err != nil {
+		unquoted = tag
+	}
+	headerVersion, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || headerVersion <= 0 {
+		return 0, errors.BadRequest(pb.ErrorReason_INVALID_IF_MATCH.String(), "If-Match must be a task ETag returned by the server")
+	}
+	if version != 0 && version != headerVersion {
+		return 0, errors.BadRequest(pb.ErrorReason_INVALID_IF_MATCH.String(), "version and If-Match refer to different task versions")
+	}
+	return headerVersion, nil
+}
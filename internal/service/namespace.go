@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateNamespace 创建命名空间
+func (s *SchedulerService) CreateNamespace(ctx context.Context, req *pb.CreateNamespaceRequest) (*pb.NamespaceReply, error) {
+	if err := s.authz.Check(ctx, biz.PermNamespacesWrite); err != nil {
+		return nil, err
+	}
+
+	namespace, err := s.namespaceUc.CreateNamespace(ctx, &biz.Namespace{
+		Name:                    req.Name,
+		Description:             req.Description,
+		MaxTasks:                req.MaxTasks,
+		MaxConcurrentExecutions: req.MaxConcurrentExecutions,
+		MaxExecutionsPerMinute:  req.MaxExecutionsPerMinute,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toNamespaceReply(namespace), nil
+}
+
+// GetNamespace 获取命名空间详情
+func (s *SchedulerService) GetNamespace(ctx context.Context, req *pb.GetNamespaceRequest) (*pb.NamespaceReply, error) {
+	if err := s.authz.Check(ctx, biz.PermNamespacesRead); err != nil {
+		return nil, err
+	}
+
+	namespace, err := s.namespaceUc.GetNamespace(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return toNamespaceReply(namespace), nil
+}
+
+// UpdateNamespace 更新命名空间
+func (s *SchedulerService) UpdateNamespace(ctx context.Context, req *pb.UpdateNamespaceRequest) (*pb.NamespaceReply, error) {
+	if err := s.authz.Check(ctx, biz.PermNamespacesWrite); err != nil {
+		return nil, err
+	}
+
+	namespace, err := s.namespaceUc.UpdateNamespace(ctx, &biz.Namespace{
+		Name:                    req.Name,
+		Description:             req.Description,
+		MaxTasks:                req.MaxTasks,
+		MaxConcurrentExecutions: req.MaxConcurrentExecutions,
+		MaxExecutionsPerMinute:  req.MaxExecutionsPerMinute,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toNamespaceReply(namespace), nil
+}
+
+// DeleteNamespace 删除命名空间
+func (s *SchedulerService) DeleteNamespace(ctx context.Context, req *pb.DeleteNamespaceRequest) (*emptypb.Empty, error) {
+	if err := s.authz.Check(ctx, biz.PermNamespacesWrite); err != nil {
+		return nil, err
+	}
+
+	if err := s.namespaceUc.DeleteNamespace(ctx, req.Name); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListNamespaces 命名空间列表查询
+func (s *SchedulerService) ListNamespaces(ctx context.Context, req *pb.ListNamespacesRequest) (*pb.ListNamespacesReply, error) {
+	if err := s.authz.Check(ctx, biz.PermNamespacesRead); err != nil {
+		return nil, err
+	}
+
+	namespaces, total, err := s.namespaceUc.ListNamespaces(ctx, &biz.NamespaceListFilter{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Keyword:  req.Keyword,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceReplies := make([]*pb.NamespaceReply, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		namespaceReplies = append(namespaceReplies, toNamespaceReply(namespace))
+	}
+
+	return &pb.ListNamespacesReply{
+		Namespaces: namespaceReplies,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	}, nil
+}
+
+// toNamespaceReply 转换为 NamespaceReply
+func toNamespaceReply(namespace *biz.Namespace) *pb.NamespaceReply {
+	return &pb.NamespaceReply{
+		Id:                      namespace.ID,
+		Name:                    namespace.Name,
+		Description:             namespace.Description,
+		MaxTasks:                namespace.MaxTasks,
+		MaxConcurrentExecutions: namespace.MaxConcurrentExecutions,
+		MaxExecutionsPerMinute:  namespace.MaxExecutionsPerMinute,
+		CreatedAt:               timestamppb.New(namespace.CreatedAt),
+		UpdatedAt:               timestamppb.New(namespace.UpdatedAt),
+	}
+}
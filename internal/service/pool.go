@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateResourcePool 创建资源池
+func (s *SchedulerService) CreateResourcePool(ctx context.Context, req *pb.CreateResourcePoolRequest) (*pb.ResourcePoolReply, error) {
+	if err := s.authz.Check(ctx, biz.PermPoolsWrite); err != nil {
+		return nil, err
+	}
+
+	pool, err := s.poolUc.CreateResourcePool(ctx, &biz.ResourcePool{
+		Name:           req.Name,
+		Description:    req.Description,
+		Handler:        req.Handler,
+		LabelKey:       req.LabelKey,
+		LabelValue:     req.LabelValue,
+		MaxConcurrency: req.MaxConcurrency,
+		RateLimit:      req.RateLimit,
+		Burst:          req.Burst,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toResourcePoolReply(pool), nil
+}
+
+// GetResourcePool 获取资源池详情
+func (s *SchedulerService) GetResourcePool(ctx context.Context, req *pb.GetResourcePoolRequest) (*pb.ResourcePoolReply, error) {
+	if err := s.authz.Check(ctx, biz.PermPoolsRead); err != nil {
+		return nil, err
+	}
+
+	pool, err := s.poolUc.GetResourcePool(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return toResourcePoolReply(pool), nil
+}
+
+// UpdateResourcePool 更新资源池
+func (s *SchedulerService) UpdateResourcePool(ctx context.Context, req *pb.UpdateResourcePoolRequest) (*pb.ResourcePoolReply, error) {
+	if err := s.authz.Check(ctx, biz.PermPoolsWrite); err != nil {
+		return nil, err
+	}
+
+	pool, err := s.poolUc.UpdateResourcePool(ctx, &biz.ResourcePool{
+		ID:             req.Id,
+		Name:           req.Name,
+		Description:    req.Description,
+		Handler:        req.Handler,
+		LabelKey:       req.LabelKey,
+		LabelValue:     req.LabelValue,
+		MaxConcurrency: req.MaxConcurrency,
+		RateLimit:      req.RateLimit,
+		Burst:          req.Burst,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toResourcePoolReply(pool), nil
+}
+
+// DeleteResourcePool 删除资源池
+func (s *SchedulerService) DeleteResourcePool(ctx context.Context, req *pb.DeleteResourcePoolRequest) (*emptypb.Empty, error) {
+	if err := s.authz.Check(ctx, biz.PermPoolsWrite); err != nil {
+		return nil, err
+	}
+
+	if err := s.poolUc.DeleteResourcePool(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListResourcePools 资源池列表查询
+func (s *SchedulerService) ListResourcePools(ctx context.Context, req *pb.ListResourcePoolsRequest) (*pb.ListResourcePoolsReply, error) {
+	if err := s.authz.Check(ctx, biz.PermPoolsRead); err != nil {
+		return nil, err
+	}
+
+	pools, total, err := s.poolUc.ListResourcePools(ctx, &biz.ResourcePoolListFilter{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Keyword:  req.Keyword,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	poolReplies := make([]*pb.ResourcePoolReply, 0, len(pools))
+	for _, pool := range pools {
+		poolReplies = append(poolReplies, toResourcePoolReply(pool))
+	}
+
+	return &pb.ListResourcePoolsReply{
+		Pools:    poolReplies,
+		Total:    total,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}, nil
+}
+
+// toResourcePoolReply 转换为 ResourcePoolReply
+func toResourcePoolReply(pool *biz.ResourcePool) *pb.ResourcePoolReply {
+	return &pb.ResourcePoolReply{
+		Id:             pool.ID,
+		Name:           pool.Name,
+		Description:    pool.Description,
+		Handler:        pool.Handler,
+		LabelKey:       pool.LabelKey,
+		LabelValue:     pool.LabelValue,
+		MaxConcurrency: pool.MaxConcurrency,
+		RateLimit:      pool.RateLimit,
+		Burst:          pool.Burst,
+		CreatedAt:      timestamppb.New(pool.CreatedAt),
+		UpdatedAt:      timestamppb.New(pool.UpdatedAt),
+	}
+}
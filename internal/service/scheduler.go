@@ -2,11 +2,14 @@ package service
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	pb "heytom-scheduler/api/scheduler/v1"
 	"heytom-scheduler/internal/biz"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -17,14 +20,26 @@ type SchedulerService struct {
 
 	taskUc      *biz.TaskUsecase
 	executionUc *biz.ExecutionUsecase
+	calendarUc  *biz.CalendarUsecase
+	poolUc      *biz.ResourcePoolUsecase
+	auditUc     *biz.AuditUsecase
+	namespaceUc *biz.NamespaceUsecase
+	secretUc    *biz.SecretUsecase
+	authz       *biz.Authorizer
 	log         *log.Helper
 }
 
 // NewSchedulerService 创建调度服务实例
-func NewSchedulerService(taskUc *biz.TaskUsecase, executionUc *biz.ExecutionUsecase, logger log.Logger) *SchedulerService {
+func NewSchedulerService(taskUc *biz.TaskUsecase, executionUc *biz.ExecutionUsecase, calendarUc *biz.CalendarUsecase, poolUc *biz.ResourcePoolUsecase, auditUc *biz.AuditUsecase, namespaceUc *biz.NamespaceUsecase, secretUc *biz.SecretUsecase, authz *biz.Authorizer, logger log.Logger) *SchedulerService {
 	return &SchedulerService{
 		taskUc:      taskUc,
 		executionUc: executionUc,
+		calendarUc:  calendarUc,
+		poolUc:      poolUc,
+		auditUc:     auditUc,
+		namespaceUc: namespaceUc,
+		secretUc:    secretUc,
+		authz:       authz,
 		log:         log.NewHelper(logger),
 	}
 }
@@ -33,29 +48,57 @@ func NewSchedulerService(taskUc *biz.TaskUsecase, executionUc *biz.ExecutionUsec
 func (s *SchedulerService) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.TaskReply, error) {
 	s.log.WithContext(ctx).Infof("CreateTask: %s", req.Name)
 
+	namespace, err := biz.ResolveNamespace(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	// 范围受限的调用方只能创建范围内的任务
+	if err := s.authz.CheckTask(ctx, biz.PermTasksCreate, &biz.Task{Namespace: namespace, Metadata: req.Metadata}); err != nil {
+		return nil, err
+	}
+
 	task, err := s.taskUc.CreateTask(ctx, &biz.Task{
-		Name:        req.Name,
-		Description: req.Description,
-		Type:        req.Type,
-		Schedule:    req.Schedule,
-		Handler:     req.Handler,
-		Payload:     req.Payload,
-		Timeout:     req.Timeout,
-		Metadata:    req.Metadata,
+		Namespace:         namespace,
+		Name:              req.Name,
+		Description:       req.Description,
+		Type:              req.Type,
+		Schedule:          req.Schedule,
+		Handler:           req.Handler,
+		Payload:           req.Payload,
+		Timeout:           req.Timeout,
+		Metadata:          req.Metadata,
+		CalendarID:        req.CalendarId,
+		StartTime:         toTimePtr(req.StartTime),
+		EndTime:           toTimePtr(req.EndTime),
+		ActiveWindows:     toBizTimeWindows(req.ActiveWindows),
+		MaxRuns:           req.MaxRuns,
+		IntervalMode:      req.IntervalMode,
+		InitialDelay:      req.InitialDelay.AsDuration(),
+		Jitter:            req.Jitter.AsDuration(),
+		Priority:          req.Priority,
+		LockGroup:         req.LockGroup,
+		ConcurrencyPolicy: req.ConcurrencyPolicy,
+		Retention:         toBizRetention(req.Retention),
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	setTaskETag(ctx, task)
 	return toTaskReply(task), nil
 }
 
 // GetTask 获取任务详情
 func (s *SchedulerService) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.TaskReply, error) {
+	if err := s.authorizeTask(ctx, biz.PermTasksRead, req.Id); err != nil {
+		return nil, err
+	}
+
 	task, err := s.taskUc.GetTask(ctx, req.Id)
 	if err != nil {
 		return nil, err
 	}
+	setTaskETag(ctx, task)
 	return toTaskReply(task), nil
 }
 
@@ -63,19 +106,54 @@ func (s *SchedulerService) GetTask(ctx context.Context, req *pb.GetTaskRequest)
 func (s *SchedulerService) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.TaskReply, error) {
 	s.log.WithContext(ctx).Infof("UpdateTask: %d", req.Id)
 
+	if err := s.authorizeTask(ctx, biz.PermTasksUpdate, req.Id); err != nil {
+		return nil, err
+	}
+	// 修改元数据时，修改后的任务也要在调用方的范围内
+	if (req.Metadata != nil || slices.Contains(req.UpdateMask.GetPaths(), "metadata")) && !s.authz.Unrestricted(ctx, biz.PermTasksUpdate) {
+		current, err := s.taskUc.LookupTask(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.authz.CheckTask(ctx, biz.PermTasksUpdate, &biz.Task{Namespace: current.Namespace, Metadata: req.Metadata}); err != nil {
+			return nil, err
+		}
+	}
+
+	version, err := requestVersion(ctx, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
 	task, err := s.taskUc.UpdateTask(ctx, &biz.Task{
-		ID:          req.Id,
-		Name:        req.Name,
-		Description: req.Description,
-		Schedule:    req.Schedule,
-		Payload:     req.Payload,
-		Timeout:     req.Timeout,
-		Metadata:    req.Metadata,
-	})
+		ID:                req.Id,
+		Version:           version,
+		Name:              req.Name,
+		Description:       req.Description,
+		Type:              req.Type,
+		Schedule:          req.Schedule,
+		Handler:           req.Handler,
+		Payload:           req.Payload,
+		Timeout:           req.Timeout,
+		Metadata:          req.Metadata,
+		CalendarID:        req.CalendarId,
+		StartTime:         toTimePtr(req.StartTime),
+		EndTime:           toTimePtr(req.EndTime),
+		ActiveWindows:     toBizTimeWindows(req.ActiveWindows),
+		MaxRuns:           req.MaxRuns,
+		IntervalMode:      req.IntervalMode,
+		InitialDelay:      req.InitialDelay.AsDuration(),
+		Jitter:            req.Jitter.AsDuration(),
+		Priority:          req.Priority,
+		LockGroup:         req.LockGroup,
+		ConcurrencyPolicy: req.ConcurrencyPolicy,
+		Retention:         toBizRetention(req.Retention),
+	}, req.UpdateMask.GetPaths())
 	if err != nil {
 		return nil, err
 	}
 
+	setTaskETag(ctx, task)
 	return toTaskReply(task), nil
 }
 
@@ -83,6 +161,10 @@ func (s *SchedulerService) UpdateTask(ctx context.Context, req *pb.UpdateTaskReq
 func (s *SchedulerService) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest) (*emptypb.Empty, error) {
 	s.log.WithContext(ctx).Infof("DeleteTask: %d", req.Id)
 
+	if err := s.authorizeTask(ctx, biz.PermTasksDelete, req.Id); err != nil {
+		return nil, err
+	}
+
 	if err := s.taskUc.DeleteTask(ctx, req.Id); err != nil {
 		return nil, err
 	}
@@ -90,29 +172,146 @@ func (s *SchedulerService) DeleteTask(ctx context.Context, req *pb.DeleteTaskReq
 	return &emptypb.Empty{}, nil
 }
 
-// ListTasks 任务列表查询
-func (s *SchedulerService) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksReply, error) {
-	tasks, total, err := s.taskUc.ListTasks(ctx, &biz.TaskListFilter{
+// RestoreTask 恢复已删除的任务
+func (s *SchedulerService) RestoreTask(ctx context.Context, req *pb.RestoreTaskRequest) (*pb.TaskReply, error) {
+	s.log.WithContext(ctx).Infof("RestoreTask: %d", req.Id)
+
+	if err := s.authorizeTask(ctx, biz.PermTasksDelete, req.Id); err != nil {
+		return nil, err
+	}
+
+	task, err := s.taskUc.RestoreTask(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	setTaskETag(ctx, task)
+	return toTaskReply(task), nil
+}
+
+// PurgeTask 彻底删除已删除的任务
+func (s *SchedulerService) PurgeTask(ctx context.Context, req *pb.PurgeTaskRequest) (*emptypb.Empty, error) {
+	s.log.WithContext(ctx).Infof("PurgeTask: %d", req.Id)
+
+	if err := s.authz.Check(ctx, biz.PermTasksPurge); err != nil {
+		return nil, err
+	}
+
+	if err := s.taskUc.PurgeTask(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListTaskRevisions 任务修订列表查询
+func (s *SchedulerService) ListTaskRevisions(ctx context.Context, req *pb.ListTaskRevisionsRequest) (*pb.ListTaskRevisionsReply, error) {
+	if err := s.authorizeTask(ctx, biz.PermTasksRead, req.TaskId); err != nil {
+		return nil, err
+	}
+
+	revisions, total, err := s.taskUc.ListTaskRevisions(ctx, &biz.TaskRevisionListFilter{
+		TaskID:   req.TaskId,
 		Page:     req.Page,
 		PageSize: req.PageSize,
-		Status:   req.Status,
-		Type:     req.Type,
-		Keyword:  req.Keyword,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	revisionReplies := make([]*pb.TaskRevisionReply, 0, len(revisions))
+	for _, revision := range revisions {
+		revisionReplies = append(revisionReplies, toTaskRevisionReply(revision))
+	}
+
+	return &pb.ListTaskRevisionsReply{
+		Revisions: revisionReplies,
+		Total:     total,
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+	}, nil
+}
+
+// GetTaskRevision 获取任务指定版本的修订
+func (s *SchedulerService) GetTaskRevision(ctx context.Context, req *pb.GetTaskRevisionRequest) (*pb.TaskRevisionReply, error) {
+	if err := s.authorizeTask(ctx, biz.PermTasksRead, req.TaskId); err != nil {
+		return nil, err
+	}
+
+	revision, err := s.taskUc.GetTaskRevision(ctx, req.TaskId, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	return toTaskRevisionReply(revision), nil
+}
+
+// RollbackTask 将任务定义回滚到指定版本
+func (s *SchedulerService) RollbackTask(ctx context.Context, req *pb.RollbackTaskRequest) (*pb.TaskReply, error) {
+	s.log.WithContext(ctx).Infof("RollbackTask: %d to version %d", req.Id, req.Revision)
+
+	if err := s.authorizeTask(ctx, biz.PermTasksUpdate, req.Id); err != nil {
+		return nil, err
+	}
+	// 回滚后的任务也要在调用方的范围内
+	if !s.authz.Unrestricted(ctx, biz.PermTasksUpdate) {
+		revision, err := s.taskUc.GetTaskRevision(ctx, req.Id, req.Revision)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.authz.CheckTask(ctx, biz.PermTasksUpdate, revision.Snapshot); err != nil {
+			return nil, err
+		}
+	}
+
+	version, err := requestVersion(ctx, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.taskUc.RollbackTask(ctx, req.Id, req.Revision, version)
+	if err != nil {
+		return nil, err
+	}
+
+	setTaskETag(ctx, task)
+	return toTaskReply(task), nil
+}
+
+// ListTasks 任务列表查询
+func (s *SchedulerService) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksReply, error) {
+	// 范围受限的调用方只能看到范围内的任务
+	scopes, err := s.authz.Scopes(ctx, biz.PermTasksRead)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, total, nextPageToken, err := s.taskUc.ListTasks(ctx, &biz.TaskListFilter{
+		Page:           req.Page,
+		PageSize:       req.PageSize,
+		Status:         req.Status,
+		Type:           req.Type,
+		Keyword:        req.Keyword,
+		CalendarID:     req.CalendarId,
+		IncludeDeleted: req.IncludeDeleted,
+		Namespace:      req.Namespace,
+		Scopes:         scopes,
+		SkipTotal:      req.SkipTotal,
+	}, req.PageToken, req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
 	taskReplies := make([]*pb.TaskReply, 0, len(tasks))
 	for _, task := range tasks {
 		taskReplies = append(taskReplies, toTaskReply(task))
 	}
 
 	return &pb.ListTasksReply{
-		Tasks:    taskReplies,
-		Total:    total,
-		Page:     req.Page,
-		PageSize: req.PageSize,
+		Tasks:         taskReplies,
+		Total:         total,
+		Page:          req.Page,
+		PageSize:      req.PageSize,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -120,7 +319,17 @@ func (s *SchedulerService) ListTasks(ctx context.Context, req *pb.ListTasksReque
 func (s *SchedulerService) ExecuteTask(ctx context.Context, req *pb.ExecuteTaskRequest) (*pb.TaskExecutionReply, error) {
 	s.log.WithContext(ctx).Infof("ExecuteTask: %d", req.Id)
 
-	executionID, err := s.taskUc.ExecuteTask(ctx, req.Id, req.Payload)
+	if err := s.authorizeTask(ctx, biz.PermTasksExecute, req.Id); err != nil {
+		return nil, err
+	}
+	// 指定负载中的密钥引用在执行时解析为明文交给处理器，需要同时拥有任务所在命名空间的密钥写权限
+	if biz.HasSecretReferences(req.Payload) {
+		if err := s.authorizeTask(ctx, biz.PermSecretsWrite, req.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	executionID, err := s.taskUc.ExecuteTask(ctx, req.Id, req.Payload, req.Priority)
 	if err != nil {
 		return nil, err
 	}
@@ -135,11 +344,16 @@ func (s *SchedulerService) ExecuteTask(ctx context.Context, req *pb.ExecuteTaskR
 func (s *SchedulerService) PauseTask(ctx context.Context, req *pb.PauseTaskRequest) (*pb.TaskReply, error) {
 	s.log.WithContext(ctx).Infof("PauseTask: %d", req.Id)
 
-	task, err := s.taskUc.PauseTask(ctx, req.Id)
+	if err := s.authorizeTask(ctx, biz.PermTasksPause, req.Id); err != nil {
+		return nil, err
+	}
+
+	task, err := s.taskUc.PauseTask(ctx, req.Id, req.Reason)
 	if err != nil {
 		return nil, err
 	}
 
+	setTaskETag(ctx, task)
 	return toTaskReply(task), nil
 }
 
@@ -147,22 +361,54 @@ func (s *SchedulerService) PauseTask(ctx context.Context, req *pb.PauseTaskReque
 func (s *SchedulerService) ResumeTask(ctx context.Context, req *pb.ResumeTaskRequest) (*pb.TaskReply, error) {
 	s.log.WithContext(ctx).Infof("ResumeTask: %d", req.Id)
 
-	task, err := s.taskUc.ResumeTask(ctx, req.Id)
+	if err := s.authorizeTask(ctx, biz.PermTasksPause, req.Id); err != nil {
+		return nil, err
+	}
+
+	task, err := s.taskUc.ResumeTask(ctx, req.Id, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	setTaskETag(ctx, task)
+	return toTaskReply(task), nil
+}
+
+// CancelTask 取消任务
+func (s *SchedulerService) CancelTask(ctx context.Context, req *pb.CancelTaskRequest) (*pb.TaskReply, error) {
+	s.log.WithContext(ctx).Infof("CancelTask: %d", req.Id)
+
+	if err := s.authorizeTask(ctx, biz.PermTasksPause, req.Id); err != nil {
+		return nil, err
+	}
+
+	task, err := s.taskUc.CancelTask(ctx, req.Id, req.Reason)
 	if err != nil {
 		return nil, err
 	}
 
+	setTaskETag(ctx, task)
 	return toTaskReply(task), nil
 }
 
 // GetTaskExecutions 获取任务执行历史
 func (s *SchedulerService) GetTaskExecutions(ctx context.Context, req *pb.GetTaskExecutionsRequest) (*pb.ListExecutionsReply, error) {
-	executions, total, err := s.executionUc.ListExecutions(ctx, &biz.ExecutionListFilter{
-		TaskID:   req.TaskId,
-		Page:     req.Page,
-		PageSize: req.PageSize,
-		Status:   req.Status,
-	})
+	// 任务不存在时返回 TASK_NOT_FOUND，已删除的任务仍可查询执行历史
+	task, err := s.taskUc.LookupTask(ctx, req.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.CheckTask(ctx, biz.PermExecutionsRead, task); err != nil {
+		return nil, err
+	}
+
+	executions, total, nextPageToken, err := s.executionUc.ListExecutions(ctx, &biz.ExecutionListFilter{
+		TaskID:    req.TaskId,
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+		Status:    req.Status,
+		SkipTotal: req.SkipTotal,
+	}, req.PageToken, req.OrderBy)
 	if err != nil {
 		return nil, err
 	}
@@ -173,15 +419,20 @@ func (s *SchedulerService) GetTaskExecutions(ctx context.Context, req *pb.GetTas
 	}
 
 	return &pb.ListExecutionsReply{
-		Executions: executionReplies,
-		Total:      total,
-		Page:       req.Page,
-		PageSize:   req.PageSize,
+		Executions:    executionReplies,
+		Total:         total,
+		Page:          req.Page,
+		PageSize:      req.PageSize,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
 // GetExecution 获取单次执行详情
 func (s *SchedulerService) GetExecution(ctx context.Context, req *pb.GetExecutionRequest) (*pb.ExecutionReply, error) {
+	if err := s.authorizeExecution(ctx, biz.PermExecutionsRead, req.Id); err != nil {
+		return nil, err
+	}
+
 	execution, err := s.executionUc.GetExecution(ctx, req.Id)
 	if err != nil {
 		return nil, err
@@ -193,6 +444,10 @@ func (s *SchedulerService) GetExecution(ctx context.Context, req *pb.GetExecutio
 func (s *SchedulerService) CancelExecution(ctx context.Context, req *pb.CancelExecutionRequest) (*pb.ExecutionReply, error) {
 	s.log.WithContext(ctx).Infof("CancelExecution: %d", req.Id)
 
+	if err := s.authorizeExecution(ctx, biz.PermExecutionsCancel, req.Id); err != nil {
+		return nil, err
+	}
+
 	execution, err := s.executionUc.CancelExecution(ctx, req.Id)
 	if err != nil {
 		return nil, err
@@ -201,46 +456,291 @@ func (s *SchedulerService) CancelExecution(ctx context.Context, req *pb.CancelEx
 	return toExecutionReply(execution), nil
 }
 
+// GetQueueStats 获取执行队列统计
+func (s *SchedulerService) GetQueueStats(ctx context.Context, req *pb.GetQueueStatsRequest) (*pb.QueueStatsReply, error) {
+	if err := s.authz.Check(ctx, biz.PermQueueRead); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.executionUc.GetQueueStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	priorities := make([]*pb.PriorityQueueStats, 0, len(stats.Priorities))
+	for _, p := range stats.Priorities {
+		item := &pb.PriorityQueueStats{
+			Priority: p.Priority,
+			Queued:   p.Queued,
+		}
+		if p.OldestQueuedAt != nil {
+			item.OldestQueuedAt = timestamppb.New(*p.OldestQueuedAt)
+		}
+		priorities = append(priorities, item)
+	}
+
+	return &pb.QueueStatsReply{
+		Priorities:  priorities,
+		TotalQueued: stats.TotalQueued,
+		Executing:   stats.Executing,
+	}, nil
+}
+
+// PreviewSchedule 预览调度配置的后续触发时间
+func (s *SchedulerService) PreviewSchedule(ctx context.Context, req *pb.PreviewScheduleRequest) (*pb.PreviewScheduleReply, error) {
+	if err := s.authz.CheckAny(ctx, biz.PermTasksRead); err != nil {
+		return nil, err
+	}
+
+	preview, err := s.taskUc.PreviewSchedule(ctx, &biz.SchedulePreviewRequest{
+		Type:       req.Type,
+		Schedule:   req.Schedule,
+		Timezone:   req.Timezone,
+		CalendarID: req.CalendarId,
+		Count:      req.Count,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	nextRunTimes := make([]*timestamppb.Timestamp, 0, len(preview.NextRunTimes))
+	for _, t := range preview.NextRunTimes {
+		nextRunTimes = append(nextRunTimes, timestamppb.New(t))
+	}
+
+	return &pb.PreviewScheduleReply{
+		Valid:        preview.Valid,
+		Errors:       preview.Errors,
+		Description:  preview.Description,
+		Timezone:     preview.Timezone,
+		NextRunTimes: nextRunTimes,
+	}, nil
+}
+
+// CreateCalendar 创建业务日历
+func (s *SchedulerService) CreateCalendar(ctx context.Context, req *pb.CreateCalendarRequest) (*pb.CalendarReply, error) {
+	s.log.WithContext(ctx).Infof("CreateCalendar: %s", req.Name)
+
+	if err := s.authz.Check(ctx, biz.PermCalendarsWrite); err != nil {
+		return nil, err
+	}
+
+	calendar, err := s.calendarUc.CreateCalendar(ctx, &biz.Calendar{
+		Name:        req.Name,
+		Description: req.Description,
+		Timezone:    req.Timezone,
+		Rules:       toBizCalendarRules(req.Rules),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toCalendarReply(calendar), nil
+}
+
+// GetCalendar 获取业务日历详情
+func (s *SchedulerService) GetCalendar(ctx context.Context, req *pb.GetCalendarRequest) (*pb.CalendarReply, error) {
+	if err := s.authz.Check(ctx, biz.PermCalendarsRead); err != nil {
+		return nil, err
+	}
+
+	calendar, err := s.calendarUc.GetCalendar(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return toCalendarReply(calendar), nil
+}
+
+// UpdateCalendar 更新业务日历
+func (s *SchedulerService) UpdateCalendar(ctx context.Context, req *pb.UpdateCalendarRequest) (*pb.CalendarReply, error) {
+	s.log.WithContext(ctx).Infof("UpdateCalendar: %d", req.Id)
+
+	if err := s.authz.Check(ctx, biz.PermCalendarsWrite); err != nil {
+		return nil, err
+	}
+
+	calendar, err := s.calendarUc.UpdateCalendar(ctx, &biz.Calendar{
+		ID:          req.Id,
+		Name:        req.Name,
+		Description: req.Description,
+		Timezone:    req.Timezone,
+		Rules:       toBizCalendarRules(req.Rules),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toCalendarReply(calendar), nil
+}
+
+// DeleteCalendar 删除业务日历
+func (s *SchedulerService) DeleteCalendar(ctx context.Context, req *pb.DeleteCalendarRequest) (*emptypb.Empty, error) {
+	s.log.WithContext(ctx).Infof("DeleteCalendar: %d", req.Id)
+
+	if err := s.authz.Check(ctx, biz.PermCalendarsWrite); err != nil {
+		return nil, err
+	}
+
+	if err := s.calendarUc.DeleteCalendar(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListCalendars 业务日历列表查询
+func (s *SchedulerService) ListCalendars(ctx context.Context, req *pb.ListCalendarsRequest) (*pb.ListCalendarsReply, error) {
+	if err := s.authz.Check(ctx, biz.PermCalendarsRead); err != nil {
+		return nil, err
+	}
+
+	calendars, total, err := s.calendarUc.ListCalendars(ctx, &biz.CalendarListFilter{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Keyword:  req.Keyword,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	calendarReplies := make([]*pb.CalendarReply, 0, len(calendars))
+	for _, calendar := range calendars {
+		calendarReplies = append(calendarReplies, toCalendarReply(calendar))
+	}
+
+	return &pb.ListCalendarsReply{
+		Calendars: calendarReplies,
+		Total:     total,
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+	}, nil
+}
+
+// ImportCalendar 从 iCalendar（.ics）导入业务日历
+func (s *SchedulerService) ImportCalendar(ctx context.Context, req *pb.ImportCalendarRequest) (*pb.CalendarReply, error) {
+	s.log.WithContext(ctx).Infof("ImportCalendar: %d", req.Id)
+
+	if err := s.authz.Check(ctx, biz.PermCalendarsWrite); err != nil {
+		return nil, err
+	}
+
+	calendar, err := s.calendarUc.ImportCalendar(ctx, req.Id, &biz.Calendar{
+		Name:        req.Name,
+		Description: req.Description,
+		Timezone:    req.Timezone,
+	}, req.Ics, req.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCalendarReply(calendar), nil
+}
+
 // toTaskReply 转换为 TaskReply
 func toTaskReply(task *biz.Task) *pb.TaskReply {
 	reply := &pb.TaskReply{
-		Id:             task.ID,
-		Name:           task.Name,
-		Description:    task.Description,
-		Type:           task.Type,
-		Status:         task.Status,
-		Schedule:       task.Schedule,
-		Handler:        task.Handler,
-		Payload:        task.Payload,
-		Timeout:        task.Timeout,
-		Metadata:       task.Metadata,
-		CreatedAt:      timestamppb.New(task.CreatedAt),
-		UpdatedAt:      timestamppb.New(task.UpdatedAt),
-		ExecutionCount: task.ExecutionCount,
-		SuccessCount:   task.SuccessCount,
-		FailedCount:    task.FailedCount,
+		Id:                task.ID,
+		Namespace:         task.Namespace,
+		Name:              task.Name,
+		Description:       task.Description,
+		Type:              task.Type,
+		Status:            task.Status,
+		StatusReason:      task.StatusReason,
+		Schedule:          task.Schedule,
+		Handler:           task.Handler,
+		Payload:           task.Payload,
+		Timeout:           task.Timeout,
+		Metadata:          task.Metadata,
+		CreatedAt:         timestamppb.New(task.CreatedAt),
+		UpdatedAt:         timestamppb.New(task.UpdatedAt),
+		ExecutionCount:    task.ExecutionCount,
+		SuccessCount:      task.SuccessCount,
+		FailedCount:       task.FailedCount,
+		CalendarId:        task.CalendarID,
+		MaxRuns:           task.MaxRuns,
+		RunCount:          task.RunCount,
+		IntervalMode:      task.IntervalMode,
+		Priority:          task.Priority,
+		LockGroup:         task.LockGroup,
+		ConcurrencyPolicy: task.ConcurrencyPolicy,
+		Version:           task.Version,
 	}
 
 	if task.NextRunTime != nil {
 		reply.NextRunTime = timestamppb.New(*task.NextRunTime)
 	}
+	if task.StartTime != nil {
+		reply.StartTime = timestamppb.New(*task.StartTime)
+	}
+	if task.EndTime != nil {
+		reply.EndTime = timestamppb.New(*task.EndTime)
+	}
+	if task.DeletedAt != nil {
+		reply.DeletedAt = timestamppb.New(*task.DeletedAt)
+	}
+	if task.StatusChangedAt != nil {
+		reply.StatusChangedAt = timestamppb.New(*task.StatusChangedAt)
+	}
+	if task.InitialDelay > 0 {
+		reply.InitialDelay = durationpb.New(task.InitialDelay)
+	}
+	if task.Jitter > 0 {
+		reply.Jitter = durationpb.New(task.Jitter)
+	}
+	for _, w := range task.ActiveWindows {
+		reply.ActiveWindows = append(reply.ActiveWindows, &pb.TimeWindow{Start: w.Start, End: w.End})
+	}
+	if !task.Retention.IsZero() {
+		reply.Retention = &pb.ExecutionRetention{
+			KeepLast:       task.Retention.KeepLast,
+			KeepDays:       task.Retention.KeepDays,
+			FailedKeepDays: task.Retention.FailedKeepDays,
+		}
+	}
 
 	return reply
 }
 
+// toTaskRevisionReply 转换任务修订响应
+func toTaskRevisionReply(revision *biz.TaskRevision) *pb.TaskRevisionReply {
+	changes := make([]*pb.TaskFieldChange, 0, len(revision.Changes))
+	for _, c := range revision.Changes {
+		changes = append(changes, &pb.TaskFieldChange{
+			Field:    c.Field,
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+		})
+	}
+
+	return &pb.TaskRevisionReply{
+		TaskId:        revision.TaskID,
+		Version:       revision.Version,
+		Action:        revision.Action,
+		Author:        revision.Author,
+		Snapshot:      toTaskReply(revision.Snapshot),
+		Changes:       changes,
+		SourceVersion: revision.SourceVersion,
+		CreatedAt:     timestamppb.New(revision.CreatedAt),
+	}
+}
+
 // toExecutionReply 转换为 ExecutionReply
 func toExecutionReply(execution *biz.TaskExecution) *pb.ExecutionReply {
 	reply := &pb.ExecutionReply{
-		Id:         execution.ID,
-		TaskId:     execution.TaskID,
-		TaskName:   execution.TaskName,
-		Status:     execution.Status,
-		NodeId:     execution.NodeID,
-		Duration:   execution.Duration,
-		Result:     execution.Result,
-		Error:      execution.Error,
-		RetryCount: execution.RetryCount,
-		Payload:    execution.Payload,
+		Id:          execution.ID,
+		Namespace:   execution.Namespace,
+		TaskId:      execution.TaskID,
+		TaskName:    execution.TaskName,
+		Status:      execution.Status,
+		NodeId:      execution.NodeID,
+		Duration:    execution.Duration,
+		Result:      execution.Result,
+		Error:       execution.Error,
+		RetryCount:  execution.RetryCount,
+		Payload:     execution.Payload,
+		Priority:    execution.Priority,
+		WaitTime:    execution.WaitTime,
+		TaskVersion: execution.TaskVersion,
 	}
 
 	if execution.StartTime != nil {
@@ -252,3 +752,80 @@ func toExecutionReply(execution *biz.TaskExecution) *pb.ExecutionReply {
 
 	return reply
 }
+
+// toCalendarReply 转换为 CalendarReply
+func toCalendarReply(calendar *biz.Calendar) *pb.CalendarReply {
+	rules := make([]*pb.CalendarRule, 0, len(calendar.Rules))
+	for _, rule := range calendar.Rules {
+		weekdays := make([]int32, 0, len(rule.Weekdays))
+		for _, wd := range rule.Weekdays {
+			weekdays = append(weekdays, int32(wd))
+		}
+		rules = append(rules, &pb.CalendarRule{
+			Action:      rule.Action,
+			StartDate:   rule.StartDate,
+			EndDate:     rule.EndDate,
+			Weekdays:    weekdays,
+			Description: rule.Description,
+		})
+	}
+
+	return &pb.CalendarReply{
+		Id:          calendar.ID,
+		Name:        calendar.Name,
+		Description: calendar.Description,
+		Timezone:    calendar.Timezone,
+		Rules:       rules,
+		CreatedAt:   timestamppb.New(calendar.CreatedAt),
+		UpdatedAt:   timestamppb.New(calendar.UpdatedAt),
+	}
+}
+
+// toBizCalendarRules 转换为业务日历规则
+func toBizCalendarRules(rules []*pb.CalendarRule) []*biz.CalendarRule {
+	result := make([]*biz.CalendarRule, 0, len(rules))
+	for _, rule := range rules {
+		weekdays := make([]time.Weekday, 0, len(rule.Weekdays))
+		for _, wd := range rule.Weekdays {
+			weekdays = append(weekdays, time.Weekday(wd))
+		}
+		result = append(result, &biz.CalendarRule{
+			Action:      rule.Action,
+			StartDate:   rule.StartDate,
+			EndDate:     rule.EndDate,
+			Weekdays:    weekdays,
+			Description: rule.Description,
+		})
+	}
+	return result
+}
+
+// toBizTimeWindows 转换为业务时间窗口
+func toBizTimeWindows(windows []*pb.TimeWindow) []biz.TimeWindow {
+	if len(windows) == 0 {
+		return nil
+	}
+	result := make([]biz.TimeWindow, 0, len(windows))
+	for _, w := range windows {
+		result = append(result, biz.TimeWindow{Start: w.Start, End: w.End})
+	}
+	return result
+}
+
+// toBizRetention 转换执行记录保留策略，未设置时返回零值
+func toBizRetention(retention *pb.ExecutionRetention) biz.RetentionPolicy {
+	return biz.RetentionPolicy{
+		KeepLast:       retention.GetKeepLast(),
+		KeepDays:       retention.GetKeepDays(),
+		FailedKeepDays: retention.GetFailedKeepDays(),
+	}
+}
+
+// toTimePtr 转换可选的时间戳，未设置时返回 nil
+func toTimePtr(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
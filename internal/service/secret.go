@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+
+	pb "heytom-scheduler/api/scheduler/v1"
+	"heytom-scheduler/internal/biz"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateSecret 创建密钥
+func (s *SchedulerService) CreateSecret(ctx context.Context, req *pb.CreateSecretRequest) (*pb.SecretReply, error) {
+	namespace, err := s.authorizeSecret(ctx, biz.PermSecretsWrite, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := s.secretUc.CreateSecret(ctx, &biz.Secret{
+		Namespace:   namespace,
+		Name:        req.Name,
+		Description: req.Description,
+	}, req.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return toSecretReply(secret), nil
+}
+
+// GetSecret 获取密钥详情
+func (s *SchedulerService) GetSecret(ctx context.Context, req *pb.GetSecretRequest) (*pb.SecretReply, error) {
+	namespace, err := s.authorizeSecret(ctx, biz.PermSecretsRead, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := s.secretUc.GetSecret(ctx, namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return toSecretReply(secret), nil
+}
+
+// UpdateSecret 更新密钥
+func (s *SchedulerService) UpdateSecret(ctx context.Context, req *pb.UpdateSecretRequest) (*pb.SecretReply, error) {
+	namespace, err := s.authorizeSecret(ctx, biz.PermSecretsWrite, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := s.secretUc.UpdateSecret(ctx, &biz.Secret{
+		Namespace:   namespace,
+		Name:        req.Name,
+		Description: req.Description,
+	}, req.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return toSecretReply(secret), nil
+}
+
+// DeleteSecret 删除密钥
+func (s *SchedulerService) DeleteSecret(ctx context.Context, req *pb.DeleteSecretRequest) (*emptypb.Empty, error) {
+	namespace, err := s.authorizeSecret(ctx, biz.PermSecretsWrite, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.secretUc.DeleteSecret(ctx, namespace, req.Name); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListSecrets 密钥列表查询，范围受限的调用方只能查询一个命名空间
+func (s *SchedulerService) ListSecrets(ctx context.Context, req *pb.ListSecretsRequest) (*pb.ListSecretsReply, error) {
+	namespace := req.Namespace
+	if !s.authz.Unrestricted(ctx, biz.PermSecretsRead) {
+		var err error
+		if namespace, err = s.authorizeSecret(ctx, biz.PermSecretsRead, req.Namespace); err != nil {
+			return nil, err
+		}
+	}
+
+	secrets, total, err := s.secretUc.ListSecrets(ctx, &biz.SecretListFilter{
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+		Namespace: namespace,
+		Keyword:   req.Keyword,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	secretReplies := make([]*pb.SecretReply, 0, len(secrets))
+	for _, secret := range secrets {
+		secretReplies = append(secretReplies, toSecretReply(secret))
+	}
+
+	return &pb.ListSecretsReply{
+		Secrets:  secretReplies,
+		Total:    total,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}, nil
+}
+
+// RotateSecretKeys 用主用密钥重新加密所有密钥的数据密钥
+func (s *SchedulerService) RotateSecretKeys(ctx context.Context, req *pb.RotateSecretKeysRequest) (*pb.RotateSecretKeysReply, error) {
+	if err := s.authz.Check(ctx, biz.PermSecretsWrite); err != nil {
+		return nil, err
+	}
+
+	rewrapped, err := s.secretUc.RotateKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RotateSecretKeysReply{PrimaryKeyId: s.secretUc.PrimaryKeyID(), Rewrapped: rewrapped}, nil
+}
+
+// authorizeSecret 解析请求的命名空间并校验调用方在该命名空间上的权限
+func (s *SchedulerService) authorizeSecret(ctx context.Context, perm biz.Permission, requested string) (string, error) {
+	namespace, err := biz.ResolveNamespace(ctx, requested)
+	if err != nil {
+		return "", err
+	}
+	if err := s.authz.CheckNamespace(ctx, perm, namespace); err != nil {
+		return "", err
+	}
+	return namespace, nil
+}
+
+// toSecretReply 转换为 SecretReply，不包括值
+func toSecretReply(secret *biz.Secret) *pb.SecretReply {
+	return &pb.SecretReply{
+		Id:          secret.ID,
+		Namespace:   secret.Namespace,
+		Name:        secret.Name,
+		Description: secret.Description,
+		Version:     secret.Version,
+		KeyId:       secret.Value.KeyID,
+		CreatedAt:   timestamppb.New(secret.CreatedAt),
+		UpdatedAt:   timestamppb.New(secret.UpdatedAt),
+	}
+}